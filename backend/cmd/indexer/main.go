@@ -0,0 +1,239 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+
+	"github.com/pitchone/sportsbook/internal/indexer"
+	"github.com/pitchone/sportsbook/pkg/db"
+)
+
+func main() {
+	// 初始化日志
+	logger, err := initLogger()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to initialize logger: %v\n", err)
+		os.Exit(1)
+	}
+	defer logger.Sync()
+
+	// 加载配置
+	if err := loadConfig(); err != nil {
+		logger.Fatal("failed to load config", zap.Error(err))
+	}
+
+	// 创建 Indexer 配置
+	cfg, err := buildIndexerConfig()
+	if err != nil {
+		logger.Fatal("failed to build indexer config", zap.Error(err))
+	}
+
+	// 创建上下文和信号处理
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	// 连接数据库
+	dbClient, err := db.NewClient(buildDBConfig(), logger)
+	if err != nil {
+		logger.Fatal("failed to connect database", zap.Error(err))
+	}
+	defer dbClient.Close()
+
+	store := indexer.NewPostgresStore(dbClient.DB())
+	if err := store.CheckSchema(ctx); err != nil {
+		logger.Fatal("database schema not ready", zap.Error(err))
+	}
+
+	// 连接 RPC
+	rpcURL := viper.GetString("indexer.rpc_url")
+	client, err := ethclient.DialContext(ctx, rpcURL)
+	if err != nil {
+		logger.Fatal("failed to connect rpc", zap.String("rpc_url", rpcURL), zap.Error(err))
+	}
+	defer client.Close()
+
+	idx, err := indexer.New(client, store, cfg, logger)
+	if err != nil {
+		logger.Fatal("failed to create indexer", zap.Error(err))
+	}
+
+	// 启动 Prometheus 指标服务
+	if port := viper.GetInt("indexer.metrics_port"); port > 0 {
+		go func() {
+			mux := http.NewServeMux()
+			mux.Handle("/metrics", promhttp.Handler())
+			if err := http.ListenAndServe(fmt.Sprintf(":%d", port), mux); err != nil {
+				logger.Error("metrics server failed", zap.Error(err))
+			}
+		}()
+	}
+
+	logger.Info("indexer started",
+		zap.String("factory", cfg.Factory.Hex()),
+		zap.Uint64("start_block", cfg.StartBlock),
+		zap.Uint64("confirmations", cfg.Confirmations),
+		zap.Duration("poll_interval", cfg.PollInterval),
+	)
+
+	// 运行直到收到信号
+	if err := idx.Run(ctx); err != nil && !errors.Is(err, context.Canceled) {
+		logger.Error("indexer stopped", zap.Error(err))
+	}
+	logger.Info("shutdown complete")
+}
+
+// initLogger 初始化日志器
+func initLogger() (*zap.Logger, error) {
+	env := viper.GetString("environment")
+	level := viper.GetString("logging.level")
+	format := viper.GetString("logging.format")
+
+	var config zap.Config
+	if env == "production" {
+		config = zap.NewProductionConfig()
+	} else {
+		config = zap.NewDevelopmentConfig()
+	}
+
+	// 设置日志级别
+	switch level {
+	case "debug":
+		config.Level = zap.NewAtomicLevelAt(zap.DebugLevel)
+	case "warn":
+		config.Level = zap.NewAtomicLevelAt(zap.WarnLevel)
+	case "error":
+		config.Level = zap.NewAtomicLevelAt(zap.ErrorLevel)
+	default:
+		config.Level = zap.NewAtomicLevelAt(zap.InfoLevel)
+	}
+
+	// 设置输出格式
+	if format == "json" {
+		config.Encoding = "json"
+	} else {
+		config.Encoding = "console"
+	}
+
+	return config.Build()
+}
+
+// loadConfig 加载配置文件
+func loadConfig() error {
+	viper.SetConfigName("config")
+	viper.SetConfigType("yaml")
+	viper.AddConfigPath(".")
+	viper.AddConfigPath("../")
+	viper.AddConfigPath("../../")
+	viper.AddConfigPath("../../..")
+
+	// 环境变量绑定
+	viper.AutomaticEnv()
+	viper.SetEnvPrefix("SPORTSBOOK")
+
+	// 设置环境变量键名替换规则（将 . 替换为 _）
+	replacer := strings.NewReplacer(".", "_")
+	viper.SetEnvKeyReplacer(replacer)
+
+	// 显式绑定必需的环境变量（解决嵌套配置问题）
+	// indexer.* 配置项
+	viper.BindEnv("indexer.rpc_url")
+	viper.BindEnv("indexer.contracts.factory")
+	viper.BindEnv("indexer.start_block")
+	viper.BindEnv("indexer.batch_size")
+	viper.BindEnv("indexer.finality_blocks")
+	viper.BindEnv("indexer.reorg_window")
+	viper.BindEnv("indexer.polling_interval")
+	viper.BindEnv("indexer.metrics_port")
+
+	// database.* 配置项
+	viper.BindEnv("database.host")
+	viper.BindEnv("database.port")
+	viper.BindEnv("database.user")
+	viper.BindEnv("database.password")
+	viper.BindEnv("database.dbname")
+	viper.BindEnv("database.sslmode")
+
+	// logging.* 配置项
+	viper.BindEnv("logging.level")
+	viper.BindEnv("logging.format")
+
+	// 其他配置项
+	viper.BindEnv("environment")
+
+	// 默认值
+	viper.SetDefault("indexer.finality_blocks", indexer.DefaultConfirmations)
+	viper.SetDefault("indexer.reorg_window", indexer.DefaultReorgWindow)
+	viper.SetDefault("indexer.polling_interval", indexer.DefaultPollInterval)
+	viper.SetDefault("indexer.metrics_port", 9091)
+	viper.SetDefault("database.port", 5432)
+	viper.SetDefault("database.sslmode", "disable")
+	viper.SetDefault("database.max_open_conns", 10)
+	viper.SetDefault("database.max_idle_conns", 2)
+	viper.SetDefault("database.conn_max_lifetime", 5*time.Minute)
+
+	if err := viper.ReadInConfig(); err != nil {
+		// 配置文件不存在不是致命错误，可以完全依赖环境变量
+		if _, ok := err.(viper.ConfigFileNotFoundError); !ok {
+			return fmt.Errorf("failed to read config: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// buildIndexerConfig 构建 Indexer 配置
+func buildIndexerConfig() (indexer.Config, error) {
+	cfg := indexer.Config{
+		StartBlock:    viper.GetUint64("indexer.start_block"),
+		Confirmations: viper.GetUint64("indexer.finality_blocks"),
+		ChunkSize:     viper.GetUint64("indexer.batch_size"),
+		ReorgWindow:   viper.GetUint64("indexer.reorg_window"),
+		PollInterval:  viper.GetDuration("indexer.polling_interval"),
+	}
+
+	// 验证必需配置
+	if viper.GetString("indexer.rpc_url") == "" {
+		return cfg, fmt.Errorf("indexer.rpc_url is required")
+	}
+	factory := viper.GetString("indexer.contracts.factory")
+	if !common.IsHexAddress(factory) {
+		return cfg, fmt.Errorf("indexer.contracts.factory is required")
+	}
+	cfg.Factory = common.HexToAddress(factory)
+
+	// 重组检测需要覆盖确认区块数
+	if cfg.ReorgWindow <= cfg.Confirmations {
+		return cfg, fmt.Errorf("indexer.reorg_window (%d) must be greater than finality_blocks (%d)", cfg.ReorgWindow, cfg.Confirmations)
+	}
+
+	return cfg, nil
+}
+
+// buildDBConfig 构建数据库配置
+func buildDBConfig() db.Config {
+	return db.Config{
+		Host:            viper.GetString("database.host"),
+		Port:            viper.GetInt("database.port"),
+		User:            viper.GetString("database.user"),
+		Password:        viper.GetString("database.password"),
+		DBName:          viper.GetString("database.dbname"),
+		SSLMode:         viper.GetString("database.sslmode"),
+		MaxOpenConns:    viper.GetInt("database.max_open_conns"),
+		MaxIdleConns:    viper.GetInt("database.max_idle_conns"),
+		ConnMaxLifetime: viper.GetDuration("database.conn_max_lifetime"),
+		QueryTimeout:    viper.GetDuration("database.query_timeout"),
+	}
+}
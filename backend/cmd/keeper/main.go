@@ -66,6 +66,27 @@ func main() {
 	settleTask := keeper.NewSettleTask(k, resultProvider)
 	scheduler.RegisterTask("settle", settleTask, taskInterval)
 
+	// 注册串关结算任务（可选）
+	if cfg.Parlay.Enabled {
+		parlayTask, err := keeper.NewParlayTask(k, cfg.Parlay)
+		if err != nil {
+			logger.Fatal("failed to create parlay task", zap.Error(err))
+		}
+		scheduler.RegisterTask("parlay", parlayTask, time.Duration(cfg.Parlay.TaskInterval)*time.Second)
+	}
+
+	// 注册相关性规则同步任务（可选）
+	if cfg.Correlation.Enabled {
+		correlationTask, err := keeper.NewCorrelationTask(k, cfg.Correlation)
+		if err != nil {
+			logger.Fatal("failed to create correlation task", zap.Error(err))
+		}
+		scheduler.RegisterTask("correlation", correlationTask, time.Duration(cfg.Correlation.TaskInterval)*time.Second)
+
+		// 收到 SIGHUP 时重新读取策略表，下一轮任务按新策略重算全部规则
+		go reloadCorrelationPolicies(correlationTask, logger)
+	}
+
 	logger.Info("keeper initialized successfully",
 		zap.Int64("chain_id", cfg.ChainID),
 		zap.Duration("task_interval", taskInterval),
@@ -80,13 +101,28 @@ func main() {
 	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
 
 	// 在单独的 goroutine 中启动调度器
-	errChan := make(chan error, 1)
+	errChan := make(chan error, 2)
 	go func() {
 		if err := scheduler.Start(ctx); err != nil {
 			errChan <- err
 		}
 	}()
 
+	// 启动 Keeper 自带的 Prometheus 指标服务
+	if cfg.MetricsPort > 0 {
+		go k.ServeMetrics(ctx)
+	}
+
+	// 启动运维管理 API（可选）
+	if cfg.Admin.Enabled {
+		adminServer := keeper.NewAdminServer(k, scheduler, settleTask)
+		go func() {
+			if err := adminServer.Run(ctx); err != nil {
+				errChan <- err
+			}
+		}()
+	}
+
 	logger.Info("keeper started successfully")
 
 	// 等待信号或错误
@@ -105,6 +141,29 @@ func main() {
 	logger.Info("shutdown complete")
 }
 
+// reloadCorrelationPolicies 在每次收到 SIGHUP 时重新加载配置文件中的相关性策略表
+func reloadCorrelationPolicies(task *keeper.CorrelationTask, logger *zap.Logger) {
+	hupChan := make(chan os.Signal, 1)
+	signal.Notify(hupChan, syscall.SIGHUP)
+
+	for range hupChan {
+		if err := viper.ReadInConfig(); err != nil {
+			logger.Error("failed to reload config", zap.Error(err))
+			continue
+		}
+		var policies []keeper.CorrelationPolicy
+		if err := viper.UnmarshalKey("keeper.correlation.policies", &policies); err != nil {
+			logger.Error("invalid correlation policies", zap.Error(err))
+			continue
+		}
+		if err := task.SetPolicies(policies); err != nil {
+			logger.Error("rejected correlation policies", zap.Error(err))
+			continue
+		}
+		logger.Info("correlation policies reloaded", zap.Int("policies", len(policies)))
+	}
+}
+
 // initLogger 初始化日志器
 func initLogger() (*zap.Logger, error) {
 	env := viper.GetString("environment")
@@ -172,10 +231,28 @@ func loadConfig() error {
 	viper.BindEnv("keeper.max_concurrent")
 	viper.BindEnv("keeper.retry_attempts")
 	viper.BindEnv("keeper.retry_delay")
+	viper.BindEnv("keeper.confirmation_depth")
+	viper.BindEnv("keeper.confirmation_timeout")
 	viper.BindEnv("keeper.database_url")
 	viper.BindEnv("keeper.health_check_port")
 	viper.BindEnv("keeper.metrics_port")
 	viper.BindEnv("keeper.alerts_enabled")
+	viper.BindEnv("keeper.result_overrides")
+	viper.BindEnv("keeper.admin.enabled")
+	viper.BindEnv("keeper.admin.bind_address")
+	viper.BindEnv("keeper.admin.port")
+	viper.BindEnv("keeper.admin.audit_log_path")
+	viper.BindEnv("keeper.parlay.enabled")
+	viper.BindEnv("keeper.parlay.basket_address")
+	viper.BindEnv("keeper.parlay.task_interval")
+	viper.BindEnv("keeper.parlay.max_batch_gas")
+	viper.BindEnv("keeper.parlay.gas_per_parlay")
+	viper.BindEnv("keeper.parlay.gas_per_leg")
+	viper.BindEnv("keeper.correlation.enabled")
+	viper.BindEnv("keeper.correlation.guard_address")
+	viper.BindEnv("keeper.correlation.task_interval")
+	viper.BindEnv("keeper.correlation.max_rules_per_tx")
+	viper.BindEnv("keeper.correlation.refresh_interval")
 
 	// sportradar.* 配置项
 	viper.BindEnv("sportradar.api_key")
@@ -203,21 +280,51 @@ func loadConfig() error {
 // buildKeeperConfig 构建 Keeper 配置
 func buildKeeperConfig() (*keeper.Config, error) {
 	cfg := &keeper.Config{
-		ChainID:         int64(viper.GetInt("keeper.chain_id")),
-		RPCEndpoint:     viper.GetString("keeper.rpc_endpoint"),
-		PrivateKey:      viper.GetString("keeper.private_key"),
-		GasLimit:        viper.GetUint64("keeper.gas_limit"),
-		MaxGasPrice:     viper.GetString("keeper.max_gas_price"),
-		TaskInterval:    viper.GetInt("keeper.task_interval"),
-		LockLeadTime:    viper.GetInt("keeper.lock_lead_time"),
-		FinalizeDelay:   viper.GetInt("keeper.finalize_delay"),
-		MaxConcurrent:   viper.GetInt("keeper.max_concurrent"),
-		RetryAttempts:   viper.GetInt("keeper.retry_attempts"),
-		RetryDelay:      viper.GetInt("keeper.retry_delay"),
-		DatabaseURL:     viper.GetString("keeper.database_url"),
-		HealthCheckPort: viper.GetInt("keeper.health_check_port"),
-		MetricsPort:     viper.GetInt("keeper.metrics_port"),
-		AlertsEnabled:   viper.GetBool("keeper.alerts_enabled"),
+		ChainID:             int64(viper.GetInt("keeper.chain_id")),
+		RPCEndpoint:         viper.GetString("keeper.rpc_endpoint"),
+		PrivateKey:          viper.GetString("keeper.private_key"),
+		GasLimit:            viper.GetUint64("keeper.gas_limit"),
+		MaxGasPrice:         viper.GetString("keeper.max_gas_price"),
+		TaskInterval:        viper.GetInt("keeper.task_interval"),
+		LockLeadTime:        viper.GetInt("keeper.lock_lead_time"),
+		FinalizeDelay:       viper.GetInt("keeper.finalize_delay"),
+		MaxConcurrent:       viper.GetInt("keeper.max_concurrent"),
+		RetryAttempts:       viper.GetInt("keeper.retry_attempts"),
+		RetryDelay:          viper.GetInt("keeper.retry_delay"),
+		ConfirmationDepth:   viper.GetInt("keeper.confirmation_depth"),
+		ConfirmationTimeout: viper.GetInt("keeper.confirmation_timeout"),
+		DatabaseURL:         viper.GetString("keeper.database_url"),
+		HealthCheckPort:     viper.GetInt("keeper.health_check_port"),
+		MetricsPort:         viper.GetInt("keeper.metrics_port"),
+		AlertsEnabled:       viper.GetBool("keeper.alerts_enabled"),
+		ResultOverrides:     viper.GetBool("keeper.result_overrides"),
+		Admin: keeper.AdminConfig{
+			Enabled:      viper.GetBool("keeper.admin.enabled"),
+			BindAddress:  viper.GetString("keeper.admin.bind_address"),
+			Port:         viper.GetInt("keeper.admin.port"),
+			Tokens:       viper.GetStringMapString("keeper.admin.tokens"),
+			AuditLogPath: viper.GetString("keeper.admin.audit_log_path"),
+		},
+		Parlay: keeper.ParlayConfig{
+			Enabled:       viper.GetBool("keeper.parlay.enabled"),
+			BasketAddress: viper.GetString("keeper.parlay.basket_address"),
+			TaskInterval:  viper.GetInt("keeper.parlay.task_interval"),
+			MaxBatchGas:   viper.GetUint64("keeper.parlay.max_batch_gas"),
+			GasPerParlay:  viper.GetUint64("keeper.parlay.gas_per_parlay"),
+			GasPerLeg:     viper.GetUint64("keeper.parlay.gas_per_leg"),
+		},
+		Correlation: keeper.CorrelationConfig{
+			Enabled:         viper.GetBool("keeper.correlation.enabled"),
+			GuardAddress:    viper.GetString("keeper.correlation.guard_address"),
+			TaskInterval:    viper.GetInt("keeper.correlation.task_interval"),
+			MaxRulesPerTx:   viper.GetInt("keeper.correlation.max_rules_per_tx"),
+			RefreshInterval: viper.GetInt("keeper.correlation.refresh_interval"),
+		},
+	}
+
+	// 相关性策略表
+	if err := viper.UnmarshalKey("keeper.correlation.policies", &cfg.Correlation.Policies); err != nil {
+		return nil, fmt.Errorf("invalid correlation policies: %w", err)
 	}
 
 	// 验证必需配置
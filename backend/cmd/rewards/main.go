@@ -8,10 +8,16 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/pitchone/sportsbook/internal/graphql"
+	"github.com/pitchone/sportsbook/internal/repository"
 	"github.com/pitchone/sportsbook/internal/rewards"
 	_ "github.com/lib/pq"
 )
@@ -26,11 +32,54 @@ type Config struct {
 	Week             uint64
 	DryRun           bool
 	OutputFile       string
+	PayoutScalerAddr string
+	BudgetWarningBps uint64
+	TradingPolicy    string
+	CampaignAddr     string
+	CampaignRules    string
+	SafeTxFile       string
+	SafeAddr         string
+	Mode             string
+	CumulativeOutput string
+	EpochsFile       string
 }
 
 func main() {
+	// export 子命令：从数据库重新导出已保存的分配数据
+	if len(os.Args) > 1 && os.Args[1] == "export" {
+		runExport(os.Args[2:])
+		return
+	}
+
+	// verify 子命令：在模拟 EVM 上用 RewardsDistributor 校验已导出的分配数据
+	if len(os.Args) > 1 && os.Args[1] == "verify" {
+		runVerify(os.Args[2:])
+		return
+	}
+
+	// explain 子命令：输出每个用户交易奖励的计算明细
+	if len(os.Args) > 1 && os.Args[1] == "explain" {
+		runExplain(os.Args[2:])
+		return
+	}
+
+	// migrate-cumulative 子命令：将已保存的周度分配合并为首个累计分配
+	if len(os.Args) > 1 && os.Args[1] == "migrate-cumulative" {
+		runMigrateCumulative(os.Args[2:])
+		return
+	}
+
+	// serve 子命令：提供用户奖励证明查询 HTTP 服务
+	if len(os.Args) > 1 && os.Args[1] == "serve" {
+		runServe(os.Args[2:])
+		return
+	}
+
 	// 解析命令行参数
 	config := parseFlags()
+	if config.Mode != rewards.DistributionModeWeekly && config.Mode != rewards.DistributionModeCumulative {
+		log.Fatalf("--mode must be %q or %q", rewards.DistributionModeWeekly, rewards.DistributionModeCumulative)
+	}
 
 	// 连接数据库（仅用于 reward_distributions 表）
 	db, err := sql.Open("postgres", config.DatabaseURL)
@@ -60,37 +109,90 @@ func main() {
 
 	// 创建聚合器
 	aggregator := rewards.NewAggregator(graphClient, db)
+	calendar := loadEpochCalendar(config.EpochsFile)
+	aggregator.SetEpochCalendar(calendar)
+	if config.TradingPolicy != "" {
+		policy, err := rewards.LoadTradingPolicy(config.TradingPolicy)
+		if err != nil {
+			log.Fatalf("Failed to load trading policy: %v", err)
+		}
+		aggregator.SetTradingPolicy(policy)
+		log.Printf("Loaded trading reward policy from %s", config.TradingPolicy)
+	}
 
-	// 确定要处理的周
+	// 活动参与奖励（配置 Campaign 合约时以链上剩余预算封顶，并在发布后记录支出）
+	var campaignLedger *rewards.CampaignLedger
+	if config.CampaignRules != "" {
+		rules, err := rewards.LoadCampaignRules(config.CampaignRules)
+		if err != nil {
+			log.Fatalf("Failed to load campaign rules: %v", err)
+		}
+		var campaignBudget rewards.CampaignBudgetReader
+		if config.CampaignAddr != "" {
+			if config.RPCURL == "" {
+				log.Fatal("--rpc-url is required when --campaign is set")
+			}
+			campaignLedger, err = rewards.NewCampaignLedger(config.RPCURL, common.HexToAddress(config.CampaignAddr), config.PrivateKey)
+			if err != nil {
+				log.Fatalf("Failed to create campaign ledger: %v", err)
+			}
+			defer campaignLedger.Close()
+			campaignBudget = campaignLedger
+		}
+		aggregator.SetCampaignRules(rules, campaignBudget)
+		log.Printf("Loaded %d campaign reward rules from %s", len(rules.Campaigns), config.CampaignRules)
+	}
+
+	// 确定要处理的周期（周期 ID 同时作为 RewardsDistributor 的 week 参数）
 	week := config.Week
 	if week == 0 {
-		week = rewards.GetCurrentWeek() - 1 // 默认处理上一周
-		log.Printf("Auto-detected previous week: %d", week)
+		week = calendar.Previous() // 默认处理上一个已结束的周期
+		log.Printf("Auto-detected previous epoch: %d", week)
 	}
+	weekStart, weekEnd := calendar.Range(week)
+	log.Printf("Epoch %d: %s - %s", week, weekStart.Format(time.RFC3339), weekEnd.Format(time.RFC3339))
 
 	log.Printf("Building rewards distribution for week %d", week)
 
-	// 聚合奖励数据
-	entries, err := aggregator.AggregateWeeklyRewards(ctx, week)
-	if err != nil {
-		log.Fatalf("Failed to aggregate rewards: %v", err)
+	// 预算缩放（配置 PayoutScaler 时按各预算池可用预算缩放各类别奖励）
+	var budget *rewards.BudgetScaler
+	if config.PayoutScalerAddr != "" {
+		if config.RPCURL == "" {
+			log.Fatal("--rpc-url is required when --payout-scaler is set")
+		}
+		budget, err = rewards.NewBudgetScaler(config.RPCURL, common.HexToAddress(config.PayoutScalerAddr), config.PrivateKey, nil)
+		if err != nil {
+			log.Fatalf("Failed to create budget scaler: %v", err)
+		}
+		defer budget.Close()
+	} else {
+		log.Printf("No PayoutScaler configured - rewards will not be budget-scaled")
 	}
 
-	log.Printf("Aggregated %d reward entries", len(entries))
+	// 聚合奖励数据并构建 Merkle 分配
+	distribution, plan, err := aggregator.BuildWeeklyDistribution(ctx, week, budget)
+	if err != nil {
+		log.Fatalf("Failed to build distribution: %v", err)
+	}
 
-	if len(entries) == 0 {
+	if distribution == nil {
 		log.Printf("No rewards to distribute for week %d", week)
 		return
 	}
 
-	// 计算缩放比例（这里简化，默认100%）
-	scaleBps := uint64(10000)
-	// TODO: 从合约查询可用预算并计算实际缩放比例
+	log.Printf("Aggregated %d reward entries", len(distribution.Entries))
 
-	// 构建 Merkle 分配
-	distribution, err := rewards.BuildDistribution(week, entries, scaleBps)
-	if err != nil {
-		log.Fatalf("Failed to build distribution: %v", err)
+	for _, pool := range plan.Pools {
+		log.Printf("Budget pool %s: requested %s, available %s, scale %d bps (%.2f%%)",
+			pool.Pool, pool.Requested, pool.Available, pool.ScaleBps, float64(pool.ScaleBps)/100)
+		if pool.ScaleBps < config.BudgetWarningBps {
+			log.Printf("⚠️  Budget warning: %s rewards scaled below %d bps", pool.Pool, config.BudgetWarningBps)
+		}
+	}
+
+	for _, payout := range plan.Campaigns {
+		log.Printf("Campaign %s (%s, %s): %d participants, requested %s, remaining %s (unrecorded %s), paid %s",
+			payout.Name, payout.CampaignID, payout.Mode, payout.Participants, payout.Requested, payout.Remaining, payout.Unrecorded, payout.Paid)
 	}
 
 	distribution.CreatedAt = time.Now().Unix()
@@ -101,12 +203,18 @@ func main() {
 	log.Printf("Checksum: %s", distribution.Checksum())
 
 	// 保存分配数据到数据库
-	if err := aggregator.SaveDistribution(ctx, distribution); err != nil {
+	if err := aggregator.SaveDistribution(ctx, distribution, plan); err != nil {
 		log.Fatalf("Failed to save distribution: %v", err)
 	}
 
 	log.Printf("Distribution saved to database")
 
+	// 累计模式：额外在上一 epoch 的累计总额上加入本周金额，保存累计快照。
+	// 目前没有累计分发合约，周度 Root 仍照常发布，是唯一的链上领取方式
+	if config.Mode == rewards.DistributionModeCumulative {
+		runCumulative(ctx, aggregator, distribution, config)
+	}
+
 	// 导出到文件
 	if config.OutputFile != "" {
 		if err := exportDistribution(distribution, config.OutputFile); err != nil {
@@ -122,23 +230,71 @@ func main() {
 		return
 	}
 
-	// 发布到链上
-	if config.RPCURL == "" || config.DistributorAddr == "" || config.PrivateKey == "" {
+	// 发布到链上（导出 Safe 交易时不需要私钥）
+	if config.RPCURL == "" || config.DistributorAddr == "" || (config.PrivateKey == "" && config.SafeTxFile == "") {
 		log.Printf("Missing RPC/Distributor/PrivateKey config - skipping on-chain publication")
-		log.Printf("Use --rpc-url, --distributor, --private-key to enable on-chain publication")
+		log.Printf("Use --rpc-url, --distributor, --private-key (or --safe-tx) to enable on-chain publication")
 		return
 	}
 
-	log.Printf("Publishing to chain...")
-
 	publisher, err := rewards.NewPublisher(config.RPCURL, common.HexToAddress(config.DistributorAddr), config.PrivateKey)
 	if err != nil {
 		log.Fatalf("Failed to create publisher: %v", err)
 	}
 	defer publisher.Close()
 
+	// 幂等检查：该周已发布相同 Root 时跳过，Root 不同时中止
+	published, err := publisher.CheckPublished(ctx, distribution)
+	if err != nil {
+		log.Fatalf("Failed to check published root: %v", err)
+	}
+	if published {
+		log.Printf("Week %d root %s already published on-chain - skipping publication", week, distribution.Root)
+		if err := aggregator.MarkPublished(ctx, week, common.Hash{}, 0); err != nil {
+			log.Fatalf("Failed to mark distribution published: %v", err)
+		}
+		recordCampaignSpending(ctx, aggregator, campaignLedger)
+		return
+	}
+
+	// 发布或导出前通过 calculateScale 锁定预算；Root 上链后由 keeper 对已锁定的周调用 markBudgetUsed
+	if budget != nil {
+		log.Printf("Reserving budget on PayoutScaler...")
+		if err := budget.Reserve(ctx, plan); err != nil {
+			log.Fatalf("Failed to reserve budget: %v", err)
+		}
+		if err := aggregator.MarkBudgetReserved(ctx, week); err != nil {
+			log.Fatalf("Failed to record budget reservation: %v", err)
+		}
+	}
+
+	// 多签模式：导出未签名的 Safe 交易，由金库执行
+	if config.SafeTxFile != "" {
+		var safe common.Address
+		if config.SafeAddr != "" {
+			safe = common.HexToAddress(config.SafeAddr)
+		}
+		batch, err := publisher.SafeTransaction(distribution, safe)
+		if err != nil {
+			log.Fatalf("Failed to build Safe transaction: %v", err)
+		}
+		file, err := os.Create(config.SafeTxFile)
+		if err != nil {
+			log.Fatalf("Failed to create %s: %v", config.SafeTxFile, err)
+		}
+		defer file.Close()
+		if err := rewards.WriteSafeBatch(file, batch); err != nil {
+			log.Fatalf("Failed to export Safe transaction: %v", err)
+		}
+		log.Printf("✅ publishRoot exported as Safe transaction to %s", config.SafeTxFile)
+		return
+	}
+
+	log.Printf("Publishing to chain...")
+
 	tx, err := publisher.PublishRoot(ctx, distribution)
 	if err != nil {
+		markPublishFailed(ctx, aggregator, week, err)
 		log.Fatalf("Failed to publish root: %v", err)
 	}
 
@@ -148,12 +304,18 @@ func main() {
 	log.Printf("Waiting for confirmation...")
 	receipt, err := publisher.WaitForConfirmation(ctx, tx, 3) // 等待3个确认
 	if err != nil {
+		markPublishFailed(ctx, aggregator, week, err)
 		log.Fatalf("Transaction failed: %v", err)
 	}
 
 	log.Printf("✅ Transaction confirmed in block %d", receipt.BlockNumber.Uint64())
 	log.Printf("Gas used: %d", receipt.GasUsed)
 
+	if err := publisher.VerifyReceipt(receipt, distribution); err != nil {
+		log.Fatalf("❌ RewardsRootPublished check failed: %v", err)
+	}
+	log.Printf("✅ RewardsRootPublished event matches distribution")
+
 	// 验证链上数据
 	publishedRoot, err := publisher.GetPublishedRoot(ctx, week)
 	if err != nil {
@@ -164,9 +326,47 @@ func main() {
 		log.Printf("✅ Root verified on-chain: %s", publishedRoot.Hex())
 	}
 
+	// 发布后扣减预算
+	if budget != nil {
+		if err := budget.MarkUsed(ctx, plan); err != nil {
+			log.Fatalf("Failed to mark budget used: %v", err)
+		}
+		if err := aggregator.MarkBudgetUsed(ctx, week); err != nil {
+			log.Fatalf("Failed to record budget usage: %v", err)
+		}
+		log.Printf("✅ Budget marked as used on PayoutScaler")
+	}
+
+	// 标记已发布后该周的活动扣减才会被记录
+	if err := aggregator.MarkPublished(ctx, week, tx.Hash(), receipt.BlockNumber.Uint64()); err != nil {
+		log.Fatalf("Failed to mark distribution published: %v", err)
+	}
+
+	// 发布后扣减活动剩余预算
+	recordCampaignSpending(ctx, aggregator, campaignLedger)
+
 	log.Printf("🎉 Rewards distribution for week %d completed successfully!", week)
 }
 
+// markPublishFailed 记录发布失败的原因，已保存的分配在下次运行时重新发布
+func markPublishFailed(ctx context.Context, aggregator *rewards.Aggregator, week uint64, reason error) {
+	if err := aggregator.MarkPublishFailed(ctx, week, reason); err != nil {
+		log.Printf("Warning: Failed to save publish status: %v", err)
+	}
+}
+
+// recordCampaignSpending 记录已发布周的活动扣减（包括之前运行失败的），失败的条目保留在数据库中，下次运行时重试
+func recordCampaignSpending(ctx context.Context, aggregator *rewards.Aggregator, campaignLedger *rewards.CampaignLedger) {
+	if campaignLedger == nil {
+		return
+	}
+	recorded, err := aggregator.RecordCampaignSpending(ctx, campaignLedger)
+	if err != nil {
+		log.Fatalf("Failed to record campaign spending (%d recorded, the rest will be retried on the next run): %v", recorded, err)
+	}
+	log.Printf("✅ Campaign spending recorded on Campaign contract (%d entries)", recorded)
+}
+
 func parseFlags() *Config {
 	config := &Config{}
 
@@ -175,9 +375,19 @@ func parseFlags() *Config {
 	flag.StringVar(&config.RPCURL, "rpc-url", os.Getenv("RPC_URL"), "Ethereum RPC URL (env: RPC_URL)")
 	flag.StringVar(&config.DistributorAddr, "distributor", os.Getenv("REWARDS_DISTRIBUTOR_ADDR"), "RewardsDistributor contract address")
 	flag.StringVar(&config.PrivateKey, "private-key", os.Getenv("PRIVATE_KEY"), "Private key for signing transactions")
-	flag.Uint64Var(&config.Week, "week", 0, "Week number (default: current week - 1)")
+	flag.Uint64Var(&config.Week, "week", 0, "Epoch number (default: last completed epoch)")
 	flag.BoolVar(&config.DryRun, "dry-run", false, "Dry run mode (don't publish to chain)")
-	flag.StringVar(&config.OutputFile, "output", "", "Output file for distribution JSON")
+	flag.StringVar(&config.OutputFile, "output", "", "Output file for distribution (.json or .csv)")
+	flag.StringVar(&config.CumulativeOutput, "cumulative-output", "", "Output file for the cumulative snapshot in cumulative mode (.json)")
+	flag.StringVar(&config.PayoutScalerAddr, "payout-scaler", os.Getenv("PAYOUT_SCALER_ADDR"), "PayoutScaler contract address for budget scaling")
+	flag.Uint64Var(&config.BudgetWarningBps, "budget-warning-bps", 8000, "Warn when a budget pool scales rewards below this many bps")
+	flag.StringVar(&config.TradingPolicy, "trading-policy", os.Getenv("TRADING_POLICY_FILE"), "Trading reward policy file, YAML or JSON (env: TRADING_POLICY_FILE)")
+	flag.StringVar(&config.CampaignAddr, "campaign", os.Getenv("CAMPAIGN_ADDR"), "Campaign contract address for budget caps and spending (env: CAMPAIGN_ADDR)")
+	flag.StringVar(&config.CampaignRules, "campaign-rules", os.Getenv("CAMPAIGN_RULES_FILE"), "Campaign reward rules file, YAML or JSON (env: CAMPAIGN_RULES_FILE)")
+	flag.StringVar(&config.Mode, "mode", getEnvOrDefault("REWARDS_MODE", rewards.DistributionModeWeekly), "Distribution mode: weekly, or cumulative to also save a cumulative snapshot (env: REWARDS_MODE)")
+	flag.StringVar(&config.EpochsFile, "epochs", os.Getenv("REWARDS_EPOCHS_FILE"), "Reward epoch calendar file, YAML or JSON (env: REWARDS_EPOCHS_FILE)")
+	flag.StringVar(&config.SafeTxFile, "safe-tx", "", "Export publishRoot as an unsigned Safe Transaction Builder JSON file instead of sending it")
+	flag.StringVar(&config.SafeAddr, "safe", os.Getenv("REWARDS_SAFE_ADDR"), "Safe that will execute the exported transaction (env: REWARDS_SAFE_ADDR)")
 
 	flag.Parse()
 
@@ -188,6 +398,203 @@ func parseFlags() *Config {
 	return config
 }
 
+// runExport 从数据库加载指定周的分配数据并导出（格式与生成时 --output 一致）
+func runExport(args []string) {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+	databaseURL := fs.String("db", os.Getenv("DATABASE_URL"), "Database URL (env: DATABASE_URL)")
+	week := fs.Uint64("week", 0, "Epoch number (default: last completed epoch)")
+	output := fs.String("output", "", "Output file (.json or .csv)")
+	epochsFile := fs.String("epochs", os.Getenv("REWARDS_EPOCHS_FILE"), "Reward epoch calendar file, YAML or JSON (env: REWARDS_EPOCHS_FILE)")
+	fs.Parse(args)
+
+	if *databaseURL == "" {
+		log.Fatal("DATABASE_URL is required")
+	}
+	if *output == "" {
+		log.Fatal("--output is required")
+	}
+	if *week == 0 {
+		*week = loadEpochCalendar(*epochsFile).Previous()
+	}
+
+	db, err := sql.Open("postgres", *databaseURL)
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer db.Close()
+
+	aggregator := rewards.NewAggregator(nil, db)
+	distribution, err := aggregator.GetDistribution(context.Background(), *week)
+	if err != nil {
+		log.Fatalf("Failed to load distribution: %v", err)
+	}
+
+	if err := exportDistribution(distribution, *output); err != nil {
+		log.Fatalf("Failed to export distribution: %v", err)
+	}
+
+	log.Printf("✅ Week %d distribution (%d entries, root %s) exported to %s",
+		distribution.Week, len(distribution.Entries), distribution.Root, *output)
+}
+
+// runExplain 按交易奖励策略计算指定周的交易奖励，输出每个用户的计算明细（JSON）
+func runExplain(args []string) {
+	fs := flag.NewFlagSet("explain", flag.ExitOnError)
+	subgraph := fs.String("subgraph", getEnvOrDefault("SUBGRAPH_ENDPOINT", "http://localhost:8010/subgraphs/name/pitchone-sportsbook"), "Subgraph GraphQL endpoint (env: SUBGRAPH_ENDPOINT)")
+	policyFile := fs.String("trading-policy", os.Getenv("TRADING_POLICY_FILE"), "Trading reward policy file, YAML or JSON (env: TRADING_POLICY_FILE)")
+	week := fs.Uint64("week", 0, "Epoch number (default: last completed epoch)")
+	user := fs.String("user", "", "Only explain this address")
+	epochsFile := fs.String("epochs", os.Getenv("REWARDS_EPOCHS_FILE"), "Reward epoch calendar file, YAML or JSON (env: REWARDS_EPOCHS_FILE)")
+	fs.Parse(args)
+
+	calendar := loadEpochCalendar(*epochsFile)
+	if *week == 0 {
+		*week = calendar.Previous()
+	}
+	if *user != "" && !common.IsHexAddress(*user) {
+		log.Fatalf("Invalid --user address: %s", *user)
+	}
+
+	aggregator := rewards.NewAggregator(graphql.NewClient(*subgraph), nil)
+	aggregator.SetEpochCalendar(calendar)
+	policy := rewards.DefaultTradingPolicy()
+	if *policyFile != "" {
+		var err error
+		if policy, err = rewards.LoadTradingPolicy(*policyFile); err != nil {
+			log.Fatalf("Failed to load trading policy: %v", err)
+		}
+		aggregator.SetTradingPolicy(policy)
+	}
+
+	explanations, err := aggregator.ExplainTradingRewards(context.Background(), *week)
+	if err != nil {
+		log.Fatalf("Failed to explain trading rewards: %v", err)
+	}
+
+	if *user != "" {
+		filtered := explanations[:0]
+		for _, explanation := range explanations {
+			if explanation.User == common.HexToAddress(*user) {
+				filtered = append(filtered, explanation)
+			}
+		}
+		explanations = filtered
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(map[string]interface{}{
+		"week":   *week,
+		"policy": policy,
+		"users":  explanations,
+	}); err != nil {
+		log.Fatalf("Failed to write explanation: %v", err)
+	}
+}
+
+// runServe 启动奖励证明查询服务（证明来自数据库，领取状态来自 RewardsDistributor）
+func runServe(args []string) {
+	fs := flag.NewFlagSet("serve", flag.ExitOnError)
+	addr := fs.String("addr", getEnvOrDefault("REWARDS_API_ADDR", ":8090"), "HTTP listen address (env: REWARDS_API_ADDR)")
+	databaseURL := fs.String("db", os.Getenv("DATABASE_URL"), "Database URL (env: DATABASE_URL)")
+	rpcURL := fs.String("rpc-url", os.Getenv("RPC_URL"), "Ethereum RPC URL (env: RPC_URL)")
+	distributorAddr := fs.String("distributor", os.Getenv("REWARDS_DISTRIBUTOR_ADDR"), "RewardsDistributor contract address")
+	fs.Parse(args)
+
+	if *databaseURL == "" {
+		log.Fatal("DATABASE_URL is required")
+	}
+	if *rpcURL == "" || !common.IsHexAddress(*distributorAddr) {
+		log.Fatal("--rpc-url and --distributor are required to report claim status")
+	}
+
+	db, err := sql.Open("postgres", *databaseURL)
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer db.Close()
+
+	if err := db.Ping(); err != nil {
+		log.Fatalf("Failed to ping database: %v", err)
+	}
+
+	client, err := ethclient.Dial(*rpcURL)
+	if err != nil {
+		log.Fatalf("Failed to connect to RPC: %v", err)
+	}
+	defer client.Close()
+
+	claims, err := rewards.NewClaimReader(client, common.HexToAddress(*distributorAddr))
+	if err != nil {
+		log.Fatalf("Failed to create claim reader: %v", err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	server := rewards.NewProofServer(repository.NewRewardsRepository(db), claims)
+	if err := server.Run(ctx, *addr); err != nil {
+		log.Fatalf("Proof server failed: %v", err)
+	}
+}
+
+// runVerify 部署 RewardsDistributor 到模拟 EVM，发布分配的 Merkle 根并逐个 claim，
+// 确认链下生成的证明与合约的叶子编码一致
+func runVerify(args []string) {
+	fs := flag.NewFlagSet("verify", flag.ExitOnError)
+	distFile := fs.String("distribution", "", "Distribution JSON exported with --output")
+	artifact := fs.String("artifact", "../contracts/out/RewardsDistributor.sol/RewardsDistributor.json", "RewardsDistributor build artifact (forge build)")
+	tokenArtifact := fs.String("token-artifact", "../contracts/out/MockERC20.sol/MockERC20.json", "MockERC20 build artifact used as reward token")
+	fs.Parse(args)
+
+	if *distFile == "" {
+		log.Fatal("--distribution is required")
+	}
+
+	data, err := os.ReadFile(*distFile)
+	if err != nil {
+		log.Fatalf("Failed to read distribution: %v", err)
+	}
+
+	var distribution rewards.MerkleDistribution
+	if err := json.Unmarshal(data, &distribution); err != nil {
+		log.Fatalf("Failed to parse distribution: %v", err)
+	}
+
+	distributor, err := rewards.LoadArtifact(*artifact)
+	if err != nil {
+		log.Fatalf("Failed to load distributor artifact: %v", err)
+	}
+	token, err := rewards.LoadArtifact(*tokenArtifact)
+	if err != nil {
+		log.Fatalf("Failed to load token artifact: %v", err)
+	}
+
+	log.Printf("Verifying week %d distribution (%d entries) against RewardsDistributor...", distribution.Week, len(distribution.Entries))
+
+	report, err := rewards.VerifyDistribution(&distribution, distributor, token)
+	if err != nil {
+		log.Fatalf("Verification failed: %v", err)
+	}
+
+	for _, failure := range report.Failures {
+		log.Printf("❌ %s amount=%s expected=%s claimable=%s claimed=%s: %s",
+			failure.User.Hex(), failure.Amount, failure.Expected, failure.Claimable, failure.Claimed, failure.Error)
+	}
+	for _, mismatch := range report.Mismatches {
+		log.Printf("❌ %s", mismatch)
+	}
+	if !report.TamperRejected {
+		log.Printf("❌ Tampered amount was not rejected by the contract")
+	}
+
+	if !report.OK() {
+		log.Fatalf("Verification failed: %d/%d proofs rejected, %d mismatches", len(report.Failures), report.Checked, len(report.Mismatches))
+	}
+
+	log.Printf("✅ All %d proofs accepted by RewardsDistributor (root %s)", report.Checked, report.Root)
+}
+
 // getEnvOrDefault 获取环境变量，如果不存在则返回默认值
 func getEnvOrDefault(key, defaultValue string) string {
 	if value := os.Getenv(key); value != "" {
@@ -196,11 +603,122 @@ func getEnvOrDefault(key, defaultValue string) string {
 	return defaultValue
 }
 
-func exportDistribution(dist *rewards.MerkleDistribution, filename string) error {
+// exportDistribution 导出分配数据，.csv 后缀导出为 CSV，其余为 JSON
+// runCumulative 构建并保存累计快照（周度分配已保存，作为各周明细）
+func runCumulative(ctx context.Context, aggregator *rewards.Aggregator, weekly *rewards.MerkleDistribution, config *Config) {
+	cumulative, err := aggregator.BuildCumulativeDistribution(ctx, weekly)
+	if err != nil {
+		log.Fatalf("Failed to build cumulative distribution: %v", err)
+	}
+	cumulative.CreatedAt = time.Now().Unix()
+
+	log.Printf("Cumulative Root (epoch %d): %s", cumulative.Epoch, cumulative.Root)
+	log.Printf("Recipients: %d, epoch amount: %s, cumulative total: %s",
+		cumulative.Recipients, cumulative.EpochAmount, cumulative.TotalAmount)
+
+	if err := aggregator.SaveCumulativeDistribution(ctx, cumulative); err != nil {
+		log.Fatalf("Failed to save cumulative distribution: %v", err)
+	}
+	log.Printf("Cumulative distribution saved to database")
+
+	if config.CumulativeOutput != "" {
+		if err := exportCumulative(cumulative, config.CumulativeOutput); err != nil {
+			log.Fatalf("Failed to export cumulative distribution: %v", err)
+		}
+		log.Printf("Cumulative distribution exported to %s", config.CumulativeOutput)
+	}
+
+	// RewardsDistributor 只接受周度 Root，累计 Root 仅作为离线快照保存
+	log.Printf("Cumulative root %s is not published on-chain (no cumulative distributor deployed)", cumulative.Root)
+}
+
+func runMigrateCumulative(args []string) {
+	fs := flag.NewFlagSet("migrate-cumulative", flag.ExitOnError)
+	databaseURL := fs.String("db", os.Getenv("DATABASE_URL"), "Database URL (env: DATABASE_URL)")
+	through := fs.Uint64("through", 0, "Last weekly distribution to include; becomes the first cumulative epoch (default: current week - 1)")
+	output := fs.String("output", "", "Output file for the cumulative distribution (.json)")
+	dryRun := fs.Bool("dry-run", false, "Build the cumulative distribution without saving it")
+	epochsFile := fs.String("epochs", os.Getenv("REWARDS_EPOCHS_FILE"), "Reward epoch calendar file, YAML or JSON (env: REWARDS_EPOCHS_FILE)")
+	fs.Parse(args)
+
+	if *databaseURL == "" {
+		log.Fatal("DATABASE_URL is required")
+	}
+	if *through == 0 {
+		*through = loadEpochCalendar(*epochsFile).Previous()
+	}
+
+	db, err := sql.Open("postgres", *databaseURL)
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer db.Close()
+
+	ctx := context.Background()
+	aggregator := rewards.NewAggregator(nil, db)
+	cumulative, err := aggregator.MigrateToCumulative(ctx, *through)
+	if err != nil {
+		log.Fatalf("Migration failed: %v", err)
+	}
+	cumulative.CreatedAt = time.Now().Unix()
+
+	log.Printf("Cumulative Root (epoch %d): %s", cumulative.Epoch, cumulative.Root)
+	log.Printf("Recipients: %d, cumulative total: %s", cumulative.Recipients, cumulative.TotalAmount)
+
+	if *output != "" {
+		if err := exportCumulative(cumulative, *output); err != nil {
+			log.Fatalf("Failed to export cumulative distribution: %v", err)
+		}
+		log.Printf("Cumulative distribution exported to %s", *output)
+	}
+
+	if *dryRun {
+		log.Printf("Dry run mode - cumulative distribution not saved")
+		return
+	}
+
+	if err := aggregator.SaveCumulativeDistribution(ctx, cumulative); err != nil {
+		log.Fatalf("Failed to save cumulative distribution: %v", err)
+	}
+	log.Printf("✅ Weekly distributions through week %d migrated to cumulative epoch %d", *through, cumulative.Epoch)
+}
+
+func exportCumulative(dist *rewards.CumulativeDistribution, filename string) error {
 	data, err := json.MarshalIndent(dist, "", "  ")
 	if err != nil {
-		return fmt.Errorf("failed to marshal distribution: %w", err)
+		return fmt.Errorf("failed to marshal cumulative distribution: %w", err)
+	}
+	return os.WriteFile(filename, data, 0o644)
+}
+
+// loadEpochCalendar 加载奖励周期日历，path 为空时使用默认日历（2024-01-01 UTC 起每 7 天）
+func loadEpochCalendar(path string) *rewards.EpochCalendar {
+	if path == "" {
+		return rewards.DefaultEpochCalendar()
+	}
+	calendar, err := rewards.LoadEpochCalendar(path)
+	if err != nil {
+		log.Fatalf("Failed to load epoch calendar: %v", err)
+	}
+	log.Printf("Loaded reward epoch calendar from %s", path)
+	return calendar
+}
+
+func exportDistribution(dist *rewards.MerkleDistribution, filename string) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", filename, err)
+	}
+	defer file.Close()
+
+	if strings.EqualFold(filepath.Ext(filename), ".csv") {
+		err = rewards.WriteDistributionCSV(file, dist)
+	} else {
+		err = rewards.WriteDistributionJSON(file, dist)
+	}
+	if err != nil {
+		return err
 	}
 
-	return os.WriteFile(filename, data, 0644)
+	return file.Close()
 }
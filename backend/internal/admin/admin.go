@@ -0,0 +1,277 @@
+// Package admin 构造 Market_V3 运维交易（lock / resolve / finalize / cancel / pause / unpause），
+// 支持 eth_call 模拟、keystore 签名发送以及导出 Safe 批量交易由多签执行
+package admin
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/pitchone/sportsbook/internal/safetx"
+	"github.com/pitchone/sportsbook/pkg/bindings"
+	"github.com/pitchone/sportsbook/pkg/results"
+)
+
+// Market_V3 状态（与 IMarket_V3.MarketStatus 一致）
+const (
+	StatusCreated uint8 = iota
+	StatusOpen
+	StatusLocked
+	StatusResolved
+	StatusFinalized
+	StatusCancelled
+)
+
+// MaxScaleBps finalize 赔付缩放上限（基点）
+const MaxScaleBps = 10000
+
+// Action 运维操作
+type Action string
+
+const (
+	ActionLock     Action = "lock"
+	ActionResolve  Action = "resolve"
+	ActionFinalize Action = "finalize"
+	ActionCancel   Action = "cancel"
+	ActionPause    Action = "pause"
+	ActionUnpause  Action = "unpause"
+)
+
+// Backend 链上读写与等待回执所需的接口（*ethclient.Client 满足）
+type Backend interface {
+	bind.ContractBackend
+	bind.DeployBackend
+}
+
+// Call 一笔待执行的市场运维调用
+type Call struct {
+	Action Action
+	Market common.Address
+	Method abi.Method
+	Data   []byte            // 完整调用数据
+	Inputs map[string]string // 按参数名展示的参数值（预览与 Safe 导出）
+}
+
+// MarketState 执行运维操作前读取的市场状态
+type MarketState struct {
+	Market       common.Address
+	Status       uint8
+	Paused       bool
+	ResultMapper common.Address
+}
+
+// StatusName 返回状态名
+func (s *MarketState) StatusName() string {
+	return StatusName(s.Status)
+}
+
+// ReadMarket 读取市场状态；非 Market_V3（无 resultMapper）时返回错误
+func ReadMarket(ctx context.Context, backend bind.ContractCaller, market common.Address) (*MarketState, error) {
+	m, err := bindings.NewMarketV3Caller(market, backend)
+	if err != nil {
+		return nil, fmt.Errorf("初始化市场合约失败: %w", err)
+	}
+	opts := &bind.CallOpts{Context: ctx}
+
+	mapper, err := m.ResultMapper(opts)
+	if err != nil {
+		return nil, fmt.Errorf("市场 %s 不是 Market_V3（读取 resultMapper 失败）: %w", market.Hex(), err)
+	}
+	status, err := m.Status(opts)
+	if err != nil {
+		return nil, fmt.Errorf("获取市场状态失败: %w", err)
+	}
+	paused, err := m.Paused(opts)
+	if err != nil {
+		return nil, fmt.Errorf("获取暂停状态失败: %w", err)
+	}
+
+	return &MarketState{
+		Market:       market,
+		Status:       status,
+		Paused:       paused,
+		ResultMapper: mapper,
+	}, nil
+}
+
+// NewLockCall 锁盘
+func NewLockCall(market common.Address) (*Call, error) {
+	return newCall(ActionLock, market, "lock", nil)
+}
+
+// NewResolveCall 以比分结算，rawResult 与 Keeper 使用相同编码（abi.encode(uint256 home, uint256 away)）
+func NewResolveCall(market common.Address, homeGoals, awayGoals uint8) (*Call, error) {
+	rawResult, err := results.EncodeMatchResult(homeGoals, awayGoals)
+	if err != nil {
+		return nil, fmt.Errorf("编码赛果失败: %w", err)
+	}
+
+	call, err := newCall(ActionResolve, market, "resolve", nil, rawResult)
+	if err != nil {
+		return nil, err
+	}
+	call.Inputs["rawResult"] = fmt.Sprintf("%#x", rawResult)
+	return call, nil
+}
+
+// NewFinalizeCall 终结市场；scaleBps 为 0 时正常结算（超限 revert），1-10000 时按比例缩减赔付并使用储备金兜底
+func NewFinalizeCall(market common.Address, scaleBps uint64) (*Call, error) {
+	if scaleBps > MaxScaleBps {
+		return nil, fmt.Errorf("scale-bps 不能超过 %d", MaxScaleBps)
+	}
+	return newCall(ActionFinalize, market, "finalize", map[string]string{
+		"scaleBps": strconv.FormatUint(scaleBps, 10),
+	}, new(big.Int).SetUint64(scaleBps))
+}
+
+// NewCancelCall 取消市场；已结算（Resolved）的市场使用 cancelResolved
+func NewCancelCall(market common.Address, reason string, resolved bool) (*Call, error) {
+	if reason == "" {
+		return nil, fmt.Errorf("取消市场需要填写原因")
+	}
+	method := "cancel"
+	if resolved {
+		method = "cancelResolved"
+	}
+	return newCall(ActionCancel, market, method, map[string]string{"reason": reason}, reason)
+}
+
+// NewPauseCall 暂停市场
+func NewPauseCall(market common.Address) (*Call, error) {
+	return newCall(ActionPause, market, "pause", nil)
+}
+
+// NewUnpauseCall 恢复市场
+func NewUnpauseCall(market common.Address) (*Call, error) {
+	return newCall(ActionUnpause, market, "unpause", nil)
+}
+
+func newCall(action Action, market common.Address, method string, inputs map[string]string, args ...interface{}) (*Call, error) {
+	marketABI, err := bindings.MarketV3MetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("加载 Market_V3 ABI 失败: %w", err)
+	}
+
+	data, err := marketABI.Pack(method, args...)
+	if err != nil {
+		return nil, fmt.Errorf("编码 %s 调用失败: %w", method, err)
+	}
+
+	if inputs == nil {
+		inputs = map[string]string{}
+	}
+
+	return &Call{
+		Action: action,
+		Market: market,
+		Method: marketABI.Methods[method],
+		Data:   data,
+		Inputs: inputs,
+	}, nil
+}
+
+// Check 检查市场当前状态是否允许执行该操作，避免发送必然 revert 的交易
+func (c *Call) Check(state *MarketState) error {
+	switch c.Action {
+	case ActionLock:
+		return requireStatus(state, StatusOpen)
+	case ActionResolve:
+		return requireStatus(state, StatusLocked)
+	case ActionFinalize:
+		return requireStatus(state, StatusResolved)
+	case ActionCancel:
+		if c.Method.Name == "cancelResolved" {
+			return requireStatus(state, StatusResolved)
+		}
+		return requireStatus(state, StatusOpen, StatusLocked)
+	case ActionPause:
+		if state.Paused {
+			return fmt.Errorf("市场已处于暂停状态")
+		}
+	case ActionUnpause:
+		if !state.Paused {
+			return fmt.Errorf("市场未暂停")
+		}
+	}
+	return nil
+}
+
+func requireStatus(state *MarketState, allowed ...uint8) error {
+	for _, s := range allowed {
+		if state.Status == s {
+			return nil
+		}
+	}
+
+	names := make([]string, 0, len(allowed))
+	for _, s := range allowed {
+		names = append(names, StatusName(s))
+	}
+	return fmt.Errorf("市场状态为 %s，需要 %v", state.StatusName(), names)
+}
+
+// Simulate 以 from 身份通过 eth_call 模拟调用，返回 revert 原因
+func (c *Call) Simulate(ctx context.Context, backend bind.ContractCaller, from common.Address) error {
+	_, err := backend.CallContract(ctx, ethereum.CallMsg{
+		From: from,
+		To:   &c.Market,
+		Data: c.Data,
+	}, nil)
+	if err != nil {
+		return fmt.Errorf("模拟 %s 失败: %w", c.Method.Name, err)
+	}
+	return nil
+}
+
+// Send 使用私钥签名发送交易并等待上链
+func (c *Call) Send(ctx context.Context, backend Backend, privateKey *ecdsa.PrivateKey, chainID *big.Int) (*types.Receipt, error) {
+	auth, err := bind.NewKeyedTransactorWithChainID(privateKey, chainID)
+	if err != nil {
+		return nil, fmt.Errorf("创建交易签名失败: %w", err)
+	}
+	auth.Context = ctx
+
+	contract := bind.NewBoundContract(c.Market, abi.ABI{}, backend, backend, backend)
+	tx, err := contract.RawTransact(auth, c.Data)
+	if err != nil {
+		return nil, fmt.Errorf("发送 %s 交易失败: %w", c.Method.Name, err)
+	}
+
+	receipt, err := bind.WaitMined(ctx, backend, tx)
+	if err != nil {
+		return nil, fmt.Errorf("等待交易 %s 失败: %w", tx.Hash().Hex(), err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return receipt, fmt.Errorf("交易 %s 执行失败", tx.Hash().Hex())
+	}
+	return receipt, nil
+}
+
+// SafeBatch 导出为 Safe 批量交易；safe 为零地址时不填写来源 Safe
+func (c *Call) SafeBatch(chainID *big.Int, safe common.Address) *safetx.Batch {
+	tx := safetx.NewTransaction(c.Market, c.Method, c.Data, c.Inputs)
+	return safetx.NewBatch(
+		chainID,
+		safe,
+		fmt.Sprintf("Market %s %s", c.Action, c.Market.Hex()),
+		fmt.Sprintf("%s on Market_V3 %s", c.Method.Sig, c.Market.Hex()),
+		tx,
+	)
+}
+
+// StatusName Market_V3 状态名
+func StatusName(status uint8) string {
+	names := []string{"Created", "Open", "Locked", "Resolved", "Finalized", "Cancelled"}
+	if int(status) < len(names) {
+		return names[status]
+	}
+	return fmt.Sprintf("Unknown(%d)", status)
+}
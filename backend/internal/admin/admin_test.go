@@ -0,0 +1,111 @@
+package admin
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pitchone/sportsbook/internal/safetx"
+	"github.com/pitchone/sportsbook/pkg/bindings"
+)
+
+var testMarket = common.HexToAddress("0x00000000000000000000000000000000000000a1")
+
+func TestNewResolveCall(t *testing.T) {
+	call, err := NewResolveCall(testMarket, 2, 1)
+	require.NoError(t, err)
+	assert.Equal(t, "resolve", call.Method.Name)
+
+	marketABI, err := bindings.MarketV3MetaData.GetAbi()
+	require.NoError(t, err)
+	method, err := marketABI.MethodById(call.Data[:4])
+	require.NoError(t, err)
+	assert.Equal(t, "resolve", method.Name)
+
+	args, err := method.Inputs.Unpack(call.Data[4:])
+	require.NoError(t, err)
+	raw := args[0].([]byte)
+	require.Len(t, raw, 64)
+	assert.Equal(t, big.NewInt(2), new(big.Int).SetBytes(raw[:32]))
+	assert.Equal(t, big.NewInt(1), new(big.Int).SetBytes(raw[32:]))
+	assert.Equal(t, hexutil.Encode(raw), call.Inputs["rawResult"])
+}
+
+func TestNewFinalizeCall(t *testing.T) {
+	call, err := NewFinalizeCall(testMarket, 9500)
+	require.NoError(t, err)
+	assert.Equal(t, "9500", call.Inputs["scaleBps"])
+
+	_, err = NewFinalizeCall(testMarket, MaxScaleBps+1)
+	assert.Error(t, err)
+}
+
+func TestNewCancelCall(t *testing.T) {
+	_, err := NewCancelCall(testMarket, "", false)
+	assert.Error(t, err)
+
+	call, err := NewCancelCall(testMarket, "match postponed", false)
+	require.NoError(t, err)
+	assert.Equal(t, "cancel", call.Method.Name)
+
+	call, err = NewCancelCall(testMarket, "loss over limit", true)
+	require.NoError(t, err)
+	assert.Equal(t, "cancelResolved", call.Method.Name)
+}
+
+func TestCallCheck(t *testing.T) {
+	lock, _ := NewLockCall(testMarket)
+	resolve, _ := NewResolveCall(testMarket, 0, 0)
+	finalize, _ := NewFinalizeCall(testMarket, 0)
+	cancel, _ := NewCancelCall(testMarket, "reason", false)
+	pause, _ := NewPauseCall(testMarket)
+	unpause, _ := NewUnpauseCall(testMarket)
+
+	open := &MarketState{Status: StatusOpen}
+	locked := &MarketState{Status: StatusLocked}
+	resolved := &MarketState{Status: StatusResolved}
+	paused := &MarketState{Status: StatusOpen, Paused: true}
+
+	assert.NoError(t, lock.Check(open))
+	assert.Error(t, lock.Check(locked))
+	assert.NoError(t, resolve.Check(locked))
+	assert.Error(t, resolve.Check(open))
+	assert.NoError(t, finalize.Check(resolved))
+	assert.Error(t, finalize.Check(locked))
+	assert.NoError(t, cancel.Check(open))
+	assert.NoError(t, cancel.Check(locked))
+	assert.Error(t, cancel.Check(resolved))
+	assert.NoError(t, pause.Check(open))
+	assert.Error(t, pause.Check(paused))
+	assert.NoError(t, unpause.Check(paused))
+	assert.Error(t, unpause.Check(open))
+}
+
+func TestCallSafeBatch(t *testing.T) {
+	safe := common.HexToAddress("0x5afe5afe5afe5afe5afe5afe5afe5afe5afe5afe")
+	call, err := NewCancelCall(testMarket, "match postponed", false)
+	require.NoError(t, err)
+
+	batch := call.SafeBatch(big.NewInt(11155111), safe)
+	assert.Equal(t, "11155111", batch.ChainID)
+	assert.Equal(t, safe.Hex(), batch.Meta.CreatedFromSafeAddress)
+	require.Len(t, batch.Transactions, 1)
+
+	tx := batch.Transactions[0]
+	assert.Equal(t, testMarket.Hex(), tx.To)
+	assert.Equal(t, hexutil.Encode(call.Data), tx.Data)
+	assert.Equal(t, "cancel", tx.ContractMethod.Name)
+	assert.Equal(t, map[string]string{"reason": "match postponed"}, tx.ContractInputsValues)
+
+	var buf bytes.Buffer
+	require.NoError(t, safetx.Write(&buf, batch))
+	var decoded map[string]interface{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+	assert.Equal(t, safetx.Version, decoded["version"])
+}
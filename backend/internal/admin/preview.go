@@ -0,0 +1,55 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/pitchone/sportsbook/pkg/bindings"
+)
+
+// MappedOutcome 比分经 ResultMapper 映射后的一个获胜结果
+type MappedOutcome struct {
+	OutcomeID *big.Int
+	Name      string
+	Weight    *big.Int // 赔付权重（基点，10000 = 全额）
+}
+
+// PreviewResolve 通过市场的 ResultMapper 预览比分映射到的结果与权重（与 resolve 时 mapResult 一致）
+func PreviewResolve(ctx context.Context, backend bind.ContractCaller, state *MarketState, homeGoals, awayGoals uint8) ([]*MappedOutcome, error) {
+	if state.ResultMapper == (common.Address{}) {
+		return nil, fmt.Errorf("市场未配置 ResultMapper")
+	}
+
+	mapper, err := bindings.NewIResultMapperCaller(state.ResultMapper, backend)
+	if err != nil {
+		return nil, fmt.Errorf("初始化 ResultMapper 失败: %w", err)
+	}
+	opts := &bind.CallOpts{Context: ctx}
+
+	preview, err := mapper.PreviewResult(opts, big.NewInt(int64(homeGoals)), big.NewInt(int64(awayGoals)))
+	if err != nil {
+		return nil, fmt.Errorf("ResultMapper.previewResult 失败: %w", err)
+	}
+	if len(preview.OutcomeIds) == 0 || len(preview.OutcomeIds) != len(preview.Weights) {
+		return nil, fmt.Errorf("ResultMapper 返回的结果无效（%d 个结果，%d 个权重）", len(preview.OutcomeIds), len(preview.Weights))
+	}
+
+	outcomes := make([]*MappedOutcome, 0, len(preview.OutcomeIds))
+	for i, id := range preview.OutcomeIds {
+		name, err := mapper.GetOutcomeName(opts, id)
+		if err != nil || name == "" {
+			name = fmt.Sprintf("Outcome %s", id)
+		}
+		outcomes = append(outcomes, &MappedOutcome{
+			OutcomeID: id,
+			Name:      name,
+			Weight:    preview.Weights[i],
+		})
+	}
+
+	return outcomes, nil
+}
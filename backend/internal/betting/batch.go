@@ -0,0 +1,103 @@
+package betting
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// MaxBatchSize 单个批量下注文件的最大条数
+const MaxBatchSize = 50
+
+// ParseAmount 将十进制金额（如 "12.5"）转换为代币最小单位，小数位超出精度时报错
+func ParseAmount(s string, decimals int) (*big.Int, error) {
+	s = strings.TrimSpace(s)
+	whole, frac, _ := strings.Cut(s, ".")
+	if whole == "" {
+		whole = "0"
+	}
+	if len(frac) > decimals {
+		return nil, fmt.Errorf("金额 %s 超出 %d 位小数精度", s, decimals)
+	}
+	frac += strings.Repeat("0", decimals-len(frac))
+
+	n, ok := new(big.Int).SetString(whole+frac, 10)
+	if !ok || n.Sign() < 0 {
+		return nil, fmt.Errorf("无效的金额: %s", s)
+	}
+	return n, nil
+}
+
+// LoadBatchFile 读取批量下注文件
+func LoadBatchFile(path string, decimals int) ([]BetRequest, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("打开批量下注文件失败: %w", err)
+	}
+	defer f.Close()
+
+	return ParseBatch(f, decimals)
+}
+
+// ParseBatch 解析批量下注列表，每行一笔: market,outcome,amount
+// 空行和 # 开头的注释行会被忽略，首行可以是表头
+func ParseBatch(r io.Reader, decimals int) ([]BetRequest, error) {
+	bets := make([]BetRequest, 0)
+	scanner := bufio.NewScanner(r)
+
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		fields := strings.Split(text, ",")
+		if len(fields) != 3 {
+			return nil, fmt.Errorf("第 %d 行: 需要 3 列 (market,outcome,amount)，实际 %d 列", line, len(fields))
+		}
+		for i := range fields {
+			fields[i] = strings.TrimSpace(fields[i])
+		}
+		if len(bets) == 0 && strings.EqualFold(fields[0], "market") {
+			continue
+		}
+
+		if !common.IsHexAddress(fields[0]) {
+			return nil, fmt.Errorf("第 %d 行: 无效的市场地址 %s", line, fields[0])
+		}
+		outcome, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("第 %d 行: 无效的结果 ID %s", line, fields[1])
+		}
+		amount, err := ParseAmount(fields[2], decimals)
+		if err != nil {
+			return nil, fmt.Errorf("第 %d 行: %w", line, err)
+		}
+		if amount.Sign() == 0 {
+			return nil, fmt.Errorf("第 %d 行: 下注金额必须大于 0", line)
+		}
+
+		bets = append(bets, BetRequest{
+			Market:    common.HexToAddress(fields[0]),
+			OutcomeID: outcome,
+			Amount:    amount,
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("读取批量下注文件失败: %w", err)
+	}
+
+	if len(bets) == 0 {
+		return nil, fmt.Errorf("批量下注文件中没有下注")
+	}
+	if len(bets) > MaxBatchSize {
+		return nil, fmt.Errorf("批量下注 %d 笔超出上限 %d", len(bets), MaxBatchSize)
+	}
+	return bets, nil
+}
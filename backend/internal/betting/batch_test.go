@@ -0,0 +1,71 @@
+package betting
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAmount(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    int64
+		wantErr bool
+	}{
+		{"10", 10_000_000, false},
+		{"12.5", 12_500_000, false},
+		{".25", 250_000, false},
+		{"0.000001", 1, false},
+		{"0.0000001", 0, true},
+		{"-1", 0, true},
+		{"abc", 0, true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseAmount(tt.in, 6)
+		if tt.wantErr {
+			assert.Error(t, err, tt.in)
+			continue
+		}
+		require.NoError(t, err, tt.in)
+		assert.Equal(t, big.NewInt(tt.want), got, tt.in)
+	}
+}
+
+func TestParseBatch(t *testing.T) {
+	input := `market,outcome,amount
+# 主胜与大球
+0x00000000000000000000000000000000000000a1, 0, 10
+
+0x00000000000000000000000000000000000000b2,2,2.5
+`
+
+	bets, err := ParseBatch(strings.NewReader(input), 6)
+	require.NoError(t, err)
+	require.Len(t, bets, 2)
+
+	assert.Equal(t, common.HexToAddress("0xa1"), bets[0].Market)
+	assert.Equal(t, uint64(0), bets[0].OutcomeID)
+	assert.Equal(t, big.NewInt(10_000_000), bets[0].Amount)
+	assert.Equal(t, uint64(2), bets[1].OutcomeID)
+	assert.Equal(t, big.NewInt(2_500_000), bets[1].Amount)
+}
+
+func TestParseBatch_Errors(t *testing.T) {
+	tests := map[string]string{
+		"empty":       "# nothing\n",
+		"bad columns": "0x00000000000000000000000000000000000000a1,0\n",
+		"bad address": "0x1234,0,1\n",
+		"bad outcome": "0x00000000000000000000000000000000000000a1,-1,1\n",
+		"zero amount": "0x00000000000000000000000000000000000000a1,0,0\n",
+	}
+
+	for name, input := range tests {
+		_, err := ParseBatch(strings.NewReader(input), 6)
+		assert.Error(t, err, name)
+	}
+}
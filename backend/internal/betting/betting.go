@@ -0,0 +1,304 @@
+package betting
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/pitchone/sportsbook/pkg/bindings"
+)
+
+// MaxSlippageBps 滑点上限（基点）
+const MaxSlippageBps = 10000
+
+// Backend 链上读写与等待回执所需的接口（*ethclient.Client 满足）
+type Backend interface {
+	bind.ContractBackend
+	bind.DeployBackend
+}
+
+// Service 以单个签名账户发送下注、赎回与退款交易
+type Service struct {
+	backend Backend
+	router  *bindings.BettingRouterV3
+
+	routerAddress common.Address
+	privateKey    *ecdsa.PrivateKey
+	from          common.Address
+	chainID       *big.Int
+}
+
+// NewService 创建交易服务
+func NewService(backend Backend, router common.Address, privateKey *ecdsa.PrivateKey, from common.Address, chainID *big.Int) (*Service, error) {
+	if router == (common.Address{}) {
+		return nil, errors.New("BettingRouter 地址未配置（contracts.betting_router）")
+	}
+
+	r, err := bindings.NewBettingRouterV3(router, backend)
+	if err != nil {
+		return nil, fmt.Errorf("初始化 BettingRouter 合约失败: %w", err)
+	}
+
+	return &Service{
+		backend:       backend,
+		router:        r,
+		routerAddress: router,
+		privateKey:    privateKey,
+		from:          from,
+		chainID:       chainID,
+	}, nil
+}
+
+// From 返回签名账户地址
+func (s *Service) From() common.Address {
+	return s.from
+}
+
+// Router 返回 BettingRouter 地址
+func (s *Service) Router() common.Address {
+	return s.routerAddress
+}
+
+// BetRequest 一笔下注请求
+type BetRequest struct {
+	Market    common.Address
+	OutcomeID uint64
+	Amount    *big.Int // 下注金额（含手续费，代币最小单位）
+}
+
+// BetQuote 下注报价：previewBet 预估份额，calculateFeeForMarket 给出手续费明细
+type BetQuote struct {
+	BetRequest
+
+	Token     common.Address // 市场结算代币
+	NetAmount *big.Int       // 扣除手续费后进入市场的金额
+	Shares    *big.Int       // 预计获得份额
+	MinShares *big.Int       // 按滑点计算的最小份额
+
+	Fee bindings.IBettingRouterV3FeeResult // 手续费明细
+}
+
+// QuoteBet 预览下注并按 slippageBps 计算最小份额
+func (s *Service) QuoteBet(ctx context.Context, req BetRequest, slippageBps uint64) (*BetQuote, error) {
+	if req.Amount == nil || req.Amount.Sign() <= 0 {
+		return nil, errors.New("下注金额必须大于 0")
+	}
+	if slippageBps > MaxSlippageBps {
+		return nil, fmt.Errorf("滑点 %d bps 超出上限 %d", slippageBps, MaxSlippageBps)
+	}
+
+	opts := s.callOpts(ctx)
+
+	validation, err := s.router.ValidateMarket(opts, req.Market)
+	if err != nil {
+		return nil, fmt.Errorf("校验市场 %s 失败: %w", req.Market.Hex(), err)
+	}
+	if !validation.Valid {
+		return nil, fmt.Errorf("市场 %s 当前不可下注（未注册、未开盘或代币不受支持）", req.Market.Hex())
+	}
+
+	outcomeID := new(big.Int).SetUint64(req.OutcomeID)
+	preview, err := s.router.PreviewBet(opts, req.Market, outcomeID, req.Amount)
+	if err != nil {
+		return nil, fmt.Errorf("预览下注失败: %w", err)
+	}
+
+	fee, err := s.router.CalculateFeeForMarket(opts, req.Market, s.from, req.Amount)
+	if err != nil {
+		return nil, fmt.Errorf("计算手续费失败: %w", err)
+	}
+
+	return &BetQuote{
+		BetRequest: req,
+		Token:      preview.Token,
+		NetAmount:  preview.NetAmount,
+		Shares:     preview.Shares,
+		MinShares:  MinShares(preview.Shares, slippageBps),
+		Fee:        fee,
+	}, nil
+}
+
+// MinShares 按滑点（基点）计算最小可接受份额
+func MinShares(shares *big.Int, slippageBps uint64) *big.Int {
+	minShares := new(big.Int).Mul(shares, new(big.Int).SetUint64(MaxSlippageBps-slippageBps))
+	return minShares.Div(minShares, big.NewInt(MaxSlippageBps))
+}
+
+// Approval 代币授权检查结果
+type Approval struct {
+	Token     common.Address
+	Allowance *big.Int // 当前对 Router 的授权额度
+	Required  *big.Int // 本次下注所需额度
+	Balance   *big.Int // 账户余额
+}
+
+// Sufficient 当前授权是否足够
+func (a *Approval) Sufficient() bool {
+	return a.Allowance.Cmp(a.Required) >= 0
+}
+
+// CheckApprovals 按代币汇总下注金额，检查余额与对 Router 的授权
+func (s *Service) CheckApprovals(ctx context.Context, quotes []*BetQuote) ([]*Approval, error) {
+	approvals := make([]*Approval, 0)
+	byToken := make(map[common.Address]*Approval)
+
+	for _, q := range quotes {
+		a, ok := byToken[q.Token]
+		if !ok {
+			a = &Approval{Token: q.Token, Required: big.NewInt(0)}
+			byToken[q.Token] = a
+			approvals = append(approvals, a)
+		}
+		a.Required.Add(a.Required, q.Amount)
+	}
+
+	opts := s.callOpts(ctx)
+	for _, a := range approvals {
+		token, err := bindings.NewIERC20Caller(a.Token, s.backend)
+		if err != nil {
+			return nil, err
+		}
+		if a.Allowance, err = token.Allowance(opts, s.from, s.routerAddress); err != nil {
+			return nil, fmt.Errorf("查询 %s 授权额度失败: %w", a.Token.Hex(), err)
+		}
+		if a.Balance, err = token.BalanceOf(opts, s.from); err != nil {
+			return nil, fmt.Errorf("查询 %s 余额失败: %w", a.Token.Hex(), err)
+		}
+		if a.Balance.Cmp(a.Required) < 0 {
+			return nil, fmt.Errorf("代币 %s 余额不足: 需要 %s, 当前 %s", a.Token.Hex(), a.Required, a.Balance)
+		}
+	}
+
+	return approvals, nil
+}
+
+// Approve 将 Router 的授权额度设置为 amount
+func (s *Service) Approve(ctx context.Context, token common.Address, amount *big.Int) (*types.Receipt, error) {
+	erc20, err := bindings.NewIERC20Transactor(token, s.backend)
+	if err != nil {
+		return nil, err
+	}
+
+	auth, err := s.transactor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := erc20.Approve(auth, s.routerAddress, amount)
+	if err != nil {
+		return nil, fmt.Errorf("发送 approve 交易失败: %w", err)
+	}
+	return s.waitMined(ctx, tx)
+}
+
+// BetResult 链上 BetPlaced 事件
+type BetResult struct {
+	Market    common.Address
+	OutcomeID *big.Int
+	Amount    *big.Int
+	Shares    *big.Int
+	Fee       *big.Int
+}
+
+// PlaceBet 通过 BettingRouter.placeBet 下注
+func (s *Service) PlaceBet(ctx context.Context, q *BetQuote) (*types.Receipt, []*BetResult, error) {
+	auth, err := s.transactor(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tx, err := s.router.PlaceBet(auth, q.Market, new(big.Int).SetUint64(q.OutcomeID), q.Amount, q.MinShares)
+	if err != nil {
+		return nil, nil, fmt.Errorf("发送 placeBet 交易失败: %w", err)
+	}
+
+	receipt, err := s.waitMined(ctx, tx)
+	if err != nil {
+		return receipt, nil, err
+	}
+	return receipt, s.betResults(receipt), nil
+}
+
+// PlaceBetBatch 通过 BettingRouter.placeBetBatch 在一笔交易中下注
+func (s *Service) PlaceBetBatch(ctx context.Context, quotes []*BetQuote) (*types.Receipt, []*BetResult, error) {
+	bets := make([]bindings.IBettingRouterV3BetParams, 0, len(quotes))
+	for _, q := range quotes {
+		bets = append(bets, bindings.IBettingRouterV3BetParams{
+			Market:    q.Market,
+			OutcomeId: new(big.Int).SetUint64(q.OutcomeID),
+			Amount:    q.Amount,
+			MinShares: q.MinShares,
+		})
+	}
+
+	auth, err := s.transactor(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tx, err := s.router.PlaceBetBatch(auth, bets)
+	if err != nil {
+		return nil, nil, fmt.Errorf("发送 placeBetBatch 交易失败: %w", err)
+	}
+
+	receipt, err := s.waitMined(ctx, tx)
+	if err != nil {
+		return receipt, nil, err
+	}
+	return receipt, s.betResults(receipt), nil
+}
+
+// betResults 解析回执中的 BetPlaced 事件
+func (s *Service) betResults(receipt *types.Receipt) []*BetResult {
+	results := make([]*BetResult, 0)
+	for _, log := range receipt.Logs {
+		if log.Address != s.routerAddress {
+			continue
+		}
+		event, err := s.router.ParseBetPlaced(*log)
+		if err != nil {
+			continue
+		}
+		results = append(results, &BetResult{
+			Market:    event.Market,
+			OutcomeID: event.OutcomeId,
+			Amount:    event.Amount,
+			Shares:    event.Shares,
+			Fee:       event.Fee,
+		})
+	}
+	return results
+}
+
+// callOpts 以签名账户身份执行只读调用（previewBet 等依赖 msg.sender）
+func (s *Service) callOpts(ctx context.Context) *bind.CallOpts {
+	return &bind.CallOpts{Context: ctx, From: s.from}
+}
+
+// transactor 创建交易签名选项
+func (s *Service) transactor(ctx context.Context) (*bind.TransactOpts, error) {
+	auth, err := bind.NewKeyedTransactorWithChainID(s.privateKey, s.chainID)
+	if err != nil {
+		return nil, fmt.Errorf("创建交易签名失败: %w", err)
+	}
+	auth.Context = ctx
+	return auth, nil
+}
+
+// waitMined 等待交易上链并检查执行状态
+func (s *Service) waitMined(ctx context.Context, tx *types.Transaction) (*types.Receipt, error) {
+	receipt, err := bind.WaitMined(ctx, s.backend, tx)
+	if err != nil {
+		return nil, fmt.Errorf("等待交易 %s 失败: %w", tx.Hash().Hex(), err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return receipt, fmt.Errorf("交易 %s 执行失败", tx.Hash().Hex())
+	}
+	return receipt, nil
+}
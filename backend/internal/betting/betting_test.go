@@ -0,0 +1,270 @@
+package betting
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pitchone/sportsbook/pkg/bindings"
+)
+
+var (
+	testRouter = common.HexToAddress("0x00000000000000000000000000000000000000a1")
+	testMarket = common.HexToAddress("0x00000000000000000000000000000000000000b2")
+	testToken  = common.HexToAddress("0x00000000000000000000000000000000000000c3")
+)
+
+// fakeRouterBackend 以 ABI 编码的固定返回值模拟 BettingRouter，并记录调用与发送的交易
+type fakeRouterBackend struct {
+	t   *testing.T
+	abi *abi.ABI
+
+	valid     bool
+	netAmount *big.Int
+	shares    *big.Int
+	fee       bindings.IBettingRouterV3FeeResult
+
+	callers map[string]common.Address // 方法名 -> 调用方（msg.From）
+	sent    []*types.Transaction
+	logs    []*types.Log // 回执中的日志
+}
+
+func newFakeRouterBackend(t *testing.T) *fakeRouterBackend {
+	parsed, err := bindings.BettingRouterV3MetaData.GetAbi()
+	require.NoError(t, err)
+
+	return &fakeRouterBackend{
+		t:         t,
+		abi:       parsed,
+		valid:     true,
+		netAmount: big.NewInt(9_800_000),
+		shares:    big.NewInt(18_123_457),
+		fee: bindings.IBettingRouterV3FeeResult{
+			GrossAmount: big.NewInt(10_000_000),
+			FeeAmount:   big.NewInt(200_000),
+			NetAmount:   big.NewInt(9_800_000),
+			DiscountBps: big.NewInt(500),
+			Referrer:    common.HexToAddress("0x00000000000000000000000000000000000000d4"),
+		},
+		callers: make(map[string]common.Address),
+	}
+}
+
+func (b *fakeRouterBackend) CallContract(ctx context.Context, msg ethereum.CallMsg, block *big.Int) ([]byte, error) {
+	method, err := b.abi.MethodById(msg.Data[:4])
+	require.NoError(b.t, err)
+	b.callers[method.Name] = msg.From
+
+	switch method.Name {
+	case "validateMarket":
+		return method.Outputs.Pack(b.valid, testToken)
+	case "previewBet":
+		return method.Outputs.Pack(testToken, b.netAmount, b.shares, b.fee.FeeAmount)
+	case "calculateFeeForMarket":
+		return method.Outputs.Pack(b.fee)
+	}
+	return nil, errors.New("unexpected call to " + method.Name)
+}
+
+func (b *fakeRouterBackend) CodeAt(ctx context.Context, account common.Address, block *big.Int) ([]byte, error) {
+	return []byte{0x1}, nil
+}
+
+func (b *fakeRouterBackend) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	return &types.Header{Number: big.NewInt(1)}, nil
+}
+
+func (b *fakeRouterBackend) PendingCodeAt(ctx context.Context, account common.Address) ([]byte, error) {
+	return []byte{0x1}, nil
+}
+
+func (b *fakeRouterBackend) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	return uint64(len(b.sent)), nil
+}
+
+func (b *fakeRouterBackend) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	return big.NewInt(1_000_000_000), nil
+}
+
+func (b *fakeRouterBackend) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
+	return big.NewInt(1_000_000_000), nil
+}
+
+func (b *fakeRouterBackend) EstimateGas(ctx context.Context, call ethereum.CallMsg) (uint64, error) {
+	return 300_000, nil
+}
+
+func (b *fakeRouterBackend) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	b.sent = append(b.sent, tx)
+	return nil
+}
+
+func (b *fakeRouterBackend) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	return nil, nil
+}
+
+func (b *fakeRouterBackend) SubscribeFilterLogs(ctx context.Context, query ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	return nil, errors.New("not supported")
+}
+
+func (b *fakeRouterBackend) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	return &types.Receipt{
+		Status:      types.ReceiptStatusSuccessful,
+		TxHash:      txHash,
+		BlockNumber: big.NewInt(1),
+		Logs:        b.logs,
+	}, nil
+}
+
+// betPlacedLog 构造 BetPlaced(user, market, token, outcomeId, amount, shares, fee) 日志
+func (b *fakeRouterBackend) betPlacedLog(emitter, user common.Address, outcomeID, amount, shares, fee int64) *types.Log {
+	event := b.abi.Events["BetPlaced"]
+	data, err := event.Inputs.NonIndexed().Pack(big.NewInt(outcomeID), big.NewInt(amount), big.NewInt(shares), big.NewInt(fee))
+	require.NoError(b.t, err)
+
+	return &types.Log{
+		Address: emitter,
+		Topics: []common.Hash{
+			event.ID,
+			common.BytesToHash(user.Bytes()),
+			common.BytesToHash(testMarket.Bytes()),
+			common.BytesToHash(testToken.Bytes()),
+		},
+		Data: data,
+	}
+}
+
+func newTestService(t *testing.T, backend *fakeRouterBackend) *Service {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	service, err := NewService(backend, testRouter, key, crypto.PubkeyToAddress(key.PublicKey), big.NewInt(1337))
+	require.NoError(t, err)
+	return service
+}
+
+func TestMinShares(t *testing.T) {
+	tests := []struct {
+		name        string
+		shares      int64
+		slippageBps uint64
+		want        int64
+	}{
+		{"no slippage", 1_000_000, 0, 1_000_000},
+		{"0.5%", 1_000_000, 50, 995_000},
+		{"1% exact", 1000, 100, 990},
+		{"1%", 18_123_457, 100, 17_942_222}, // 17942222.43 向下取整
+		{"rounds down", 999, 100, 989},
+		{"full slippage", 1_000_000, MaxSlippageBps, 0},
+		{"zero shares", 0, 50, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			shares := big.NewInt(tt.shares)
+			assert.Equal(t, big.NewInt(tt.want), MinShares(shares, tt.slippageBps))
+			assert.Equal(t, big.NewInt(tt.shares), shares, "MinShares must not modify its input")
+		})
+	}
+}
+
+func TestQuoteBet(t *testing.T) {
+	backend := newFakeRouterBackend(t)
+	service := newTestService(t, backend)
+
+	quote, err := service.QuoteBet(context.Background(), BetRequest{
+		Market:    testMarket,
+		OutcomeID: 2,
+		Amount:    big.NewInt(10_000_000),
+	}, 50)
+	require.NoError(t, err)
+
+	// previewBet 的返回值按字段解码
+	assert.Equal(t, testToken, quote.Token)
+	assert.Equal(t, big.NewInt(9_800_000), quote.NetAmount)
+	assert.Equal(t, big.NewInt(18_123_457), quote.Shares)
+	assert.Equal(t, big.NewInt(18_032_839), quote.MinShares) // 18123457 * 9950 / 10000
+	assert.Equal(t, backend.fee, quote.Fee)
+
+	// previewBet 与手续费依赖 msg.sender，以签名账户身份调用
+	assert.Equal(t, service.From(), backend.callers["previewBet"])
+	assert.Equal(t, service.From(), backend.callers["calculateFeeForMarket"])
+}
+
+func TestQuoteBet_Invalid(t *testing.T) {
+	tests := []struct {
+		name        string
+		amount      *big.Int
+		slippageBps uint64
+		invalid     bool
+		wantErr     string
+	}{
+		{name: "zero amount", amount: big.NewInt(0), slippageBps: 50, wantErr: "下注金额必须大于 0"},
+		{name: "missing amount", amount: nil, slippageBps: 50, wantErr: "下注金额必须大于 0"},
+		{name: "slippage above max", amount: big.NewInt(1), slippageBps: MaxSlippageBps + 1, wantErr: "超出上限"},
+		{name: "market not open", amount: big.NewInt(1), slippageBps: 50, invalid: true, wantErr: "当前不可下注"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			backend := newFakeRouterBackend(t)
+			backend.valid = !tt.invalid
+			service := newTestService(t, backend)
+
+			_, err := service.QuoteBet(context.Background(), BetRequest{Market: testMarket, Amount: tt.amount}, tt.slippageBps)
+			assert.ErrorContains(t, err, tt.wantErr)
+			assert.NotContains(t, backend.callers, "previewBet")
+		})
+	}
+}
+
+func TestPlaceBet(t *testing.T) {
+	backend := newFakeRouterBackend(t)
+	service := newTestService(t, backend)
+
+	quote, err := service.QuoteBet(context.Background(), BetRequest{
+		Market:    testMarket,
+		OutcomeID: 2,
+		Amount:    big.NewInt(10_000_000),
+	}, 100)
+	require.NoError(t, err)
+
+	backend.logs = []*types.Log{
+		backend.betPlacedLog(testRouter, service.From(), 2, 10_000_000, 18_100_000, 200_000),
+		// 其他合约发出的同名事件不计入结果
+		backend.betPlacedLog(testToken, service.From(), 1, 1, 1, 1),
+	}
+
+	_, results, err := service.PlaceBet(context.Background(), quote)
+	require.NoError(t, err)
+
+	// placeBet 的 minShares 参数来自报价
+	require.Len(t, backend.sent, 1)
+	tx := backend.sent[0]
+	assert.Equal(t, testRouter, *tx.To())
+	method, err := backend.abi.MethodById(tx.Data()[:4])
+	require.NoError(t, err)
+	assert.Equal(t, "placeBet", method.Name)
+	args, err := method.Inputs.Unpack(tx.Data()[4:])
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{testMarket, big.NewInt(2), big.NewInt(10_000_000), quote.MinShares}, args)
+	assert.Equal(t, big.NewInt(17_942_222), quote.MinShares)
+
+	require.Len(t, results, 1)
+	assert.Equal(t, &BetResult{
+		Market:    testMarket,
+		OutcomeID: big.NewInt(2),
+		Amount:    big.NewInt(10_000_000),
+		Shares:    big.NewInt(18_100_000),
+		Fee:       big.NewInt(200_000),
+	}, results[0])
+}
@@ -0,0 +1,36 @@
+// Package betting 提供 p1cli 的交易功能：通过 BettingRouter_V3 下注，以及在 Market_V3 上赎回、退款
+package betting
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// LoadKeystore 解密 keystore 文件（geth / Foundry cast wallet 格式），返回私钥与地址
+func LoadKeystore(path, password string) (*ecdsa.PrivateKey, common.Address, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, common.Address{}, fmt.Errorf("读取 keystore 失败: %w", err)
+	}
+
+	key, err := keystore.DecryptKey(data, password)
+	if err != nil {
+		return nil, common.Address{}, fmt.Errorf("解密 keystore 失败: %w", err)
+	}
+
+	return key.PrivateKey, key.Address, nil
+}
+
+// ReadPasswordFile 读取密码文件（去掉末尾换行）
+func ReadPasswordFile(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("读取密码文件失败: %w", err)
+	}
+	return strings.TrimRight(string(data), "\r\n"), nil
+}
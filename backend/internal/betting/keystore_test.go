@@ -0,0 +1,44 @@
+package betting
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadKeystore(t *testing.T) {
+	privateKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	key := &keystore.Key{
+		Id:         uuid.New(),
+		Address:    crypto.PubkeyToAddress(privateKey.PublicKey),
+		PrivateKey: privateKey,
+	}
+	data, err := keystore.EncryptKey(key, "secret", keystore.LightScryptN, keystore.LightScryptP)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "key.json")
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+	passwordPath := filepath.Join(dir, "password")
+	require.NoError(t, os.WriteFile(passwordPath, []byte("secret\n"), 0o600))
+
+	password, err := ReadPasswordFile(passwordPath)
+	require.NoError(t, err)
+	assert.Equal(t, "secret", password)
+
+	loaded, address, err := LoadKeystore(path, password)
+	require.NoError(t, err)
+	assert.Equal(t, key.Address, address)
+	assert.Equal(t, privateKey.D, loaded.D)
+
+	_, _, err = LoadKeystore(path, "wrong")
+	assert.Error(t, err)
+}
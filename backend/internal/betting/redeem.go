@@ -0,0 +1,158 @@
+package betting
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/pitchone/sportsbook/pkg/bindings"
+)
+
+// Market_V3 状态（与 IMarket_V3.MarketStatus 一致）
+const (
+	marketStatusFinalized uint8 = 4
+	marketStatusCancelled uint8 = 5
+)
+
+// Claim 一个可赎回或可退款的头寸
+type Claim struct {
+	Market    common.Address
+	OutcomeID *big.Int
+	Shares    *big.Int
+	Amount    *big.Int // 预计获得金额（eth_call 模拟）
+}
+
+// QuoteRedeem 列出已终结市场中可赎回的获胜头寸
+// 对每个有余额的结果模拟 redeemFor，回滚的（未获胜）结果会被跳过
+func (s *Service) QuoteRedeem(ctx context.Context, market common.Address) ([]*Claim, error) {
+	return s.quoteClaims(ctx, market, marketStatusFinalized, "redeemFor")
+}
+
+// QuoteRefund 列出已取消市场中可退款的头寸
+func (s *Service) QuoteRefund(ctx context.Context, market common.Address) ([]*Claim, error) {
+	return s.quoteClaims(ctx, market, marketStatusCancelled, "refundFor")
+}
+
+func (s *Service) quoteClaims(ctx context.Context, market common.Address, wantStatus uint8, method string) ([]*Claim, error) {
+	m, err := bindings.NewMarketV3(market, s.backend)
+	if err != nil {
+		return nil, fmt.Errorf("初始化市场合约失败: %w", err)
+	}
+
+	opts := s.callOpts(ctx)
+
+	status, err := m.Status(opts)
+	if err != nil {
+		return nil, fmt.Errorf("获取市场状态失败: %w", err)
+	}
+	if status != wantStatus {
+		return nil, fmt.Errorf("市场状态为 %s，%s 需要 %s", marketStatusName(status), method, marketStatusName(wantStatus))
+	}
+
+	count, err := m.OutcomeCount(opts)
+	if err != nil {
+		return nil, fmt.Errorf("获取结果数量失败: %w", err)
+	}
+
+	raw := &bindings.MarketV3CallerRaw{Contract: &m.MarketV3Caller}
+	claims := make([]*Claim, 0)
+
+	for i := int64(0); i < count.Int64(); i++ {
+		outcomeID := big.NewInt(i)
+		shares, err := m.BalanceOf(opts, s.from, outcomeID)
+		if err != nil {
+			return nil, fmt.Errorf("获取结果 %d 份额失败: %w", i, err)
+		}
+		if shares.Sign() == 0 {
+			continue
+		}
+
+		var out []interface{}
+		if err := raw.Call(opts, &out, method, s.from, outcomeID, shares); err != nil {
+			continue
+		}
+
+		claims = append(claims, &Claim{
+			Market:    market,
+			OutcomeID: outcomeID,
+			Shares:    shares,
+			Amount:    abiBigInt(out),
+		})
+	}
+
+	return claims, nil
+}
+
+// Redeem 通过 Market_V3.redeemBatchFor 一次赎回同一市场的全部获胜头寸
+func (s *Service) Redeem(ctx context.Context, market common.Address, claims []*Claim) (*types.Receipt, error) {
+	m, err := bindings.NewMarketV3Transactor(market, s.backend)
+	if err != nil {
+		return nil, fmt.Errorf("初始化市场合约失败: %w", err)
+	}
+
+	outcomeIDs := make([]*big.Int, 0, len(claims))
+	shares := make([]*big.Int, 0, len(claims))
+	for _, c := range claims {
+		outcomeIDs = append(outcomeIDs, c.OutcomeID)
+		shares = append(shares, c.Shares)
+	}
+
+	auth, err := s.transactor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := m.RedeemBatchFor(auth, s.from, outcomeIDs, shares)
+	if err != nil {
+		return nil, fmt.Errorf("发送 redeemBatchFor 交易失败: %w", err)
+	}
+	return s.waitMined(ctx, tx)
+}
+
+// Refund 通过 Market_V3.refundFor 逐个退款（合约没有批量退款入口）
+func (s *Service) Refund(ctx context.Context, market common.Address, claims []*Claim) ([]*types.Receipt, error) {
+	m, err := bindings.NewMarketV3Transactor(market, s.backend)
+	if err != nil {
+		return nil, fmt.Errorf("初始化市场合约失败: %w", err)
+	}
+
+	receipts := make([]*types.Receipt, 0, len(claims))
+	for _, c := range claims {
+		auth, err := s.transactor(ctx)
+		if err != nil {
+			return receipts, err
+		}
+
+		tx, err := m.RefundFor(auth, s.from, c.OutcomeID, c.Shares)
+		if err != nil {
+			return receipts, fmt.Errorf("发送 refundFor(%s) 交易失败: %w", c.OutcomeID, err)
+		}
+		receipt, err := s.waitMined(ctx, tx)
+		if err != nil {
+			return receipts, err
+		}
+		receipts = append(receipts, receipt)
+	}
+	return receipts, nil
+}
+
+// abiBigInt 读取单个 uint256 返回值
+func abiBigInt(out []interface{}) *big.Int {
+	if len(out) == 0 {
+		return big.NewInt(0)
+	}
+	return *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+}
+
+// marketStatusName Market_V3 状态名
+func marketStatusName(status uint8) string {
+	names := []string{"Created", "Open", "Locked", "Resolved", "Finalized", "Cancelled"}
+	if int(status) < len(names) {
+		return names[status]
+	}
+	return fmt.Sprintf("Unknown(%d)", status)
+}
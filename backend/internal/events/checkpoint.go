@@ -0,0 +1,130 @@
+package events
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Checkpoint 导出进度，每个区间写出后保存；中断后以相同参数重新运行即从 Next 续传
+type Checkpoint struct {
+	Contract  string           `json:"contract"`
+	Addresses []common.Address `json:"addresses"`
+	Events    []string         `json:"events,omitempty"`
+	Format    string           `json:"format"`
+	From      uint64           `json:"from"`
+	To        uint64           `json:"to"`
+	Next      uint64           `json:"next"`   // 下一个待扫描的区块
+	Offset    int64            `json:"offset"` // 已确认写出的字节数，续传时截断到此处
+	Rows      uint64           `json:"rows"`
+	UpdatedAt time.Time        `json:"updatedAt"`
+}
+
+// newCheckpoint 创建从 from 开始的进度（地址与事件排序后记录，便于续传时比较）
+func newCheckpoint(opts *ExportOptions, to uint64) *Checkpoint {
+	addresses := append([]common.Address(nil), opts.Addresses...)
+	sort.Slice(addresses, func(i, j int) bool {
+		return addresses[i].Hex() < addresses[j].Hex()
+	})
+	events := append([]string(nil), opts.Events...)
+	sort.Strings(events)
+
+	return &Checkpoint{
+		Contract:  opts.Contract,
+		Addresses: addresses,
+		Events:    events,
+		Format:    opts.Format,
+		From:      opts.From,
+		To:        to,
+		Next:      opts.From,
+	}
+}
+
+// Done 是否已扫描到 To
+func (c *Checkpoint) Done() bool {
+	return c.Next > c.To
+}
+
+// sameParams 检查两次导出的参数是否一致
+func (c *Checkpoint) sameParams(other *Checkpoint) error {
+	switch {
+	case c.Contract != other.Contract:
+		return fmt.Errorf("合约类型 %s != %s", c.Contract, other.Contract)
+	case c.Format != other.Format:
+		return fmt.Errorf("格式 %s != %s", c.Format, other.Format)
+	case c.From != other.From || c.To != other.To:
+		return fmt.Errorf("区块范围 %d-%d != %d-%d", c.From, c.To, other.From, other.To)
+	case !sameAddresses(c.Addresses, other.Addresses):
+		return fmt.Errorf("合约地址不同")
+	case strings.Join(c.Events, ",") != strings.Join(other.Events, ","):
+		return fmt.Errorf("事件过滤 [%s] != [%s]", strings.Join(c.Events, ","), strings.Join(other.Events, ","))
+	}
+	return nil
+}
+
+func sameAddresses(a, b []common.Address) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// LoadCheckpoint 读取检查点，文件不存在时返回 nil
+func LoadCheckpoint(path string) (*Checkpoint, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("读取检查点失败: %w", err)
+	}
+
+	var c Checkpoint
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, fmt.Errorf("解析检查点 %s 失败: %w", path, err)
+	}
+	return &c, nil
+}
+
+// Save 写入检查点（先写临时文件再重命名，中途退出不会留下半个文件）
+func (c *Checkpoint) Save(path string) error {
+	c.UpdatedAt = time.Now().UTC()
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("写入检查点失败: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("写入检查点失败: %w", err)
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("写入检查点失败: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("写入检查点失败: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("写入检查点失败: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,318 @@
+// Package events 按区块区间扫描协议合约事件并解码为行，供 p1cli events export 导出
+package events
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/pitchone/sportsbook/pkg/bindings"
+)
+
+// 可导出的合约类型
+const (
+	ContractMarket    = "market"
+	ContractFactory   = "factory"
+	ContractRouter    = "router"
+	ContractVault     = "vault"
+	ContractFeeRouter = "fee-router"
+)
+
+// factoryABI MarketFactory_V3 的事件
+// pkg/bindings 中的 MarketFactory 仍是旧版 ABI（MarketCreated(address,bytes32,address)），与 V3 部署的 topic0 不一致
+const factoryABI = `[` +
+	`{"anonymous":false,"inputs":[` +
+	`{"indexed":true,"name":"templateId","type":"bytes32"},` +
+	`{"indexed":false,"name":"name","type":"string"},` +
+	`{"indexed":false,"name":"strategyType","type":"string"}],` +
+	`"name":"TemplateRegistered","type":"event"},` +
+	`{"anonymous":false,"inputs":[` +
+	`{"indexed":true,"name":"templateId","type":"bytes32"},` +
+	`{"indexed":false,"name":"active","type":"bool"}],` +
+	`"name":"TemplateUpdated","type":"event"},` +
+	`{"anonymous":false,"inputs":[` +
+	`{"indexed":false,"name":"strategyType","type":"string"},` +
+	`{"indexed":false,"name":"strategy","type":"address"}],` +
+	`"name":"StrategyRegistered","type":"event"},` +
+	`{"anonymous":false,"inputs":[` +
+	`{"indexed":false,"name":"mapper","type":"address"}],` +
+	`"name":"MapperRegistered","type":"event"},` +
+	`{"anonymous":false,"inputs":[` +
+	`{"indexed":true,"name":"market","type":"address"},` +
+	`{"indexed":true,"name":"templateId","type":"bytes32"},` +
+	`{"indexed":false,"name":"matchId","type":"string"},` +
+	`{"indexed":false,"name":"kickoffTime","type":"uint256"}],` +
+	`"name":"MarketCreated","type":"event"},` +
+	`{"anonymous":false,"inputs":[` +
+	`{"indexed":true,"name":"newRouter","type":"address"}],` +
+	`"name":"RouterUpdated","type":"event"},` +
+	`{"anonymous":false,"inputs":[` +
+	`{"indexed":true,"name":"newKeeper","type":"address"}],` +
+	`"name":"KeeperUpdated","type":"event"},` +
+	`{"anonymous":false,"inputs":[` +
+	`{"indexed":true,"name":"keeper","type":"address"}],` +
+	`"name":"KeeperAdded","type":"event"},` +
+	`{"anonymous":false,"inputs":[` +
+	`{"indexed":true,"name":"keeper","type":"address"}],` +
+	`"name":"KeeperRemoved","type":"event"},` +
+	`{"anonymous":false,"inputs":[` +
+	`{"indexed":true,"name":"newOracle","type":"address"}],` +
+	`"name":"OracleUpdated","type":"event"},` +
+	`{"anonymous":false,"inputs":[` +
+	`{"indexed":true,"name":"newVault","type":"address"}],` +
+	`"name":"VaultUpdated","type":"event"},` +
+	`{"anonymous":false,"inputs":[` +
+	`{"indexed":true,"name":"newParamController","type":"address"}],` +
+	`"name":"ParamControllerUpdated","type":"event"}` +
+	`]`
+
+// vaultABI LiquidityVault_V3 的事件：ILiquidityVault_V3 中声明的事件及 ERC4626 的 Deposit / Withdraw
+// pkg/bindings 中没有 LiquidityVault_V3 的绑定
+const vaultABI = `[` +
+	`{"anonymous":false,"inputs":[` +
+	`{"indexed":true,"name":"market","type":"address"},` +
+	`{"indexed":false,"name":"maxLiabilityBps","type":"uint256"}],` +
+	`"name":"MarketAuthorized","type":"event"},` +
+	`{"anonymous":false,"inputs":[` +
+	`{"indexed":true,"name":"market","type":"address"}],` +
+	`"name":"MarketRevoked","type":"event"},` +
+	`{"anonymous":false,"inputs":[` +
+	`{"indexed":true,"name":"market","type":"address"},` +
+	`{"indexed":false,"name":"newMaxLiabilityBps","type":"uint256"}],` +
+	`"name":"MaxLiabilityUpdated","type":"event"},` +
+	`{"anonymous":false,"inputs":[` +
+	`{"indexed":true,"name":"market","type":"address"},` +
+	`{"indexed":false,"name":"amount","type":"uint256"},` +
+	`{"indexed":false,"name":"totalBorrowed","type":"uint256"}],` +
+	`"name":"LiquidityBorrowed","type":"event"},` +
+	`{"anonymous":false,"inputs":[` +
+	`{"indexed":true,"name":"market","type":"address"},` +
+	`{"indexed":false,"name":"principal","type":"uint256"},` +
+	`{"indexed":false,"name":"pnl","type":"int256"},` +
+	`{"indexed":false,"name":"totalBorrowed","type":"uint256"}],` +
+	`"name":"LiquiditySettled","type":"event"},` +
+	`{"anonymous":false,"inputs":[` +
+	`{"indexed":false,"name":"profit","type":"uint256"},` +
+	`{"indexed":false,"name":"toReserve","type":"uint256"},` +
+	`{"indexed":false,"name":"toLPs","type":"uint256"}],` +
+	`"name":"ProfitDistributed","type":"event"},` +
+	`{"anonymous":false,"inputs":[` +
+	`{"indexed":false,"name":"newBalance","type":"uint256"},` +
+	`{"indexed":false,"name":"isDeposit","type":"bool"}],` +
+	`"name":"ReserveFundUpdated","type":"event"},` +
+	`{"anonymous":false,"inputs":[` +
+	`{"indexed":true,"name":"market","type":"address"},` +
+	`{"indexed":false,"name":"amount","type":"uint256"},` +
+	`{"indexed":false,"name":"remainingReserve","type":"uint256"}],` +
+	`"name":"ReserveFundUsed","type":"event"},` +
+	`{"anonymous":false,"inputs":[` +
+	`{"indexed":true,"name":"market","type":"address"},` +
+	`{"indexed":false,"name":"shortfall","type":"uint256"}],` +
+	`"name":"LiabilityShortfall","type":"event"},` +
+	`{"anonymous":false,"inputs":[` +
+	`{"indexed":true,"name":"sender","type":"address"},` +
+	`{"indexed":true,"name":"owner","type":"address"},` +
+	`{"indexed":false,"name":"assets","type":"uint256"},` +
+	`{"indexed":false,"name":"shares","type":"uint256"}],` +
+	`"name":"Deposit","type":"event"},` +
+	`{"anonymous":false,"inputs":[` +
+	`{"indexed":true,"name":"sender","type":"address"},` +
+	`{"indexed":true,"name":"receiver","type":"address"},` +
+	`{"indexed":true,"name":"owner","type":"address"},` +
+	`{"indexed":false,"name":"assets","type":"uint256"},` +
+	`{"indexed":false,"name":"shares","type":"uint256"}],` +
+	`"name":"Withdraw","type":"event"}` +
+	`]`
+
+// contractMeta 合约类型对应的 ABI 元数据（有绑定的合约与生成的 *Filterer 使用同一 ABI）
+var contractMeta = map[string]*bind.MetaData{
+	ContractMarket:    bindings.MarketV3MetaData,
+	ContractFactory:   {ABI: factoryABI},
+	ContractRouter:    bindings.BettingRouterV3MetaData,
+	ContractVault:     {ABI: vaultABI},
+	ContractFeeRouter: bindings.FeeRouterMetaData,
+}
+
+// Contracts 返回支持的合约类型
+func Contracts() []string {
+	return []string{ContractMarket, ContractFactory, ContractRouter, ContractVault, ContractFeeRouter}
+}
+
+// Row 一条解码后的事件
+// 参数值：地址（小写）与哈希为十六进制，uint256 等大整数为十进制字符串，索引的动态类型为其 keccak256 哈希
+// 各事件参数不同，Args 按参数名整体保存，不按事件拆分为独立列
+type Row struct {
+	BlockNumber uint64                 `json:"blockNumber"`
+	BlockHash   common.Hash            `json:"blockHash"`
+	TxHash      common.Hash            `json:"txHash"`
+	TxIndex     uint                   `json:"txIndex"`
+	LogIndex    uint                   `json:"logIndex"`
+	Contract    string                 `json:"contract"`
+	Address     common.Address         `json:"address"`
+	Event       string                 `json:"event"`
+	Signature   string                 `json:"signature"`
+	Args        map[string]interface{} `json:"args"`
+}
+
+// Decoder 按 topic0 解码一类合约的事件
+type Decoder struct {
+	contract string
+	bound    *bind.BoundContract
+	events   map[common.Hash]abi.Event
+}
+
+// NewDecoder 创建合约类型的事件解码器
+func NewDecoder(contract string) (*Decoder, error) {
+	meta, ok := contractMeta[contract]
+	if !ok {
+		return nil, fmt.Errorf("不支持的合约类型: %s (支持: %s)", contract, strings.Join(Contracts(), ", "))
+	}
+	parsed, err := meta.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("解析 %s ABI 失败: %w", contract, err)
+	}
+
+	d := &Decoder{
+		contract: contract,
+		bound:    bind.NewBoundContract(common.Address{}, *parsed, nil, nil, nil),
+		events:   make(map[common.Hash]abi.Event, len(parsed.Events)),
+	}
+	for _, event := range parsed.Events {
+		if !event.Anonymous {
+			d.events[event.ID] = event
+		}
+	}
+	return d, nil
+}
+
+// Topics 返回事件的 topic0，用于 eth_getLogs 过滤；names 为空时返回全部事件
+// names 匹配事件名（重载事件同名时全部匹配）
+func (d *Decoder) Topics(names []string) ([]common.Hash, error) {
+	wanted := make(map[string]bool, len(names))
+	for _, name := range names {
+		wanted[name] = false
+	}
+
+	topics := make([]common.Hash, 0, len(d.events))
+	for id, event := range d.events {
+		if len(names) > 0 {
+			if _, ok := wanted[event.RawName]; !ok {
+				continue
+			}
+			wanted[event.RawName] = true
+		}
+		topics = append(topics, id)
+	}
+	for name, found := range wanted {
+		if !found {
+			return nil, fmt.Errorf("%s 合约没有事件 %s", d.contract, name)
+		}
+	}
+
+	sort.Slice(topics, func(i, j int) bool {
+		return topics[i].Hex() < topics[j].Hex()
+	})
+	return topics, nil
+}
+
+// Decode 解码一条日志；topic0 不属于该合约时返回 nil
+func (d *Decoder) Decode(log types.Log) (*Row, error) {
+	if len(log.Topics) == 0 {
+		return nil, nil
+	}
+	event, ok := d.events[log.Topics[0]]
+	if !ok {
+		return nil, nil
+	}
+
+	args := make(map[string]interface{}, len(event.Inputs))
+	if err := d.bound.UnpackLogIntoMap(args, event.Name, log); err != nil {
+		return nil, fmt.Errorf("解码 %s 失败 (tx %s, log %d): %w", event.Sig, log.TxHash.Hex(), log.Index, err)
+	}
+	for name, value := range args {
+		args[name] = normalize(reflect.ValueOf(value))
+	}
+
+	return &Row{
+		BlockNumber: log.BlockNumber,
+		BlockHash:   log.BlockHash,
+		TxHash:      log.TxHash,
+		TxIndex:     log.TxIndex,
+		LogIndex:    log.Index,
+		Contract:    d.contract,
+		Address:     log.Address,
+		Event:       event.RawName,
+		Signature:   event.Sig,
+		Args:        args,
+	}, nil
+}
+
+var (
+	bigIntType  = reflect.TypeOf((*big.Int)(nil))
+	addressType = reflect.TypeOf(common.Address{})
+	hashType    = reflect.TypeOf(common.Hash{})
+)
+
+// normalize 将 ABI 解码值转换为可 JSON 编码且不丢精度的值
+func normalize(v reflect.Value) interface{} {
+	if !v.IsValid() {
+		return nil
+	}
+
+	switch v.Type() {
+	case bigIntType:
+		if v.IsNil() {
+			return nil
+		}
+		return v.Interface().(*big.Int).String()
+	case addressType:
+		return strings.ToLower(v.Interface().(common.Address).Hex())
+	case hashType:
+		return v.Interface().(common.Hash).Hex()
+	}
+
+	switch v.Kind() {
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return hexutil.Encode(v.Bytes())
+		}
+		fallthrough
+	case reflect.Array:
+		if v.Kind() == reflect.Array && v.Type().Elem().Kind() == reflect.Uint8 {
+			b := make([]byte, v.Len())
+			reflect.Copy(reflect.ValueOf(b), v)
+			return hexutil.Encode(b)
+		}
+		items := make([]interface{}, v.Len())
+		for i := range items {
+			items[i] = normalize(v.Index(i))
+		}
+		return items
+	case reflect.Struct:
+		fields := make(map[string]interface{}, v.NumField())
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+			name := field.Name
+			if tag := field.Tag.Get("json"); tag != "" && tag != "-" {
+				name = strings.Split(tag, ",")[0]
+			}
+			fields[name] = normalize(v.Field(i))
+		}
+		return fields
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return normalize(v.Elem())
+	default:
+		return v.Interface()
+	}
+}
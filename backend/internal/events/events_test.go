@@ -0,0 +1,195 @@
+package events
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pitchone/sportsbook/pkg/bindings"
+)
+
+var (
+	testMarket = common.HexToAddress("0x00000000000000000000000000000000000000a1")
+	testUser   = common.HexToAddress("0x00000000000000000000000000000000000000b2")
+)
+
+// makeLog 按 ABI 编码事件日志：indexed 为索引参数，data 为非索引参数
+func makeLog(t *testing.T, meta string, event string, address common.Address, block uint64, indexed []interface{}, data ...interface{}) types.Log {
+	t.Helper()
+	parsed, err := contractMeta[meta].GetAbi()
+	require.NoError(t, err)
+	ev, ok := parsed.Events[event]
+	require.True(t, ok, event)
+
+	query := make([][]interface{}, len(indexed))
+	for i, v := range indexed {
+		query[i] = []interface{}{v}
+	}
+	topics, err := abi.MakeTopics(query...)
+	require.NoError(t, err)
+
+	log := types.Log{
+		Address:     address,
+		Topics:      []common.Hash{ev.ID},
+		BlockNumber: block,
+		TxHash:      common.BigToHash(new(big.Int).SetUint64(block)),
+	}
+	for _, topic := range topics {
+		log.Topics = append(log.Topics, topic[0])
+	}
+	log.Data, err = ev.Inputs.NonIndexed().Pack(data...)
+	require.NoError(t, err)
+	return log
+}
+
+func betPlacedLog(t *testing.T, block uint64) types.Log {
+	marketID := common.HexToHash("0x1234")
+	return makeLog(t, ContractMarket, "BetPlaced0", testMarket, block,
+		[]interface{}{marketID, testUser, big.NewInt(2)},
+		big.NewInt(10_000_000), new(big.Int).Mul(big.NewInt(15), big.NewInt(1e18)))
+}
+
+func TestDecoder_DecodeMatchesFilterer(t *testing.T) {
+	decoder, err := NewDecoder(ContractMarket)
+	require.NoError(t, err)
+
+	log := betPlacedLog(t, 42)
+	log.Index = 3
+	row, err := decoder.Decode(log)
+	require.NoError(t, err)
+	require.NotNil(t, row)
+
+	assert.Equal(t, uint64(42), row.BlockNumber)
+	assert.Equal(t, uint(3), row.LogIndex)
+	assert.Equal(t, ContractMarket, row.Contract)
+	assert.Equal(t, "BetPlaced", row.Event)
+	assert.Equal(t, "BetPlaced(bytes32,address,uint256,uint256,uint256)", row.Signature)
+
+	// 与生成的 MarketV3Filterer 解析结果一致
+	filterer, err := bindings.NewMarketV3Filterer(testMarket, nil)
+	require.NoError(t, err)
+	event, err := filterer.ParseBetPlaced0(log)
+	require.NoError(t, err)
+
+	assert.Equal(t, common.Hash(event.MarketId).Hex(), row.Args["marketId"])
+	assert.Equal(t, strings.ToLower(event.User.Hex()), row.Args["user"])
+	assert.Equal(t, event.OutcomeId.String(), row.Args["outcomeId"])
+	assert.Equal(t, event.Amount.String(), row.Args["amount"])
+	assert.Equal(t, "15000000000000000000", row.Args["shares"])
+}
+
+func TestDecoder_FactoryMarketCreatedV3(t *testing.T) {
+	decoder, err := NewDecoder(ContractFactory)
+	require.NoError(t, err)
+
+	// MarketFactory_V3 发出的原始日志：MarketCreated(market, templateId, "EPL_2024_MUN_vs_LIV", 1735689600)
+	templateID := common.HexToHash("0x7b1a8c0e2f4d6a9b3c5e7f1a2b4c6d8e0f1a3b5c7d9e1f2a4b6c8d0e2f4a6b8c")
+	log := types.Log{
+		Address: common.HexToAddress("0x00000000000000000000000000000000000000c3"),
+		Topics: []common.Hash{
+			crypto.Keccak256Hash([]byte("MarketCreated(address,bytes32,string,uint256)")),
+			common.BytesToHash(testMarket.Bytes()),
+			templateID,
+		},
+		Data: common.FromHex("0x" +
+			"0000000000000000000000000000000000000000000000000000000000000040" +
+			"0000000000000000000000000000000000000000000000000000000067748580" +
+			"0000000000000000000000000000000000000000000000000000000000000013" +
+			"45504c5f323032345f4d554e5f76735f4c495600000000000000000000000000"),
+		BlockNumber: 7,
+	}
+
+	row, err := decoder.Decode(log)
+	require.NoError(t, err)
+	require.NotNil(t, row)
+
+	assert.Equal(t, "MarketCreated", row.Event)
+	assert.Equal(t, "MarketCreated(address,bytes32,string,uint256)", row.Signature)
+	assert.Equal(t, map[string]interface{}{
+		"market":      strings.ToLower(testMarket.Hex()),
+		"templateId":  templateID.Hex(),
+		"matchId":     "EPL_2024_MUN_vs_LIV",
+		"kickoffTime": "1735689600",
+	}, row.Args)
+
+	// 旧版 MarketFactory 绑定的 topic0 不再匹配
+	legacy, err := bindings.MarketFactoryMetaData.GetAbi()
+	require.NoError(t, err)
+	row, err = decoder.Decode(types.Log{Topics: []common.Hash{legacy.Events["MarketCreated"].ID}})
+	require.NoError(t, err)
+	assert.Nil(t, row)
+}
+
+func TestDecoder_VaultLiquiditySettled(t *testing.T) {
+	decoder, err := NewDecoder(ContractVault)
+	require.NoError(t, err)
+
+	// LiquiditySettled(market, 5000e6, -1250e6, 12000e6)：亏损结算 pnl 为负
+	log := types.Log{
+		Topics: []common.Hash{
+			crypto.Keccak256Hash([]byte("LiquiditySettled(address,uint256,int256,uint256)")),
+			common.BytesToHash(testMarket.Bytes()),
+		},
+		Data: common.FromHex("0x" +
+			"000000000000000000000000000000000000000000000000000000012a05f200" +
+			"ffffffffffffffffffffffffffffffffffffffffffffffffffffffffb57e8380" +
+			"00000000000000000000000000000000000000000000000000000002cb417800"),
+	}
+
+	row, err := decoder.Decode(log)
+	require.NoError(t, err)
+	require.NotNil(t, row)
+
+	assert.Equal(t, ContractVault, row.Contract)
+	assert.Equal(t, "LiquiditySettled", row.Event)
+	assert.Equal(t, map[string]interface{}{
+		"market":        strings.ToLower(testMarket.Hex()),
+		"principal":     "5000000000",
+		"pnl":           "-1250000000",
+		"totalBorrowed": "12000000000",
+	}, row.Args)
+
+	topics, err := decoder.Topics([]string{"LiquidityBorrowed", "LiquiditySettled", "ProfitDistributed"})
+	require.NoError(t, err)
+	assert.Len(t, topics, 3)
+}
+
+func TestDecoder_UnknownTopic(t *testing.T) {
+	decoder, err := NewDecoder(ContractFeeRouter)
+	require.NoError(t, err)
+
+	row, err := decoder.Decode(betPlacedLog(t, 1))
+	require.NoError(t, err)
+	assert.Nil(t, row)
+
+	row, err = decoder.Decode(types.Log{})
+	require.NoError(t, err)
+	assert.Nil(t, row)
+}
+
+func TestDecoder_Topics(t *testing.T) {
+	decoder, err := NewDecoder(ContractMarket)
+	require.NoError(t, err)
+
+	all, err := decoder.Topics(nil)
+	require.NoError(t, err)
+	assert.Greater(t, len(all), 10)
+
+	// 重载的 BetPlaced 两个签名都匹配
+	betPlaced, err := decoder.Topics([]string{"BetPlaced"})
+	require.NoError(t, err)
+	assert.Len(t, betPlaced, 2)
+
+	_, err = decoder.Topics([]string{"FeeRouted"})
+	assert.Error(t, err)
+
+	_, err = NewDecoder("oracle")
+	assert.Error(t, err)
+}
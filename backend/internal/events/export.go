@@ -0,0 +1,296 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Client 导出使用的 RPC 接口（ethclient.Client 实现）
+type Client interface {
+	LogFilterer
+	BlockNumber(ctx context.Context) (uint64, error)
+}
+
+// ExportOptions 导出参数
+type ExportOptions struct {
+	Contract   string
+	Addresses  []common.Address
+	Events     []string // 只导出这些事件，空表示全部
+	From       uint64
+	To         uint64 // 0 表示最新区块；续传时沿用检查点中的区块
+	Format     string
+	Output     string // 空表示写到 Stdout（不支持 Parquet，也不记录检查点）
+	Checkpoint string // 默认为 Output + ".checkpoint"
+	ChunkSize  uint64
+
+	Stdout   io.Writer
+	Progress func(*Checkpoint) // 每个区间写出后回调
+}
+
+// CheckpointPath 返回检查点文件路径
+func (o *ExportOptions) CheckpointPath() string {
+	if o.Checkpoint != "" {
+		return o.Checkpoint
+	}
+	return o.Output + ".checkpoint"
+}
+
+// stagePath Parquet 导出过程中暂存 NDJSON 行的文件
+func (o *ExportOptions) stagePath() string {
+	return o.Output + ".ndjson.part"
+}
+
+// Export 扫描并导出事件，返回最终进度
+// 写到文件时每个区间写出并 fsync 后保存检查点；出错或中断后以相同参数重新运行即可续传，
+// 检查点之后写出的部分会被截断，不会产生重复行
+func Export(ctx context.Context, client Client, opts ExportOptions) (*Checkpoint, error) {
+	if len(opts.Addresses) == 0 {
+		return nil, fmt.Errorf("未指定合约地址")
+	}
+	switch opts.Format {
+	case FormatNDJSON, FormatCSV:
+	case FormatParquet:
+		if opts.Output == "" {
+			return nil, fmt.Errorf("Parquet 格式需要指定输出文件")
+		}
+	default:
+		return nil, fmt.Errorf("无效的输出格式: %s (支持: %s, %s, %s)", opts.Format, FormatNDJSON, FormatCSV, FormatParquet)
+	}
+
+	decoder, err := NewDecoder(opts.Contract)
+	if err != nil {
+		return nil, err
+	}
+	topics, err := decoder.Topics(opts.Events)
+	if err != nil {
+		return nil, err
+	}
+	scanner := NewScanner(client, opts.Addresses, topics, opts.ChunkSize)
+
+	if opts.Output == "" {
+		return exportStream(ctx, client, decoder, scanner, &opts)
+	}
+	return exportFile(ctx, client, decoder, scanner, &opts)
+}
+
+// exportStream 写到 Stdout，不记录检查点
+func exportStream(ctx context.Context, client Client, decoder *Decoder, scanner *Scanner, opts *ExportOptions) (*Checkpoint, error) {
+	to, err := resolveTo(ctx, client, opts)
+	if err != nil {
+		return nil, err
+	}
+	progress := newCheckpoint(opts, to)
+
+	out := opts.Stdout
+	if out == nil {
+		out = os.Stdout
+	}
+	writer := newRowWriter(opts.Format, out)
+	if csv, ok := writer.(*csvWriter); ok {
+		if err := csv.WriteHeader(); err != nil {
+			return nil, err
+		}
+	}
+
+	err = scanner.Scan(ctx, progress.Next, progress.To, func(from, to uint64, logs []types.Log) error {
+		rows, err := writeLogs(decoder, writer, logs)
+		if err != nil {
+			return err
+		}
+		if err := writer.Flush(); err != nil {
+			return err
+		}
+		progress.Next = to + 1
+		progress.Rows += rows
+		if opts.Progress != nil {
+			opts.Progress(progress)
+		}
+		return nil
+	})
+	return progress, err
+}
+
+// exportFile 写到文件，按检查点续传；Parquet 先暂存为 NDJSON，扫描完成后转换
+func exportFile(ctx context.Context, client Client, decoder *Decoder, scanner *Scanner, opts *ExportOptions) (*Checkpoint, error) {
+	checkpointPath := opts.CheckpointPath()
+	progress, err := LoadCheckpoint(checkpointPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if progress != nil {
+		if opts.To == 0 {
+			opts.To = progress.To
+		}
+		if err := progress.sameParams(newCheckpoint(opts, opts.To)); err != nil {
+			return nil, fmt.Errorf("检查点 %s 与本次参数不一致（%v），请使用相同参数续传或删除检查点后重新导出", checkpointPath, err)
+		}
+	} else {
+		to, err := resolveTo(ctx, client, opts)
+		if err != nil {
+			return nil, err
+		}
+		progress = newCheckpoint(opts, to)
+	}
+
+	dataPath := opts.Output
+	if opts.Format == FormatParquet {
+		dataPath = opts.stagePath()
+	}
+
+	if !progress.Done() {
+		if err := scanToFile(ctx, decoder, scanner, opts, progress, dataPath, checkpointPath); err != nil {
+			return progress, err
+		}
+	}
+
+	if opts.Format == FormatParquet {
+		if err := convertParquet(dataPath, opts.Output); err != nil {
+			return progress, err
+		}
+		os.Remove(dataPath)
+	}
+	os.Remove(checkpointPath)
+	return progress, nil
+}
+
+// scanToFile 从 progress.Next 扫描到 progress.To，每个区间写出后保存检查点
+func scanToFile(ctx context.Context, decoder *Decoder, scanner *Scanner, opts *ExportOptions, progress *Checkpoint, dataPath, checkpointPath string) error {
+	file, err := openOutput(dataPath, progress.Offset)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	writer := newRowWriter(progress.Format, file)
+	if csv, ok := writer.(*csvWriter); ok && progress.Offset == 0 {
+		if err := csv.WriteHeader(); err != nil {
+			return err
+		}
+	}
+
+	return scanner.Scan(ctx, progress.Next, progress.To, func(from, to uint64, logs []types.Log) error {
+		rows, err := writeLogs(decoder, writer, logs)
+		if err != nil {
+			return err
+		}
+		if err := writer.Flush(); err != nil {
+			return fmt.Errorf("写入 %s 失败: %w", dataPath, err)
+		}
+		if err := file.Sync(); err != nil {
+			return fmt.Errorf("写入 %s 失败: %w", dataPath, err)
+		}
+		offset, err := file.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return err
+		}
+
+		progress.Next = to + 1
+		progress.Offset = offset
+		progress.Rows += rows
+		if err := progress.Save(checkpointPath); err != nil {
+			return err
+		}
+		if opts.Progress != nil {
+			opts.Progress(progress)
+		}
+		return nil
+	})
+}
+
+// openOutput 打开输出文件：offset 为 0 时新建（覆盖），否则截断到 offset 续写
+func openOutput(path string, offset int64) (*os.File, error) {
+	if offset == 0 {
+		file, err := os.Create(path)
+		if err != nil {
+			return nil, fmt.Errorf("创建输出文件失败: %w", err)
+		}
+		return file, nil
+	}
+
+	file, err := os.OpenFile(path, os.O_WRONLY, 0)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("检查点对应的输出文件 %s 不存在，请删除检查点后重新导出", path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("打开输出文件失败: %w", err)
+	}
+	if err := file.Truncate(offset); err != nil {
+		file.Close()
+		return nil, fmt.Errorf("截断输出文件失败: %w", err)
+	}
+	if _, err := file.Seek(offset, io.SeekStart); err != nil {
+		file.Close()
+		return nil, err
+	}
+	return file, nil
+}
+
+// writeLogs 解码并写出一个区间的日志，跳过已回滚（removed）与不属于该合约的日志
+func writeLogs(decoder *Decoder, writer rowWriter, logs []types.Log) (uint64, error) {
+	var rows uint64
+	for _, log := range logs {
+		if log.Removed {
+			continue
+		}
+		row, err := decoder.Decode(log)
+		if err != nil {
+			return rows, err
+		}
+		if row == nil {
+			continue
+		}
+		if err := writer.Write(row); err != nil {
+			return rows, err
+		}
+		rows++
+	}
+	return rows, nil
+}
+
+// convertParquet 将暂存的 NDJSON 转换为 Parquet（先写临时文件再重命名）
+func convertParquet(stagePath, output string) error {
+	src, err := os.Open(stagePath)
+	if err != nil {
+		return fmt.Errorf("打开暂存文件失败: %w", err)
+	}
+	defer src.Close()
+
+	tmp := output + ".tmp"
+	dst, err := os.Create(tmp)
+	if err != nil {
+		return fmt.Errorf("创建输出文件失败: %w", err)
+	}
+	if _, err := writeParquet(dst, src); err != nil {
+		dst.Close()
+		os.Remove(tmp)
+		return err
+	}
+	if err := dst.Close(); err != nil {
+		os.Remove(tmp)
+		return fmt.Errorf("写入输出文件失败: %w", err)
+	}
+	return os.Rename(tmp, output)
+}
+
+// resolveTo 返回结束区块：未指定时使用最新区块
+func resolveTo(ctx context.Context, client Client, opts *ExportOptions) (uint64, error) {
+	to := opts.To
+	if to == 0 {
+		latest, err := client.BlockNumber(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("获取最新区块失败: %w", err)
+		}
+		to = latest
+	}
+	if opts.From > to {
+		return 0, fmt.Errorf("起始区块 %d 大于结束区块 %d", opts.From, to)
+	}
+	return to, nil
+}
@@ -0,0 +1,241 @@
+package events
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/parquet-go/parquet-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeChain 按区块返回日志；区间超过 maxRange 时报错（模拟 RPC 的区间/结果数限制）
+type fakeChain struct {
+	logs     []types.Log
+	latest   uint64
+	maxRange uint64
+	fail     bool // 所有请求都失败
+	ranges   [][2]uint64
+}
+
+func (f *fakeChain) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	from, to := q.FromBlock.Uint64(), q.ToBlock.Uint64()
+	if f.fail {
+		return nil, fmt.Errorf("request timed out")
+	}
+	if f.maxRange > 0 && to-from+1 > f.maxRange {
+		return nil, fmt.Errorf("query returned more than 10000 results")
+	}
+	f.ranges = append(f.ranges, [2]uint64{from, to})
+
+	var out []types.Log
+	for _, log := range f.logs {
+		if log.BlockNumber >= from && log.BlockNumber <= to {
+			out = append(out, log)
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeChain) BlockNumber(ctx context.Context) (uint64, error) {
+	return f.latest, nil
+}
+
+// newFakeChain 每 10 个区块一条 BetPlaced
+func newFakeChain(t *testing.T, latest uint64) *fakeChain {
+	chain := &fakeChain{latest: latest}
+	for block := uint64(0); block <= latest; block += 10 {
+		chain.logs = append(chain.logs, betPlacedLog(t, block))
+	}
+	return chain
+}
+
+func TestScanner_AdaptiveChunks(t *testing.T) {
+	chain := newFakeChain(t, 9999)
+	chain.maxRange = 700
+
+	var seen []types.Log
+	next := uint64(0)
+	scanner := NewScanner(chain, []common.Address{testMarket}, nil, 2000)
+	err := scanner.Scan(context.Background(), 0, 9999, func(from, to uint64, logs []types.Log) error {
+		assert.Equal(t, next, from, "区间应连续")
+		next = to + 1
+		seen = append(seen, logs...)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(10000), next)
+	assert.Len(t, seen, len(chain.logs))
+
+	// 失败后缩小，连续成功后放大但不超过上限
+	for _, r := range chain.ranges {
+		assert.LessOrEqual(t, r[1]-r[0]+1, uint64(700))
+	}
+	assert.Equal(t, uint64(500), chain.ranges[0][1]-chain.ranges[0][0]+1)
+}
+
+func TestScanner_GivesUp(t *testing.T) {
+	defer func(d time.Duration) { scanRetryDelay = d }(scanRetryDelay)
+	scanRetryDelay = time.Millisecond
+
+	chain := &fakeChain{fail: true}
+	scanner := NewScanner(chain, []common.Address{testMarket}, nil, 64)
+	err := scanner.Scan(context.Background(), 100, 1000, func(from, to uint64, logs []types.Log) error {
+		t.Fatal("不应回调")
+		return nil
+	})
+	assert.ErrorContains(t, err, "获取区块 100 的日志失败")
+}
+
+func readNDJSON(t *testing.T, path string) []Row {
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+
+	var rows []Row
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var row Row
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &row))
+		rows = append(rows, row)
+	}
+	require.NoError(t, scanner.Err())
+	return rows
+}
+
+func TestExport_ResumeAfterInterrupt(t *testing.T) {
+	chain := newFakeChain(t, 999)
+	output := filepath.Join(t.TempDir(), "bets.ndjson")
+	opts := ExportOptions{
+		Contract:  ContractMarket,
+		Addresses: []common.Address{testMarket},
+		From:      0,
+		Format:    FormatNDJSON,
+		Output:    output,
+		ChunkSize: 100,
+	}
+
+	// 第一次运行：写出 3 个区间后中断
+	ctx, cancel := context.WithCancel(context.Background())
+	chunks := 0
+	first := opts
+	first.Progress = func(*Checkpoint) {
+		if chunks++; chunks == 3 {
+			cancel()
+		}
+	}
+	_, err := Export(ctx, chain, first)
+	require.ErrorIs(t, err, context.Canceled)
+
+	saved, err := LoadCheckpoint(opts.CheckpointPath())
+	require.NoError(t, err)
+	require.NotNil(t, saved)
+	assert.Equal(t, uint64(300), saved.Next)
+	assert.Equal(t, uint64(999), saved.To)
+	assert.Equal(t, uint64(30), saved.Rows)
+
+	// 模拟检查点之后写了半行
+	file, err := os.OpenFile(output, os.O_APPEND|os.O_WRONLY, 0)
+	require.NoError(t, err)
+	_, err = file.WriteString(`{"blockNumber":3`)
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+
+	// 参数不一致时拒绝续传
+	mismatched := opts
+	mismatched.Events = []string{"PayoutClaimed"}
+	_, err = Export(context.Background(), chain, mismatched)
+	assert.ErrorContains(t, err, "检查点")
+
+	// 第二次运行：续传至完成（最新区块已变化，沿用检查点中的结束区块）
+	chain.latest = 5000
+	progress, err := Export(context.Background(), chain, opts)
+	require.NoError(t, err)
+	assert.True(t, progress.Done())
+	assert.Equal(t, uint64(100), progress.Rows)
+
+	rows := readNDJSON(t, output)
+	require.Len(t, rows, 100)
+	for i, row := range rows {
+		assert.Equal(t, uint64(i*10), row.BlockNumber)
+		assert.Equal(t, "BetPlaced", row.Event)
+	}
+	_, err = os.Stat(opts.CheckpointPath())
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestExport_CSV(t *testing.T) {
+	chain := newFakeChain(t, 99)
+	output := filepath.Join(t.TempDir(), "bets.csv")
+
+	_, err := Export(context.Background(), chain, ExportOptions{
+		Contract:  ContractMarket,
+		Addresses: []common.Address{testMarket},
+		Format:    FormatCSV,
+		Output:    output,
+	})
+	require.NoError(t, err)
+
+	file, err := os.Open(output)
+	require.NoError(t, err)
+	defer file.Close()
+	records, err := csv.NewReader(file).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 11)
+	assert.Equal(t, csvHeader, records[0])
+	assert.Equal(t, "90", records[10][0])
+	assert.Equal(t, "BetPlaced", records[10][7])
+
+	var args map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(records[10][9]), &args))
+	assert.Equal(t, "10000000", args["amount"])
+}
+
+func TestExport_Parquet(t *testing.T) {
+	chain := newFakeChain(t, 4999)
+	output := filepath.Join(t.TempDir(), "bets.parquet")
+	opts := ExportOptions{
+		Contract:  ContractMarket,
+		Addresses: []common.Address{testMarket},
+		Events:    []string{"BetPlaced"},
+		Format:    FormatParquet,
+		Output:    output,
+		ChunkSize: 1000,
+	}
+
+	progress, err := Export(context.Background(), chain, opts)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(500), progress.Rows)
+
+	rows, err := parquet.ReadFile[parquetRow](output)
+	require.NoError(t, err)
+	require.Len(t, rows, 500)
+	assert.Equal(t, int64(4990), rows[499].BlockNumber)
+	assert.Equal(t, "BetPlaced", rows[0].Event)
+	assert.Contains(t, rows[0].Args, `"outcomeId":"2"`)
+
+	for _, path := range []string{opts.stagePath(), opts.CheckpointPath()} {
+		_, err := os.Stat(path)
+		assert.True(t, os.IsNotExist(err), path)
+	}
+
+	_, err = Export(context.Background(), chain, ExportOptions{
+		Contract:  ContractMarket,
+		Addresses: []common.Address{testMarket},
+		Format:    FormatParquet,
+	})
+	assert.Error(t, err)
+}
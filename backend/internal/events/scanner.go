@@ -0,0 +1,125 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// 扫描区间大小
+const (
+	DefaultChunkSize = 2000  // 初始每次 eth_getLogs 的区块数
+	MaxChunkSize     = 50000 // 连续成功后放大的上限
+)
+
+// 扫描重试
+const (
+	growAfter  = 4 // 连续成功多少次后放大区间
+	maxRetries = 5 // 区间缩到 1 个区块后仍失败的重试次数
+)
+
+// scanRetryDelay 单区块请求失败后的重试间隔基数（第 n 次重试等待 n 倍）
+var scanRetryDelay = time.Second
+
+// LogFilterer 读取日志（ethclient.Client 实现）
+type LogFilterer interface {
+	FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error)
+}
+
+// Scanner 按自适应区间扫描日志
+// 请求失败（区间过大、结果过多、超时等）时区间减半重试，连续成功后逐步放大
+type Scanner struct {
+	client     LogFilterer
+	addresses  []common.Address
+	topics     []common.Hash
+	chunk      uint64
+	retryDelay time.Duration
+}
+
+// NewScanner 创建扫描器；topics 为空时不按事件过滤，chunk 为 0 时使用 DefaultChunkSize
+func NewScanner(client LogFilterer, addresses []common.Address, topics []common.Hash, chunk uint64) *Scanner {
+	if chunk == 0 {
+		chunk = DefaultChunkSize
+	}
+	if chunk > MaxChunkSize {
+		chunk = MaxChunkSize
+	}
+	return &Scanner{
+		client:     client,
+		addresses:  addresses,
+		topics:     topics,
+		chunk:      chunk,
+		retryDelay: scanRetryDelay,
+	}
+}
+
+// Scan 按顺序扫描 [from, to]，每个区间的日志交给 handle；handle 返回错误时停止
+func (s *Scanner) Scan(ctx context.Context, from, to uint64, handle func(from, to uint64, logs []types.Log) error) error {
+	chunk := s.chunk
+	successes, failures := 0, 0
+
+	for start := from; start <= to; {
+		end := to
+		if to-start >= chunk {
+			end = start + chunk - 1
+		}
+
+		logs, err := s.client.FilterLogs(ctx, s.query(start, end))
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			successes = 0
+			if span := end - start + 1; span > 1 {
+				chunk = span / 2
+				continue
+			}
+			failures++
+			if failures > maxRetries {
+				return fmt.Errorf("获取区块 %d 的日志失败: %w", start, err)
+			}
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(s.retryDelay * time.Duration(failures)):
+			}
+			continue
+		}
+
+		failures = 0
+		if err := handle(start, end, logs); err != nil {
+			return err
+		}
+		if end == to {
+			return nil
+		}
+		start = end + 1
+
+		successes++
+		if successes >= growAfter && chunk < MaxChunkSize {
+			chunk *= 2
+			if chunk > MaxChunkSize {
+				chunk = MaxChunkSize
+			}
+			successes = 0
+		}
+	}
+	return nil
+}
+
+func (s *Scanner) query(from, to uint64) ethereum.FilterQuery {
+	q := ethereum.FilterQuery{
+		FromBlock: new(big.Int).SetUint64(from),
+		ToBlock:   new(big.Int).SetUint64(to),
+		Addresses: s.addresses,
+	}
+	if len(s.topics) > 0 {
+		q.Topics = [][]common.Hash{s.topics}
+	}
+	return q
+}
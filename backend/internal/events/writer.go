@@ -0,0 +1,170 @@
+package events
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/parquet-go/parquet-go"
+)
+
+// 输出格式
+const (
+	FormatNDJSON  = "ndjson"
+	FormatCSV     = "csv"
+	FormatParquet = "parquet"
+)
+
+// csvHeader CSV 列，args 为 JSON 对象
+// 同一文件可能混有多种事件，参数不拆分为按事件的类型化列；大整数保持十进制字符串，下游按需转换
+var csvHeader = []string{
+	"block_number", "block_hash", "tx_hash", "tx_index", "log_index",
+	"contract", "address", "event", "signature", "args",
+}
+
+// rowWriter 按格式写出事件行
+type rowWriter interface {
+	Write(row *Row) error
+	Flush() error
+}
+
+// newRowWriter 创建 NDJSON 或 CSV 写出器（Parquet 先以 NDJSON 暂存，完成后转换）
+func newRowWriter(format string, w io.Writer) rowWriter {
+	if format == FormatCSV {
+		return &csvWriter{w: csv.NewWriter(w)}
+	}
+	buf := bufio.NewWriter(w)
+	return &ndjsonWriter{buf: buf, enc: json.NewEncoder(buf)}
+}
+
+type ndjsonWriter struct {
+	buf *bufio.Writer
+	enc *json.Encoder
+}
+
+func (w *ndjsonWriter) Write(row *Row) error {
+	return w.enc.Encode(row)
+}
+
+func (w *ndjsonWriter) Flush() error {
+	return w.buf.Flush()
+}
+
+type csvWriter struct {
+	w *csv.Writer
+}
+
+func (w *csvWriter) WriteHeader() error {
+	return w.w.Write(csvHeader)
+}
+
+func (w *csvWriter) Write(row *Row) error {
+	args, err := json.Marshal(row.Args)
+	if err != nil {
+		return err
+	}
+	return w.w.Write([]string{
+		strconv.FormatUint(row.BlockNumber, 10),
+		row.BlockHash.Hex(),
+		row.TxHash.Hex(),
+		strconv.FormatUint(uint64(row.TxIndex), 10),
+		strconv.FormatUint(uint64(row.LogIndex), 10),
+		row.Contract,
+		strings.ToLower(row.Address.Hex()),
+		row.Event,
+		row.Signature,
+		string(args),
+	})
+}
+
+func (w *csvWriter) Flush() error {
+	w.w.Flush()
+	return w.w.Error()
+}
+
+// parquetRow Parquet 列，与 CSV 列一致；args 以 JSON 逻辑类型保存，可用 json_extract 等按参数名读取
+type parquetRow struct {
+	BlockNumber int64  `parquet:"block_number"`
+	BlockHash   string `parquet:"block_hash"`
+	TxHash      string `parquet:"tx_hash"`
+	TxIndex     int64  `parquet:"tx_index"`
+	LogIndex    int64  `parquet:"log_index"`
+	Contract    string `parquet:"contract,dict"`
+	Address     string `parquet:"address,dict"`
+	Event       string `parquet:"event,dict"`
+	Signature   string `parquet:"signature,dict"`
+	Args        string `parquet:"args,json"`
+}
+
+// parquetBatchSize 每次写入 Parquet 的行数
+const parquetBatchSize = 1024
+
+// writeParquet 将暂存的 NDJSON 行转换为 Parquet，返回行数
+func writeParquet(dst io.Writer, src io.Reader) (uint64, error) {
+	writer := parquet.NewGenericWriter[parquetRow](dst, parquet.Compression(&parquet.Zstd))
+	reader := bufio.NewReader(src)
+	batch := make([]parquetRow, 0, parquetBatchSize)
+	var count uint64
+
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		if _, err := writer.Write(batch); err != nil {
+			return fmt.Errorf("写入 Parquet 失败: %w", err)
+		}
+		count += uint64(len(batch))
+		batch = batch[:0]
+		return nil
+	}
+
+	for {
+		line, err := reader.ReadBytes('\n')
+		if len(line) > 0 {
+			var row Row
+			if err := json.Unmarshal(line, &row); err != nil {
+				return count, fmt.Errorf("解析暂存行失败: %w", err)
+			}
+			args, err := json.Marshal(row.Args)
+			if err != nil {
+				return count, err
+			}
+			batch = append(batch, parquetRow{
+				BlockNumber: int64(row.BlockNumber),
+				BlockHash:   row.BlockHash.Hex(),
+				TxHash:      row.TxHash.Hex(),
+				TxIndex:     int64(row.TxIndex),
+				LogIndex:    int64(row.LogIndex),
+				Contract:    row.Contract,
+				Address:     strings.ToLower(row.Address.Hex()),
+				Event:       row.Event,
+				Signature:   row.Signature,
+				Args:        string(args),
+			})
+			if len(batch) == parquetBatchSize {
+				if err := flush(); err != nil {
+					return count, err
+				}
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return count, fmt.Errorf("读取暂存文件失败: %w", err)
+		}
+	}
+
+	if err := flush(); err != nil {
+		return count, err
+	}
+	if err := writer.Close(); err != nil {
+		return count, fmt.Errorf("写入 Parquet 失败: %w", err)
+	}
+	return count, nil
+}
@@ -9,6 +9,7 @@ import (
 	"io"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -77,6 +78,14 @@ func (c *Client) doQuery(ctx context.Context, query string, variables map[string
 	return nil
 }
 
+// blockArg 返回 Subgraph 时间旅行查询参数，block 为 0 时查询最新索引状态
+func blockArg(block uint64) string {
+	if block == 0 {
+		return ""
+	}
+	return fmt.Sprintf("block: { number: %d }", block)
+}
+
 // GetMarketsToLock 查询需要锁盘的市场
 // 返回状态为 Open 且 lockTime 在指定时间窗口内的市场
 func (c *Client) GetMarketsToLock(ctx context.Context, now, lockWindow int64) ([]Market, error) {
@@ -236,6 +245,124 @@ func (c *Client) IsMarketResolved(ctx context.Context, address common.Address) (
 	return market.State == "Resolved" || market.State == "Finalized", nil
 }
 
+// GetPendingBaskets 查询待结算的串关（按创建时间升序分页）
+func (c *Client) GetPendingBaskets(ctx context.Context, first, skip int) ([]Basket, error) {
+	query := `
+	query PendingBaskets($first: Int!, $skip: Int!) {
+		baskets(
+			where: { status: Pending }
+			orderBy: createdAt
+			orderDirection: asc
+			first: $first
+			skip: $skip
+		) {
+			id
+			creator { id }
+			markets
+			outcomes
+			marketCount
+			totalStake
+			status
+			createdAt
+		}
+	}`
+
+	variables := map[string]interface{}{
+		"first": first,
+		"skip":  skip,
+	}
+
+	var resp BasketsResponse
+	if err := c.doQuery(ctx, query, variables, &resp); err != nil {
+		return nil, err
+	}
+
+	if len(resp.Errors) > 0 {
+		return nil, fmt.Errorf("graphql error: %s", resp.Errors[0].Message)
+	}
+
+	return resp.Data.Baskets, nil
+}
+
+// GetOpenMarkets 查询开放中的市场（按创建时间升序分页）
+func (c *Client) GetOpenMarkets(ctx context.Context, first, skip int) ([]Market, error) {
+	query := `
+	query OpenMarkets($first: Int!, $skip: Int!) {
+		markets(
+			where: { state: Open }
+			orderBy: createdAt
+			orderDirection: asc
+			first: $first
+			skip: $skip
+		) {
+			id
+			matchId
+			templateId
+			homeTeam
+			awayTeam
+			state
+			kickoffTime
+		}
+	}`
+
+	variables := map[string]interface{}{
+		"first": first,
+		"skip":  skip,
+	}
+
+	var resp MarketsResponse
+	if err := c.doQuery(ctx, query, variables, &resp); err != nil {
+		return nil, err
+	}
+
+	if len(resp.Errors) > 0 {
+		return nil, fmt.Errorf("graphql error: %s", resp.Errors[0].Message)
+	}
+
+	return resp.Data.Markets, nil
+}
+
+// GetMarketsByAddresses 批量查询市场状态
+func (c *Client) GetMarketsByAddresses(ctx context.Context, addresses []common.Address) ([]Market, error) {
+	if len(addresses) == 0 {
+		return nil, nil
+	}
+
+	query := `
+	query MarketsByAddress($ids: [ID!]!, $first: Int!) {
+		markets(where: { id_in: $ids }, first: $first) {
+			id
+			matchId
+			templateId
+			state
+			resolvedAt
+			winnerOutcome
+		}
+	}`
+
+	// Subgraph 的实体 ID 为小写地址
+	ids := make([]string, 0, len(addresses))
+	for _, addr := range addresses {
+		ids = append(ids, strings.ToLower(addr.Hex()))
+	}
+
+	variables := map[string]interface{}{
+		"ids":   ids,
+		"first": len(ids),
+	}
+
+	var resp MarketsResponse
+	if err := c.doQuery(ctx, query, variables, &resp); err != nil {
+		return nil, err
+	}
+
+	if len(resp.Errors) > 0 {
+		return nil, fmt.Errorf("graphql error: %s", resp.Errors[0].Message)
+	}
+
+	return resp.Data.Markets, nil
+}
+
 // GetOrdersByTimeRange 查询指定时间范围内的订单（用于 Rewards 聚合）
 func (c *Client) GetOrdersByTimeRange(ctx context.Context, start, end int64, first int, skip int) ([]Order, error) {
 	query := `
@@ -251,13 +378,14 @@ func (c *Client) GetOrdersByTimeRange(ctx context.Context, start, end int64, fir
 			skip: $skip
 		) {
 			id
-			market { id }
-			user { id }
+			market { id templateId matchId }
+			user { id referrer }
 			outcome
 			amount
 			shares
 			fee
 			referrer
+			price
 			timestamp
 		}
 	}`
@@ -281,6 +409,157 @@ func (c *Client) GetOrdersByTimeRange(ctx context.Context, start, end int64, fir
 	return resp.Data.Orders, nil
 }
 
+// GetUserOrders 分页查询用户订单（按时间倒序）
+func (c *Client) GetUserOrders(ctx context.Context, user common.Address, first, skip int, block uint64) ([]Order, error) {
+	query := fmt.Sprintf(`
+	query UserOrders($user: String!, $first: Int!, $skip: Int!) {
+		orders(
+			%s
+			where: { user: $user }
+			orderBy: timestamp
+			orderDirection: desc
+			first: $first
+			skip: $skip
+		) {
+			id
+			market { id templateId matchId }
+			user { id }
+			outcome
+			amount
+			shares
+			fee
+			referrer
+			price
+			timestamp
+			blockNumber
+			transactionHash
+		}
+	}`, blockArg(block))
+
+	variables := map[string]interface{}{
+		"user":  strings.ToLower(user.Hex()),
+		"first": first,
+		"skip":  skip,
+	}
+
+	var resp OrdersResponse
+	if err := c.doQuery(ctx, query, variables, &resp); err != nil {
+		return nil, err
+	}
+
+	if len(resp.Errors) > 0 {
+		return nil, fmt.Errorf("graphql error: %s", resp.Errors[0].Message)
+	}
+
+	return resp.Data.Orders, nil
+}
+
+// GetMarketPositions 分页查询市场中余额不为零的头寸（按余额倒序）
+func (c *Client) GetMarketPositions(ctx context.Context, market common.Address, first, skip int, block uint64) ([]Position, error) {
+	query := fmt.Sprintf(`
+	query MarketPositions($market: String!, $first: Int!, $skip: Int!) {
+		positions(
+			%s
+			where: { market: $market, balance_gt: "0" }
+			orderBy: balance
+			orderDirection: desc
+			first: $first
+			skip: $skip
+		) {
+			id
+			owner { id }
+			outcome
+			balance
+			averageCost
+			totalInvested
+		}
+	}`, blockArg(block))
+
+	variables := map[string]interface{}{
+		"market": strings.ToLower(market.Hex()),
+		"first":  first,
+		"skip":   skip,
+	}
+
+	var resp PositionsResponse
+	if err := c.doQuery(ctx, query, variables, &resp); err != nil {
+		return nil, err
+	}
+
+	if len(resp.Errors) > 0 {
+		return nil, fmt.Errorf("graphql error: %s", resp.Errors[0].Message)
+	}
+
+	return resp.Data.Positions, nil
+}
+
+// GetOutcomeVolumesUpdatedSince 分页查询 since 之后有成交的结果交易量
+func (c *Client) GetOutcomeVolumesUpdatedSince(ctx context.Context, since int64, first, skip int) ([]OutcomeVolume, error) {
+	query := `
+	query OutcomeVolumes($since: BigInt!, $first: Int!, $skip: Int!) {
+		outcomeVolumes(
+			where: { lastUpdatedAt_gte: $since }
+			orderBy: lastUpdatedAt
+			orderDirection: desc
+			first: $first
+			skip: $skip
+		) {
+			id
+			market { id }
+			outcomeId
+			volume
+			shares
+			betCount
+			lastUpdatedAt
+		}
+	}`
+
+	variables := map[string]interface{}{
+		"since": strconv.FormatInt(since, 10),
+		"first": first,
+		"skip":  skip,
+	}
+
+	var resp OutcomeVolumesResponse
+	if err := c.doQuery(ctx, query, variables, &resp); err != nil {
+		return nil, err
+	}
+
+	if len(resp.Errors) > 0 {
+		return nil, fmt.Errorf("graphql error: %s", resp.Errors[0].Message)
+	}
+
+	return resp.Data.OutcomeVolumes, nil
+}
+
+// GetGlobalStats 查询平台全局统计，Subgraph 尚未索引到任何事件时返回 nil
+func (c *Client) GetGlobalStats(ctx context.Context, block uint64) (*GlobalStats, error) {
+	query := fmt.Sprintf(`
+	query GlobalStats {
+		globalStats(id: "global" %s) {
+			totalMarkets
+			totalUsers
+			totalVolume
+			totalFees
+			totalRedeemed
+			activeMarkets
+			resolvedMarkets
+			lastUpdatedAt
+		}
+	}`, blockArg(block))
+
+	var resp GlobalStatsResponse
+	if err := c.doQuery(ctx, query, nil, &resp); err != nil {
+		return nil, err
+	}
+
+	if len(resp.Errors) > 0 {
+		return nil, fmt.Errorf("graphql error: %s", resp.Errors[0].Message)
+	}
+
+	return resp.Data.GlobalStats, nil
+}
+
 // GetReferralRewardsByTimeRange 查询指定时间范围内的推荐奖励
 func (c *Client) GetReferralRewardsByTimeRange(ctx context.Context, start, end int64) ([]ReferralReward, error) {
 	query := `
@@ -356,6 +635,64 @@ func (c *Client) GetQuestRewardClaimsByTimeRange(ctx context.Context, start, end
 	return resp.Data.QuestRewardClaims, nil
 }
 
+// GetCampaignParticipations 分页查询指定活动在 before 之前的参与记录（含活动信息）
+func (c *Client) GetCampaignParticipations(ctx context.Context, campaignIDs []string, before int64, first, skip int) ([]CampaignParticipation, error) {
+	if len(campaignIDs) == 0 {
+		return nil, nil
+	}
+
+	query := `
+	query CampaignParticipations($campaigns: [String!]!, $before: BigInt!, $first: Int!, $skip: Int!) {
+		campaignParticipations(
+			where: {
+				campaign_in: $campaigns,
+				timestamp_lt: $before
+			}
+			orderBy: timestamp
+			orderDirection: asc
+			first: $first
+			skip: $skip
+		) {
+			id
+			campaign {
+				id
+				name
+				status
+				budgetCap
+				remainingBudget
+				startTime
+				endTime
+			}
+			user { id }
+			timestamp
+		}
+	}`
+
+	// Subgraph 的实体 ID 为小写十六进制
+	ids := make([]string, 0, len(campaignIDs))
+	for _, id := range campaignIDs {
+		ids = append(ids, strings.ToLower(id))
+	}
+
+	variables := map[string]interface{}{
+		"campaigns": ids,
+		"before":    strconv.FormatInt(before, 10),
+		"first":     first,
+		"skip":      skip,
+	}
+
+	var resp CampaignParticipationsResponse
+	if err := c.doQuery(ctx, query, variables, &resp); err != nil {
+		return nil, err
+	}
+
+	if len(resp.Errors) > 0 {
+		return nil, fmt.Errorf("graphql error: %s", resp.Errors[0].Message)
+	}
+
+	return resp.Data.CampaignParticipations, nil
+}
+
 // HealthCheck 检查 Subgraph 是否可用
 func (c *Client) HealthCheck(ctx context.Context) error {
 	query := `{ _meta { block { number } } }`
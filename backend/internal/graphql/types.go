@@ -3,6 +3,7 @@ package graphql
 
 import (
 	"math/big"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
 )
@@ -48,14 +49,18 @@ func (m *Market) OracleAddress() common.Address {
 // Order 表示 Subgraph 中的 Order 实体
 type Order struct {
 	ID        string `json:"id"`        // 订单 ID
-	Market    string `json:"market"`    // 市场地址
+	Market    Market `json:"market"`    // 所属市场（id/templateId/matchId）
 	User      User   `json:"user"`      // 用户
 	Outcome   int    `json:"outcome"`   // 下注方向
 	Amount    string `json:"amount"`    // 下注金额 (USDC)
 	Shares    string `json:"shares"`    // 获得份额
 	Fee       string `json:"fee"`       // 手续费
 	Referrer  string `json:"referrer"`  // 推荐人地址
+	Price     string `json:"price"`     // 下注时的隐含概率 (BigDecimal)
 	Timestamp string `json:"timestamp"` // 下注时间戳
+
+	BlockNumber     string `json:"blockNumber"`     // 区块号
+	TransactionHash string `json:"transactionHash"` // 交易哈希
 }
 
 // User 表示 Subgraph 中的 User 实体
@@ -72,6 +77,40 @@ func (u *User) Address() common.Address {
 	return common.HexToAddress(u.ID)
 }
 
+// Position 表示 Subgraph 中的 Position 实体（ERC-1155 头寸）
+type Position struct {
+	ID            string `json:"id"`            // market-user-outcome
+	Market        Market `json:"market"`        // 所属市场
+	Owner         User   `json:"owner"`         // 持有人
+	Outcome       int    `json:"outcome"`       // 头寸方向
+	Balance       string `json:"balance"`       // 持有份额
+	AverageCost   string `json:"averageCost"`   // 平均成本 (BigDecimal, USDC per share)
+	TotalInvested string `json:"totalInvested"` // 累计投入 (BigDecimal, USDC)
+}
+
+// OutcomeVolume 表示 Subgraph 中每个市场结果的累计交易量
+type OutcomeVolume struct {
+	ID            string `json:"id"`            // marketAddress-outcomeId
+	Market        Market `json:"market"`        // 所属市场
+	OutcomeID     int    `json:"outcomeId"`     // 结果 ID
+	Volume        string `json:"volume"`        // 累计押注金额 (BigDecimal, USDC)
+	Shares        string `json:"shares"`        // 累计份额
+	BetCount      int    `json:"betCount"`      // 押注次数
+	LastUpdatedAt string `json:"lastUpdatedAt"` // 最后更新时间戳
+}
+
+// GlobalStats 表示 Subgraph 中的全局统计（固定 ID: global）
+type GlobalStats struct {
+	TotalMarkets    int    `json:"totalMarkets"`    // 总市场数
+	TotalUsers      int    `json:"totalUsers"`      // 总用户数
+	TotalVolume     string `json:"totalVolume"`     // 总交易量 (BigDecimal, USDC)
+	TotalFees       string `json:"totalFees"`       // 总手续费 (BigDecimal, USDC)
+	TotalRedeemed   string `json:"totalRedeemed"`   // 总赎回金额 (BigDecimal, USDC)
+	ActiveMarkets   int    `json:"activeMarkets"`   // 活跃市场数
+	ResolvedMarkets int    `json:"resolvedMarkets"` // 已结算市场数
+	LastUpdatedAt   string `json:"lastUpdatedAt"`   // 最后更新时间戳
+}
+
 // ReferralReward 表示推荐返佣记录
 type ReferralReward struct {
 	ID        string `json:"id"`        // 记录 ID
@@ -89,6 +128,46 @@ type QuestRewardClaim struct {
 	Timestamp    string `json:"timestamp"`    // 时间戳
 }
 
+// Campaign 表示 Subgraph 中的 Campaign 实体
+type Campaign struct {
+	ID              string `json:"id"`              // 活动 ID (bytes32)
+	Name            string `json:"name"`            // 活动名称
+	Status          string `json:"status"`          // 状态: Active, Paused, Ended
+	BudgetCap       string `json:"budgetCap"`       // 预算上限 (USDC)
+	RemainingBudget string `json:"remainingBudget"` // 剩余预算 (USDC)
+	StartTime       string `json:"startTime"`       // 开始时间戳
+	EndTime         string `json:"endTime"`         // 结束时间戳
+}
+
+// CampaignParticipation 表示活动参与记录
+type CampaignParticipation struct {
+	ID        string   `json:"id"`        // campaignId-userAddress
+	Campaign  Campaign `json:"campaign"`  // 所属活动
+	User      User     `json:"user"`      // 参与用户
+	Timestamp string   `json:"timestamp"` // 参与时间戳
+}
+
+// Basket 表示 Subgraph 中的 Basket（串关）实体
+type Basket struct {
+	ID          string   `json:"id"`          // 串关 ID（合约 parlayId）
+	Creator     User     `json:"creator"`     // 创建者
+	Markets     []string `json:"markets"`     // 各腿市场地址
+	Outcomes    []int    `json:"outcomes"`    // 各腿下注结果
+	MarketCount int      `json:"marketCount"` // 腿数
+	TotalStake  string   `json:"totalStake"`  // 下注金额 (USDC)
+	Status      string   `json:"status"`      // 状态: Pending, Won, Lost, Refunded
+	CreatedAt   string   `json:"createdAt"`   // 创建时间戳
+}
+
+// MarketAddresses 返回各腿市场地址
+func (b *Basket) MarketAddresses() []common.Address {
+	addrs := make([]common.Address, 0, len(b.Markets))
+	for _, m := range b.Markets {
+		addrs = append(addrs, common.HexToAddress(m))
+	}
+	return addrs
+}
+
 // GraphQL 查询响应结构
 
 // MarketsResponse 表示市场查询响应
@@ -115,6 +194,30 @@ type OrdersResponse struct {
 	Errors []GraphQLError `json:"errors,omitempty"`
 }
 
+// PositionsResponse 表示头寸查询响应
+type PositionsResponse struct {
+	Data struct {
+		Positions []Position `json:"positions"`
+	} `json:"data"`
+	Errors []GraphQLError `json:"errors,omitempty"`
+}
+
+// OutcomeVolumesResponse 表示结果交易量查询响应
+type OutcomeVolumesResponse struct {
+	Data struct {
+		OutcomeVolumes []OutcomeVolume `json:"outcomeVolumes"`
+	} `json:"data"`
+	Errors []GraphQLError `json:"errors,omitempty"`
+}
+
+// GlobalStatsResponse 表示全局统计查询响应
+type GlobalStatsResponse struct {
+	Data struct {
+		GlobalStats *GlobalStats `json:"globalStats"`
+	} `json:"data"`
+	Errors []GraphQLError `json:"errors,omitempty"`
+}
+
 // ReferralRewardsResponse 表示推荐奖励查询响应
 type ReferralRewardsResponse struct {
 	Data struct {
@@ -131,6 +234,22 @@ type QuestRewardClaimsResponse struct {
 	Errors []GraphQLError `json:"errors,omitempty"`
 }
 
+// CampaignParticipationsResponse 表示活动参与查询响应
+type CampaignParticipationsResponse struct {
+	Data struct {
+		CampaignParticipations []CampaignParticipation `json:"campaignParticipations"`
+	} `json:"data"`
+	Errors []GraphQLError `json:"errors,omitempty"`
+}
+
+// BasketsResponse 表示串关查询响应
+type BasketsResponse struct {
+	Data struct {
+		Baskets []Basket `json:"baskets"`
+	} `json:"data"`
+	Errors []GraphQLError `json:"errors,omitempty"`
+}
+
 // GraphQLError 表示 GraphQL 错误
 type GraphQLError struct {
 	Message   string `json:"message"`
@@ -150,3 +269,19 @@ func ParseBigInt(s string) *big.Int {
 	n.SetString(s, 10)
 	return n
 }
+
+// ParseDecimal 将 BigDecimal 字符串转换为指定精度的整数（截断多余小数位）
+// 例如 ParseDecimal("12.5", 6) = 12500000，无法解析时返回 0
+func ParseDecimal(s string, decimals int) *big.Int {
+	whole, frac, _ := strings.Cut(s, ".")
+	if len(frac) > decimals {
+		frac = frac[:decimals]
+	}
+	frac += strings.Repeat("0", decimals-len(frac))
+
+	n, ok := new(big.Int).SetString(whole+frac, 10)
+	if !ok {
+		return big.NewInt(0)
+	}
+	return n
+}
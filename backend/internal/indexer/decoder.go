@@ -0,0 +1,271 @@
+package indexer
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/pitchone/sportsbook/pkg/bindings"
+	"github.com/pitchone/sportsbook/pkg/models"
+)
+
+// 事件类型（models.Event.EventType）
+const (
+	EventMarketCreated   = "MarketCreated"
+	EventBetPlaced       = "BetPlaced"
+	EventMarketLocked    = "MarketLocked"
+	EventMarketResolved  = "MarketResolved"
+	EventMarketFinalized = "MarketFinalized"
+	EventMarketCancelled = "MarketCancelled"
+	EventPayoutClaimed   = "PayoutClaimed"
+	EventRefundClaimed   = "RefundClaimed"
+)
+
+// factoryABI MarketFactory_V3 的 MarketCreated 事件
+// pkg/bindings 中的 MarketFactory 仍是旧版签名 MarketCreated(address,bytes32,address)
+const factoryABI = `[{"anonymous":false,"inputs":[` +
+	`{"indexed":true,"name":"market","type":"address"},` +
+	`{"indexed":true,"name":"templateId","type":"bytes32"},` +
+	`{"indexed":false,"name":"matchId","type":"string"},` +
+	`{"indexed":false,"name":"kickoffTime","type":"uint256"}],` +
+	`"name":"MarketCreated","type":"event"}]`
+
+// marketEvents 索引的 Market_V3 事件
+var marketEvents = []string{
+	EventBetPlaced,
+	EventMarketLocked,
+	EventMarketResolved,
+	EventMarketFinalized,
+	EventMarketCancelled,
+	EventPayoutClaimed,
+	EventRefundClaimed,
+}
+
+// factoryMarketCreated MarketCreated 事件参数
+type factoryMarketCreated struct {
+	Market      common.Address
+	TemplateId  [32]byte
+	MatchId     string
+	KickoffTime *big.Int
+}
+
+// decoder 将工厂与市场日志解码为 pkg/models 中的事件
+type decoder struct {
+	factory      *bind.BoundContract
+	createdTopic common.Hash
+	market       *bindings.MarketV3Filterer
+	marketTopics map[common.Hash]string
+	topics       []common.Hash
+}
+
+func newDecoder() (*decoder, error) {
+	parsed, err := abi.JSON(strings.NewReader(factoryABI))
+	if err != nil {
+		return nil, fmt.Errorf("解析 MarketFactory ABI 失败: %w", err)
+	}
+	marketABI, err := bindings.MarketV3MetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("解析 Market_V3 ABI 失败: %w", err)
+	}
+	market, err := bindings.NewMarketV3Filterer(common.Address{}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	d := &decoder{
+		factory:      bind.NewBoundContract(common.Address{}, parsed, nil, nil, nil),
+		createdTopic: parsed.Events[EventMarketCreated].ID,
+		market:       market,
+		marketTopics: make(map[common.Hash]string, len(marketEvents)),
+	}
+	d.topics = append(d.topics, d.createdTopic)
+	for _, name := range marketEvents {
+		event, ok := marketABI.Events[name]
+		if !ok {
+			return nil, fmt.Errorf("Market_V3 ABI 中不存在事件 %s", name)
+		}
+		d.marketTopics[event.ID] = name
+		d.topics = append(d.topics, event.ID)
+	}
+	return d, nil
+}
+
+// Topics 返回 eth_getLogs 的事件过滤条件
+func (d *decoder) Topics() []common.Hash {
+	return d.topics
+}
+
+// IsMarketCreated 判断日志是否为工厂的 MarketCreated 事件
+func (d *decoder) IsMarketCreated(log types.Log) bool {
+	return len(log.Topics) > 0 && log.Topics[0] == d.createdTopic
+}
+
+// Decode 解码日志，base 为区块与交易信息；不是索引的事件时返回 nil
+func (d *decoder) Decode(log types.Log, base models.Event) (interface{}, error) {
+	if len(log.Topics) == 0 {
+		return nil, nil
+	}
+	if log.Topics[0] == d.createdTopic {
+		return d.decodeMarketCreated(log, base)
+	}
+
+	name, ok := d.marketTopics[log.Topics[0]]
+	if !ok {
+		return nil, nil
+	}
+	base.EventType = name
+
+	switch name {
+	case EventBetPlaced:
+		ev, err := d.market.ParseBetPlaced(log)
+		if err != nil {
+			return nil, fmt.Errorf("解码 %s 失败: %w", name, err)
+		}
+		return &models.BetPlacedEvent{
+			Event:         base,
+			MarketAddress: log.Address,
+			User:          ev.User,
+			Outcome:       uint8(ev.OutcomeId.Uint64()),
+			Amount:        ev.Amount,
+			Shares:        ev.Shares,
+		}, nil
+
+	case EventMarketLocked:
+		ev, err := d.market.ParseMarketLocked(log)
+		if err != nil {
+			return nil, fmt.Errorf("解码 %s 失败: %w", name, err)
+		}
+		return &models.LockedEvent{
+			Event:         base,
+			MarketAddress: log.Address,
+			LockTime:      ev.Timestamp.Uint64(),
+		}, nil
+
+	case EventMarketResolved:
+		ev, err := d.market.ParseMarketResolved(log)
+		if err != nil {
+			return nil, fmt.Errorf("解码 %s 失败: %w", name, err)
+		}
+		return &models.ResolvedEvent{
+			Event:          base,
+			MarketAddress:  log.Address,
+			WinningOutcome: winningOutcome(ev.OutcomeIds, ev.Weights),
+			ResolveTime:    uint64(base.BlockTime.Unix()),
+			ResultHash:     crypto.Keccak256Hash(log.Data),
+		}, nil
+
+	case EventMarketFinalized:
+		ev, err := d.market.ParseMarketFinalized(log)
+		if err != nil {
+			return nil, fmt.Errorf("解码 %s 失败: %w", name, err)
+		}
+		return &models.FinalizedEvent{
+			Event:         base,
+			MarketAddress: log.Address,
+			FinalizeTime:  ev.Timestamp.Uint64(),
+		}, nil
+
+	case EventMarketCancelled:
+		ev, err := d.market.ParseMarketCancelled(log)
+		if err != nil {
+			return nil, fmt.Errorf("解码 %s 失败: %w", name, err)
+		}
+		return &models.CancelledEvent{
+			Event:         base,
+			MarketAddress: log.Address,
+			Reason:        ev.Reason,
+		}, nil
+
+	case EventPayoutClaimed:
+		ev, err := d.market.ParsePayoutClaimed(log)
+		if err != nil {
+			return nil, fmt.Errorf("解码 %s 失败: %w", name, err)
+		}
+		return &models.RedeemedEvent{
+			Event:         base,
+			MarketAddress: log.Address,
+			User:          ev.User,
+			Outcome:       uint8(ev.OutcomeId.Uint64()),
+			Shares:        ev.Shares,
+			Payout:        ev.Payout,
+		}, nil
+
+	case EventRefundClaimed:
+		ev, err := d.market.ParseRefundClaimed(log)
+		if err != nil {
+			return nil, fmt.Errorf("解码 %s 失败: %w", name, err)
+		}
+		return &models.RedeemedEvent{
+			Event:         base,
+			MarketAddress: log.Address,
+			User:          ev.User,
+			Outcome:       uint8(ev.OutcomeId.Uint64()),
+			Shares:        ev.Shares,
+			Payout:        ev.Amount,
+		}, nil
+	}
+	return nil, nil
+}
+
+func (d *decoder) decodeMarketCreated(log types.Log, base models.Event) (*models.MarketCreatedEvent, error) {
+	var ev factoryMarketCreated
+	if err := d.factory.UnpackLog(&ev, EventMarketCreated, log); err != nil {
+		return nil, fmt.Errorf("解码 %s 失败: %w", EventMarketCreated, err)
+	}
+	base.EventType = EventMarketCreated
+	home, away := parseTeams(ev.MatchId)
+	return &models.MarketCreatedEvent{
+		Event:         base,
+		MarketAddress: ev.Market,
+		TemplateID:    ev.TemplateId,
+		MatchID:       ev.MatchId,
+		HomeTeam:      home,
+		AwayTeam:      away,
+		KickoffTime:   ev.KickoffTime.Uint64(),
+	}, nil
+}
+
+// winningOutcome 返回权重最高的结果（权重相同时取 ID 较小者）
+func winningOutcome(outcomeIDs, weights []*big.Int) uint8 {
+	best := -1
+	for i := range outcomeIDs {
+		if i >= len(weights) {
+			break
+		}
+		if best < 0 || weights[i].Cmp(weights[best]) > 0 ||
+			(weights[i].Cmp(weights[best]) == 0 && outcomeIDs[i].Cmp(outcomeIDs[best]) < 0) {
+			best = i
+		}
+	}
+	if best < 0 {
+		return 0
+	}
+	return uint8(outcomeIDs[best].Uint64())
+}
+
+// parseTeams 从 matchId 解析主客队代码，格式如 EPL_2024_MUN_vs_MCI
+// 与 Subgraph 的 parseTeamsFromMatchId 规则一致，但保留球队代码而不转换为全称
+func parseTeams(matchID string) (string, string) {
+	idx := strings.Index(matchID, "_vs_")
+	if idx < 0 {
+		return "", ""
+	}
+	before := matchID[:idx]
+	last := strings.LastIndex(before, "_")
+	if last < 0 {
+		return "", ""
+	}
+	home := before[last+1:]
+
+	away := matchID[idx+len("_vs_"):]
+	if i := strings.Index(away, "_"); i >= 0 {
+		away = away[:i]
+	}
+	return home, away
+}
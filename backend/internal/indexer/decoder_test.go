@@ -0,0 +1,161 @@
+package indexer
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pitchone/sportsbook/pkg/bindings"
+	"github.com/pitchone/sportsbook/pkg/models"
+)
+
+var (
+	testFactory  = common.HexToAddress("0x00000000000000000000000000000000000000f1")
+	testMarket   = common.HexToAddress("0x00000000000000000000000000000000000000a1")
+	testUser     = common.HexToAddress("0x00000000000000000000000000000000000000b2")
+	testTemplate = common.HexToHash("0x77646c")
+)
+
+// encodeLog 按 ABI 编码事件日志：indexed 为索引参数，data 为非索引参数
+func encodeLog(t *testing.T, parsed *abi.ABI, event string, address common.Address, block uint64, indexed []interface{}, data ...interface{}) types.Log {
+	t.Helper()
+	ev, ok := parsed.Events[event]
+	require.True(t, ok, event)
+
+	query := make([][]interface{}, len(indexed))
+	for i, v := range indexed {
+		query[i] = []interface{}{v}
+	}
+	topics, err := abi.MakeTopics(query...)
+	require.NoError(t, err)
+
+	log := types.Log{
+		Address:     address,
+		Topics:      []common.Hash{ev.ID},
+		BlockNumber: block,
+		TxHash:      crypto.Keccak256Hash(address.Bytes(), new(big.Int).SetUint64(block).Bytes(), []byte(event)),
+	}
+	for _, topic := range topics {
+		log.Topics = append(log.Topics, topic[0])
+	}
+	log.Data, err = ev.Inputs.NonIndexed().Pack(data...)
+	require.NoError(t, err)
+	return log
+}
+
+func factoryABIForTest(t *testing.T) *abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(factoryABI))
+	require.NoError(t, err)
+	return &parsed
+}
+
+func marketABIForTest(t *testing.T) *abi.ABI {
+	parsed, err := bindings.MarketV3MetaData.GetAbi()
+	require.NoError(t, err)
+	return parsed
+}
+
+func marketCreatedLog(t *testing.T, factory, market common.Address, block uint64) types.Log {
+	return encodeLog(t, factoryABIForTest(t), EventMarketCreated, factory, block,
+		[]interface{}{market, testTemplate}, "EPL_2024_MUN_vs_MCI", big.NewInt(1_730_000_000))
+}
+
+func betLog(t *testing.T, market common.Address, block uint64, amount int64) types.Log {
+	return encodeLog(t, marketABIForTest(t), EventBetPlaced, market, block,
+		[]interface{}{testUser, big.NewInt(2)}, big.NewInt(amount), new(big.Int).Mul(big.NewInt(amount), big.NewInt(1e12)))
+}
+
+func lockedLog(t *testing.T, market common.Address, block uint64) types.Log {
+	return encodeLog(t, marketABIForTest(t), EventMarketLocked, market, block, nil, big.NewInt(1_730_000_100))
+}
+
+func resolvedLog(t *testing.T, market common.Address, block uint64) types.Log {
+	return encodeLog(t, marketABIForTest(t), EventMarketResolved, market, block, nil,
+		[]*big.Int{big.NewInt(0), big.NewInt(1), big.NewInt(2)},
+		[]*big.Int{big.NewInt(0), big.NewInt(0), big.NewInt(1e18)})
+}
+
+func TestDecoder_MarketCreated(t *testing.T) {
+	d, err := newDecoder()
+	require.NoError(t, err)
+
+	log := marketCreatedLog(t, testFactory, testMarket, 10)
+	require.True(t, d.IsMarketCreated(log))
+
+	blockTime := time.Unix(1_729_000_000, 0)
+	ev, err := d.Decode(log, models.Event{BlockNumber: 10, BlockTime: blockTime})
+	require.NoError(t, err)
+	created, ok := ev.(*models.MarketCreatedEvent)
+	require.True(t, ok)
+
+	assert.Equal(t, EventMarketCreated, created.EventType)
+	assert.Equal(t, testMarket, created.MarketAddress)
+	assert.Equal(t, testTemplate, created.TemplateID)
+	assert.Equal(t, "EPL_2024_MUN_vs_MCI", created.MatchID)
+	assert.Equal(t, "MUN", created.HomeTeam)
+	assert.Equal(t, "MCI", created.AwayTeam)
+	assert.Equal(t, uint64(1_730_000_000), created.KickoffTime)
+	assert.Equal(t, blockTime, created.BlockTime)
+}
+
+func TestDecoder_MarketEvents(t *testing.T) {
+	d, err := newDecoder()
+	require.NoError(t, err)
+	assert.Len(t, d.Topics(), len(marketEvents)+1)
+
+	ev, err := d.Decode(betLog(t, testMarket, 11, 10_000_000), models.Event{})
+	require.NoError(t, err)
+	bet, ok := ev.(*models.BetPlacedEvent)
+	require.True(t, ok)
+	assert.Equal(t, EventBetPlaced, bet.EventType)
+	assert.Equal(t, testUser, bet.User)
+	assert.Equal(t, uint8(2), bet.Outcome)
+	assert.Equal(t, "10000000", bet.Amount.String())
+
+	resolved := resolvedLog(t, testMarket, 30)
+	ev, err = d.Decode(resolved, models.Event{BlockTime: time.Unix(1_730_007_200, 0)})
+	require.NoError(t, err)
+	res, ok := ev.(*models.ResolvedEvent)
+	require.True(t, ok)
+	assert.Equal(t, uint8(2), res.WinningOutcome)
+	assert.Equal(t, uint64(1_730_007_200), res.ResolveTime)
+	assert.Equal(t, crypto.Keccak256Hash(resolved.Data), res.ResultHash)
+
+	// 未索引的事件
+	ev, err = d.Decode(types.Log{Topics: []common.Hash{common.HexToHash("0x01")}}, models.Event{})
+	require.NoError(t, err)
+	assert.Nil(t, ev)
+}
+
+func TestWinningOutcome(t *testing.T) {
+	ids := []*big.Int{big.NewInt(0), big.NewInt(1), big.NewInt(2)}
+	assert.Equal(t, uint8(1), winningOutcome(ids, []*big.Int{big.NewInt(0), big.NewInt(1e18), big.NewInt(0)}))
+	// 半赢半输等权重时取 ID 较小者
+	assert.Equal(t, uint8(0), winningOutcome(ids, []*big.Int{big.NewInt(5e17), big.NewInt(0), big.NewInt(5e17)}))
+	assert.Equal(t, uint8(0), winningOutcome(nil, nil))
+}
+
+func TestParseTeams(t *testing.T) {
+	tests := []struct {
+		matchID    string
+		home, away string
+	}{
+		{"EPL_2024_MUN_vs_MCI", "MUN", "MCI"},
+		{"EPL_2024_ARS_vs_CHE_OU_2.5", "ARS", "CHE"},
+		{"MUN_vs_MCI", "", ""},
+		{"EPL_2024_MUN_MCI", "", ""},
+	}
+	for _, tt := range tests {
+		home, away := parseTeams(tt.matchID)
+		assert.Equal(t, tt.home, home, tt.matchID)
+		assert.Equal(t, tt.away, away, tt.matchID)
+	}
+}
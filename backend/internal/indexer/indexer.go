@@ -0,0 +1,355 @@
+package indexer
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"go.uber.org/zap"
+
+	"github.com/pitchone/sportsbook/internal/events"
+	"github.com/pitchone/sportsbook/pkg/models"
+)
+
+// 默认配置
+const (
+	DefaultConfirmations = 12
+	DefaultReorgWindow   = 256
+	DefaultPollInterval  = 5 * time.Second
+)
+
+// Config 索引器配置
+type Config struct {
+	Factory       common.Address // MarketFactory_V3 地址
+	StartBlock    uint64         // 首次运行的起始区块（通常为工厂部署区块）
+	Confirmations uint64         // 只索引落后链头该数量的区块
+	ChunkSize     uint64         // 初始每次 eth_getLogs 的区块数
+	ReorgWindow   uint64         // 保留最近多少个区块的哈希用于重组检测
+	PollInterval  time.Duration  // 追上链头后的轮询间隔
+}
+
+// Chain 索引器使用的 RPC 接口（ethclient.Client 实现）
+type Chain interface {
+	events.LogFilterer
+	BlockNumber(ctx context.Context) (uint64, error)
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// BlockRef 已处理区块的哈希记录
+type BlockRef struct {
+	Number     uint64
+	Hash       common.Hash
+	ParentHash common.Hash
+	Time       uint64
+}
+
+// Batch 一个扫描区间的写入内容，由 Store 在同一事务中提交
+type Batch struct {
+	From, To   uint64
+	Events     []interface{} // pkg/models 中的事件，按日志顺序
+	Blocks     []BlockRef
+	PruneBelow uint64 // 删除低于该区块的哈希记录
+}
+
+// Store 索引数据的存储
+type Store interface {
+	// Cursor 返回最后处理的区块，未处理过时返回 0
+	Cursor(ctx context.Context) (uint64, error)
+	// Markets 返回已索引的市场地址
+	Markets(ctx context.Context) ([]common.Address, error)
+	// RecentBlocks 返回保存的区块哈希，按区块号倒序
+	RecentBlocks(ctx context.Context) ([]BlockRef, error)
+	// Apply 写入事件与区块哈希，并将进度推进到 batch.To
+	Apply(ctx context.Context, batch *Batch) error
+	// Rollback 删除 ancestor 之后的数据，重建市场状态，并将进度回退到 ancestor
+	Rollback(ctx context.Context, ancestor uint64) error
+}
+
+// Indexer 跟随链头，将工厂与市场事件写入 Store
+type Indexer struct {
+	chain   Chain
+	store   Store
+	config  Config
+	decoder *decoder
+	markets map[common.Address]struct{}
+	logger  *zap.Logger
+}
+
+// New 创建索引器
+func New(chain Chain, store Store, cfg Config, logger *zap.Logger) (*Indexer, error) {
+	if cfg.Factory == (common.Address{}) {
+		return nil, fmt.Errorf("未配置 MarketFactory 地址")
+	}
+	if cfg.ReorgWindow == 0 {
+		cfg.ReorgWindow = DefaultReorgWindow
+	}
+	if cfg.PollInterval == 0 {
+		cfg.PollInterval = DefaultPollInterval
+	}
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+
+	d, err := newDecoder()
+	if err != nil {
+		return nil, err
+	}
+	return &Indexer{
+		chain:   chain,
+		store:   store,
+		config:  cfg,
+		decoder: d,
+		logger:  logger,
+	}, nil
+}
+
+// Run 持续同步直到 ctx 取消；出错时记录日志并在下一个轮询周期重试
+func (i *Indexer) Run(ctx context.Context) error {
+	for {
+		caughtUp, err := i.Sync(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			syncErrors.Inc()
+			i.logger.Error("sync failed", zap.Error(err))
+		}
+
+		if caughtUp || err != nil {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(i.config.PollInterval):
+			}
+		}
+	}
+}
+
+// Sync 检查链重组后同步到 latest - Confirmations，返回是否已追上
+func (i *Indexer) Sync(ctx context.Context) (bool, error) {
+	if i.markets == nil {
+		if err := i.loadMarkets(ctx); err != nil {
+			return false, err
+		}
+	}
+
+	latest, err := i.chain.BlockNumber(ctx)
+	if err != nil {
+		return false, fmt.Errorf("获取最新区块失败: %w", err)
+	}
+	chainHead.Set(float64(latest))
+	if latest < i.config.Confirmations {
+		return true, nil
+	}
+	target := latest - i.config.Confirmations
+
+	if err := i.checkReorg(ctx); err != nil {
+		return false, err
+	}
+
+	cursor, err := i.store.Cursor(ctx)
+	if err != nil {
+		return false, err
+	}
+	next := cursor + 1
+	if next < i.config.StartBlock {
+		next = i.config.StartBlock
+	}
+	if next > target {
+		return true, nil
+	}
+
+	scanner := events.NewScanner(i.chain, nil, i.decoder.Topics(), i.config.ChunkSize)
+	err = scanner.Scan(ctx, next, target, func(from, to uint64, logs []types.Log) error {
+		return i.process(ctx, from, to, logs)
+	})
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// checkReorg 比较最后处理区块的哈希，不一致时回滚到仍在主链上的最近区块
+func (i *Indexer) checkReorg(ctx context.Context) error {
+	recent, err := i.store.RecentBlocks(ctx)
+	if err != nil {
+		return err
+	}
+	if len(recent) == 0 {
+		return nil
+	}
+
+	for n, ref := range recent {
+		header, err := i.chain.HeaderByNumber(ctx, new(big.Int).SetUint64(ref.Number))
+		if err != nil {
+			return fmt.Errorf("获取区块 %d 失败: %w", ref.Number, err)
+		}
+		if header.Hash() != ref.Hash {
+			continue
+		}
+		if n == 0 {
+			return nil
+		}
+
+		i.logger.Warn("chain reorg detected",
+			zap.Uint64("last_processed", recent[0].Number),
+			zap.Uint64("common_ancestor", ref.Number),
+		)
+		reorgsDetected.Inc()
+		if err := i.store.Rollback(ctx, ref.Number); err != nil {
+			return fmt.Errorf("回滚到区块 %d 失败: %w", ref.Number, err)
+		}
+		processedBlock.Set(float64(ref.Number))
+		return i.loadMarkets(ctx)
+	}
+	return fmt.Errorf("链重组深度超过保存的 %d 个区块哈希（最早 %d），需要人工处理", len(recent), recent[len(recent)-1].Number)
+}
+
+// process 解码一个扫描区间的日志并提交
+func (i *Indexer) process(ctx context.Context, from, to uint64, logs []types.Log) error {
+	sort.Slice(logs, func(a, b int) bool {
+		if logs[a].BlockNumber != logs[b].BlockNumber {
+			return logs[a].BlockNumber < logs[b].BlockNumber
+		}
+		return logs[a].Index < logs[b].Index
+	})
+
+	batch := &Batch{From: from, To: to}
+	if to > i.config.ReorgWindow {
+		batch.PruneBelow = to - i.config.ReorgWindow
+	}
+	headers := make(map[uint64]*types.Header)
+	created := make(map[common.Address]struct{})
+
+	for _, log := range logs {
+		if log.Removed {
+			continue
+		}
+		if i.decoder.IsMarketCreated(log) {
+			if log.Address != i.config.Factory {
+				continue
+			}
+		} else if !i.known(log.Address, created) {
+			continue
+		}
+
+		header, err := i.header(ctx, headers, log.BlockNumber)
+		if err != nil {
+			return err
+		}
+		if header.Hash() != log.BlockHash {
+			return fmt.Errorf("区块 %d 在扫描期间发生变化，稍后重试", log.BlockNumber)
+		}
+
+		ev, err := i.decoder.Decode(log, models.Event{
+			TxHash:      log.TxHash.Hex(),
+			LogIndex:    log.Index,
+			BlockNumber: log.BlockNumber,
+			BlockTime:   time.Unix(int64(header.Time), 0),
+		})
+		if err != nil {
+			return fmt.Errorf("区块 %d 交易 %s: %w", log.BlockNumber, log.TxHash.Hex(), err)
+		}
+		if ev == nil {
+			continue
+		}
+		if mc, ok := ev.(*models.MarketCreatedEvent); ok {
+			created[mc.MarketAddress] = struct{}{}
+		}
+		batch.Events = append(batch.Events, ev)
+	}
+
+	// 区间最后一个区块总是记录哈希，作为下一轮重组检测的基准
+	if _, err := i.header(ctx, headers, to); err != nil {
+		return err
+	}
+	for number, header := range headers {
+		batch.Blocks = append(batch.Blocks, BlockRef{
+			Number:     number,
+			Hash:       header.Hash(),
+			ParentHash: header.ParentHash,
+			Time:       header.Time,
+		})
+	}
+	sort.Slice(batch.Blocks, func(a, b int) bool { return batch.Blocks[a].Number < batch.Blocks[b].Number })
+
+	if err := i.store.Apply(ctx, batch); err != nil {
+		return err
+	}
+
+	for market := range created {
+		i.markets[market] = struct{}{}
+	}
+	for _, ev := range batch.Events {
+		eventsIndexed.WithLabelValues(EventBase(ev).EventType).Inc()
+	}
+	processedBlock.Set(float64(to))
+	if len(batch.Events) > 0 {
+		i.logger.Debug("indexed block range",
+			zap.Uint64("from", from),
+			zap.Uint64("to", to),
+			zap.Int("events", len(batch.Events)),
+		)
+	}
+	return nil
+}
+
+// known 判断日志是否来自已索引（或本区间内刚创建）的市场
+func (i *Indexer) known(address common.Address, created map[common.Address]struct{}) bool {
+	if _, ok := i.markets[address]; ok {
+		return true
+	}
+	_, ok := created[address]
+	return ok
+}
+
+// header 读取区块头，同一区间内缓存
+func (i *Indexer) header(ctx context.Context, cache map[uint64]*types.Header, number uint64) (*types.Header, error) {
+	if header, ok := cache[number]; ok {
+		return header, nil
+	}
+	header, err := i.chain.HeaderByNumber(ctx, new(big.Int).SetUint64(number))
+	if err != nil {
+		return nil, fmt.Errorf("获取区块 %d 失败: %w", number, err)
+	}
+	cache[number] = header
+	return header, nil
+}
+
+// loadMarkets 从 Store 加载已索引的市场
+func (i *Indexer) loadMarkets(ctx context.Context) error {
+	markets, err := i.store.Markets(ctx)
+	if err != nil {
+		return err
+	}
+	i.markets = make(map[common.Address]struct{}, len(markets))
+	for _, market := range markets {
+		i.markets[market] = struct{}{}
+	}
+	return nil
+}
+
+// EventBase 返回事件的公共字段
+func EventBase(ev interface{}) models.Event {
+	switch e := ev.(type) {
+	case *models.MarketCreatedEvent:
+		return e.Event
+	case *models.BetPlacedEvent:
+		return e.Event
+	case *models.LockedEvent:
+		return e.Event
+	case *models.ResolvedEvent:
+		return e.Event
+	case *models.FinalizedEvent:
+		return e.Event
+	case *models.CancelledEvent:
+		return e.Event
+	case *models.RedeemedEvent:
+		return e.Event
+	}
+	return models.Event{}
+}
@@ -0,0 +1,263 @@
+package indexer
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pitchone/sportsbook/pkg/models"
+)
+
+// fakeChain 每个区块的哈希由区块号与分叉号决定；logs 按区块号存放
+type fakeChain struct {
+	latest uint64
+	fork   map[uint64]byte // 区块所在分叉，默认 0
+	logs   map[uint64][]types.Log
+}
+
+func newFakeChain(latest uint64) *fakeChain {
+	return &fakeChain{latest: latest, fork: make(map[uint64]byte), logs: make(map[uint64][]types.Log)}
+}
+
+func (c *fakeChain) headerAt(number uint64) *types.Header {
+	header := &types.Header{
+		Number: new(big.Int).SetUint64(number),
+		Time:   1_729_000_000 + number*12,
+		Extra:  []byte{c.fork[number]},
+	}
+	if number > 0 {
+		header.ParentHash = c.headerAt(number - 1).Hash()
+	}
+	return header
+}
+
+// addLog 在区块末尾追加日志
+func (c *fakeChain) addLog(log types.Log) {
+	log.Index = uint(len(c.logs[log.BlockNumber]))
+	c.logs[log.BlockNumber] = append(c.logs[log.BlockNumber], log)
+}
+
+// reorg 从 from 开始替换为新分叉并清空这些区块的日志
+func (c *fakeChain) reorg(from uint64) {
+	for n := from; n <= c.latest+100; n++ {
+		c.fork[n]++
+		delete(c.logs, n)
+	}
+}
+
+func (c *fakeChain) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	topics := make(map[common.Hash]bool)
+	if len(q.Topics) > 0 {
+		for _, topic := range q.Topics[0] {
+			topics[topic] = true
+		}
+	}
+	var out []types.Log
+	for n := q.FromBlock.Uint64(); n <= q.ToBlock.Uint64(); n++ {
+		hash := c.headerAt(n).Hash()
+		for _, log := range c.logs[n] {
+			if len(topics) > 0 && !topics[log.Topics[0]] {
+				continue
+			}
+			log.BlockHash = hash
+			out = append(out, log)
+		}
+	}
+	return out, nil
+}
+
+func (c *fakeChain) BlockNumber(ctx context.Context) (uint64, error) {
+	return c.latest, nil
+}
+
+func (c *fakeChain) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	if number.Uint64() > c.latest {
+		return nil, fmt.Errorf("not found")
+	}
+	return c.headerAt(number.Uint64()), nil
+}
+
+// memStore 内存实现的 Store
+type memStore struct {
+	cursor    uint64
+	events    []interface{}
+	blocks    map[uint64]BlockRef
+	rollbacks []uint64
+}
+
+func newMemStore() *memStore {
+	return &memStore{blocks: make(map[uint64]BlockRef)}
+}
+
+func (s *memStore) Cursor(ctx context.Context) (uint64, error) {
+	return s.cursor, nil
+}
+
+func (s *memStore) Markets(ctx context.Context) ([]common.Address, error) {
+	var markets []common.Address
+	for _, ev := range s.events {
+		if created, ok := ev.(*models.MarketCreatedEvent); ok {
+			markets = append(markets, created.MarketAddress)
+		}
+	}
+	return markets, nil
+}
+
+func (s *memStore) RecentBlocks(ctx context.Context) ([]BlockRef, error) {
+	refs := make([]BlockRef, 0, len(s.blocks))
+	for _, ref := range s.blocks {
+		refs = append(refs, ref)
+	}
+	sort.Slice(refs, func(i, j int) bool { return refs[i].Number > refs[j].Number })
+	return refs, nil
+}
+
+func (s *memStore) Apply(ctx context.Context, batch *Batch) error {
+	s.events = append(s.events, batch.Events...)
+	for _, ref := range batch.Blocks {
+		s.blocks[ref.Number] = ref
+	}
+	for number := range s.blocks {
+		if number < batch.PruneBelow {
+			delete(s.blocks, number)
+		}
+	}
+	s.cursor = batch.To
+	return nil
+}
+
+func (s *memStore) Rollback(ctx context.Context, ancestor uint64) error {
+	s.rollbacks = append(s.rollbacks, ancestor)
+	kept := s.events[:0]
+	for _, ev := range s.events {
+		if EventBase(ev).BlockNumber <= ancestor {
+			kept = append(kept, ev)
+		}
+	}
+	s.events = kept
+	for number := range s.blocks {
+		if number > ancestor {
+			delete(s.blocks, number)
+		}
+	}
+	s.cursor = ancestor
+	return nil
+}
+
+// eventSummary 返回 "类型@区块" 列表
+func (s *memStore) eventSummary() []string {
+	out := make([]string, 0, len(s.events))
+	for _, ev := range s.events {
+		base := EventBase(ev)
+		out = append(out, fmt.Sprintf("%s@%d", base.EventType, base.BlockNumber))
+	}
+	return out
+}
+
+func newTestIndexer(t *testing.T, chain *fakeChain, store *memStore) *Indexer {
+	idx, err := New(chain, store, Config{
+		Factory:       testFactory,
+		StartBlock:    5,
+		Confirmations: 5,
+		ChunkSize:     8,
+		ReorgWindow:   64,
+	}, nil)
+	require.NoError(t, err)
+	return idx
+}
+
+func TestIndexer_Sync(t *testing.T) {
+	other := common.HexToAddress("0x00000000000000000000000000000000000000c3")
+
+	chain := newFakeChain(60)
+	chain.addLog(marketCreatedLog(t, testFactory, testMarket, 10))
+	chain.addLog(betLog(t, testMarket, 10, 1_000_000))  // 与创建同一区块
+	chain.addLog(betLog(t, other, 12, 1_000_000))       // 未知市场
+	chain.addLog(marketCreatedLog(t, other, other, 13)) // 非工厂地址
+	chain.addLog(lockedLog(t, testMarket, 20))
+	chain.addLog(resolvedLog(t, testMarket, 57)) // 未达到确认数
+
+	store := newMemStore()
+	idx := newTestIndexer(t, chain, store)
+
+	caughtUp, err := idx.Sync(context.Background())
+	require.NoError(t, err)
+	assert.True(t, caughtUp)
+	assert.Equal(t, uint64(55), store.cursor)
+	assert.Equal(t, []string{"MarketCreated@10", "BetPlaced@10", "MarketLocked@20"}, store.eventSummary())
+
+	bet := store.events[1].(*models.BetPlacedEvent)
+	assert.Equal(t, chain.headerAt(10).Time, uint64(bet.BlockTime.Unix()))
+	assert.Equal(t, uint(1), bet.LogIndex)
+
+	// 最后处理的区块记录了哈希
+	recent, err := store.RecentBlocks(context.Background())
+	require.NoError(t, err)
+	require.NotEmpty(t, recent)
+	assert.Equal(t, uint64(55), recent[0].Number)
+	assert.Equal(t, chain.headerAt(55).Hash(), recent[0].Hash)
+
+	// 链头前进后继续同步
+	chain.latest = 65
+	_, err = idx.Sync(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, uint64(60), store.cursor)
+	assert.Equal(t, "MarketResolved@57", store.eventSummary()[3])
+	assert.Empty(t, store.rollbacks)
+}
+
+func TestIndexer_Reorg(t *testing.T) {
+	chain := newFakeChain(60)
+	chain.addLog(marketCreatedLog(t, testFactory, testMarket, 10))
+	chain.addLog(lockedLog(t, testMarket, 20))
+	chain.addLog(betLog(t, testMarket, 48, 1_000_000))
+	chain.addLog(resolvedLog(t, testMarket, 52))
+
+	store := newMemStore()
+	idx := newTestIndexer(t, chain, store)
+	_, err := idx.Sync(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"MarketCreated@10", "MarketLocked@20", "BetPlaced@48", "MarketResolved@52"}, store.eventSummary())
+
+	// 区块 50 起被替换：结算消失，新分叉上有一笔下注
+	chain.reorg(50)
+	chain.addLog(betLog(t, testMarket, 51, 2_000_000))
+	chain.latest = 62
+
+	_, err = idx.Sync(context.Background())
+	require.NoError(t, err)
+	require.Len(t, store.rollbacks, 1)
+	assert.Less(t, store.rollbacks[0], uint64(50))
+	assert.GreaterOrEqual(t, store.rollbacks[0], uint64(48))
+	assert.Equal(t, uint64(57), store.cursor)
+	assert.Equal(t, []string{"MarketCreated@10", "MarketLocked@20", "BetPlaced@48", "BetPlaced@51"}, store.eventSummary())
+
+	recent, err := store.RecentBlocks(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, chain.headerAt(57).Hash(), recent[0].Hash)
+}
+
+func TestIndexer_ReorgBeyondWindow(t *testing.T) {
+	chain := newFakeChain(60)
+	chain.addLog(marketCreatedLog(t, testFactory, testMarket, 10))
+
+	store := newMemStore()
+	idx := newTestIndexer(t, chain, store)
+	_, err := idx.Sync(context.Background())
+	require.NoError(t, err)
+
+	// 所有保存的区块都不在主链上
+	chain.reorg(0)
+	_, err = idx.Sync(context.Background())
+	assert.ErrorContains(t, err, "链重组深度超过")
+	assert.Empty(t, store.rollbacks)
+	assert.Equal(t, uint64(55), store.cursor)
+}
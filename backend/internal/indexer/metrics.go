@@ -0,0 +1,62 @@
+package indexer
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	// chainHead 最近一次读取的链头区块
+	chainHead = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: "pitchone",
+			Subsystem: "indexer",
+			Name:      "chain_head_block",
+			Help:      "Latest block number reported by the RPC endpoint.",
+		},
+	)
+
+	// processedBlock 已提交的最后一个区块
+	processedBlock = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: "pitchone",
+			Subsystem: "indexer",
+			Name:      "processed_block",
+			Help:      "Last block committed to the database.",
+		},
+	)
+
+	// eventsIndexed 按事件类型统计写入的事件数
+	eventsIndexed = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "pitchone",
+			Subsystem: "indexer",
+			Name:      "events_indexed_total",
+			Help:      "Events written to the database.",
+		},
+		[]string{"event"},
+	)
+
+	// reorgsDetected 检测到并回滚的链重组次数
+	reorgsDetected = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "pitchone",
+			Subsystem: "indexer",
+			Name:      "reorgs_detected_total",
+			Help:      "Chain reorganizations detected and rolled back.",
+		},
+	)
+
+	// syncErrors 同步失败次数
+	syncErrors = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "pitchone",
+			Subsystem: "indexer",
+			Name:      "sync_errors_total",
+			Help:      "Sync iterations that failed and will be retried.",
+		},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(chainHead, processedBlock, eventsIndexed, reorgsDetected, syncErrors)
+}
@@ -0,0 +1,385 @@
+package indexer
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/pitchone/sportsbook/pkg/models"
+)
+
+// 市场状态（markets.status，与 Subgraph 的 Market.state 一致）
+const (
+	StatusOpen      = "Open"
+	StatusLocked    = "Locked"
+	StatusResolved  = "Resolved"
+	StatusFinalized = "Finalized"
+	StatusCancelled = "Cancelled"
+)
+
+// marketVersion 索引器写入的市场版本（markets.version）
+const marketVersion = "v3"
+
+// cursorKey indexer_state 中的进度键
+const cursorKey = "last_processed_block"
+
+// PostgresStore 写入 pkg/db/init.sql 与 indexer.sql 定义的表
+// 地址与哈希统一存为小写 hex，市场 ID 即市场地址
+type PostgresStore struct {
+	db *sql.DB
+}
+
+// NewPostgresStore 创建 Postgres 存储
+func NewPostgresStore(db *sql.DB) *PostgresStore {
+	return &PostgresStore{db: db}
+}
+
+// CheckSchema 检查 indexer.sql 是否已执行
+func (s *PostgresStore) CheckSchema(ctx context.Context) error {
+	for _, table := range []string{"markets", "orders", "payouts", "indexer_state", "market_events", "indexer_blocks"} {
+		var exists bool
+		if err := s.db.QueryRowContext(ctx, "SELECT to_regclass($1) IS NOT NULL", table).Scan(&exists); err != nil {
+			return fmt.Errorf("检查数据库表失败: %w", err)
+		}
+		if !exists {
+			return fmt.Errorf("数据库缺少表 %s，请先执行 pkg/db/init.sql 与 pkg/db/indexer.sql", table)
+		}
+	}
+	return nil
+}
+
+func (s *PostgresStore) Cursor(ctx context.Context) (uint64, error) {
+	var cursor int64
+	err := s.db.QueryRowContext(ctx, "SELECT value FROM indexer_state WHERE key = $1", cursorKey).Scan(&cursor)
+	if err == sql.ErrNoRows {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("读取索引进度失败: %w", err)
+	}
+	return uint64(cursor), nil
+}
+
+func (s *PostgresStore) Markets(ctx context.Context) ([]common.Address, error) {
+	rows, err := s.db.QueryContext(ctx, "SELECT id FROM markets WHERE version = $1", marketVersion)
+	if err != nil {
+		return nil, fmt.Errorf("查询市场失败: %w", err)
+	}
+	defer rows.Close()
+
+	markets := make([]common.Address, 0)
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		markets = append(markets, common.HexToAddress(id))
+	}
+	return markets, rows.Err()
+}
+
+func (s *PostgresStore) RecentBlocks(ctx context.Context) ([]BlockRef, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT block_number, block_hash, parent_hash, block_time
+		FROM indexer_blocks
+		ORDER BY block_number DESC
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("查询区块哈希失败: %w", err)
+	}
+	defer rows.Close()
+
+	blocks := make([]BlockRef, 0)
+	for rows.Next() {
+		var ref BlockRef
+		var hash, parent string
+		if err := rows.Scan(&ref.Number, &hash, &parent, &ref.Time); err != nil {
+			return nil, err
+		}
+		ref.Hash = common.HexToHash(hash)
+		ref.ParentHash = common.HexToHash(parent)
+		blocks = append(blocks, ref)
+	}
+	return blocks, rows.Err()
+}
+
+func (s *PostgresStore) Apply(ctx context.Context, batch *Batch) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, ev := range batch.Events {
+		if err := applyEvent(ctx, tx, ev); err != nil {
+			base := EventBase(ev)
+			return fmt.Errorf("写入 %s（交易 %s #%d）失败: %w", base.EventType, base.TxHash, base.LogIndex, err)
+		}
+	}
+
+	for _, ref := range batch.Blocks {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO indexer_blocks (block_number, block_hash, parent_hash, block_time)
+			VALUES ($1, $2, $3, $4)
+			ON CONFLICT (block_number) DO UPDATE
+			SET block_hash = EXCLUDED.block_hash, parent_hash = EXCLUDED.parent_hash, block_time = EXCLUDED.block_time
+		`, ref.Number, hexLower(ref.Hash), hexLower(ref.ParentHash), ref.Time)
+		if err != nil {
+			return fmt.Errorf("写入区块哈希失败: %w", err)
+		}
+	}
+	if batch.PruneBelow > 0 {
+		if _, err := tx.ExecContext(ctx, "DELETE FROM indexer_blocks WHERE block_number < $1", batch.PruneBelow); err != nil {
+			return fmt.Errorf("清理区块哈希失败: %w", err)
+		}
+	}
+
+	if err := setCursor(ctx, tx, batch.To); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (s *PostgresStore) Rollback(ctx context.Context, ancestor uint64) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// 回滚区间内有状态变化的市场，删除事件后按剩余事件重建状态
+	affected, err := queryStrings(ctx, tx,
+		"SELECT DISTINCT market_id FROM market_events WHERE block_number > $1", ancestor)
+	if err != nil {
+		return err
+	}
+
+	for _, stmt := range []string{
+		"DELETE FROM orders WHERE block_number > $1",
+		"DELETE FROM payouts WHERE block_number > $1",
+		"DELETE FROM market_events WHERE block_number > $1",
+		"DELETE FROM markets WHERE version = '" + marketVersion + "' AND created_block > $1",
+		"DELETE FROM indexer_blocks WHERE block_number > $1",
+	} {
+		if _, err := tx.ExecContext(ctx, stmt, ancestor); err != nil {
+			return fmt.Errorf("回滚失败: %w", err)
+		}
+	}
+
+	for _, market := range affected {
+		if err := rebuildStatus(ctx, tx, market); err != nil {
+			return err
+		}
+	}
+
+	if err := setCursor(ctx, tx, ancestor); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// applyEvent 写入单个事件
+func applyEvent(ctx context.Context, tx *sql.Tx, ev interface{}) error {
+	switch e := ev.(type) {
+	case *models.MarketCreatedEvent:
+		params := "{}"
+		if len(e.MarketParams) > 0 {
+			b, err := json.Marshal(e.MarketParams)
+			if err != nil {
+				return err
+			}
+			params = string(b)
+		}
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO markets (
+				id, template_id, match_id, home_team, away_team, kickoff_time, status,
+				created_at, created_block, tx_hash, log_index, market_address, updated_at, version, market_params
+			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $1, $8, $12, $13)
+			ON CONFLICT (id) DO NOTHING
+		`, hexLower(e.MarketAddress), hexLower(e.TemplateID), e.MatchID, e.HomeTeam, e.AwayTeam, e.KickoffTime,
+			StatusOpen, e.BlockTime.Unix(), e.BlockNumber, strings.ToLower(e.TxHash), e.LogIndex, marketVersion, params)
+		return err
+
+	case *models.BetPlacedEvent:
+		// Market_V3 的 BetPlaced 不含手续费（由 BettingRouter 收取），与 Subgraph 一致记为 0
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO orders (market_id, user_address, outcome, stake, shares, fee, timestamp, block_number, tx_hash, log_index)
+			VALUES ($1, $2, $3, $4, $5, 0, $6, $7, $8, $9)
+			ON CONFLICT (tx_hash, log_index) DO NOTHING
+		`, hexLower(e.MarketAddress), hexLower(e.User), e.Outcome, e.Amount.String(), e.Shares.String(),
+			e.BlockTime.Unix(), e.BlockNumber, strings.ToLower(e.TxHash), e.LogIndex)
+		return err
+
+	case *models.RedeemedEvent:
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO payouts (market_id, user_address, outcome, shares, amount, event_type, timestamp, block_number, tx_hash, log_index)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+			ON CONFLICT (tx_hash, log_index) DO NOTHING
+		`, hexLower(e.MarketAddress), hexLower(e.User), e.Outcome, e.Shares.String(), e.Payout.String(), e.EventType,
+			e.BlockTime.Unix(), e.BlockNumber, strings.ToLower(e.TxHash), e.LogIndex)
+		return err
+	}
+
+	change, ok := lifecycleOf(ev)
+	if !ok {
+		return fmt.Errorf("不支持的事件类型 %T", ev)
+	}
+	base := EventBase(ev)
+	result, err := tx.ExecContext(ctx, `
+		INSERT INTO market_events (market_id, event_type, event_time, winner_outcome, result_hash, reason, block_number, tx_hash, log_index)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		ON CONFLICT (tx_hash, log_index) DO NOTHING
+	`, change.market, base.EventType, change.time, change.winner, change.resultHash, change.reason,
+		base.BlockNumber, strings.ToLower(base.TxHash), base.LogIndex)
+	if err != nil {
+		return err
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		return nil
+	}
+	return change.apply(ctx, tx)
+}
+
+// lifecycle 市场状态变化（锁盘、结算、最终确认、取消）
+type lifecycle struct {
+	market     string
+	eventType  string
+	time       int64
+	winner     sql.NullInt64
+	resultHash []byte
+	reason     sql.NullString
+}
+
+// lifecycleOf 将状态事件转换为 lifecycle
+func lifecycleOf(ev interface{}) (*lifecycle, bool) {
+	switch e := ev.(type) {
+	case *models.LockedEvent:
+		return &lifecycle{market: hexLower(e.MarketAddress), eventType: e.EventType, time: int64(e.LockTime)}, true
+	case *models.ResolvedEvent:
+		return &lifecycle{
+			market:     hexLower(e.MarketAddress),
+			eventType:  e.EventType,
+			time:       int64(e.ResolveTime),
+			winner:     sql.NullInt64{Int64: int64(e.WinningOutcome), Valid: true},
+			resultHash: e.ResultHash.Bytes(),
+		}, true
+	case *models.FinalizedEvent:
+		return &lifecycle{market: hexLower(e.MarketAddress), eventType: e.EventType, time: int64(e.FinalizeTime)}, true
+	case *models.CancelledEvent:
+		return &lifecycle{
+			market:    hexLower(e.MarketAddress),
+			eventType: e.EventType,
+			time:      e.BlockTime.Unix(),
+			reason:    sql.NullString{String: e.Reason, Valid: true},
+		}, true
+	}
+	return nil, false
+}
+
+// apply 更新 markets 的状态字段
+func (l *lifecycle) apply(ctx context.Context, tx *sql.Tx) error {
+	var err error
+	switch l.eventType {
+	case EventMarketLocked:
+		_, err = tx.ExecContext(ctx,
+			"UPDATE markets SET status = $2, locked_at = $3, updated_at = $3 WHERE id = $1",
+			l.market, StatusLocked, l.time)
+	case EventMarketResolved:
+		_, err = tx.ExecContext(ctx,
+			"UPDATE markets SET status = $2, resolved_at = $3, winner_outcome = $4, result_hash = $5, updated_at = $3 WHERE id = $1",
+			l.market, StatusResolved, l.time, l.winner, l.resultHash)
+	case EventMarketFinalized:
+		_, err = tx.ExecContext(ctx,
+			"UPDATE markets SET status = $2, finalized_at = $3, updated_at = $3 WHERE id = $1",
+			l.market, StatusFinalized, l.time)
+	case EventMarketCancelled:
+		_, err = tx.ExecContext(ctx,
+			"UPDATE markets SET status = $2, updated_at = $3 WHERE id = $1",
+			l.market, StatusCancelled, l.time)
+	default:
+		return fmt.Errorf("未知的市场事件 %s", l.eventType)
+	}
+	return err
+}
+
+// rebuildStatus 重置市场状态字段并按剩余的 market_events 重放
+func rebuildStatus(ctx context.Context, tx *sql.Tx, market string) error {
+	_, err := tx.ExecContext(ctx, `
+		UPDATE markets
+		SET status = $2, locked_at = NULL, resolved_at = NULL, winner_outcome = NULL,
+		    result_hash = NULL, finalized_at = NULL, updated_at = created_at
+		WHERE id = $1
+	`, market, StatusOpen)
+	if err != nil {
+		return fmt.Errorf("重置市场 %s 状态失败: %w", market, err)
+	}
+
+	rows, err := tx.QueryContext(ctx, `
+		SELECT event_type, event_time, winner_outcome, result_hash, reason
+		FROM market_events
+		WHERE market_id = $1
+		ORDER BY block_number, log_index
+	`, market)
+	if err != nil {
+		return fmt.Errorf("查询市场 %s 事件失败: %w", market, err)
+	}
+	changes := make([]*lifecycle, 0)
+	for rows.Next() {
+		l := &lifecycle{market: market}
+		if err := rows.Scan(&l.eventType, &l.time, &l.winner, &l.resultHash, &l.reason); err != nil {
+			rows.Close()
+			return err
+		}
+		changes = append(changes, l)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for _, l := range changes {
+		if err := l.apply(ctx, tx); err != nil {
+			return fmt.Errorf("重建市场 %s 状态失败: %w", market, err)
+		}
+	}
+	return nil
+}
+
+// setCursor 更新索引进度
+func setCursor(ctx context.Context, tx *sql.Tx, block uint64) error {
+	_, err := tx.ExecContext(ctx, `
+		INSERT INTO indexer_state (key, value, updated_at) VALUES ($1, $2, CURRENT_TIMESTAMP)
+		ON CONFLICT (key) DO UPDATE SET value = EXCLUDED.value, updated_at = EXCLUDED.updated_at
+	`, cursorKey, block)
+	if err != nil {
+		return fmt.Errorf("更新索引进度失败: %w", err)
+	}
+	return nil
+}
+
+func queryStrings(ctx context.Context, tx *sql.Tx, query string, args ...interface{}) ([]string, error) {
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	values := make([]string, 0)
+	for rows.Next() {
+		var v string
+		if err := rows.Scan(&v); err != nil {
+			return nil, err
+		}
+		values = append(values, v)
+	}
+	return values, rows.Err()
+}
+
+// hexLower 地址或哈希的小写 hex（与 Subgraph 的实体 ID 一致）
+func hexLower(v interface{ Hex() string }) string {
+	return strings.ToLower(v.Hex())
+}
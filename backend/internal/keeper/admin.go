@@ -0,0 +1,394 @@
+package keeper
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"go.uber.org/zap"
+
+	"github.com/pitchone/sportsbook/internal/repository"
+)
+
+// AdminServer exposes an authenticated HTTP API for keeper operators.
+//
+// Routes:
+//
+//	GET    /admin/tasks                    list tasks with their status
+//	GET    /admin/tasks/{name}             status of a single task
+//	POST   /admin/tasks/{name}/pause       stop scheduled runs
+//	POST   /admin/tasks/{name}/resume      re-enable scheduled runs
+//	POST   /admin/tasks/{name}/run         run the task now
+//	GET    /admin/skiplist                 list skipped markets
+//	POST   /admin/skiplist                 add a market {"market", "reason"}
+//	DELETE /admin/skiplist/{market}        remove a market
+//	POST   /admin/markets/{market}/result  settle with a manual result
+//
+// When result overrides are enabled, a manual result is stored as a pending
+// override instead and settled only after a second operator approves it.
+//
+// Every request must carry "Authorization: Bearer <token>". Mutating actions
+// are written to the audit log together with the operator name.
+type AdminServer struct {
+	keeper     *Keeper
+	scheduler  *Scheduler
+	settleTask *SettleTask
+	audit      *AuditLog
+}
+
+// manualResultRequest is the body of POST /admin/markets/{market}/result
+type manualResultRequest struct {
+	HomeGoals *uint8 `json:"homeGoals"`
+	AwayGoals *uint8 `json:"awayGoals"`
+	ExtraTime bool   `json:"extraTime"`
+	Reason    string `json:"reason"`
+	// Required when result overrides are enabled
+	EvidenceURL string `json:"evidenceUrl"`
+}
+
+// skipRequest is the body of POST /admin/skiplist
+type skipRequest struct {
+	Market string `json:"market"`
+	Reason string `json:"reason"`
+}
+
+type operatorKey struct{}
+
+// NewAdminServer creates a new admin API server
+func NewAdminServer(keeper *Keeper, scheduler *Scheduler, settleTask *SettleTask) *AdminServer {
+	return &AdminServer{
+		keeper:     keeper,
+		scheduler:  scheduler,
+		settleTask: settleTask,
+		audit:      NewAuditLog(keeper.config.Admin.AuditLogPath, keeper.logger),
+	}
+}
+
+// Handler returns the HTTP handler for the admin API
+func (s *AdminServer) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /admin/tasks", s.handleListTasks)
+	mux.HandleFunc("GET /admin/tasks/{name}", s.handleGetTask)
+	mux.HandleFunc("POST /admin/tasks/{name}/pause", s.handlePauseTask)
+	mux.HandleFunc("POST /admin/tasks/{name}/resume", s.handleResumeTask)
+	mux.HandleFunc("POST /admin/tasks/{name}/run", s.handleRunTask)
+	mux.HandleFunc("GET /admin/skiplist", s.handleListSkipped)
+	mux.HandleFunc("POST /admin/skiplist", s.handleAddSkipped)
+	mux.HandleFunc("DELETE /admin/skiplist/{market}", s.handleRemoveSkipped)
+	mux.HandleFunc("POST /admin/markets/{market}/result", s.handleManualResult)
+
+	return s.authenticate(mux)
+}
+
+// Run serves the admin API until the context is cancelled or the keeper stops
+func (s *AdminServer) Run(ctx context.Context) error {
+	addr := net.JoinHostPort(s.keeper.config.Admin.BindAddress, strconv.Itoa(s.keeper.config.Admin.Port))
+	server := &http.Server{
+		Addr:              addr,
+		Handler:           s.Handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	errChan := make(chan error, 1)
+	go func() {
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			errChan <- err
+		}
+		close(errChan)
+	}()
+
+	s.keeper.logger.Info("admin server started", zap.String("addr", addr))
+
+	select {
+	case err := <-errChan:
+		return fmt.Errorf("admin server error: %w", err)
+	case <-ctx.Done():
+	case <-s.keeper.stopChan:
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		return fmt.Errorf("failed to shut down admin server: %w", err)
+	}
+
+	s.keeper.logger.Info("admin server stopped")
+	return nil
+}
+
+// authenticate resolves the bearer token to an operator name
+func (s *AdminServer) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || token == "" {
+			writeJSONError(w, http.StatusUnauthorized, "missing bearer token")
+			return
+		}
+
+		operator := ""
+		for name, expected := range s.keeper.config.Admin.Tokens {
+			if subtle.ConstantTimeCompare([]byte(token), []byte(expected)) == 1 {
+				operator = name
+			}
+		}
+		if operator == "" {
+			s.keeper.logger.Warn("admin request with invalid token",
+				zap.String("remoteAddr", r.RemoteAddr),
+				zap.String("path", r.URL.Path),
+			)
+			writeJSONError(w, http.StatusUnauthorized, "invalid token")
+			return
+		}
+
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), operatorKey{}, operator)))
+	})
+}
+
+func (s *AdminServer) handleListTasks(w http.ResponseWriter, r *http.Request) {
+	names := s.scheduler.ListTasks()
+	sort.Strings(names)
+
+	tasks := make([]map[string]interface{}, 0, len(names))
+	for _, name := range names {
+		status, err := s.scheduler.GetTaskStatus(name)
+		if err != nil {
+			continue
+		}
+		tasks = append(tasks, status)
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"tasks": tasks})
+}
+
+func (s *AdminServer) handleGetTask(w http.ResponseWriter, r *http.Request) {
+	status, err := s.scheduler.GetTaskStatus(r.PathValue("name"))
+	if err != nil {
+		writeJSONError(w, http.StatusNotFound, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, status)
+}
+
+func (s *AdminServer) handlePauseTask(w http.ResponseWriter, r *http.Request) {
+	name := r.PathValue("name")
+	err := s.scheduler.PauseTask(name)
+	s.record(r, "task.pause", name, nil, err)
+	s.respondTaskAction(w, name, err)
+}
+
+func (s *AdminServer) handleResumeTask(w http.ResponseWriter, r *http.Request) {
+	name := r.PathValue("name")
+	err := s.scheduler.ResumeTask(name)
+	s.record(r, "task.resume", name, nil, err)
+	s.respondTaskAction(w, name, err)
+}
+
+func (s *AdminServer) handleRunTask(w http.ResponseWriter, r *http.Request) {
+	name := r.PathValue("name")
+	err := s.scheduler.TriggerTask(name)
+	s.record(r, "task.run", name, nil, err)
+	s.respondTaskAction(w, name, err)
+}
+
+// respondTaskAction writes the task status after a task action
+func (s *AdminServer) respondTaskAction(w http.ResponseWriter, name string, err error) {
+	if err != nil {
+		code := http.StatusConflict
+		if strings.HasPrefix(err.Error(), "task not found") {
+			code = http.StatusNotFound
+		}
+		writeJSONError(w, code, err.Error())
+		return
+	}
+
+	status, err := s.scheduler.GetTaskStatus(name)
+	if err != nil {
+		writeJSONError(w, http.StatusNotFound, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, status)
+}
+
+func (s *AdminServer) handleListSkipped(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]interface{}{"markets": s.keeper.skipList.List()})
+}
+
+func (s *AdminServer) handleAddSkipped(w http.ResponseWriter, r *http.Request) {
+	var req skipRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+	if !common.IsHexAddress(req.Market) {
+		writeJSONError(w, http.StatusBadRequest, "invalid market address")
+		return
+	}
+	if req.Reason == "" {
+		writeJSONError(w, http.StatusBadRequest, "reason is required")
+		return
+	}
+
+	market := common.HexToAddress(req.Market)
+	entry := s.keeper.skipList.Add(market, req.Reason, operatorFrom(r))
+	s.record(r, "skiplist.add", market.Hex(), map[string]interface{}{"reason": req.Reason}, nil)
+
+	writeJSON(w, http.StatusOK, entry)
+}
+
+func (s *AdminServer) handleRemoveSkipped(w http.ResponseWriter, r *http.Request) {
+	raw := r.PathValue("market")
+	if !common.IsHexAddress(raw) {
+		writeJSONError(w, http.StatusBadRequest, "invalid market address")
+		return
+	}
+
+	market := common.HexToAddress(raw)
+	var err error
+	if !s.keeper.skipList.Remove(market) {
+		err = fmt.Errorf("market not on skip list: %s", market.Hex())
+	}
+	s.record(r, "skiplist.remove", market.Hex(), nil, err)
+
+	if err != nil {
+		writeJSONError(w, http.StatusNotFound, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"removed": market.Hex()})
+}
+
+func (s *AdminServer) handleManualResult(w http.ResponseWriter, r *http.Request) {
+	raw := r.PathValue("market")
+	if !common.IsHexAddress(raw) {
+		writeJSONError(w, http.StatusBadRequest, "invalid market address")
+		return
+	}
+	market := common.HexToAddress(raw)
+
+	var req manualResultRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+	if req.HomeGoals == nil || req.AwayGoals == nil {
+		writeJSONError(w, http.StatusBadRequest, "homeGoals and awayGoals are required")
+		return
+	}
+	if req.Reason == "" {
+		writeJSONError(w, http.StatusBadRequest, "reason is required")
+		return
+	}
+
+	params := map[string]interface{}{
+		"homeGoals": *req.HomeGoals,
+		"awayGoals": *req.AwayGoals,
+		"extraTime": req.ExtraTime,
+		"reason":    req.Reason,
+	}
+
+	result := &MatchResult{
+		HomeGoals: *req.HomeGoals,
+		AwayGoals: *req.AwayGoals,
+		ExtraTime: req.ExtraTime,
+	}
+
+	// With the overrides store enabled the result waits for a second operator's approval
+	if s.settleTask.OverridesEnabled() {
+		s.submitManualResult(w, r, market, result, req, params)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Minute)
+	defer cancel()
+
+	err := s.settleTask.SettleWithManualResult(ctx, market, result)
+	s.record(r, "market.manual_result", market.Hex(), params, err)
+
+	if err != nil {
+		writeJSONError(w, http.StatusUnprocessableEntity, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"market":  market.Hex(),
+		"settled": true,
+	})
+}
+
+// submitManualResult stores a manual result as a pending result override
+func (s *AdminServer) submitManualResult(w http.ResponseWriter, r *http.Request, market common.Address, result *MatchResult, req manualResultRequest, params map[string]interface{}) {
+	if u, err := url.ParseRequestURI(req.EvidenceURL); err != nil || u.Host == "" {
+		writeJSONError(w, http.StatusBadRequest, "evidenceUrl is required when result overrides are enabled")
+		return
+	}
+	params["evidenceUrl"] = req.EvidenceURL
+
+	ctx, cancel := context.WithTimeout(r.Context(), 30*time.Second)
+	defer cancel()
+
+	id, err := s.settleTask.SubmitManualResult(ctx, market, result, req.Reason, req.EvidenceURL)
+	if err == nil {
+		params["overrideId"] = id
+	}
+	s.record(r, "market.manual_result", market.Hex(), params, err)
+
+	if errors.Is(err, repository.ErrOverrideActiveExists) {
+		writeJSONError(w, http.StatusConflict, err.Error())
+		return
+	}
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusAccepted, map[string]interface{}{
+		"market":     market.Hex(),
+		"overrideId": id,
+		"status":     repository.OverrideStatusPending,
+		"settled":    false,
+	})
+}
+
+// record writes an audit entry for the request
+func (s *AdminServer) record(r *http.Request, action, target string, params map[string]interface{}, actionErr error) {
+	entry := AuditEntry{
+		Operator:   operatorFrom(r),
+		Action:     action,
+		Target:     target,
+		Params:     params,
+		Success:    actionErr == nil,
+		RemoteAddr: r.RemoteAddr,
+	}
+	if actionErr != nil {
+		entry.Error = actionErr.Error()
+	}
+
+	if err := s.audit.Record(entry); err != nil {
+		s.keeper.logger.Error("failed to write audit log", zap.Error(err))
+	}
+}
+
+// operatorFrom returns the authenticated operator name of a request
+func operatorFrom(r *http.Request) string {
+	operator, _ := r.Context().Value(operatorKey{}).(string)
+	return operator
+}
+
+// writeJSON writes a JSON response
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(v)
+}
+
+// writeJSONError writes a JSON error response
+func writeJSONError(w http.ResponseWriter, code int, message string) {
+	writeJSON(w, code, map[string]string{"error": message})
+}
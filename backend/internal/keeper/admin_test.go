@@ -0,0 +1,268 @@
+package keeper
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/pitchone/sportsbook/internal/repository"
+)
+
+// countingTask counts how many times it was executed
+type countingTask struct {
+	runs atomic.Int32
+}
+
+func (t *countingTask) Execute(ctx context.Context) error {
+	t.runs.Add(1)
+	return nil
+}
+
+// newTestAdminServer builds an admin server without RPC or Subgraph dependencies
+func newTestAdminServer(t *testing.T) (*AdminServer, *countingTask, string) {
+	auditPath := filepath.Join(t.TempDir(), "audit.jsonl")
+	k := &Keeper{
+		config: &Config{
+			RetryAttempts: 1,
+			Admin: AdminConfig{
+				Enabled:      true,
+				Tokens:       map[string]string{"alice": "token-a", "bob": "token-b"},
+				AuditLogPath: auditPath,
+			},
+		},
+		logger:   zap.NewNop(),
+		skipList: NewMarketSkipList(),
+		stopChan: make(chan struct{}),
+	}
+
+	task := &countingTask{}
+	scheduler := NewScheduler(k)
+	scheduler.RegisterTask("lock", task, time.Hour)
+
+	return NewAdminServer(k, scheduler, NewSettleTask(k, nil)), task, auditPath
+}
+
+func doAdminRequest(h http.Handler, method, path, token, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func readAuditLog(t *testing.T, path string) []AuditEntry {
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+
+	var entries []AuditEntry
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var entry AuditEntry
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &entry))
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+// TestAdminServer_Authentication tests bearer token handling
+func TestAdminServer_Authentication(t *testing.T) {
+	server, _, _ := newTestAdminServer(t)
+	h := server.Handler()
+
+	assert.Equal(t, http.StatusUnauthorized, doAdminRequest(h, "GET", "/admin/tasks", "", "").Code)
+	assert.Equal(t, http.StatusUnauthorized, doAdminRequest(h, "GET", "/admin/tasks", "wrong", "").Code)
+	assert.Equal(t, http.StatusOK, doAdminRequest(h, "GET", "/admin/tasks", "token-a", "").Code)
+}
+
+// TestAdminServer_TaskActions tests listing, pausing, resuming and triggering tasks
+func TestAdminServer_TaskActions(t *testing.T) {
+	server, task, auditPath := newTestAdminServer(t)
+	h := server.Handler()
+
+	rec := doAdminRequest(h, "POST", "/admin/tasks/lock/pause", "token-a", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	var status map[string]interface{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &status))
+	assert.Equal(t, true, status["paused"])
+
+	rec = doAdminRequest(h, "POST", "/admin/tasks/lock/resume", "token-b", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &status))
+	assert.Equal(t, false, status["paused"])
+
+	assert.Equal(t, http.StatusNotFound, doAdminRequest(h, "POST", "/admin/tasks/missing/pause", "token-a", "").Code)
+
+	// Run-now is picked up by a running scheduler, even while paused
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go server.scheduler.Start(ctx)
+	require.Eventually(t, func() bool { return task.runs.Load() == 1 }, time.Second, 10*time.Millisecond)
+
+	require.NoError(t, server.scheduler.PauseTask("lock"))
+	require.Equal(t, http.StatusOK, doAdminRequest(h, "POST", "/admin/tasks/lock/run", "token-a", "").Code)
+	require.Eventually(t, func() bool { return task.runs.Load() == 2 }, time.Second, 10*time.Millisecond)
+
+	entries := readAuditLog(t, auditPath)
+	require.Len(t, entries, 4)
+	assert.Equal(t, "task.pause", entries[0].Action)
+	assert.Equal(t, "alice", entries[0].Operator)
+	assert.Equal(t, "task.resume", entries[1].Action)
+	assert.Equal(t, "bob", entries[1].Operator)
+	assert.False(t, entries[2].Success)
+	assert.Equal(t, "task.run", entries[3].Action)
+}
+
+// TestAdminServer_SkipList tests adding and removing skipped markets
+func TestAdminServer_SkipList(t *testing.T) {
+	server, _, auditPath := newTestAdminServer(t)
+	h := server.Handler()
+	market := common.HexToAddress("0x1234567890123456789012345678901234567890")
+
+	rec := doAdminRequest(h, "POST", "/admin/skiplist", "token-a", `{"market":"`+market.Hex()+`","reason":"bad feed"}`)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.True(t, server.keeper.skipList.Contains(market))
+
+	assert.Equal(t, http.StatusBadRequest, doAdminRequest(h, "POST", "/admin/skiplist", "token-a", `{"market":"0x12"}`).Code)
+
+	rec = doAdminRequest(h, "GET", "/admin/skiplist", "token-a", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	var list struct {
+		Markets []SkipEntry `json:"markets"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &list))
+	require.Len(t, list.Markets, 1)
+	assert.Equal(t, "alice", list.Markets[0].AddedBy)
+
+	assert.Equal(t, http.StatusOK, doAdminRequest(h, "DELETE", "/admin/skiplist/"+market.Hex(), "token-a", "").Code)
+	assert.False(t, server.keeper.skipList.Contains(market))
+	assert.Equal(t, http.StatusNotFound, doAdminRequest(h, "DELETE", "/admin/skiplist/"+market.Hex(), "token-a", "").Code)
+
+	entries := readAuditLog(t, auditPath)
+	require.Len(t, entries, 3)
+	assert.Equal(t, "skiplist.add", entries[0].Action)
+	assert.Equal(t, "bad feed", entries[0].Params["reason"])
+	assert.True(t, entries[1].Success)
+	assert.False(t, entries[2].Success)
+}
+
+// TestAdminServer_ManualResultValidation tests request validation for manual results
+func TestAdminServer_ManualResultValidation(t *testing.T) {
+	server, _, _ := newTestAdminServer(t)
+	h := server.Handler()
+	path := "/admin/markets/0x1234567890123456789012345678901234567890/result"
+
+	assert.Equal(t, http.StatusBadRequest, doAdminRequest(h, "POST", "/admin/markets/nope/result", "token-a", `{}`).Code)
+	assert.Equal(t, http.StatusBadRequest, doAdminRequest(h, "POST", path, "token-a", `{"homeGoals":1,"reason":"x"}`).Code)
+	assert.Equal(t, http.StatusBadRequest, doAdminRequest(h, "POST", path, "token-a", `{"homeGoals":1,"awayGoals":0}`).Code)
+}
+
+// TestSettleTask_MatchResultForPrefersManual tests that manual results bypass the data source
+func TestSettleTask_MatchResultForPrefersManual(t *testing.T) {
+	k := &Keeper{config: &Config{}, logger: zap.NewNop()}
+	task := NewSettleTask(k, nil)
+	market := &MarketToSettle{MarketAddress: common.HexToAddress("0x01"), EventID: "evt"}
+
+	task.manualResults[market.MarketAddress] = &MatchResult{HomeGoals: 2, AwayGoals: 1}
+
+	result, err := task.matchResultFor(context.Background(), market)
+	require.NoError(t, err)
+	assert.Equal(t, uint8(2), result.HomeGoals)
+	assert.Equal(t, uint8(1), result.AwayGoals)
+}
+
+// fakeOverrideStore is an in-memory result override store
+type fakeOverrideStore struct {
+	created  []*repository.ResultOverride
+	approved map[string]*repository.ResultOverride
+}
+
+func (s *fakeOverrideStore) CreateOverride(ctx context.Context, o *repository.ResultOverride) (int64, error) {
+	s.created = append(s.created, o)
+	return int64(len(s.created)), nil
+}
+
+func (s *fakeOverrideStore) GetApprovedOverride(ctx context.Context, marketAddress string) (*repository.ResultOverride, error) {
+	return s.approved[strings.ToLower(marketAddress)], nil
+}
+
+func (s *fakeOverrideStore) MarkOverrideApplied(ctx context.Context, id int64, actor, txHash string) error {
+	return nil
+}
+
+// TestAdminServer_ManualResultRequiresApproval tests that manual results become
+// pending overrides instead of settling when result overrides are enabled
+func TestAdminServer_ManualResultRequiresApproval(t *testing.T) {
+	server, _, auditPath := newTestAdminServer(t)
+	store := &fakeOverrideStore{}
+	server.keeper.overridesRepo = store
+	h := server.Handler()
+	market := "0x1234567890123456789012345678901234567890"
+	path := "/admin/markets/" + market + "/result"
+
+	rec := doAdminRequest(h, "POST", path, "token-a", `{"homeGoals":2,"awayGoals":1,"reason":"feed down"}`)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Empty(t, store.created)
+
+	rec = doAdminRequest(h, "POST", path, "token-a",
+		`{"homeGoals":2,"awayGoals":1,"reason":"feed down","evidenceUrl":"https://example.com/match"}`)
+	require.Equal(t, http.StatusAccepted, rec.Code)
+
+	var body map[string]interface{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	assert.Equal(t, float64(1), body["overrideId"])
+	assert.Equal(t, repository.OverrideStatusPending, body["status"])
+	assert.Equal(t, false, body["settled"])
+
+	require.Len(t, store.created, 1)
+	assert.Equal(t, uint8(2), store.created[0].HomeGoals)
+	assert.Equal(t, uint8(1), store.created[0].AwayGoals)
+	assert.Equal(t, "https://example.com/match", store.created[0].EvidenceURL)
+
+	// The direct settlement path is refused while overrides are enabled
+	err := server.settleTask.SettleWithManualResult(context.Background(), common.HexToAddress(market), &MatchResult{})
+	assert.Error(t, err)
+
+	entries := readAuditLog(t, auditPath)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "market.manual_result", entries[0].Action)
+	assert.True(t, entries[0].Success)
+	assert.Equal(t, float64(1), entries[0].Params["overrideId"])
+}
+
+// TestSettleTask_MatchResultForPrefersApprovedOverride tests that an approved
+// override wins over, and discards, a single-operator manual result
+func TestSettleTask_MatchResultForPrefersApprovedOverride(t *testing.T) {
+	market := &MarketToSettle{MarketAddress: common.HexToAddress("0x01"), EventID: "evt"}
+	store := &fakeOverrideStore{approved: map[string]*repository.ResultOverride{
+		strings.ToLower(market.MarketAddress.Hex()): {ID: 7, HomeGoals: 0, AwayGoals: 3},
+	}}
+	k := &Keeper{config: &Config{}, logger: zap.NewNop(), overridesRepo: store}
+	task := NewSettleTask(k, nil)
+
+	task.manualResults[market.MarketAddress] = &MatchResult{HomeGoals: 2, AwayGoals: 1}
+
+	result, err := task.matchResultFor(context.Background(), market)
+	require.NoError(t, err)
+	assert.Equal(t, uint8(0), result.HomeGoals)
+	assert.Equal(t, uint8(3), result.AwayGoals)
+	assert.True(t, result.AwayWin)
+	require.NotNil(t, result.Override)
+	assert.Equal(t, int64(7), result.Override.ID)
+	assert.NotContains(t, task.manualResults, market.MarketAddress)
+}
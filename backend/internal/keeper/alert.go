@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -41,6 +42,14 @@ const (
 	AlertTypeTransactionFailure AlertType = "transaction_failure"
 	// AlertTypeHighGasPrice when gas price exceeds configured maximum
 	AlertTypeHighGasPrice AlertType = "high_gas_price"
+	// AlertTypeManualOverride when a market is settled with a manual result override
+	AlertTypeManualOverride AlertType = "manual_override"
+	// AlertTypeReorg when a keeper transaction is dropped by a chain reorganization
+	AlertTypeReorg AlertType = "chain_reorg"
+	// AlertTypeParlayStuck when a parlay cannot settle because a leg market was cancelled
+	AlertTypeParlayStuck AlertType = "parlay_stuck"
+	// AlertTypeBudgetWarning when a reward budget pool cannot pay rewards in full
+	AlertTypeBudgetWarning AlertType = "budget_warning"
 )
 
 // Alert represents an alert event
@@ -224,3 +233,72 @@ func NewTaskExecutionFailureAlert(taskName string, err error, context map[string
 		Context:  context,
 	}
 }
+
+// NewManualOverrideAlert creates an alert for a market settled with a manual result override
+func NewManualOverrideAlert(marketAddr common.Address, txHash common.Hash, context map[string]interface{}) *Alert {
+	return &Alert{
+		Severity:      AlertSeverityWarning,
+		Type:          AlertTypeManualOverride,
+		Title:         "Market Settled With Manual Override",
+		Message:       "Market " + marketAddr.Hex() + " was settled using an operator-approved result override",
+		MarketAddress: &marketAddr,
+		TxHash:        &txHash,
+		Context:       context,
+	}
+}
+
+// NewReorgAlert creates an alert for a keeper transaction dropped by a reorg
+func NewReorgAlert(action string, marketAddr common.Address, txHash common.Hash, context map[string]interface{}) *Alert {
+	return &Alert{
+		Severity:      AlertSeverityCritical,
+		Type:          AlertTypeReorg,
+		Title:         "Transaction Dropped By Reorg",
+		Message:       "The " + action + " transaction for market " + marketAddr.Hex() + " was dropped by a chain reorganization and has been re-queued",
+		MarketAddress: &marketAddr,
+		TxHash:        &txHash,
+		Context:       context,
+	}
+}
+
+// NewParlayStuckAlert creates an alert for a parlay blocked by cancelled leg markets
+func NewParlayStuckAlert(parlayID string, cancelledLegs []common.Address, context map[string]interface{}) *Alert {
+	legs := make([]string, 0, len(cancelledLegs))
+	for _, leg := range cancelledLegs {
+		legs = append(legs, leg.Hex())
+	}
+	if context == nil {
+		context = make(map[string]interface{})
+	}
+	context["cancelled_legs"] = legs
+
+	alert := &Alert{
+		Severity: AlertSeverityWarning,
+		Type:     AlertTypeParlayStuck,
+		Title:    "Parlay Stuck On Cancelled Leg",
+		Message:  "Parlay " + parlayID + " cannot be settled because a leg market was cancelled",
+		Context:  context,
+	}
+	if len(cancelledLegs) > 0 {
+		alert.MarketAddress = &cancelledLegs[0]
+	}
+	return alert
+}
+
+// NewBudgetWarningAlert creates an alert for a reward pool scaled below the warning threshold
+func NewBudgetWarningAlert(week uint64, pool string, scaleBps, thresholdBps uint64, context map[string]interface{}) *Alert {
+	if context == nil {
+		context = make(map[string]interface{})
+	}
+	context["week"] = week
+	context["pool"] = pool
+	context["scale_bps"] = scaleBps
+	context["threshold_bps"] = thresholdBps
+
+	return &Alert{
+		Severity: AlertSeverityWarning,
+		Type:     AlertTypeBudgetWarning,
+		Title:    "Reward Budget Scaled Down",
+		Message:  fmt.Sprintf("Week %d %s rewards scaled to %.2f%% of requested amount", week, pool, float64(scaleBps)/100),
+		Context:  context,
+	}
+}
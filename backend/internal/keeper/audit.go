@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// AuditEntry records a single operator action taken through the admin API
+type AuditEntry struct {
+	Timestamp  time.Time              `json:"timestamp"`
+	Operator   string                 `json:"operator"`
+	Action     string                 `json:"action"`
+	Target     string                 `json:"target,omitempty"`
+	Params     map[string]interface{} `json:"params,omitempty"`
+	Success    bool                   `json:"success"`
+	Error      string                 `json:"error,omitempty"`
+	RemoteAddr string                 `json:"remoteAddr,omitempty"`
+}
+
+// AuditLog appends operator actions to a JSON-lines file and the service log
+type AuditLog struct {
+	filePath string
+	logger   *zap.Logger
+	mu       sync.Mutex
+}
+
+// NewAuditLog creates an audit log. An empty path logs to the service log only.
+func NewAuditLog(filePath string, logger *zap.Logger) *AuditLog {
+	return &AuditLog{
+		filePath: filePath,
+		logger:   logger,
+	}
+}
+
+// Record writes an audit entry
+func (a *AuditLog) Record(entry AuditEntry) error {
+	if entry.Timestamp.IsZero() {
+		entry.Timestamp = time.Now().UTC()
+	}
+
+	a.logger.Info("admin action",
+		zap.String("operator", entry.Operator),
+		zap.String("action", entry.Action),
+		zap.String("target", entry.Target),
+		zap.Any("params", entry.Params),
+		zap.Bool("success", entry.Success),
+		zap.String("error", entry.Error),
+		zap.String("remoteAddr", entry.RemoteAddr),
+	)
+
+	if a.filePath == "" {
+		return nil
+	}
+
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("failed to marshal audit entry: %w", err)
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	file, err := os.OpenFile(a.filePath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to open audit log: %w", err)
+	}
+	defer file.Close()
+
+	if _, err := file.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("failed to write audit log: %w", err)
+	}
+
+	return nil
+}
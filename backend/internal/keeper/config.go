@@ -3,6 +3,10 @@ package keeper
 import (
 	"errors"
 	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/pitchone/sportsbook/internal/rewards"
 )
 
 // Config holds Keeper service configuration
@@ -24,6 +28,11 @@ type Config struct {
 	RetryAttempts int `mapstructure:"retry_attempts"`  // Max retry attempts
 	RetryDelay    int `mapstructure:"retry_delay"`     // Seconds between retries
 
+	// Reorg protection: receipts are re-checked once this many blocks deep
+	// (0 = network default, 1 = first receipt is final)
+	ConfirmationDepth   int `mapstructure:"confirmation_depth"`
+	ConfirmationTimeout int `mapstructure:"confirmation_timeout"` // Seconds to wait for confirmations
+
 	// Subgraph (替代数据库查询)
 	SubgraphEndpoint string `mapstructure:"subgraph_endpoint"`
 
@@ -43,11 +52,23 @@ type Config struct {
 	// Webhook alerts (optional)
 	WebhookURL string `mapstructure:"webhook_url"`
 
+	// Consult approved manual result overrides (requires DatabaseURL)
+	ResultOverrides bool `mapstructure:"result_overrides"`
+
 	// API-Football configuration for fixtures fetching
 	APIFootball APIFootballConfig `mapstructure:"api_football"`
 
 	// Rewards distribution configuration
 	Rewards RewardsConfig `mapstructure:"rewards"`
+
+	// Operator admin API configuration
+	Admin AdminConfig `mapstructure:"admin"`
+
+	// Parlay (Basket) settlement configuration
+	Parlay ParlayConfig `mapstructure:"parlay"`
+
+	// Automatic CorrelationGuard rules for new markets
+	Correlation CorrelationConfig `mapstructure:"correlation"`
 }
 
 // APIFootballConfig holds configuration for API-Football integration
@@ -91,6 +112,123 @@ type RewardsConfig struct {
 
 	// Private key for signing transactions (can reuse keeper's key)
 	PrivateKey string `mapstructure:"private_key"`
+
+	// PayoutScaler contract address; when set, rewards are scaled to the
+	// available budget of each pool (the signer needs OPERATOR_ROLE)
+	PayoutScalerAddress string `mapstructure:"payout_scaler_address"`
+
+	// Alert when any pool scales rewards below this many bps (default 8000)
+	BudgetWarningBps uint64 `mapstructure:"budget_warning_bps"`
+
+	// Reward type -> budget pool (promo/campaign/quest/insurance);
+	// unset types use rewards.DefaultCategoryPools
+	CategoryPools map[string]string `mapstructure:"category_pools"`
+
+	// Trading reward policy file (YAML/JSON); empty uses rewards.DefaultTradingPolicy
+	TradingPolicyFile string `mapstructure:"trading_policy_file"`
+
+	// Campaign contract address; when set, campaign rewards are capped by the
+	// on-chain remaining budget and spending is recorded after publication
+	// (the signer needs OPERATOR_ROLE)
+	CampaignAddress string `mapstructure:"campaign_address"`
+
+	// Campaign participation reward rules file (YAML/JSON); empty disables campaign rewards
+	CampaignRulesFile string `mapstructure:"campaign_rules_file"`
+
+	// When set, publishRoot is exported as an unsigned Safe Transaction Builder
+	// batch into this directory instead of being sent (treasury-controlled publishers).
+	// With a PayoutScaler the budget is still reserved before export, so PrivateKey is required.
+	SafeExportDir string `mapstructure:"safe_export_dir"`
+
+	// Safe that will execute exported publishRoot transactions (optional, informational)
+	SafeAddress string `mapstructure:"safe_address"`
+
+	// Reward epoch calendar segments (start, length, timezone); empty uses
+	// rewards.DefaultEpochPeriods (7-day epochs from 2024-01-01 UTC)
+	Epochs []rewards.EpochPeriod `mapstructure:"epochs"`
+}
+
+// BudgetPools returns the reward type to budget pool mapping with overrides applied
+func (c RewardsConfig) BudgetPools() (map[rewards.RewardType]rewards.BudgetPool, error) {
+	pools := rewards.DefaultCategoryPools()
+	for category, name := range c.CategoryPools {
+		pool, err := rewards.ParseBudgetPool(name)
+		if err != nil {
+			return nil, err
+		}
+		pools[rewards.RewardType(category)] = pool
+	}
+	return pools, nil
+}
+
+// AdminConfig holds configuration for the operator admin HTTP API
+type AdminConfig struct {
+	// Enable/disable the admin API
+	Enabled bool `mapstructure:"enabled"`
+
+	// Listen address (default 127.0.0.1) and port (default 8082)
+	BindAddress string `mapstructure:"bind_address"`
+	Port        int    `mapstructure:"port"`
+
+	// Bearer tokens keyed by operator name; the name is recorded in the audit log
+	Tokens map[string]string `mapstructure:"tokens"`
+
+	// JSON-lines audit log file (empty = service log only)
+	AuditLogPath string `mapstructure:"audit_log_path"`
+}
+
+// ParlayConfig holds configuration for the parlay (Basket) settlement task
+type ParlayConfig struct {
+	// Enable/disable parlay settlement
+	Enabled bool `mapstructure:"enabled"`
+
+	// Basket contract address
+	BasketAddress string `mapstructure:"basket_address"`
+
+	// Seconds between task runs (default: keeper task_interval)
+	TaskInterval int `mapstructure:"task_interval"`
+
+	// Gas budget for a single batchSettle transaction (default 3,000,000).
+	// Each parlay is budgeted GasPerParlay plus GasPerLeg for every leg.
+	MaxBatchGas  uint64 `mapstructure:"max_batch_gas"`
+	GasPerParlay uint64 `mapstructure:"gas_per_parlay"` // Default 80,000
+	GasPerLeg    uint64 `mapstructure:"gas_per_leg"`    // Default 25,000
+}
+
+// CorrelationConfig holds configuration for the CorrelationGuard rule sync task
+type CorrelationConfig struct {
+	// Enable/disable automatic correlation rules
+	Enabled bool `mapstructure:"enabled"`
+
+	// CorrelationGuard contract address (keeper account needs RULE_MANAGER_ROLE)
+	GuardAddress string `mapstructure:"guard_address"`
+
+	// Seconds between task runs (default: keeper task_interval)
+	TaskInterval int `mapstructure:"task_interval"`
+
+	// Maximum rules per batchSetRules transaction (default 50)
+	MaxRulesPerTx int `mapstructure:"max_rules_per_tx"`
+
+	// Seconds after which cached on-chain rules are re-read, so rules changed
+	// outside the keeper are restored (default 3600)
+	RefreshInterval int `mapstructure:"refresh_interval"`
+
+	// Policy table; the first entry whose match_prefix matches a MatchID applies
+	Policies []CorrelationPolicy `mapstructure:"policies"`
+}
+
+// CorrelationPolicy is one row of the correlation policy table
+type CorrelationPolicy struct {
+	// MatchID prefix, e.g. "EPL_" (empty matches every match)
+	MatchPrefix string `mapstructure:"match_prefix"`
+
+	// Rule between legs from the same match
+	SameMatchPenaltyBps uint64 `mapstructure:"same_match_penalty_bps"`
+	SameMatchBlocked    bool   `mapstructure:"same_match_blocked"`
+
+	// Rule between open matches of this policy that share a team
+	SharedTeamPenaltyBps uint64 `mapstructure:"shared_team_penalty_bps"`
+	SharedTeamBlocked    bool   `mapstructure:"shared_team_blocked"`
 }
 
 // Validate validates the configuration
@@ -139,6 +277,14 @@ func (c *Config) Validate() error {
 		c.RetryDelay = 5 // Default 5 seconds
 	}
 
+	if c.ConfirmationDepth == 0 {
+		c.ConfirmationDepth = defaultConfirmationDepth(c.ChainID)
+	}
+
+	if c.ConfirmationTimeout == 0 {
+		c.ConfirmationTimeout = 900 // Default 15 minutes
+	}
+
 	// SubgraphEndpoint 是必需的（替代旧的 DatabaseURL）
 	if c.SubgraphEndpoint == "" {
 		// 向后兼容：如果没有设置 SubgraphEndpoint，尝试使用默认值
@@ -181,6 +327,78 @@ func (c *Config) Validate() error {
 	if c.Rewards.PrivateKey == "" {
 		c.Rewards.PrivateKey = c.PrivateKey
 	}
+	if c.Rewards.BudgetWarningBps == 0 {
+		c.Rewards.BudgetWarningBps = 8000
+	}
+	if c.Rewards.PayoutScalerAddress != "" && !common.IsHexAddress(c.Rewards.PayoutScalerAddress) {
+		return errors.New("rewards.payout_scaler_address must be a valid address")
+	}
+	if c.Rewards.CampaignAddress != "" && !common.IsHexAddress(c.Rewards.CampaignAddress) {
+		return errors.New("rewards.campaign_address must be a valid address")
+	}
+	if c.Rewards.SafeAddress != "" && !common.IsHexAddress(c.Rewards.SafeAddress) {
+		return errors.New("rewards.safe_address must be a valid address")
+	}
+	if _, err := rewards.NewEpochCalendar(c.Rewards.Epochs); err != nil {
+		return fmt.Errorf("rewards.epochs: %w", err)
+	}
+	if _, err := c.Rewards.BudgetPools(); err != nil {
+		return fmt.Errorf("rewards.category_pools: %w", err)
+	}
+
+	// Admin API defaults
+	if c.Admin.BindAddress == "" {
+		c.Admin.BindAddress = "127.0.0.1"
+	}
+	if c.Admin.Port == 0 {
+		c.Admin.Port = 8082
+	}
+	if c.Admin.Enabled {
+		if len(c.Admin.Tokens) == 0 {
+			return errors.New("admin.tokens is required when the admin API is enabled")
+		}
+		for operator, token := range c.Admin.Tokens {
+			if token == "" {
+				return fmt.Errorf("admin token for operator %q is empty", operator)
+			}
+		}
+	}
+
+	// Parlay defaults
+	if c.Parlay.TaskInterval == 0 {
+		c.Parlay.TaskInterval = c.TaskInterval
+	}
+	if c.Parlay.MaxBatchGas == 0 {
+		c.Parlay.MaxBatchGas = 3000000
+	}
+	if c.Parlay.GasPerParlay == 0 {
+		c.Parlay.GasPerParlay = 80000
+	}
+	if c.Parlay.GasPerLeg == 0 {
+		c.Parlay.GasPerLeg = 25000
+	}
+	if c.Parlay.Enabled && !common.IsHexAddress(c.Parlay.BasketAddress) {
+		return errors.New("parlay.basket_address must be a valid address when parlay settlement is enabled")
+	}
+
+	// Correlation defaults
+	if c.Correlation.TaskInterval == 0 {
+		c.Correlation.TaskInterval = c.TaskInterval
+	}
+	if c.Correlation.MaxRulesPerTx == 0 {
+		c.Correlation.MaxRulesPerTx = 50
+	}
+	if c.Correlation.RefreshInterval == 0 {
+		c.Correlation.RefreshInterval = 3600
+	}
+	if c.Correlation.Enabled {
+		if !common.IsHexAddress(c.Correlation.GuardAddress) {
+			return errors.New("correlation.guard_address must be a valid address when correlation rules are enabled")
+		}
+		if err := ValidateCorrelationPolicies(c.Correlation.Policies); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
@@ -228,3 +446,16 @@ func maskDatabaseURL(url string) string {
 
 	return masked
 }
+
+// ValidateCorrelationPolicies checks a correlation policy table
+func ValidateCorrelationPolicies(policies []CorrelationPolicy) error {
+	if len(policies) == 0 {
+		return errors.New("correlation.policies is required when correlation rules are enabled")
+	}
+	for i, p := range policies {
+		if p.SameMatchPenaltyBps > 10000 || p.SharedTeamPenaltyBps > 10000 {
+			return fmt.Errorf("correlation.policies[%d]: penalty must not exceed 10000 bps", i)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,165 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"go.uber.org/zap"
+)
+
+// ErrTransactionReorged is returned when a mined transaction disappears from
+// the canonical chain before reaching the configured confirmation depth
+var ErrTransactionReorged = errors.New("transaction dropped by chain reorganization")
+
+// confirmationPollInterval is how often receipts are re-checked while waiting for confirmations
+var confirmationPollInterval = 2 * time.Second
+
+// defaultConfirmationDepths holds per-network confirmation depths, keyed by chain ID
+var defaultConfirmationDepths = map[int64]int{
+	1:        12, // Ethereum mainnet
+	11155111: 6,  // Sepolia
+	10:       20, // Optimism
+	8453:     20, // Base
+	84532:    10, // Base Sepolia
+	42161:    20, // Arbitrum One
+	421614:   10, // Arbitrum Sepolia
+	137:      64, // Polygon PoS
+	31337:    1,  // Anvil / Hardhat
+}
+
+// defaultConfirmationDepth returns the confirmation depth for a chain (3 for unknown chains)
+func defaultConfirmationDepth(chainID int64) int {
+	if depth, ok := defaultConfirmationDepths[chainID]; ok {
+		return depth
+	}
+	return 3
+}
+
+// receiptSource is the subset of RPC calls needed to re-check receipts
+type receiptSource interface {
+	BlockNumber(ctx context.Context) (uint64, error)
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+}
+
+// confirmReceipt waits until the receipt's transaction is buried under `depth`
+// blocks (counting its own block) and re-fetches the receipt on every poll to
+// make sure it is still canonical. If the transaction moved to another block,
+// onMoved is called and the count restarts from the new block. A missing
+// receipt is tolerated as a lagging node until the node has returned it once,
+// the head reaches the confirmation block, or the context expires; any of
+// those turns it into ErrTransactionReorged.
+func confirmReceipt(
+	ctx context.Context,
+	src receiptSource,
+	receipt *types.Receipt,
+	depth uint64,
+	interval time.Duration,
+	onMoved func(old, new *types.Receipt),
+) (*types.Receipt, error) {
+	if depth <= 1 {
+		return receipt, nil
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	seen := false
+	missing := false
+	for {
+		select {
+		case <-ctx.Done():
+			// The receipt was already returned when the tx was mined, so a
+			// receipt still missing at the deadline means it left the chain
+			if missing && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return nil, fmt.Errorf("%w: receipt still missing after %v", ErrTransactionReorged, ctx.Err())
+			}
+			return nil, fmt.Errorf("timed out waiting for %d confirmations: %w", depth, ctx.Err())
+		case <-ticker.C:
+			head, err := src.BlockNumber(ctx)
+			if err != nil {
+				continue
+			}
+
+			current, err := src.TransactionReceipt(ctx, receipt.TxHash)
+			if errors.Is(err, ethereum.NotFound) {
+				missing = true
+				if seen || head >= receipt.BlockNumber.Uint64()+depth-1 {
+					return nil, ErrTransactionReorged
+				}
+				continue
+			}
+			if err != nil {
+				continue
+			}
+			seen = true
+			missing = false
+
+			if current.BlockHash != receipt.BlockHash {
+				if onMoved != nil {
+					onMoved(receipt, current)
+				}
+				receipt = current
+			}
+
+			if head >= receipt.BlockNumber.Uint64()+depth-1 {
+				return receipt, nil
+			}
+		}
+	}
+}
+
+// waitForConfirmations re-checks a mined receipt after the configured
+// confirmation depth, recording metrics and alerting on detected reorgs
+func (k *Keeper) waitForConfirmations(ctx context.Context, action string, market common.Address, receipt *types.Receipt) (*types.Receipt, error) {
+	depth := k.config.ConfirmationDepth
+	if depth <= 1 {
+		return receipt, nil
+	}
+
+	confirmCtx, cancel := context.WithTimeout(ctx, time.Duration(k.config.ConfirmationTimeout)*time.Second)
+	defer cancel()
+
+	onMoved := func(old, new *types.Receipt) {
+		reorgsDetected.WithLabelValues(action, "reincluded").Inc()
+		k.logger.Warn("transaction re-included in a different block after reorg",
+			zap.String("action", action),
+			zap.String("market", market.Hex()),
+			zap.String("txHash", old.TxHash.Hex()),
+			zap.Uint64("oldBlock", old.BlockNumber.Uint64()),
+			zap.Uint64("newBlock", new.BlockNumber.Uint64()),
+		)
+	}
+
+	confirmed, err := confirmReceipt(confirmCtx, k.web3Client.client, receipt, uint64(depth), confirmationPollInterval, onMoved)
+	if errors.Is(err, ErrTransactionReorged) {
+		reorgsDetected.WithLabelValues(action, "dropped").Inc()
+		k.logger.Error("transaction dropped by reorg",
+			zap.String("action", action),
+			zap.String("market", market.Hex()),
+			zap.String("txHash", receipt.TxHash.Hex()),
+			zap.Uint64("minedBlock", receipt.BlockNumber.Uint64()),
+			zap.Int("confirmationDepth", depth),
+		)
+		k.sendAlert(ctx, NewReorgAlert(action, market, receipt.TxHash, map[string]interface{}{
+			"mined_block":        receipt.BlockNumber.Uint64(),
+			"mined_block_hash":   receipt.BlockHash.Hex(),
+			"confirmation_depth": depth,
+		}))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	k.logger.Debug("transaction reached confirmation depth",
+		zap.String("action", action),
+		zap.String("txHash", confirmed.TxHash.Hex()),
+		zap.Int("confirmationDepth", depth),
+	)
+
+	return confirmed, nil
+}
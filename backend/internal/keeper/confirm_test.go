@@ -0,0 +1,179 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeChain is a receiptSource whose head advances one block per BlockNumber call
+type fakeChain struct {
+	mu       sync.Mutex
+	head     uint64
+	receipts map[common.Hash]*types.Receipt
+	// onHead is called with the new head before it is returned
+	onHead func(c *fakeChain, head uint64)
+}
+
+func (c *fakeChain) BlockNumber(ctx context.Context) (uint64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.head++
+	if c.onHead != nil {
+		c.onHead(c, c.head)
+	}
+	return c.head, nil
+}
+
+func (c *fakeChain) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	r, ok := c.receipts[txHash]
+	if !ok {
+		return nil, ethereum.NotFound
+	}
+	return r, nil
+}
+
+func newTestReceipt(txHash common.Hash, block uint64, blockHash string) *types.Receipt {
+	return &types.Receipt{
+		TxHash:      txHash,
+		BlockNumber: new(big.Int).SetUint64(block),
+		BlockHash:   common.HexToHash(blockHash),
+		Status:      types.ReceiptStatusSuccessful,
+	}
+}
+
+// TestConfirmReceipt tests receipt re-checking after the confirmation depth
+func TestConfirmReceipt(t *testing.T) {
+	txHash := common.HexToHash("0xaa")
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	t.Run("depth of one returns the first receipt", func(t *testing.T) {
+		receipt := newTestReceipt(txHash, 10, "0x01")
+		got, err := confirmReceipt(ctx, &fakeChain{}, receipt, 1, time.Millisecond, nil)
+		require.NoError(t, err)
+		assert.Same(t, receipt, got)
+	})
+
+	t.Run("confirms once buried", func(t *testing.T) {
+		receipt := newTestReceipt(txHash, 10, "0x01")
+		chain := &fakeChain{head: 10, receipts: map[common.Hash]*types.Receipt{txHash: receipt}}
+
+		got, err := confirmReceipt(ctx, chain, receipt, 5, time.Millisecond, nil)
+		require.NoError(t, err)
+		assert.Equal(t, receipt.BlockHash, got.BlockHash)
+		assert.GreaterOrEqual(t, chain.head, uint64(14))
+	})
+
+	t.Run("dropped transaction is reported as reorged", func(t *testing.T) {
+		receipt := newTestReceipt(txHash, 10, "0x01")
+		chain := &fakeChain{
+			head:     10,
+			receipts: map[common.Hash]*types.Receipt{txHash: receipt},
+			onHead: func(c *fakeChain, head uint64) {
+				if head == 12 {
+					delete(c.receipts, txHash)
+				}
+			},
+		}
+
+		_, err := confirmReceipt(ctx, chain, receipt, 3, time.Millisecond, nil)
+		assert.True(t, errors.Is(err, ErrTransactionReorged))
+	})
+
+	t.Run("receipt not yet indexed is not a reorg", func(t *testing.T) {
+		receipt := newTestReceipt(txHash, 10, "0x01")
+		chain := &fakeChain{
+			head:     10,
+			receipts: map[common.Hash]*types.Receipt{},
+			onHead: func(c *fakeChain, head uint64) {
+				// a lagging RPC node only returns the receipt two blocks later
+				if head == 12 {
+					c.receipts[txHash] = receipt
+				}
+			},
+		}
+
+		got, err := confirmReceipt(ctx, chain, receipt, 3, time.Millisecond, nil)
+		require.NoError(t, err)
+		assert.Equal(t, receipt.BlockHash, got.BlockHash)
+	})
+
+	t.Run("dropped before the first poll is reported as reorged", func(t *testing.T) {
+		receipt := newTestReceipt(txHash, 10, "0x01")
+		chain := &fakeChain{head: 10, receipts: map[common.Hash]*types.Receipt{}}
+
+		_, err := confirmReceipt(ctx, chain, receipt, 3, time.Millisecond, nil)
+		assert.ErrorIs(t, err, ErrTransactionReorged)
+	})
+
+	t.Run("receipt missing at the deadline is reported as reorged", func(t *testing.T) {
+		receipt := newTestReceipt(txHash, 10, "0x01")
+		chain := &fakeChain{head: 0, receipts: map[common.Hash]*types.Receipt{}}
+		chain.onHead = func(c *fakeChain, head uint64) { c.head = 10 }
+
+		shortCtx, shortCancel := context.WithTimeout(ctx, 20*time.Millisecond)
+		defer shortCancel()
+
+		_, err := confirmReceipt(shortCtx, chain, receipt, 3, time.Millisecond, nil)
+		assert.ErrorIs(t, err, ErrTransactionReorged)
+	})
+
+	t.Run("re-included transaction restarts the count", func(t *testing.T) {
+		receipt := newTestReceipt(txHash, 10, "0x01")
+		moved := newTestReceipt(txHash, 11, "0x02")
+		chain := &fakeChain{
+			head:     10,
+			receipts: map[common.Hash]*types.Receipt{txHash: receipt},
+			onHead: func(c *fakeChain, head uint64) {
+				if head == 11 {
+					c.receipts[txHash] = moved
+				}
+			},
+		}
+
+		var movedCalls int
+		got, err := confirmReceipt(ctx, chain, receipt, 3, time.Millisecond, func(old, new *types.Receipt) {
+			movedCalls++
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 1, movedCalls)
+		assert.Equal(t, moved.BlockHash, got.BlockHash)
+		assert.GreaterOrEqual(t, chain.head, uint64(13))
+	})
+
+	t.Run("times out when the chain does not advance", func(t *testing.T) {
+		receipt := newTestReceipt(txHash, 10, "0x01")
+		chain := &fakeChain{head: 0, receipts: map[common.Hash]*types.Receipt{txHash: receipt}}
+		chain.onHead = func(c *fakeChain, head uint64) { c.head = 1 }
+
+		shortCtx, shortCancel := context.WithTimeout(ctx, 20*time.Millisecond)
+		defer shortCancel()
+
+		_, err := confirmReceipt(shortCtx, chain, receipt, 3, time.Millisecond, nil)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
+
+// TestDefaultConfirmationDepth tests per-network confirmation depth defaults
+func TestDefaultConfirmationDepth(t *testing.T) {
+	assert.Equal(t, 12, defaultConfirmationDepth(1))
+	assert.Equal(t, 1, defaultConfirmationDepth(31337))
+	assert.Equal(t, 3, defaultConfirmationDepth(999999))
+
+	cfg := &Config{ChainID: 8453, RPCEndpoint: "http://localhost:8545", PrivateKey: "0x01"}
+	require.NoError(t, cfg.Validate())
+	assert.Equal(t, 20, cfg.ConfirmationDepth)
+	assert.Equal(t, 900, cfg.ConfirmationTimeout)
+}
@@ -0,0 +1,407 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pitchone/sportsbook/internal/graphql"
+	"github.com/pitchone/sportsbook/pkg/bindings"
+	"go.uber.org/zap"
+)
+
+// correlationPageSize is the number of open markets fetched per Subgraph query
+const correlationPageSize = 500
+
+// CorrelationTask keeps CorrelationGuard rules in sync with the policy table as
+// new markets are created or the policies change. Only rules that differ from
+// the on-chain state are sent.
+type CorrelationTask struct {
+	keeper       *Keeper
+	config       CorrelationConfig
+	guardAddress common.Address
+	guard        *bindings.CorrelationGuard
+
+	mu sync.Mutex
+	// Markets whose rules have been pushed; a run with no new markets, no policy
+	// change and a fresh cache is a no-op
+	seen map[string]struct{}
+	// Set by SetPolicies until the new policies have been pushed
+	policiesChanged bool
+	// Last known on-chain value of each rule, re-read every RefreshInterval
+	applied     map[ruleKey]ruleValue
+	refreshedAt time.Time
+	now         func() time.Time
+}
+
+// ruleKey identifies a CorrelationGuard rule by its ordered pair of match IDs
+type ruleKey struct {
+	MatchA [32]byte
+	MatchB [32]byte
+}
+
+// ruleValue is the penalty and block flag of a CorrelationGuard rule
+type ruleValue struct {
+	PenaltyBps uint64
+	Blocked    bool
+}
+
+// correlationRule is a rule to be sent via batchSetRules
+type correlationRule struct {
+	Key   ruleKey
+	Value ruleValue
+}
+
+// NewCorrelationTask creates a new CorrelationTask instance
+func NewCorrelationTask(keeper *Keeper, config CorrelationConfig) (*CorrelationTask, error) {
+	guardAddress := common.HexToAddress(config.GuardAddress)
+
+	guard, err := bindings.NewCorrelationGuard(guardAddress, keeper.web3Client.client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create CorrelationGuard contract instance: %w", err)
+	}
+
+	return &CorrelationTask{
+		keeper:       keeper,
+		config:       config,
+		guardAddress: guardAddress,
+		guard:        guard,
+		seen:         make(map[string]struct{}),
+		applied:      make(map[ruleKey]ruleValue),
+		now:          time.Now,
+	}, nil
+}
+
+// SetPolicies replaces the policy table; the next run recomputes every rule
+func (t *CorrelationTask) SetPolicies(policies []CorrelationPolicy) error {
+	if err := ValidateCorrelationPolicies(policies); err != nil {
+		return err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.config.Policies = policies
+	t.policiesChanged = true
+	return nil
+}
+
+// Execute runs the correlation rule sync task
+func (t *CorrelationTask) Execute(ctx context.Context) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	markets, err := t.getOpenMarkets(ctx)
+	if err != nil {
+		t.keeper.logger.Error("failed to get open markets", zap.Error(err))
+		return fmt.Errorf("failed to get open markets: %w", err)
+	}
+
+	newMarkets := 0
+	for _, m := range markets {
+		if _, ok := t.seen[m.ID]; !ok {
+			newMarkets++
+		}
+	}
+
+	// Drop the cache periodically so rules edited on-chain outside the keeper are restored
+	stale := t.cacheExpired()
+	if stale {
+		t.applied = make(map[ruleKey]ruleValue)
+	}
+
+	if newMarkets == 0 && !t.policiesChanged && !stale {
+		t.keeper.logger.Debug("no new markets or policy changes for correlation rules")
+		return nil
+	}
+
+	desired := buildCorrelationRules(markets, t.config.Policies)
+
+	// Fill the cache from chain for rules we have not seen yet
+	for key := range desired {
+		if _, ok := t.applied[key]; ok {
+			continue
+		}
+		current, err := t.guard.GetCorrelationRule(&bind.CallOpts{Context: ctx}, key.MatchA, key.MatchB)
+		if err != nil {
+			return fmt.Errorf("failed to read correlation rule: %w", err)
+		}
+		t.applied[key] = ruleValue{PenaltyBps: current.PenaltyBps.Uint64(), Blocked: current.IsBlocked}
+	}
+
+	changes := diffCorrelationRules(desired, t.applied)
+
+	t.keeper.logger.Info("correlation rules computed",
+		zap.Int("openMarkets", len(markets)),
+		zap.Int("newMarkets", newMarkets),
+		zap.Bool("policiesChanged", t.policiesChanged),
+		zap.Bool("cacheRefreshed", stale),
+		zap.Int("rules", len(desired)),
+		zap.Int("changes", len(changes)),
+	)
+
+	for start := 0; start < len(changes); start += t.config.MaxRulesPerTx {
+		end := start + t.config.MaxRulesPerTx
+		if end > len(changes) {
+			end = len(changes)
+		}
+		if err := t.sendRules(ctx, changes[start:end]); err != nil {
+			return fmt.Errorf("failed to push correlation rules: %w", err)
+		}
+		for _, rule := range changes[start:end] {
+			t.applied[rule.Key] = rule.Value
+		}
+	}
+
+	for _, m := range markets {
+		t.seen[m.ID] = struct{}{}
+	}
+	t.policiesChanged = false
+	if stale {
+		t.refreshedAt = t.now()
+	}
+
+	return nil
+}
+
+// cacheExpired reports whether the applied rule cache is older than RefreshInterval
+func (t *CorrelationTask) cacheExpired() bool {
+	if t.config.RefreshInterval <= 0 {
+		return false
+	}
+	return t.now().Sub(t.refreshedAt) >= time.Duration(t.config.RefreshInterval)*time.Second
+}
+
+// getOpenMarkets pages through open markets in the Subgraph
+func (t *CorrelationTask) getOpenMarkets(ctx context.Context) ([]graphql.Market, error) {
+	var markets []graphql.Market
+	for skip := 0; ; skip += correlationPageSize {
+		page, err := t.keeper.graphClient.GetOpenMarkets(ctx, correlationPageSize, skip)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query Subgraph: %w", err)
+		}
+		markets = append(markets, page...)
+		if len(page) < correlationPageSize {
+			return markets, nil
+		}
+	}
+}
+
+// guardMatchID returns the CorrelationGuard match ID for a MatchID string,
+// matching keccak256(abi.encodePacked(market.matchId())) on-chain
+func guardMatchID(matchID string) [32]byte {
+	return crypto.Keccak256Hash([]byte(matchID))
+}
+
+// policyFor returns the index of the first policy matching a MatchID, or -1
+func policyFor(matchID string, policies []CorrelationPolicy) int {
+	for i, p := range policies {
+		if strings.HasPrefix(matchID, p.MatchPrefix) {
+			return i
+		}
+	}
+	return -1
+}
+
+// newRuleKey orders a pair of match IDs the way CorrelationGuard stores them
+func newRuleKey(a, b [32]byte) ruleKey {
+	if bytes.Compare(a[:], b[:]) > 0 {
+		a, b = b, a
+	}
+	return ruleKey{MatchA: a, MatchB: b}
+}
+
+// buildCorrelationRules groups markets by MatchID and derives the rules the
+// policy table asks for: a same-match rule for every match, and a shared-team
+// rule between matches of the same policy that have a team in common.
+func buildCorrelationRules(markets []graphql.Market, policies []CorrelationPolicy) map[ruleKey]ruleValue {
+	// MatchID -> policy index, team names
+	type matchInfo struct {
+		policy int
+		teams  map[string]struct{}
+	}
+	matches := make(map[string]*matchInfo)
+	for _, m := range markets {
+		if m.MatchID == "" {
+			continue
+		}
+		info, ok := matches[m.MatchID]
+		if !ok {
+			policy := policyFor(m.MatchID, policies)
+			if policy < 0 {
+				continue
+			}
+			info = &matchInfo{policy: policy, teams: make(map[string]struct{})}
+			matches[m.MatchID] = info
+		}
+		for _, team := range []string{m.HomeTeam, m.AwayTeam} {
+			if team = strings.ToLower(strings.TrimSpace(team)); team != "" {
+				info.teams[team] = struct{}{}
+			}
+		}
+	}
+
+	rules := make(map[ruleKey]ruleValue)
+
+	// (policy, team) -> matches
+	byTeam := make(map[string][]string)
+	for matchID, info := range matches {
+		p := policies[info.policy]
+		id := guardMatchID(matchID)
+		rules[newRuleKey(id, id)] = ruleValue{PenaltyBps: p.SameMatchPenaltyBps, Blocked: p.SameMatchBlocked}
+
+		if p.SharedTeamPenaltyBps == 0 && !p.SharedTeamBlocked {
+			continue
+		}
+		for team := range info.teams {
+			key := fmt.Sprintf("%d/%s", info.policy, team)
+			byTeam[key] = append(byTeam[key], matchID)
+		}
+	}
+
+	for _, matchIDs := range byTeam {
+		p := policies[matches[matchIDs[0]].policy]
+		for i := 0; i < len(matchIDs); i++ {
+			for j := i + 1; j < len(matchIDs); j++ {
+				key := newRuleKey(guardMatchID(matchIDs[i]), guardMatchID(matchIDs[j]))
+				rules[key] = ruleValue{PenaltyBps: p.SharedTeamPenaltyBps, Blocked: p.SharedTeamBlocked}
+			}
+		}
+	}
+
+	return rules
+}
+
+// diffCorrelationRules returns the desired rules whose value differs from the
+// applied state, in a deterministic order
+func diffCorrelationRules(desired, applied map[ruleKey]ruleValue) []correlationRule {
+	var changes []correlationRule
+	for key, value := range desired {
+		if current, ok := applied[key]; ok && current == value {
+			continue
+		}
+		changes = append(changes, correlationRule{Key: key, Value: value})
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		a, b := changes[i].Key, changes[j].Key
+		if c := bytes.Compare(a.MatchA[:], b.MatchA[:]); c != 0 {
+			return c < 0
+		}
+		return bytes.Compare(a.MatchB[:], b.MatchB[:]) < 0
+	})
+
+	return changes
+}
+
+// sendRules sends a single batchSetRules transaction
+func (t *CorrelationTask) sendRules(ctx context.Context, rules []correlationRule) error {
+	payload := make([]bindings.ICorrelationGuardCorrelationRule, 0, len(rules))
+	for _, rule := range rules {
+		payload = append(payload, bindings.ICorrelationGuardCorrelationRule{
+			MatchId1:   rule.Key.MatchA,
+			MatchId2:   rule.Key.MatchB,
+			PenaltyBps: new(big.Int).SetUint64(rule.Value.PenaltyBps),
+			IsBlocked:  rule.Value.Blocked,
+		})
+	}
+
+	// Get current gas price
+	gasPrice, err := t.keeper.web3Client.CalculateGasPrice(ctx, t.keeper.maxGasPrice)
+	if err != nil {
+		return fmt.Errorf("failed to calculate gas price: %w", err)
+	}
+
+	// Get nonce
+	nonce, err := t.keeper.web3Client.GetNonce(ctx, t.keeper.web3Client.account)
+	if err != nil {
+		return fmt.Errorf("failed to get nonce: %w", err)
+	}
+
+	// Gas limit is estimated, since it scales with the number of rules
+	auth := &bind.TransactOpts{
+		From:     t.keeper.web3Client.account,
+		Nonce:    big.NewInt(int64(nonce)),
+		Signer:   t.createSigner(),
+		Value:    big.NewInt(0),
+		GasPrice: gasPrice,
+		Context:  ctx,
+	}
+
+	tx, err := t.guard.BatchSetRules(auth, payload)
+	if err != nil {
+		return fmt.Errorf("failed to send batchSetRules transaction: %w", err)
+	}
+
+	t.keeper.logger.Info("batchSetRules transaction sent",
+		zap.String("guard", t.guardAddress.Hex()),
+		zap.String("txHash", tx.Hash().Hex()),
+		zap.Int("rules", len(payload)),
+		zap.Uint64("nonce", nonce),
+		zap.String("gasPrice", gasPrice.String()),
+	)
+
+	// Wait for transaction to be mined (with timeout)
+	receiptCtx, cancel := context.WithTimeout(ctx, 2*time.Minute)
+	defer cancel()
+
+	receipt, err := t.waitForTransaction(receiptCtx, tx.Hash())
+	if err != nil {
+		return fmt.Errorf("failed to wait for transaction: %w", err)
+	}
+
+	// Check transaction status
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return fmt.Errorf("batchSetRules transaction failed: status %d", receipt.Status)
+	}
+
+	// Re-check the receipt once it is buried under the configured confirmation depth
+	receipt, err = t.keeper.waitForConfirmations(ctx, "correlation_rules", t.guardAddress, receipt)
+	if err != nil {
+		return fmt.Errorf("failed to confirm batchSetRules transaction: %w", err)
+	}
+
+	t.keeper.logger.Info("batchSetRules transaction confirmed",
+		zap.String("txHash", tx.Hash().Hex()),
+		zap.Uint64("blockNumber", receipt.BlockNumber.Uint64()),
+		zap.Uint64("gasUsed", receipt.GasUsed),
+	)
+
+	return nil
+}
+
+// createSigner creates a transaction signer function
+func (t *CorrelationTask) createSigner() bind.SignerFn {
+	return func(address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+		return t.keeper.web3Client.SignTransaction(tx)
+	}
+}
+
+// waitForTransaction waits for a transaction to be mined
+func (t *CorrelationTask) waitForTransaction(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	ticker := time.NewTicker(2 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+			receipt, err := t.keeper.web3Client.WaitForTransaction(ctx, txHash)
+			if err != nil {
+				// Transaction not mined yet, continue waiting
+				continue
+			}
+			return receipt, nil
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pitchone/sportsbook/internal/graphql"
+)
+
+// TestGuardMatchID tests that match IDs hash like CorrelationGuard._extractMatchId
+func TestGuardMatchID(t *testing.T) {
+	want := crypto.Keccak256Hash([]byte("EPL_2425_R20_MUN_vs_MCI"))
+	assert.Equal(t, [32]byte(want), guardMatchID("EPL_2425_R20_MUN_vs_MCI"))
+}
+
+// TestBuildCorrelationRules tests rule derivation from the policy table
+func TestBuildCorrelationRules(t *testing.T) {
+	policies := []CorrelationPolicy{
+		{MatchPrefix: "EPL_", SameMatchPenaltyBps: 3000, SharedTeamPenaltyBps: 500},
+		{MatchPrefix: "UCL_", SameMatchBlocked: true},
+	}
+
+	markets := []graphql.Market{
+		{ID: "0x01", MatchID: "EPL_R20_MUN_vs_MCI", HomeTeam: "MUN", AwayTeam: "MCI"},
+		{ID: "0x02", MatchID: "EPL_R20_MUN_vs_MCI", HomeTeam: "MUN", AwayTeam: "MCI"},
+		{ID: "0x03", MatchID: "EPL_R21_ARS_vs_MUN", HomeTeam: "ARS", AwayTeam: " mun "},
+		{ID: "0x04", MatchID: "EPL_R21_LIV_vs_CHE", HomeTeam: "LIV", AwayTeam: "CHE"},
+		{ID: "0x05", MatchID: "UCL_QF_MUN_vs_RMA", HomeTeam: "MUN", AwayTeam: "RMA"},
+		{ID: "0x06", MatchID: "SERIEA_R10_JUV_vs_INT", HomeTeam: "JUV", AwayTeam: "INT"},
+	}
+
+	rules := buildCorrelationRules(markets, policies)
+
+	same := func(matchID string) ruleKey {
+		id := guardMatchID(matchID)
+		return newRuleKey(id, id)
+	}
+	pair := func(a, b string) ruleKey {
+		return newRuleKey(guardMatchID(a), guardMatchID(b))
+	}
+
+	assert.Equal(t, map[ruleKey]ruleValue{
+		same("EPL_R20_MUN_vs_MCI"): {PenaltyBps: 3000},
+		same("EPL_R21_ARS_vs_MUN"): {PenaltyBps: 3000},
+		same("EPL_R21_LIV_vs_CHE"): {PenaltyBps: 3000},
+		same("UCL_QF_MUN_vs_RMA"):  {Blocked: true},
+		// MUN plays in both EPL matches; the UCL match uses a different policy
+		pair("EPL_R20_MUN_vs_MCI", "EPL_R21_ARS_vs_MUN"): {PenaltyBps: 500},
+	}, rules)
+
+	// Pair keys are order independent
+	assert.Equal(t, pair("EPL_R21_ARS_vs_MUN", "EPL_R20_MUN_vs_MCI"), pair("EPL_R20_MUN_vs_MCI", "EPL_R21_ARS_vs_MUN"))
+}
+
+// TestDiffCorrelationRules tests that only changed rules are sent
+func TestDiffCorrelationRules(t *testing.T) {
+	a := guardMatchID("A")
+	b := guardMatchID("B")
+	c := guardMatchID("C")
+
+	desired := map[ruleKey]ruleValue{
+		newRuleKey(a, a): {PenaltyBps: 3000},
+		newRuleKey(b, b): {PenaltyBps: 3000},
+		newRuleKey(a, c): {Blocked: true},
+		newRuleKey(c, c): {},
+	}
+	applied := map[ruleKey]ruleValue{
+		newRuleKey(a, a): {PenaltyBps: 3000},
+		newRuleKey(b, b): {PenaltyBps: 2000},
+		newRuleKey(c, c): {},
+	}
+
+	changes := diffCorrelationRules(desired, applied)
+	require.Len(t, changes, 2)
+
+	got := map[ruleKey]ruleValue{}
+	for _, change := range changes {
+		got[change.Key] = change.Value
+	}
+	assert.Equal(t, map[ruleKey]ruleValue{
+		newRuleKey(b, b): {PenaltyBps: 3000},
+		newRuleKey(a, c): {Blocked: true},
+	}, got)
+
+	// Deterministic order
+	assert.Equal(t, changes, diffCorrelationRules(desired, applied))
+
+	assert.Empty(t, diffCorrelationRules(desired, desired))
+}
+
+// TestCorrelationTaskResync tests that policy edits and cache expiry force a resync
+func TestCorrelationTaskResync(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	task := &CorrelationTask{
+		config:  CorrelationConfig{RefreshInterval: 600, Policies: []CorrelationPolicy{{MatchPrefix: "EPL_"}}},
+		seen:    map[string]struct{}{},
+		applied: map[ruleKey]ruleValue{},
+		now:     func() time.Time { return now },
+	}
+
+	// Never refreshed
+	assert.True(t, task.cacheExpired())
+	task.refreshedAt = now
+	assert.False(t, task.cacheExpired())
+	now = now.Add(10 * time.Minute)
+	assert.True(t, task.cacheExpired())
+
+	task.config.RefreshInterval = 0
+	assert.False(t, task.cacheExpired())
+
+	assert.Error(t, task.SetPolicies(nil))
+	assert.Error(t, task.SetPolicies([]CorrelationPolicy{{SameMatchPenaltyBps: 10001}}))
+	assert.False(t, task.policiesChanged)
+
+	policies := []CorrelationPolicy{{MatchPrefix: "EPL_", SameMatchPenaltyBps: 2000}}
+	require.NoError(t, task.SetPolicies(policies))
+	assert.True(t, task.policiesChanged)
+	assert.Equal(t, policies, task.config.Policies)
+}
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"fmt"
 	"math/big"
+	"net/http"
 	"os"
 	"sync"
 	"time"
@@ -13,6 +14,7 @@ import (
 	"github.com/pitchone/sportsbook/internal/graphql"
 	"github.com/pitchone/sportsbook/internal/repository"
 	"github.com/pitchone/sportsbook/internal/rewards"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"go.uber.org/zap"
 
 	_ "github.com/lib/pq" // PostgreSQL driver
@@ -34,11 +36,17 @@ type Keeper struct {
 	// Database for fixtures and rewards
 	db              *sql.DB
 	fixturesRepo    *repository.FixturesRepository
+	overridesRepo   resultOverrideStore
 	apiFootballClient *datasource.APIFootballClient
 
 	// Rewards distribution (optional)
 	rewardsAggregator *rewards.Aggregator
 	rewardsPublisher  *rewards.Publisher
+	rewardsBudget     *rewards.BudgetScaler
+	rewardsCampaign   *rewards.CampaignLedger
+
+	// Markets excluded from lock/settle runs (managed via the admin API)
+	skipList *MarketSkipList
 
 	// Internal state
 	running      bool
@@ -189,6 +197,8 @@ func NewKeeper(cfg *Config) (*Keeper, error) {
 	// Initialize rewards aggregator and publisher (optional)
 	var rewardsAggregator *rewards.Aggregator
 	var rewardsPublisher *rewards.Publisher
+	var rewardsBudget *rewards.BudgetScaler
+	var rewardsCampaign *rewards.CampaignLedger
 
 	if cfg.Rewards.Enabled {
 		// Rewards requires database connection
@@ -211,6 +221,40 @@ func NewKeeper(cfg *Config) (*Keeper, error) {
 
 		if db != nil {
 			rewardsAggregator = rewards.NewAggregator(graphClient, db)
+			calendar, err := rewards.NewEpochCalendar(cfg.Rewards.Epochs)
+			if err != nil {
+				return nil, fmt.Errorf("invalid rewards epochs: %w", err)
+			}
+			rewardsAggregator.SetEpochCalendar(calendar)
+			if cfg.Rewards.TradingPolicyFile != "" {
+				policy, err := rewards.LoadTradingPolicy(cfg.Rewards.TradingPolicyFile)
+				if err != nil {
+					return nil, err
+				}
+				rewardsAggregator.SetTradingPolicy(policy)
+				logger.Info("trading reward policy loaded", zap.String("file", cfg.Rewards.TradingPolicyFile))
+			}
+			if cfg.Rewards.CampaignRulesFile != "" {
+				rules, err := rewards.LoadCampaignRules(cfg.Rewards.CampaignRulesFile)
+				if err != nil {
+					return nil, err
+				}
+				if cfg.Rewards.CampaignAddress != "" {
+					rewardsCampaign, err = CreateRewardsCampaignLedger(cfg.Rewards, logger)
+					if err != nil {
+						return nil, fmt.Errorf("failed to create campaign ledger: %w", err)
+					}
+				}
+				var budget rewards.CampaignBudgetReader
+				if rewardsCampaign != nil {
+					budget = rewardsCampaign
+				}
+				rewardsAggregator.SetCampaignRules(rules, budget)
+				logger.Info("campaign reward rules loaded",
+					zap.String("file", cfg.Rewards.CampaignRulesFile),
+					zap.Int("campaigns", len(rules.Campaigns)),
+				)
+			}
 			logger.Info("rewards aggregator initialized")
 
 			// Initialize publisher if distributor address is configured
@@ -223,11 +267,47 @@ func NewKeeper(cfg *Config) (*Keeper, error) {
 					)
 				}
 			}
+
+			// Budget scaling is required once configured: unscaled rewards could exceed the pool
+			if cfg.Rewards.PayoutScalerAddress != "" {
+				var err error
+				rewardsBudget, err = CreateRewardsBudgetScaler(cfg.Rewards, logger)
+				if err != nil {
+					return nil, fmt.Errorf("failed to create rewards budget scaler: %w", err)
+				}
+			}
 		} else {
 			logger.Warn("rewards enabled but DatabaseURL is missing, rewards task will be disabled")
 		}
 	}
 
+	// Initialize result overrides store (optional)
+	var overridesRepo *repository.OverridesRepository
+	if cfg.ResultOverrides {
+		if db == nil && cfg.DatabaseURL != "" {
+			logger.Info("initializing database for result overrides")
+			var err error
+			db, err = sql.Open("postgres", cfg.DatabaseURL)
+			if err != nil {
+				return nil, fmt.Errorf("failed to open database for result overrides: %w", err)
+			}
+
+			ctx5, cancel5 := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel5()
+			if err := db.PingContext(ctx5); err != nil {
+				db.Close()
+				return nil, fmt.Errorf("failed to ping database for result overrides: %w", err)
+			}
+		}
+
+		if db != nil {
+			overridesRepo = repository.NewOverridesRepository(db)
+			logger.Info("result overrides store initialized")
+		} else {
+			logger.Warn("result overrides enabled but DatabaseURL is missing, overrides will be ignored")
+		}
+	}
+
 	keeper := &Keeper{
 		config:            cfg,
 		web3Client:        web3Client,
@@ -242,10 +322,18 @@ func NewKeeper(cfg *Config) (*Keeper, error) {
 		apiFootballClient: apiFootballClient,
 		rewardsAggregator: rewardsAggregator,
 		rewardsPublisher:  rewardsPublisher,
+		rewardsBudget:     rewardsBudget,
+		rewardsCampaign:   rewardsCampaign,
+		skipList:          NewMarketSkipList(),
 		stopChan:          make(chan struct{}),
 		doneChan:          make(chan struct{}),
 	}
 
+	// Assign only a non-nil store so the interface stays nil when overrides are disabled
+	if overridesRepo != nil {
+		keeper.overridesRepo = overridesRepo
+	}
+
 	return keeper, nil
 }
 
@@ -350,6 +438,12 @@ func (k *Keeper) Shutdown(ctx context.Context) error {
 	if k.rewardsPublisher != nil {
 		k.rewardsPublisher.Close()
 	}
+	if k.rewardsBudget != nil {
+		k.rewardsBudget.Close()
+	}
+	if k.rewardsCampaign != nil {
+		k.rewardsCampaign.Close()
+	}
 
 	// GraphQL 客户端不需要显式关闭（使用 HTTP 连接池）
 
@@ -389,6 +483,21 @@ func (k *Keeper) HealthCheck() *HealthStatus {
 	return status
 }
 
+// sendAlert sends an alert if alerting is enabled
+func (k *Keeper) sendAlert(ctx context.Context, alert *Alert) {
+	if k.alertManager == nil || !k.config.AlertsEnabled {
+		return
+	}
+
+	if err := k.alertManager.Notify(ctx, alert); err != nil {
+		k.logger.Warn("failed to send alert",
+			zap.String("type", string(alert.Type)),
+			zap.String("title", alert.Title),
+			zap.Error(err),
+		)
+	}
+}
+
 // runHealthCheckServer runs the health check HTTP server
 func (k *Keeper) runHealthCheckServer(ctx context.Context) {
 	defer k.wg.Done()
@@ -411,19 +520,43 @@ func (k *Keeper) runHealthCheckServer(ctx context.Context) {
 // runMetricsServer runs the Prometheus metrics HTTP server
 func (k *Keeper) runMetricsServer(ctx context.Context) {
 	defer k.wg.Done()
+	k.ServeMetrics(ctx)
+}
 
+// ServeMetrics serves Prometheus metrics on the configured port until ctx is
+// done or the keeper stops. Processes that drive tasks through a Scheduler
+// instead of Start call it directly.
+func (k *Keeper) ServeMetrics(ctx context.Context) {
 	k.logger.Info("metrics server started",
 		zap.Int("port", k.config.MetricsPort),
 	)
 
-	// TODO: Implement actual HTTP server with Prometheus metrics
-	// For now, just simulate running
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	server := &http.Server{
+		Addr:              fmt.Sprintf(":%d", k.config.MetricsPort),
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go func() {
+		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			k.logger.Error("metrics server failed", zap.Error(err))
+		}
+	}()
+
 	select {
 	case <-ctx.Done():
 		k.logger.Info("metrics server stopping (context done)")
 	case <-k.stopChan:
 		k.logger.Info("metrics server stopping (stop signal)")
 	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		k.logger.Warn("metrics server shutdown error", zap.Error(err))
+	}
 	k.logger.Info("metrics server stopped")
 }
 
@@ -459,15 +592,59 @@ func (k *Keeper) runTaskScheduler(ctx context.Context) {
 
 	// Register RewardsTask (if rewards is enabled and aggregator is configured)
 	if k.config.Rewards.Enabled && k.rewardsAggregator != nil {
-		rewardsTask := NewRewardsTask(k, k.rewardsAggregator, k.rewardsPublisher, k.config.Rewards)
+		rewardsTask := NewRewardsTask(k, k.rewardsAggregator, k.rewardsPublisher, k.rewardsBudget, k.rewardsCampaign, k.config.Rewards)
 		interval := time.Duration(k.config.Rewards.TaskInterval) * time.Second
 		scheduler.RegisterTask("rewards", rewardsTask, interval)
 		k.logger.Info("rewards task registered",
 			zap.Duration("interval", interval),
 			zap.Bool("publisherEnabled", k.rewardsPublisher != nil),
+			zap.Bool("budgetScalingEnabled", k.rewardsBudget != nil),
 		)
 	}
 
+	// Register ParlayTask (if parlay settlement is enabled)
+	if k.config.Parlay.Enabled {
+		parlayTask, err := NewParlayTask(k, k.config.Parlay)
+		if err != nil {
+			k.logger.Error("failed to create parlay task", zap.Error(err))
+		} else {
+			interval := time.Duration(k.config.Parlay.TaskInterval) * time.Second
+			scheduler.RegisterTask("parlay", parlayTask, interval)
+			k.logger.Info("parlay task registered",
+				zap.Duration("interval", interval),
+				zap.String("basket", k.config.Parlay.BasketAddress),
+			)
+		}
+	}
+
+	// Register CorrelationTask (if automatic correlation rules are enabled)
+	if k.config.Correlation.Enabled {
+		correlationTask, err := NewCorrelationTask(k, k.config.Correlation)
+		if err != nil {
+			k.logger.Error("failed to create correlation task", zap.Error(err))
+		} else {
+			interval := time.Duration(k.config.Correlation.TaskInterval) * time.Second
+			scheduler.RegisterTask("correlation", correlationTask, interval)
+			k.logger.Info("correlation task registered",
+				zap.Duration("interval", interval),
+				zap.String("guard", k.config.Correlation.GuardAddress),
+				zap.Int("policies", len(k.config.Correlation.Policies)),
+			)
+		}
+	}
+
+	// Start admin API (if enabled)
+	if k.config.Admin.Enabled {
+		adminServer := NewAdminServer(k, scheduler, settleTask)
+		k.wg.Add(1)
+		go func() {
+			defer k.wg.Done()
+			if err := adminServer.Run(ctx); err != nil {
+				k.logger.Error("admin server failed", zap.Error(err))
+			}
+		}()
+	}
+
 	// Start scheduler
 	if err := scheduler.Start(ctx); err != nil {
 		k.logger.Error("scheduler failed to start", zap.Error(err))
@@ -188,7 +188,7 @@ func TestKeeperHealthCheck(t *testing.T) {
 		health := keeper.HealthCheck()
 		assert.True(t, health.Healthy, "Keeper should be healthy")
 		assert.NotEmpty(t, health.Version, "Version should be set")
-		assert.Equal(t, "ok", health.Database, "Database should be ok")
+		assert.Equal(t, "ok", health.Subgraph, "Subgraph should be ok")
 		assert.Equal(t, "ok", health.Web3, "Web3 should be ok")
 	})
 }
@@ -2,8 +2,10 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
+	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
@@ -16,6 +18,10 @@ import (
 // LockTask handles the task of locking markets before match start
 type LockTask struct {
 	keeper *Keeper
+
+	// Markets whose lock tx was dropped by a reorg, retried on the next run
+	requeued  map[common.Address]*MarketToLock
+	requeueMu sync.Mutex
 }
 
 // MarketToLock represents a market that needs to be locked
@@ -30,7 +36,8 @@ type MarketToLock struct {
 // NewLockTask creates a new LockTask instance
 func NewLockTask(keeper *Keeper) *LockTask {
 	return &LockTask{
-		keeper: keeper,
+		keeper:   keeper,
+		requeued: make(map[common.Address]*MarketToLock),
 	}
 }
 
@@ -44,6 +51,7 @@ func (t *LockTask) Execute(ctx context.Context) error {
 		t.keeper.logger.Error("failed to get markets to lock", zap.Error(err))
 		return fmt.Errorf("failed to get markets to lock: %w", err)
 	}
+	markets = t.takeRequeued(markets)
 
 	if len(markets) == 0 {
 		t.keeper.logger.Debug("no markets to lock")
@@ -74,6 +82,9 @@ func (t *LockTask) Execute(ctx context.Context) error {
 					zap.String("version", market.Version),
 					zap.Error(err),
 				)
+				if errors.Is(err, ErrTransactionReorged) {
+					t.requeue(market)
+				}
 				// Continue with other markets even if one fails
 				continue
 			}
@@ -89,6 +100,38 @@ func (t *LockTask) Execute(ctx context.Context) error {
 	return nil
 }
 
+// requeue schedules a market for another lock attempt on the next run
+func (t *LockTask) requeue(market *MarketToLock) {
+	t.requeueMu.Lock()
+	defer t.requeueMu.Unlock()
+
+	t.requeued[market.MarketAddress] = market
+	actionsRequeued.WithLabelValues("lock").Inc()
+
+	t.keeper.logger.Warn("market re-queued for locking after reorg",
+		zap.String("market", market.MarketAddress.Hex()),
+	)
+}
+
+// takeRequeued appends re-queued markets not already in the list and clears the queue
+func (t *LockTask) takeRequeued(markets []*MarketToLock) []*MarketToLock {
+	t.requeueMu.Lock()
+	defer t.requeueMu.Unlock()
+
+	seen := make(map[common.Address]bool, len(markets))
+	for _, m := range markets {
+		seen[m.MarketAddress] = true
+	}
+	for addr, m := range t.requeued {
+		if !seen[addr] && !t.keeper.skipList.Contains(addr) {
+			markets = append(markets, m)
+		}
+		delete(t.requeued, addr)
+	}
+
+	return markets
+}
+
 // getMarketsToLock queries the Subgraph for markets that need locking
 func (t *LockTask) getMarketsToLock(ctx context.Context) ([]*MarketToLock, error) {
 	// Calculate lock window: current time + lock lead time (Unix timestamp)
@@ -103,6 +146,13 @@ func (t *LockTask) getMarketsToLock(ctx context.Context) ([]*MarketToLock, error
 
 	markets := make([]*MarketToLock, 0, len(subgraphMarkets))
 	for _, m := range subgraphMarkets {
+		if t.keeper.skipList.Contains(m.Address()) {
+			t.keeper.logger.Info("market on skip list, not locking",
+				zap.String("market", m.ID),
+			)
+			continue
+		}
+
 		// 解析时间戳
 		var lockTimeUnix, kickoffTimeUnix int64
 		if m.LockTime != "" {
@@ -193,6 +243,12 @@ func (t *LockTask) lockMarketV2(ctx context.Context, marketAddr common.Address)
 		return fmt.Errorf("lock transaction failed: status %d", receipt.Status)
 	}
 
+	// Re-check the receipt once it is buried under the configured confirmation depth
+	receipt, err = t.keeper.waitForConfirmations(ctx, "lock", marketAddr, receipt)
+	if err != nil {
+		return fmt.Errorf("failed to confirm lock transaction: %w", err)
+	}
+
 	t.keeper.logger.Info("lock transaction confirmed",
 		zap.String("market", marketAddr.Hex()),
 		zap.String("txHash", tx.Hash().Hex()),
@@ -268,6 +324,12 @@ func (t *LockTask) lockMarketV3(ctx context.Context, marketAddr common.Address)
 		return fmt.Errorf("lock transaction failed: status %d", receipt.Status)
 	}
 
+	// Re-check the receipt once it is buried under the configured confirmation depth
+	receipt, err = t.keeper.waitForConfirmations(ctx, "lock", marketAddr, receipt)
+	if err != nil {
+		return fmt.Errorf("failed to confirm lock transaction: %w", err)
+	}
+
 	t.keeper.logger.Info("V3 lock transaction confirmed",
 		zap.String("market", marketAddr.Hex()),
 		zap.String("txHash", tx.Hash().Hex()),
@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	// reorgsDetected counts keeper transactions affected by chain reorganizations.
+	// outcome is "dropped" (tx no longer on chain) or "reincluded" (tx moved to another block).
+	reorgsDetected = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "pitchone",
+			Subsystem: "keeper",
+			Name:      "reorgs_detected_total",
+			Help:      "Keeper transactions affected by a chain reorganization.",
+		},
+		[]string{"action", "outcome"},
+	)
+
+	// actionsRequeued counts keeper actions re-queued after their transaction was dropped
+	actionsRequeued = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "pitchone",
+			Subsystem: "keeper",
+			Name:      "actions_requeued_total",
+			Help:      "Keeper actions re-queued after their transaction was dropped by a reorg.",
+		},
+		[]string{"action"},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(reorgsDetected, actionsRequeued)
+}
@@ -0,0 +1,416 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pitchone/sportsbook/internal/graphql"
+	"github.com/pitchone/sportsbook/pkg/bindings"
+	"go.uber.org/zap"
+)
+
+// parlayPageSize is the number of pending baskets fetched per Subgraph query
+const parlayPageSize = 500
+
+// ParlayTask settles pending parlays (Basket) once all of their leg markets have resolved
+type ParlayTask struct {
+	keeper        *Keeper
+	config        ParlayConfig
+	basketAddress common.Address
+	basket        *bindings.Basket
+
+	// Parlays already reported as stuck, so each one is alerted only once
+	alerted   map[string]struct{}
+	alertedMu sync.Mutex
+}
+
+// ParlayToSettle represents a pending parlay whose legs have all resolved
+type ParlayToSettle struct {
+	ID   *big.Int
+	Legs int
+}
+
+// NewParlayTask creates a new ParlayTask instance
+func NewParlayTask(keeper *Keeper, config ParlayConfig) (*ParlayTask, error) {
+	basketAddress := common.HexToAddress(config.BasketAddress)
+
+	basket, err := bindings.NewBasket(basketAddress, keeper.web3Client.client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Basket contract instance: %w", err)
+	}
+
+	return &ParlayTask{
+		keeper:        keeper,
+		config:        config,
+		basketAddress: basketAddress,
+		basket:        basket,
+		alerted:       make(map[string]struct{}),
+	}, nil
+}
+
+// Execute runs the parlay settlement task
+func (t *ParlayTask) Execute(ctx context.Context) error {
+	t.keeper.logger.Info("executing parlay settle task")
+
+	baskets, err := t.getPendingBaskets(ctx)
+	if err != nil {
+		t.keeper.logger.Error("failed to get pending parlays", zap.Error(err))
+		return fmt.Errorf("failed to get pending parlays: %w", err)
+	}
+
+	if len(baskets) == 0 {
+		t.keeper.logger.Debug("no pending parlays")
+		return nil
+	}
+
+	states, err := t.getLegStates(ctx, baskets)
+	if err != nil {
+		return fmt.Errorf("failed to get leg market states: %w", err)
+	}
+
+	ready, stuck := classifyBaskets(baskets, states, t.keeper.skipList)
+	for id, legs := range stuck {
+		t.reportStuck(ctx, id, legs)
+	}
+
+	// The Subgraph may lag the chain, so confirm each candidate on-chain
+	parlays := t.filterSettleable(ctx, ready)
+	if len(parlays) == 0 {
+		t.keeper.logger.Debug("no parlays ready to settle",
+			zap.Int("pending", len(baskets)),
+			zap.Int("stuck", len(stuck)),
+		)
+		return nil
+	}
+
+	batches := chunkParlaysByGas(parlays, t.config.MaxBatchGas, t.config.GasPerParlay, t.config.GasPerLeg)
+
+	t.keeper.logger.Info("found parlays to settle",
+		zap.Int("count", len(parlays)),
+		zap.Int("batches", len(batches)),
+	)
+
+	var failed int
+	for _, batch := range batches {
+		if err := t.settleBatch(ctx, batch); err != nil {
+			failed++
+			t.keeper.logger.Error("failed to settle parlay batch",
+				zap.Int("size", len(batch)),
+				zap.Error(err),
+			)
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d parlay batches failed", failed, len(batches))
+	}
+
+	return nil
+}
+
+// getPendingBaskets pages through pending baskets in the Subgraph
+func (t *ParlayTask) getPendingBaskets(ctx context.Context) ([]graphql.Basket, error) {
+	var baskets []graphql.Basket
+	for skip := 0; ; skip += parlayPageSize {
+		page, err := t.keeper.graphClient.GetPendingBaskets(ctx, parlayPageSize, skip)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query Subgraph: %w", err)
+		}
+		baskets = append(baskets, page...)
+		if len(page) < parlayPageSize {
+			return baskets, nil
+		}
+	}
+}
+
+// getLegStates returns the Subgraph state of every leg market, keyed by address
+func (t *ParlayTask) getLegStates(ctx context.Context, baskets []graphql.Basket) (map[common.Address]string, error) {
+	seen := make(map[common.Address]struct{})
+	var addrs []common.Address
+	for i := range baskets {
+		for _, addr := range baskets[i].MarketAddresses() {
+			if _, ok := seen[addr]; ok {
+				continue
+			}
+			seen[addr] = struct{}{}
+			addrs = append(addrs, addr)
+		}
+	}
+
+	states := make(map[common.Address]string, len(addrs))
+	for start := 0; start < len(addrs); start += parlayPageSize {
+		end := start + parlayPageSize
+		if end > len(addrs) {
+			end = len(addrs)
+		}
+
+		markets, err := t.keeper.graphClient.GetMarketsByAddresses(ctx, addrs[start:end])
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range markets {
+			states[m.Address()] = m.State
+		}
+	}
+
+	return states, nil
+}
+
+// classifyBaskets splits pending baskets into those whose legs have all resolved
+// and those blocked by cancelled legs (returned by parlay ID). Baskets with legs
+// that are still open, unknown to the Subgraph or on the skip list are left alone.
+func classifyBaskets(
+	baskets []graphql.Basket,
+	states map[common.Address]string,
+	skipList *MarketSkipList,
+) ([]*ParlayToSettle, map[string][]common.Address) {
+	var ready []*ParlayToSettle
+	stuck := make(map[string][]common.Address)
+
+	for i := range baskets {
+		b := &baskets[i]
+		legs := b.MarketAddresses()
+
+		resolved := len(legs) > 0
+		skipped := false
+		var cancelled []common.Address
+		for _, leg := range legs {
+			if skipList.Contains(leg) {
+				skipped = true
+			}
+			switch states[leg] {
+			case "Resolved", "Finalized":
+			case "Cancelled":
+				cancelled = append(cancelled, leg)
+				resolved = false
+			default:
+				resolved = false
+			}
+		}
+
+		if len(cancelled) > 0 {
+			stuck[b.ID] = cancelled
+			continue
+		}
+		if !resolved || skipped {
+			continue
+		}
+
+		id, ok := new(big.Int).SetString(b.ID, 10)
+		if !ok {
+			continue
+		}
+		ready = append(ready, &ParlayToSettle{ID: id, Legs: len(legs)})
+	}
+
+	return ready, stuck
+}
+
+// chunkParlaysByGas groups parlays into batches whose budgeted gas stays within
+// maxGas. A parlay that exceeds the budget on its own is settled in its own batch.
+func chunkParlaysByGas(parlays []*ParlayToSettle, maxGas, gasPerParlay, gasPerLeg uint64) [][]*ParlayToSettle {
+	var batches [][]*ParlayToSettle
+	var current []*ParlayToSettle
+	var used uint64
+
+	for _, p := range parlays {
+		cost := gasPerParlay + gasPerLeg*uint64(p.Legs)
+		if len(current) > 0 && used+cost > maxGas {
+			batches = append(batches, current)
+			current = nil
+			used = 0
+		}
+		current = append(current, p)
+		used += cost
+	}
+	if len(current) > 0 {
+		batches = append(batches, current)
+	}
+
+	return batches
+}
+
+// batchGasLimit returns the gas limit budgeted for a batch
+func (t *ParlayTask) batchGasLimit(batch []*ParlayToSettle) uint64 {
+	var gas uint64
+	for _, p := range batch {
+		gas += t.config.GasPerParlay + t.config.GasPerLeg*uint64(p.Legs)
+	}
+	return gas
+}
+
+// filterSettleable keeps the parlays that canSettle reports as settleable on-chain
+func (t *ParlayTask) filterSettleable(ctx context.Context, parlays []*ParlayToSettle) []*ParlayToSettle {
+	settleable := make([]*ParlayToSettle, 0, len(parlays))
+	for _, p := range parlays {
+		ok, _, err := t.basket.CanSettle(&bind.CallOpts{Context: ctx}, p.ID)
+		if err != nil {
+			t.keeper.logger.Warn("failed to check parlay settleability",
+				zap.String("parlayId", p.ID.String()),
+				zap.Error(err),
+			)
+			continue
+		}
+		if ok {
+			settleable = append(settleable, p)
+		}
+	}
+	return settleable
+}
+
+// reportStuck alerts once per parlay blocked by cancelled leg markets
+func (t *ParlayTask) reportStuck(ctx context.Context, parlayID string, cancelledLegs []common.Address) {
+	t.alertedMu.Lock()
+	_, seen := t.alerted[parlayID]
+	t.alerted[parlayID] = struct{}{}
+	t.alertedMu.Unlock()
+
+	if seen {
+		return
+	}
+
+	t.keeper.logger.Warn("parlay stuck on cancelled leg market",
+		zap.String("parlayId", parlayID),
+		zap.Int("cancelledLegs", len(cancelledLegs)),
+	)
+
+	t.keeper.sendAlert(ctx, NewParlayStuckAlert(parlayID, cancelledLegs, map[string]interface{}{
+		"basket": t.basketAddress.Hex(),
+	}))
+}
+
+// settleBatch sends a single batchSettle transaction for the given parlays
+func (t *ParlayTask) settleBatch(ctx context.Context, batch []*ParlayToSettle) error {
+	ids := make([]*big.Int, 0, len(batch))
+	for _, p := range batch {
+		ids = append(ids, p.ID)
+	}
+
+	// Get current gas price
+	gasPrice, err := t.keeper.web3Client.CalculateGasPrice(ctx, t.keeper.maxGasPrice)
+	if err != nil {
+		return fmt.Errorf("failed to calculate gas price: %w", err)
+	}
+
+	// Get nonce
+	nonce, err := t.keeper.web3Client.GetNonce(ctx, t.keeper.web3Client.account)
+	if err != nil {
+		return fmt.Errorf("failed to get nonce: %w", err)
+	}
+
+	// batchSettle swallows per-parlay failures, so the gas limit comes from the
+	// budget rather than eth_estimateGas, which can under-estimate try/catch loops
+	auth := &bind.TransactOpts{
+		From:     t.keeper.web3Client.account,
+		Nonce:    big.NewInt(int64(nonce)),
+		Signer:   t.createSigner(),
+		Value:    big.NewInt(0),
+		GasPrice: gasPrice,
+		GasLimit: t.batchGasLimit(batch),
+		Context:  ctx,
+	}
+
+	tx, err := t.basket.BatchSettle(auth, ids)
+	if err != nil {
+		return fmt.Errorf("failed to send batchSettle transaction: %w", err)
+	}
+
+	t.keeper.logger.Info("batchSettle transaction sent",
+		zap.String("basket", t.basketAddress.Hex()),
+		zap.String("txHash", tx.Hash().Hex()),
+		zap.Int("parlays", len(ids)),
+		zap.Uint64("nonce", nonce),
+		zap.Uint64("gasLimit", auth.GasLimit),
+		zap.String("gasPrice", gasPrice.String()),
+	)
+
+	// Wait for transaction to be mined (with timeout)
+	receiptCtx, cancel := context.WithTimeout(ctx, 2*time.Minute)
+	defer cancel()
+
+	receipt, err := t.waitForTransaction(receiptCtx, tx.Hash())
+	if err != nil {
+		return fmt.Errorf("failed to wait for transaction: %w", err)
+	}
+
+	// Check transaction status
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return fmt.Errorf("batchSettle transaction failed: status %d", receipt.Status)
+	}
+
+	// Re-check the receipt once it is buried under the configured confirmation depth.
+	// Parlays from a dropped transaction are still pending and are picked up next run.
+	receipt, err = t.keeper.waitForConfirmations(ctx, "parlay_settle", t.basketAddress, receipt)
+	if err != nil {
+		return fmt.Errorf("failed to confirm batchSettle transaction: %w", err)
+	}
+
+	settled := t.settledParlays(receipt)
+	for _, id := range ids {
+		if _, ok := settled[id.String()]; !ok {
+			t.keeper.logger.Warn("parlay not settled by batchSettle",
+				zap.String("parlayId", id.String()),
+				zap.String("txHash", tx.Hash().Hex()),
+			)
+		}
+	}
+
+	t.keeper.logger.Info("batchSettle transaction confirmed",
+		zap.String("txHash", tx.Hash().Hex()),
+		zap.Uint64("blockNumber", receipt.BlockNumber.Uint64()),
+		zap.Uint64("gasUsed", receipt.GasUsed),
+		zap.Int("settled", len(settled)),
+		zap.Int("requested", len(ids)),
+	)
+
+	return nil
+}
+
+// settledParlays returns the IDs of parlays with a ParlaySettled event in the receipt
+func (t *ParlayTask) settledParlays(receipt *types.Receipt) map[string]struct{} {
+	settled := make(map[string]struct{})
+	for _, log := range receipt.Logs {
+		if log.Address != t.basketAddress {
+			continue
+		}
+		event, err := t.basket.ParseParlaySettled(*log)
+		if err != nil {
+			continue
+		}
+		settled[event.ParlayId.String()] = struct{}{}
+	}
+	return settled
+}
+
+// createSigner creates a transaction signer function
+func (t *ParlayTask) createSigner() bind.SignerFn {
+	return func(address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+		return t.keeper.web3Client.SignTransaction(tx)
+	}
+}
+
+// waitForTransaction waits for a transaction to be mined
+func (t *ParlayTask) waitForTransaction(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	ticker := time.NewTicker(2 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+			receipt, err := t.keeper.web3Client.WaitForTransaction(ctx, txHash)
+			if err != nil {
+				// Transaction not mined yet, continue waiting
+				continue
+			}
+			return receipt, nil
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pitchone/sportsbook/internal/graphql"
+)
+
+// TestClassifyBaskets tests selection of settleable and stuck parlays
+func TestClassifyBaskets(t *testing.T) {
+	resolved := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	finalized := common.HexToAddress("0x1000000000000000000000000000000000000002")
+	locked := common.HexToAddress("0x1000000000000000000000000000000000000003")
+	cancelled := common.HexToAddress("0x1000000000000000000000000000000000000004")
+	skipped := common.HexToAddress("0x1000000000000000000000000000000000000005")
+	unknown := common.HexToAddress("0x1000000000000000000000000000000000000006")
+
+	states := map[common.Address]string{
+		resolved:  "Resolved",
+		finalized: "Finalized",
+		locked:    "Locked",
+		cancelled: "Cancelled",
+		skipped:   "Resolved",
+	}
+
+	basket := func(id string, legs ...common.Address) graphql.Basket {
+		b := graphql.Basket{ID: id, Status: "Pending"}
+		for _, leg := range legs {
+			// Subgraph returns lowercase hex
+			b.Markets = append(b.Markets, strings.ToLower(leg.Hex()))
+		}
+		return b
+	}
+
+	skipList := NewMarketSkipList()
+	skipList.Add(skipped, "disputed result", "alice")
+
+	baskets := []graphql.Basket{
+		basket("1", resolved, finalized),
+		basket("2", resolved, locked),
+		basket("3", resolved, cancelled),
+		basket("4", resolved, skipped),
+		basket("5", resolved, unknown),
+		basket("6", locked, cancelled),
+		basket("7"),
+	}
+
+	ready, stuck := classifyBaskets(baskets, states, skipList)
+
+	require.Len(t, ready, 1)
+	assert.Equal(t, big.NewInt(1), ready[0].ID)
+	assert.Equal(t, 2, ready[0].Legs)
+
+	assert.Equal(t, map[string][]common.Address{
+		"3": {cancelled},
+		"6": {cancelled},
+	}, stuck)
+}
+
+// TestChunkParlaysByGas tests gas-bounded batching of parlays
+func TestChunkParlaysByGas(t *testing.T) {
+	parlay := func(id int64, legs int) *ParlayToSettle {
+		return &ParlayToSettle{ID: big.NewInt(id), Legs: legs}
+	}
+
+	t.Run("fills batches up to the budget", func(t *testing.T) {
+		// cost = 100 + 10*legs
+		parlays := []*ParlayToSettle{parlay(1, 2), parlay(2, 3), parlay(3, 2), parlay(4, 5)}
+		batches := chunkParlaysByGas(parlays, 300, 100, 10)
+
+		require.Len(t, batches, 2)
+		assert.Equal(t, []*ParlayToSettle{parlays[0], parlays[1]}, batches[0])
+		assert.Equal(t, []*ParlayToSettle{parlays[2], parlays[3]}, batches[1])
+	})
+
+	t.Run("oversized parlay gets its own batch", func(t *testing.T) {
+		parlays := []*ParlayToSettle{parlay(1, 2), parlay(2, 50), parlay(3, 2)}
+		batches := chunkParlaysByGas(parlays, 300, 100, 10)
+
+		require.Len(t, batches, 3)
+		for i, batch := range batches {
+			assert.Equal(t, []*ParlayToSettle{parlays[i]}, batch)
+		}
+	})
+
+	t.Run("empty input", func(t *testing.T) {
+		assert.Empty(t, chunkParlaysByGas(nil, 300, 100, 10))
+	})
+}
@@ -2,18 +2,58 @@ package keeper
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pitchone/sportsbook/internal/repository"
 	"github.com/pitchone/sportsbook/internal/rewards"
 	"go.uber.org/zap"
 )
 
+// rewardsAggregator is the part of rewards.Aggregator used by RewardsTask
+type rewardsAggregator interface {
+	Calendar() *rewards.EpochCalendar
+	PublishStatus(ctx context.Context, week uint64) (string, error)
+	GetDistribution(ctx context.Context, week uint64) (*rewards.MerkleDistribution, error)
+	BuildWeeklyDistribution(ctx context.Context, week uint64, scaler *rewards.BudgetScaler) (*rewards.MerkleDistribution, *rewards.ScalePlan, error)
+	SaveDistribution(ctx context.Context, dist *rewards.MerkleDistribution, plan *rewards.ScalePlan) error
+	MarkPublished(ctx context.Context, week uint64, txHash common.Hash, blockNumber uint64) error
+	MarkPublishFailed(ctx context.Context, week uint64, reason error) error
+	MarkBudgetReserved(ctx context.Context, week uint64) error
+	MarkBudgetUsed(ctx context.Context, week uint64) error
+	ReservedBudgetWeeks(ctx context.Context) ([]uint64, error)
+	RecordCampaignSpending(ctx context.Context, ledger *rewards.CampaignLedger) (int, error)
+}
+
+// rewardsPublisher is the part of rewards.Publisher used by RewardsTask
+type rewardsPublisher interface {
+	CheckPublished(ctx context.Context, dist *rewards.MerkleDistribution) (bool, error)
+	PublishRoot(ctx context.Context, dist *rewards.MerkleDistribution) (*types.Transaction, error)
+	WaitForConfirmation(ctx context.Context, tx *types.Transaction, confirmations uint64) (*types.Receipt, error)
+	VerifyReceipt(receipt *types.Receipt, dist *rewards.MerkleDistribution) error
+	GetPublishedRoot(ctx context.Context, week uint64) (common.Hash, error)
+	SafeTransaction(dist *rewards.MerkleDistribution, safe common.Address) (*rewards.SafeBatch, error)
+}
+
+// rewardsBudget is the part of rewards.BudgetScaler used by RewardsTask
+type rewardsBudget interface {
+	Pools() map[rewards.RewardType]rewards.BudgetPool
+	Reserve(ctx context.Context, plan *rewards.ScalePlan) error
+	MarkUsed(ctx context.Context, plan *rewards.ScalePlan) error
+}
+
 // RewardsTask handles weekly rewards distribution
 type RewardsTask struct {
 	keeper     *Keeper
-	aggregator *rewards.Aggregator
-	publisher  *rewards.Publisher
+	aggregator rewardsAggregator
+	publisher  rewardsPublisher        // nil when on-chain publication is not configured
+	budget     rewardsBudget           // optional PayoutScaler budget scaling
+	campaign   *rewards.CampaignLedger // optional Campaign spending ledger
 	config     RewardsConfig
 }
 
@@ -22,25 +62,47 @@ func NewRewardsTask(
 	keeper *Keeper,
 	aggregator *rewards.Aggregator,
 	publisher *rewards.Publisher,
+	budget *rewards.BudgetScaler,
+	campaign *rewards.CampaignLedger,
 	config RewardsConfig,
 ) *RewardsTask {
-	return &RewardsTask{
+	t := &RewardsTask{
 		keeper:     keeper,
 		aggregator: aggregator,
-		publisher:  publisher,
+		campaign:   campaign,
 		config:     config,
 	}
+	if publisher != nil {
+		t.publisher = publisher
+	}
+	if budget != nil {
+		t.budget = budget
+	}
+	return t
 }
 
 // Execute implements the Task interface
 func (t *RewardsTask) Execute(ctx context.Context) error {
-	// Check if it's time to run (Sunday 23:59 UTC)
+	// Budget usage and campaign spending of published weeks are retried on every run until recorded
+	t.markBudgetUsed(ctx)
+	t.recordCampaignSpending(ctx)
+
+	// Check if it's time to run (final hour of the current epoch)
 	if !t.shouldRunNow() {
 		t.keeper.logger.Debug("rewards task: not time to run yet")
 		return nil
 	}
 
-	week := rewards.GetCurrentWeek() - 1 // Process previous week
+	// Process the last completed epoch
+	return t.processWeek(ctx, t.aggregator.Calendar().Previous())
+}
+
+// processWeek builds, saves and publishes a week's distribution. Weeks whose root
+// is confirmed on-chain are skipped. Saved weeks that are not published yet
+// (failed or interrupted runs, Safe batches awaiting execution) are never rebuilt:
+// their saved root may already be in the treasury's hands, so it is published or
+// exported again as is.
+func (t *RewardsTask) processWeek(ctx context.Context, week uint64) error {
 	t.keeper.logger.Info("executing rewards distribution task",
 		zap.Uint64("week", week),
 	)
@@ -48,85 +110,138 @@ func (t *RewardsTask) Execute(ctx context.Context) error {
 	startTime := time.Now()
 
 	// 1. Check if already processed
-	existing, _ := t.aggregator.GetDistribution(ctx, week)
-	if existing != nil {
-		t.keeper.logger.Info("week already processed, skipping",
-			zap.Uint64("week", week),
-			zap.String("root", existing.Root),
-		)
-		return nil
-	}
-
-	// 2. Aggregate rewards
-	t.keeper.logger.Info("aggregating rewards...", zap.Uint64("week", week))
-	entries, err := t.aggregator.AggregateWeeklyRewards(ctx, week)
-	if err != nil {
-		t.keeper.logger.Error("failed to aggregate rewards",
+	status, err := t.aggregator.PublishStatus(ctx, week)
+	if err != nil && !errors.Is(err, rewards.ErrDistributionNotFound) {
+		t.keeper.logger.Error("failed to load distribution status",
 			zap.Uint64("week", week),
 			zap.Error(err),
 		)
 		return err
 	}
-
-	t.keeper.logger.Info("rewards aggregated",
-		zap.Uint64("week", week),
-		zap.Int("entries", len(entries)),
-	)
-
-	if len(entries) == 0 {
-		t.keeper.logger.Info("no rewards to distribute",
+	saved := err == nil
+	if status == repository.DistributionPublished {
+		t.keeper.logger.Info("week already published, skipping",
 			zap.Uint64("week", week),
 		)
 		return nil
 	}
 
-	// 3. Build Merkle distribution
-	t.keeper.logger.Info("building Merkle tree...")
-	scaleBps := uint64(10000) // TODO: Query available budget from contract
-	distribution, err := rewards.BuildDistribution(week, entries, scaleBps)
-	if err != nil {
-		t.keeper.logger.Error("failed to build distribution",
+	var distribution *rewards.MerkleDistribution
+	var plan *rewards.ScalePlan
+	if saved {
+		// 2a. Reuse the saved distribution and derive its budget plan from the saved amounts
+		if distribution, err = t.aggregator.GetDistribution(ctx, week); err != nil {
+			t.keeper.logger.Error("failed to load saved distribution",
+				zap.Uint64("week", week),
+				zap.Error(err),
+			)
+			return err
+		}
+		if t.budget != nil {
+			plan = rewards.DistributionPlan(distribution, t.budget.Pools())
+		}
+		t.keeper.logger.Info("week saved but not published, retrying publication",
 			zap.Uint64("week", week),
-			zap.Error(err),
+			zap.String("status", status),
+			zap.String("root", distribution.Root),
 		)
-		return err
-	}
+	} else {
+		// 2b. Aggregate rewards, scale each category to its budget pool and build the Merkle tree
+		t.keeper.logger.Info("aggregating rewards...", zap.Uint64("week", week))
+		// Planning a new week reads the PayoutScaler pools; a nil budget builds unscaled
+		scaler, _ := t.budget.(*rewards.BudgetScaler)
+		distribution, plan, err = t.aggregator.BuildWeeklyDistribution(ctx, week, scaler)
+		if err != nil {
+			t.keeper.logger.Error("failed to build distribution",
+				zap.Uint64("week", week),
+				zap.Error(err),
+			)
+			return err
+		}
 
-	distribution.CreatedAt = time.Now().Unix()
+		if distribution == nil {
+			t.keeper.logger.Info("no rewards to distribute",
+				zap.Uint64("week", week),
+			)
+			return nil
+		}
 
-	t.keeper.logger.Info("Merkle tree built",
-		zap.String("root", distribution.Root),
-		zap.Int("recipients", distribution.Recipients),
-		zap.String("totalAmount", distribution.TotalAmount),
-		zap.Uint64("scaleBps", distribution.ScaleBps),
-	)
+		t.checkBudget(plan)
 
-	// 4. Save to database
-	if err := t.aggregator.SaveDistribution(ctx, distribution); err != nil {
-		t.keeper.logger.Error("failed to save distribution",
-			zap.Uint64("week", week),
-			zap.Error(err),
+		distribution.CreatedAt = time.Now().Unix()
+
+		t.keeper.logger.Info("Merkle tree built",
+			zap.String("root", distribution.Root),
+			zap.Int("recipients", distribution.Recipients),
+			zap.String("totalAmount", distribution.TotalAmount),
+			zap.Uint64("scaleBps", distribution.ScaleBps),
 		)
-		return err
-	}
 
-	t.keeper.logger.Info("distribution saved to database")
+		// 3. Save to database
+		if err := t.aggregator.SaveDistribution(ctx, distribution, plan); err != nil {
+			t.keeper.logger.Error("failed to save distribution",
+				zap.Uint64("week", week),
+				zap.Error(err),
+			)
+			return err
+		}
+
+		t.keeper.logger.Info("distribution saved to database")
+	}
 
-	// 5. Publish to chain (if publisher is configured)
+	// 4. Publish to chain (if publisher is configured)
 	if t.publisher != nil {
+		// A previous run or the treasury may have published this root already;
+		// never send publishRoot twice
+		published, err := t.publisher.CheckPublished(ctx, distribution)
+		if err != nil {
+			t.publishFailed(ctx, week, "RewardsPublishCheckFailed", err, nil)
+			return nil
+		}
+		if published {
+			t.keeper.logger.Warn("root already published on-chain, marking published",
+				zap.Uint64("week", week),
+				zap.String("root", distribution.Root),
+			)
+			t.markPublished(ctx, week, common.Hash{}, 0)
+			return nil
+		}
+
+		// Reserve pool budgets before the root can become claimable, whether it is
+		// sent directly or exported for the treasury. A reservation left by an
+		// earlier attempt is reused rather than reserved again.
+		if t.budget != nil {
+			if err := t.reserveBudget(ctx, week, plan); err != nil {
+				t.publishFailed(ctx, week, "RewardsBudgetReserveFailed", err, nil)
+				return nil
+			}
+		}
+
+		if t.config.SafeExportDir != "" {
+			// The batch for the saved root was already handed to the treasury
+			if _, err := os.Stat(t.safeTransactionPath(week)); saved && err == nil {
+				t.keeper.logger.Debug("Safe transaction already exported, waiting for execution",
+					zap.Uint64("week", week),
+				)
+				return nil
+			}
+			t.exportSafeTransaction(distribution)
+			return nil
+		}
+
 		t.keeper.logger.Info("publishing to blockchain...")
 
 		tx, err := t.publisher.PublishRoot(ctx, distribution)
-		if err != nil {
-			t.keeper.logger.Error("failed to publish root",
+		if errors.Is(err, rewards.ErrWeekAlreadyPublished) {
+			t.keeper.logger.Warn("root already published on-chain, skipping publication",
 				zap.Uint64("week", week),
 				zap.Error(err),
 			)
-			// Don't return error - distribution is saved, can retry publish later
-			t.sendAlert("RewardsPublishFailed", map[string]interface{}{
-				"week":  week,
-				"error": err.Error(),
-			})
+			return nil
+		}
+		if err != nil {
+			// The distribution is saved; the next run publishes it again
+			t.publishFailed(ctx, week, "RewardsPublishFailed", err, nil)
 			return nil
 		}
 
@@ -138,15 +253,7 @@ func (t *RewardsTask) Execute(ctx context.Context) error {
 		t.keeper.logger.Info("waiting for confirmation...")
 		receipt, err := t.publisher.WaitForConfirmation(ctx, tx, 3)
 		if err != nil {
-			t.keeper.logger.Error("transaction failed",
-				zap.Uint64("week", week),
-				zap.Error(err),
-			)
-			t.sendAlert("RewardsTransactionFailed", map[string]interface{}{
-				"week":   week,
-				"txHash": tx.Hash().Hex(),
-				"error":  err.Error(),
-			})
+			t.publishFailed(ctx, week, "RewardsTransactionFailed", err, tx)
 			return nil
 		}
 
@@ -155,6 +262,11 @@ func (t *RewardsTask) Execute(ctx context.Context) error {
 			zap.Uint64("gasUsed", receipt.GasUsed),
 		)
 
+		if err := t.publisher.VerifyReceipt(receipt, distribution); err != nil {
+			t.publishFailed(ctx, week, "RewardsPublishEventMismatch", err, tx)
+			return nil
+		}
+
 		// Verify on-chain
 		publishedRoot, err := t.publisher.GetPublishedRoot(ctx, week)
 		if err != nil {
@@ -169,6 +281,9 @@ func (t *RewardsTask) Execute(ctx context.Context) error {
 				zap.String("root", publishedRoot.Hex()),
 			)
 		}
+
+		// The week's budget usage and campaign spending become due once its root is marked published
+		t.markPublished(ctx, week, tx.Hash(), receipt.BlockNumber.Uint64())
 	} else {
 		t.keeper.logger.Warn("skipping on-chain publication (no publisher configured)")
 	}
@@ -190,23 +305,234 @@ func (t *RewardsTask) Execute(ctx context.Context) error {
 	return nil
 }
 
-// shouldRunNow checks if rewards task should run now
-// Runs at Sunday 23:59 UTC (or whenever task interval triggers near that time)
-func (t *RewardsTask) shouldRunNow() bool {
-	now := time.Now().UTC()
+// reserveBudget locks the week's pool budgets with calculateScale and records
+// the reservation so markBudgetUsed follows once the root is published
+func (t *RewardsTask) reserveBudget(ctx context.Context, week uint64, plan *rewards.ScalePlan) error {
+	if err := t.budget.Reserve(ctx, plan); err != nil {
+		return err
+	}
+	if err := t.aggregator.MarkBudgetReserved(ctx, week); err != nil {
+		return fmt.Errorf("failed to record budget reservation: %w", err)
+	}
+	return nil
+}
 
-	// Check if it's Sunday
-	if now.Weekday() != time.Sunday {
-		return false
+// publishFailed logs and alerts a failed publication attempt and stores it as
+// the week's publish status so the next run retries. tx is the publishRoot
+// transaction when one was sent.
+func (t *RewardsTask) publishFailed(ctx context.Context, week uint64, title string, err error, tx *types.Transaction) {
+	alertContext := map[string]interface{}{
+		"week":  week,
+		"error": err.Error(),
+	}
+	fields := []zap.Field{zap.Uint64("week", week), zap.Error(err)}
+	if tx != nil {
+		alertContext["txHash"] = tx.Hash().Hex()
+		fields = append(fields, zap.String("txHash", tx.Hash().Hex()))
 	}
 
-	// Check if it's between 23:00 and 23:59
-	hour := now.Hour()
-	if hour != 23 {
-		return false
+	t.keeper.logger.Error("failed to publish rewards root", append(fields, zap.String("step", title))...)
+	t.sendAlert(title, alertContext)
+
+	if err := t.aggregator.MarkPublishFailed(ctx, week, err); err != nil {
+		t.keeper.logger.Error("failed to save publish status",
+			zap.Uint64("week", week),
+			zap.Error(err),
+		)
+	}
+}
+
+// markPublished stores that the week's root is on-chain and records the campaign
+// spending that became due
+func (t *RewardsTask) markPublished(ctx context.Context, week uint64, txHash common.Hash, blockNumber uint64) {
+	if err := t.aggregator.MarkPublished(ctx, week, txHash, blockNumber); err != nil {
+		t.keeper.logger.Error("failed to mark distribution published",
+			zap.Uint64("week", week),
+			zap.Error(err),
+		)
+		t.sendAlert("RewardsMarkPublishedFailed", map[string]interface{}{
+			"week":  week,
+			"error": err.Error(),
+		})
+		return
 	}
 
-	return true
+	// Move the reserved budget to used and deduct campaign rewards from each
+	// campaign's remaining budget
+	t.markBudgetUsed(ctx)
+	t.recordCampaignSpending(ctx)
+}
+
+// markBudgetUsed calls markBudgetUsed for published weeks whose budget is still
+// only reserved, using the amounts of the saved distribution. markBudgetUsed is
+// not idempotent on-chain, so a week is marked used as soon as it succeeds;
+// failed weeks stay reserved and are retried on the next run.
+func (t *RewardsTask) markBudgetUsed(ctx context.Context) {
+	if t.budget == nil {
+		return
+	}
+
+	weeks, err := t.aggregator.ReservedBudgetWeeks(ctx)
+	if err != nil {
+		t.keeper.logger.Error("failed to list reserved reward budgets", zap.Error(err))
+		return
+	}
+
+	for _, week := range weeks {
+		if err := t.markWeekBudgetUsed(ctx, week); err != nil {
+			t.keeper.logger.Error("failed to mark reward budget used",
+				zap.Uint64("week", week),
+				zap.Error(err),
+			)
+			t.sendAlert("RewardsBudgetMarkUsedFailed", map[string]interface{}{
+				"week":  week,
+				"error": err.Error(),
+			})
+			continue
+		}
+		t.keeper.logger.Info("reward budget marked used", zap.Uint64("week", week))
+	}
+}
+
+func (t *RewardsTask) markWeekBudgetUsed(ctx context.Context, week uint64) error {
+	distribution, err := t.aggregator.GetDistribution(ctx, week)
+	if err != nil {
+		return err
+	}
+	if err := t.budget.MarkUsed(ctx, rewards.DistributionPlan(distribution, t.budget.Pools())); err != nil {
+		return err
+	}
+	return t.aggregator.MarkBudgetUsed(ctx, week)
+}
+
+// recordCampaignSpending records the pending campaign spending of published weeks.
+// Failed entries stay pending and are retried on the next run.
+func (t *RewardsTask) recordCampaignSpending(ctx context.Context) {
+	if t.campaign == nil || !t.campaign.CanRecordSpending() {
+		return
+	}
+
+	recorded, err := t.aggregator.RecordCampaignSpending(ctx, t.campaign)
+	if recorded > 0 {
+		t.keeper.logger.Info("campaign spending recorded", zap.Int("count", recorded))
+	}
+	if err != nil {
+		t.keeper.logger.Error("failed to record campaign spending", zap.Error(err))
+		t.sendAlert("RewardsCampaignSpendingFailed", map[string]interface{}{
+			"recorded": recorded,
+			"error":    err.Error(),
+		})
+	}
+}
+
+// exportSafeTransaction writes publishRoot as an unsigned Safe batch instead of sending it.
+// The budget is reserved before export; once the root shows up on-chain a later run
+// marks the week published, marks its budget used and records its campaign spending.
+func (t *RewardsTask) exportSafeTransaction(distribution *rewards.MerkleDistribution) {
+	path, err := t.writeSafeTransaction(distribution)
+	if err != nil {
+		t.keeper.logger.Error("failed to export Safe transaction",
+			zap.Uint64("week", distribution.Week),
+			zap.Error(err),
+		)
+		t.sendAlert("RewardsSafeExportFailed", map[string]interface{}{
+			"week":  distribution.Week,
+			"error": err.Error(),
+		})
+		return
+	}
+
+	t.keeper.logger.Info("publishRoot exported as Safe transaction",
+		zap.Uint64("week", distribution.Week),
+		zap.String("file", path),
+	)
+	t.sendAlert("RewardsSafeTransactionExported", map[string]interface{}{
+		"week": distribution.Week,
+		"root": distribution.Root,
+		"file": path,
+	})
+}
+
+func (t *RewardsTask) writeSafeTransaction(distribution *rewards.MerkleDistribution) (string, error) {
+	var safe common.Address
+	if t.config.SafeAddress != "" {
+		safe = common.HexToAddress(t.config.SafeAddress)
+	}
+
+	batch, err := t.publisher.SafeTransaction(distribution, safe)
+	if err != nil {
+		return "", err
+	}
+
+	if err := os.MkdirAll(t.config.SafeExportDir, 0o755); err != nil {
+		return "", fmt.Errorf("failed to create export directory: %w", err)
+	}
+
+	path := t.safeTransactionPath(distribution.Week)
+	file, err := os.Create(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to create %s: %w", path, err)
+	}
+	defer file.Close()
+
+	if err := rewards.WriteSafeBatch(file, batch); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+// safeTransactionPath is the exported Safe batch file of a week
+func (t *RewardsTask) safeTransactionPath(week uint64) string {
+	return filepath.Join(t.config.SafeExportDir, fmt.Sprintf("publish-root-week-%d.json", week))
+}
+
+// checkBudget logs the budget scaling plan and alerts when a pool is scaled
+// below the configured threshold
+func (t *RewardsTask) checkBudget(plan *rewards.ScalePlan) {
+	for _, pool := range plan.Pools {
+		t.keeper.logger.Info("reward budget scaled",
+			zap.Uint64("week", plan.Week),
+			zap.String("pool", pool.Pool.String()),
+			zap.String("requested", pool.Requested.String()),
+			zap.String("available", pool.Available.String()),
+			zap.Uint64("scaleBps", pool.ScaleBps),
+		)
+
+		if pool.ScaleBps >= t.config.BudgetWarningBps {
+			continue
+		}
+
+		t.keeper.logger.Warn("reward budget below warning threshold",
+			zap.Uint64("week", plan.Week),
+			zap.String("pool", pool.Pool.String()),
+			zap.Uint64("scaleBps", pool.ScaleBps),
+			zap.Uint64("thresholdBps", t.config.BudgetWarningBps),
+		)
+
+		if t.keeper.alertManager == nil || !t.keeper.config.AlertsEnabled {
+			continue
+		}
+		alert := NewBudgetWarningAlert(plan.Week, pool.Pool.String(), pool.ScaleBps, t.config.BudgetWarningBps, map[string]interface{}{
+			"requested": pool.Requested.String(),
+			"available": pool.Available.String(),
+		})
+		if err := t.keeper.alertManager.Notify(context.Background(), alert); err != nil {
+			t.keeper.logger.Warn("failed to send budget warning alert", zap.Error(err))
+		}
+	}
+}
+
+// shouldRunNow checks if rewards task should run now: during the final hour of
+// the current epoch (Sunday 23:00-23:59 UTC with the default weekly calendar)
+// Runs at Sunday 23:59 UTC (or whenever task interval triggers near that time)
+func (t *RewardsTask) shouldRunNow() bool {
+	return inFinalHour(t.aggregator.Calendar(), time.Now())
+}
+
+// inFinalHour reports whether now falls within the last hour of its epoch
+func inFinalHour(calendar *rewards.EpochCalendar, now time.Time) bool {
+	_, end := calendar.Range(calendar.EpochAt(now))
+	return !now.Before(end.Add(-time.Hour))
 }
 
 // sendAlert sends an alert through the alert manager
@@ -234,7 +560,8 @@ func (t *RewardsTask) sendAlert(title string, alertContext map[string]interface{
 
 // CreateRewardsPublisher creates a rewards publisher if configured
 func CreateRewardsPublisher(config RewardsConfig, logger *zap.Logger) (*rewards.Publisher, error) {
-	if config.DistributorAddress == "" || config.PrivateKey == "" || config.RPCEndpoint == "" {
+	// Exporting Safe transactions does not need a signing key
+	if config.DistributorAddress == "" || config.RPCEndpoint == "" || (config.PrivateKey == "" && config.SafeExportDir == "") {
 		logger.Warn("rewards publisher not configured (missing distributor/privateKey/rpc)")
 		return nil, nil
 	}
@@ -254,3 +581,46 @@ func CreateRewardsPublisher(config RewardsConfig, logger *zap.Logger) (*rewards.
 
 	return publisher, nil
 }
+
+// CreateRewardsBudgetScaler creates the PayoutScaler budget scaler for the rewards task
+func CreateRewardsBudgetScaler(config RewardsConfig, logger *zap.Logger) (*rewards.BudgetScaler, error) {
+	pools, err := config.BudgetPools()
+	if err != nil {
+		return nil, err
+	}
+
+	scaler, err := rewards.NewBudgetScaler(
+		config.RPCEndpoint,
+		common.HexToAddress(config.PayoutScalerAddress),
+		config.PrivateKey,
+		pools,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("rewards budget scaler initialized",
+		zap.String("payoutScaler", config.PayoutScalerAddress),
+		zap.Uint64("warningBps", config.BudgetWarningBps),
+	)
+
+	return scaler, nil
+}
+
+// CreateRewardsCampaignLedger creates the Campaign ledger used to cap and record campaign rewards
+func CreateRewardsCampaignLedger(config RewardsConfig, logger *zap.Logger) (*rewards.CampaignLedger, error) {
+	ledger, err := rewards.NewCampaignLedger(
+		config.RPCEndpoint,
+		common.HexToAddress(config.CampaignAddress),
+		config.PrivateKey,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("rewards campaign ledger initialized",
+		zap.String("campaign", config.CampaignAddress),
+	)
+
+	return ledger, nil
+}
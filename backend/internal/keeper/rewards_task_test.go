@@ -0,0 +1,345 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/pitchone/sportsbook/internal/repository"
+	"github.com/pitchone/sportsbook/internal/rewards"
+)
+
+func TestInFinalHour(t *testing.T) {
+	// Default calendar: weekly epochs starting Monday 2024-01-01 UTC
+	weekly := rewards.DefaultEpochCalendar()
+	assert.True(t, inFinalHour(weekly, time.Date(2024, 11, 17, 23, 30, 0, 0, time.UTC)))  // Sunday 23:30
+	assert.False(t, inFinalHour(weekly, time.Date(2024, 11, 17, 22, 59, 0, 0, time.UTC))) // Sunday 22:59
+	assert.False(t, inFinalHour(weekly, time.Date(2024, 11, 16, 23, 30, 0, 0, time.UTC))) // Saturday
+
+	// Daily epochs in a non-UTC timezone close at local midnight
+	daily, err := rewards.NewEpochCalendar([]rewards.EpochPeriod{{Start: "2024-01-01", Length: "daily", Timezone: "Asia/Shanghai"}})
+	require.NoError(t, err)
+	assert.True(t, inFinalHour(daily, time.Date(2024, 11, 16, 15, 10, 0, 0, time.UTC)))  // 23:10 CST
+	assert.False(t, inFinalHour(daily, time.Date(2024, 11, 16, 23, 10, 0, 0, time.UTC))) // 07:10 CST
+}
+
+// fakeRewardsAggregator keeps saved distributions and their publish status in memory
+type fakeRewardsAggregator struct {
+	calendar *rewards.EpochCalendar
+	dist     *rewards.MerkleDistribution
+	saved    map[uint64]*rewards.MerkleDistribution
+	status   map[uint64]string
+	budget   map[uint64]string
+	builds   int
+}
+
+func newFakeRewardsAggregator(t *testing.T, week uint64) *fakeRewardsAggregator {
+	dist, err := rewards.BuildDistribution(week, []rewards.RewardEntry{
+		{User: common.HexToAddress("0x1111111111111111111111111111111111111111"), Week: week, Amount: "1000000"},
+		{User: common.HexToAddress("0x2222222222222222222222222222222222222222"), Week: week, Amount: "2500000"},
+	}, 10000)
+	require.NoError(t, err)
+
+	return &fakeRewardsAggregator{
+		calendar: rewards.DefaultEpochCalendar(),
+		dist:     dist,
+		saved:    make(map[uint64]*rewards.MerkleDistribution),
+		status:   make(map[uint64]string),
+		budget:   make(map[uint64]string),
+	}
+}
+
+func (a *fakeRewardsAggregator) Calendar() *rewards.EpochCalendar { return a.calendar }
+
+func (a *fakeRewardsAggregator) PublishStatus(ctx context.Context, week uint64) (string, error) {
+	status, ok := a.status[week]
+	if !ok {
+		return "", rewards.ErrDistributionNotFound
+	}
+	return status, nil
+}
+
+func (a *fakeRewardsAggregator) GetDistribution(ctx context.Context, week uint64) (*rewards.MerkleDistribution, error) {
+	dist, ok := a.saved[week]
+	if !ok {
+		return nil, rewards.ErrDistributionNotFound
+	}
+	return dist, nil
+}
+
+func (a *fakeRewardsAggregator) BuildWeeklyDistribution(ctx context.Context, week uint64, scaler *rewards.BudgetScaler) (*rewards.MerkleDistribution, *rewards.ScalePlan, error) {
+	a.builds++
+	dist := *a.dist
+	return &dist, &rewards.ScalePlan{Week: week}, nil
+}
+
+func (a *fakeRewardsAggregator) SaveDistribution(ctx context.Context, dist *rewards.MerkleDistribution, plan *rewards.ScalePlan) error {
+	a.saved[dist.Week] = dist
+	if _, ok := a.status[dist.Week]; !ok {
+		a.status[dist.Week] = repository.DistributionPending
+	}
+	return nil
+}
+
+func (a *fakeRewardsAggregator) MarkPublished(ctx context.Context, week uint64, txHash common.Hash, blockNumber uint64) error {
+	a.status[week] = repository.DistributionPublished
+	return nil
+}
+
+func (a *fakeRewardsAggregator) MarkPublishFailed(ctx context.Context, week uint64, reason error) error {
+	a.status[week] = repository.DistributionFailed
+	return nil
+}
+
+func (a *fakeRewardsAggregator) MarkBudgetReserved(ctx context.Context, week uint64) error {
+	if a.budget[week] != repository.DistributionBudgetUsed {
+		a.budget[week] = repository.DistributionBudgetReserved
+	}
+	return nil
+}
+
+func (a *fakeRewardsAggregator) MarkBudgetUsed(ctx context.Context, week uint64) error {
+	a.budget[week] = repository.DistributionBudgetUsed
+	return nil
+}
+
+func (a *fakeRewardsAggregator) ReservedBudgetWeeks(ctx context.Context) ([]uint64, error) {
+	var weeks []uint64
+	for week, status := range a.budget {
+		if status == repository.DistributionBudgetReserved && a.status[week] == repository.DistributionPublished {
+			weeks = append(weeks, week)
+		}
+	}
+	return weeks, nil
+}
+
+func (a *fakeRewardsAggregator) RecordCampaignSpending(ctx context.Context, ledger *rewards.CampaignLedger) (int, error) {
+	return 0, nil
+}
+
+// fakeRewardsPublisher publishes roots in memory and fails the first publishFails attempts
+type fakeRewardsPublisher struct {
+	roots        map[uint64]common.Hash
+	publishFails int
+	publishCalls int
+	exports      int
+}
+
+func (p *fakeRewardsPublisher) CheckPublished(ctx context.Context, dist *rewards.MerkleDistribution) (bool, error) {
+	root, ok := p.roots[dist.Week]
+	if !ok {
+		return false, nil
+	}
+	if root.Hex() != dist.Root {
+		return false, errors.New("different root published")
+	}
+	return true, nil
+}
+
+func (p *fakeRewardsPublisher) PublishRoot(ctx context.Context, dist *rewards.MerkleDistribution) (*types.Transaction, error) {
+	p.publishCalls++
+	if p.publishCalls <= p.publishFails {
+		return nil, errors.New("nonce too low")
+	}
+	p.roots[dist.Week] = common.HexToHash(dist.Root)
+	return types.NewTx(&types.LegacyTx{Nonce: uint64(p.publishCalls)}), nil
+}
+
+func (p *fakeRewardsPublisher) WaitForConfirmation(ctx context.Context, tx *types.Transaction, confirmations uint64) (*types.Receipt, error) {
+	return &types.Receipt{Status: types.ReceiptStatusSuccessful, TxHash: tx.Hash(), BlockNumber: big.NewInt(100)}, nil
+}
+
+func (p *fakeRewardsPublisher) VerifyReceipt(receipt *types.Receipt, dist *rewards.MerkleDistribution) error {
+	return nil
+}
+
+func (p *fakeRewardsPublisher) GetPublishedRoot(ctx context.Context, week uint64) (common.Hash, error) {
+	return p.roots[week], nil
+}
+
+func (p *fakeRewardsPublisher) SafeTransaction(dist *rewards.MerkleDistribution, safe common.Address) (*rewards.SafeBatch, error) {
+	p.exports++
+	return rewards.NewPublishRootSafeBatch(big.NewInt(1), common.Address{}, safe, dist)
+}
+
+// fakeRewardsBudget records the plans passed to Reserve and MarkUsed
+type fakeRewardsBudget struct {
+	reserved []*rewards.ScalePlan
+	used     []*rewards.ScalePlan
+}
+
+func (b *fakeRewardsBudget) Pools() map[rewards.RewardType]rewards.BudgetPool {
+	return rewards.DefaultCategoryPools()
+}
+
+func (b *fakeRewardsBudget) Reserve(ctx context.Context, plan *rewards.ScalePlan) error {
+	b.reserved = append(b.reserved, plan)
+	return nil
+}
+
+func (b *fakeRewardsBudget) MarkUsed(ctx context.Context, plan *rewards.ScalePlan) error {
+	b.used = append(b.used, plan)
+	return nil
+}
+
+func TestRewardsTask_RetriesFailedPublish(t *testing.T) {
+	const week = 300
+	ctx := context.Background()
+	aggregator := newFakeRewardsAggregator(t, week)
+	publisher := &fakeRewardsPublisher{roots: make(map[uint64]common.Hash), publishFails: 1}
+	task := &RewardsTask{
+		keeper:     &Keeper{config: &Config{}, logger: zap.NewNop()},
+		aggregator: aggregator,
+		publisher:  publisher,
+	}
+
+	// First attempt fails: the distribution is saved and marked failed
+	require.NoError(t, task.processWeek(ctx, week))
+	assert.Equal(t, repository.DistributionFailed, aggregator.status[week])
+	assert.Equal(t, 1, publisher.publishCalls)
+	assert.Empty(t, publisher.roots)
+
+	// The saved but unpublished week is published on the next run
+	require.NoError(t, task.processWeek(ctx, week))
+	assert.Equal(t, repository.DistributionPublished, aggregator.status[week])
+	assert.Equal(t, 2, publisher.publishCalls)
+	assert.Equal(t, aggregator.dist.Root, publisher.roots[week].Hex())
+
+	// Confirmed weeks are skipped without rebuilding or publishing again
+	require.NoError(t, task.processWeek(ctx, week))
+	assert.Equal(t, 2, publisher.publishCalls)
+	assert.Equal(t, 1, aggregator.builds)
+}
+
+func TestRewardsTask_RepublishesSavedDistribution(t *testing.T) {
+	const week = 300
+	ctx := context.Background()
+	aggregator := newFakeRewardsAggregator(t, week)
+	publisher := &fakeRewardsPublisher{roots: make(map[uint64]common.Hash), publishFails: 1}
+	budget := &fakeRewardsBudget{}
+	task := &RewardsTask{
+		keeper:     &Keeper{config: &Config{}, logger: zap.NewNop()},
+		aggregator: aggregator,
+		publisher:  publisher,
+		budget:     budget,
+	}
+
+	require.NoError(t, task.processWeek(ctx, week))
+	saved := aggregator.saved[week]
+	require.NotNil(t, saved)
+
+	// Late data would change the root if the week were aggregated again
+	changed, err := rewards.BuildDistribution(week, []rewards.RewardEntry{
+		{User: common.HexToAddress("0x3333333333333333333333333333333333333333"), Week: week, Amount: "42"},
+	}, 10000)
+	require.NoError(t, err)
+	aggregator.dist = changed
+
+	// The retry publishes the saved root and leaves the saved proofs alone
+	require.NoError(t, task.processWeek(ctx, week))
+	assert.Equal(t, 1, aggregator.builds)
+	assert.Same(t, saved, aggregator.saved[week])
+	assert.Equal(t, saved.Root, publisher.roots[week].Hex())
+	assert.Equal(t, repository.DistributionPublished, aggregator.status[week])
+
+	// Both attempts reserved the budget; it is marked used once after publication
+	assert.Len(t, budget.reserved, 2)
+	assert.Len(t, budget.used, 1)
+	assert.Equal(t, repository.DistributionBudgetUsed, aggregator.budget[week])
+}
+
+func TestRewardsTask_SafeExportReservesBudgetAndWaitsForExecution(t *testing.T) {
+	const week = 300
+	ctx := context.Background()
+	aggregator := newFakeRewardsAggregator(t, week)
+	publisher := &fakeRewardsPublisher{roots: make(map[uint64]common.Hash)}
+	budget := &fakeRewardsBudget{}
+	task := &RewardsTask{
+		keeper:     &Keeper{config: &Config{}, logger: zap.NewNop()},
+		aggregator: aggregator,
+		publisher:  publisher,
+		budget:     budget,
+		config:     RewardsConfig{SafeExportDir: t.TempDir()},
+	}
+
+	// The budget is reserved before the batch is handed to the treasury
+	require.NoError(t, task.processWeek(ctx, week))
+	assert.Equal(t, 1, publisher.exports)
+	assert.FileExists(t, task.safeTransactionPath(week))
+	assert.Len(t, budget.reserved, 1)
+	assert.Equal(t, repository.DistributionBudgetReserved, aggregator.budget[week])
+	assert.Equal(t, repository.DistributionPending, aggregator.status[week])
+
+	// Until the Safe executes, later runs neither rebuild nor export a new batch
+	require.NoError(t, task.processWeek(ctx, week))
+	assert.Equal(t, 1, publisher.exports)
+	assert.Equal(t, 1, aggregator.builds)
+	assert.Empty(t, budget.used)
+
+	// Once the root is on-chain the week is marked published and its budget used
+	publisher.roots[week] = common.HexToHash(aggregator.saved[week].Root)
+	require.NoError(t, task.processWeek(ctx, week))
+	assert.Equal(t, repository.DistributionPublished, aggregator.status[week])
+	assert.Equal(t, 0, publisher.publishCalls)
+	require.Len(t, budget.used, 1)
+	assert.Equal(t, uint64(week), budget.used[0].Week)
+	assert.Equal(t, repository.DistributionBudgetUsed, aggregator.budget[week])
+
+	// markBudgetUsed is not repeated on later runs
+	task.markBudgetUsed(ctx)
+	assert.Len(t, budget.used, 1)
+}
+
+func TestRewardsTask_MarksPublishedAfterInterruptedRun(t *testing.T) {
+	const week = 300
+	ctx := context.Background()
+	aggregator := newFakeRewardsAggregator(t, week)
+	publisher := &fakeRewardsPublisher{roots: make(map[uint64]common.Hash)}
+	task := &RewardsTask{
+		keeper:     &Keeper{config: &Config{}, logger: zap.NewNop()},
+		aggregator: aggregator,
+		publisher:  publisher,
+	}
+
+	// A previous run saved and published the root but stopped before marking it
+	require.NoError(t, aggregator.SaveDistribution(ctx, aggregator.dist, nil))
+	publisher.roots[week] = common.HexToHash(aggregator.dist.Root)
+
+	require.NoError(t, task.processWeek(ctx, week))
+	assert.Equal(t, repository.DistributionPublished, aggregator.status[week])
+	assert.Equal(t, 0, publisher.publishCalls)
+	assert.Equal(t, 0, aggregator.builds)
+}
+
+func TestRewardsTask_PropagatesStatusLookupError(t *testing.T) {
+	ctx := context.Background()
+	aggregator := &failingStatusAggregator{fakeRewardsAggregator: newFakeRewardsAggregator(t, 300)}
+	publisher := &fakeRewardsPublisher{roots: make(map[uint64]common.Hash)}
+	task := &RewardsTask{
+		keeper:     &Keeper{config: &Config{}, logger: zap.NewNop()},
+		aggregator: aggregator,
+		publisher:  publisher,
+	}
+
+	err := task.processWeek(ctx, 300)
+	assert.ErrorContains(t, err, "connection refused")
+	assert.Equal(t, 0, aggregator.builds)
+	assert.Equal(t, 0, publisher.publishCalls)
+}
+
+// failingStatusAggregator fails every publish status lookup
+type failingStatusAggregator struct {
+	*fakeRewardsAggregator
+}
+
+func (a *failingStatusAggregator) PublishStatus(ctx context.Context, week uint64) (string, error) {
+	return "", errors.New("connection refused")
+}
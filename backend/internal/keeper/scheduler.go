@@ -21,6 +21,16 @@ type ScheduledTask struct {
 	Interval time.Duration
 	ticker   *time.Ticker
 	stopChan chan struct{}
+	trigger  chan struct{} // run-now requests from the admin API
+
+	// Runtime state, guarded by mu
+	mu           sync.Mutex
+	paused       bool
+	running      bool
+	lastRun      time.Time
+	lastDuration time.Duration
+	lastError    string
+	runCount     int
 }
 
 // Scheduler manages scheduled tasks
@@ -55,6 +65,7 @@ func (s *Scheduler) RegisterTask(name string, task Task, interval time.Duration)
 		Task:     task,
 		Interval: interval,
 		stopChan: make(chan struct{}),
+		trigger:  make(chan struct{}, 1),
 	}
 }
 
@@ -137,6 +148,18 @@ func (s *Scheduler) runTask(ctx context.Context, task *ScheduledTask) {
 			)
 			return
 		case <-task.ticker.C:
+			if task.isPaused() {
+				s.keeper.logger.Debug("task paused, skipping scheduled run",
+					zap.String("name", task.Name),
+				)
+				continue
+			}
+			s.executeTask(ctx, task)
+		case <-task.trigger:
+			// Manual runs are executed even while the task is paused
+			s.keeper.logger.Info("manual task run triggered",
+				zap.String("name", task.Name),
+			)
 			s.executeTask(ctx, task)
 		}
 	}
@@ -149,13 +172,19 @@ func (s *Scheduler) executeTask(ctx context.Context, task *ScheduledTask) {
 	)
 
 	startTime := time.Now()
+	task.markStarted(startTime)
 
 	// Execute with retries
 	var lastErr error
+	defer func() {
+		task.markFinished(time.Since(startTime), lastErr)
+	}()
+
 	for attempt := 1; attempt <= s.keeper.config.RetryAttempts; attempt++ {
 		err := task.Task.Execute(ctx)
 		if err == nil {
 			// Success
+			lastErr = nil
 			duration := time.Since(startTime)
 			s.keeper.logger.Info("task executed successfully",
 				zap.String("name", task.Name),
@@ -219,15 +248,113 @@ func (s *Scheduler) GetTaskStatus(name string) (map[string]interface{}, error) {
 		return nil, fmt.Errorf("task not found: %s", name)
 	}
 
+	task.mu.Lock()
+	defer task.mu.Unlock()
+
 	status := map[string]interface{}{
-		"name":     task.Name,
-		"interval": task.Interval.String(),
-		"running":  task.ticker != nil,
+		"name":      task.Name,
+		"interval":  task.Interval.String(),
+		"running":   task.ticker != nil,
+		"paused":    task.paused,
+		"executing": task.running,
+		"runCount":  task.runCount,
+		"lastError": task.lastError,
+	}
+	if !task.lastRun.IsZero() {
+		status["lastRun"] = task.lastRun.UTC().Format(time.RFC3339)
+		status["lastDuration"] = task.lastDuration.String()
 	}
 
 	return status, nil
 }
 
+// PauseTask stops scheduled runs of a task until it is resumed
+func (s *Scheduler) PauseTask(name string) error {
+	task, err := s.getTask(name)
+	if err != nil {
+		return err
+	}
+
+	task.mu.Lock()
+	task.paused = true
+	task.mu.Unlock()
+
+	s.keeper.logger.Info("task paused", zap.String("name", name))
+	return nil
+}
+
+// ResumeTask re-enables scheduled runs of a paused task
+func (s *Scheduler) ResumeTask(name string) error {
+	task, err := s.getTask(name)
+	if err != nil {
+		return err
+	}
+
+	task.mu.Lock()
+	task.paused = false
+	task.mu.Unlock()
+
+	s.keeper.logger.Info("task resumed", zap.String("name", name))
+	return nil
+}
+
+// TriggerTask requests an immediate run of a task.
+// A request is dropped if another one is already pending.
+func (s *Scheduler) TriggerTask(name string) error {
+	task, err := s.getTask(name)
+	if err != nil {
+		return err
+	}
+
+	select {
+	case task.trigger <- struct{}{}:
+	default:
+		return fmt.Errorf("task %s already has a pending run", name)
+	}
+
+	return nil
+}
+
+// getTask looks up a registered task by name
+func (s *Scheduler) getTask(name string) (*ScheduledTask, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	task, exists := s.tasks[name]
+	if !exists {
+		return nil, fmt.Errorf("task not found: %s", name)
+	}
+	return task, nil
+}
+
+// isPaused reports whether scheduled runs are paused
+func (t *ScheduledTask) isPaused() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.paused
+}
+
+// markStarted records the start of an execution
+func (t *ScheduledTask) markStarted(at time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.running = true
+	t.lastRun = at
+}
+
+// markFinished records the outcome of an execution
+func (t *ScheduledTask) markFinished(duration time.Duration, err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.running = false
+	t.lastDuration = duration
+	t.runCount++
+	t.lastError = ""
+	if err != nil {
+		t.lastError = err.Error()
+	}
+}
+
 // ListTasks returns a list of all registered tasks
 func (s *Scheduler) ListTasks() []string {
 	s.mu.RLock()
@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pitchone/sportsbook/internal/repository"
+)
+
+// TestMatchResultFromOverride tests conversion of an approved override into a match result
+func TestMatchResultFromOverride(t *testing.T) {
+	tests := []struct {
+		name                   string
+		home, away             uint8
+		homeWin, awayWin, draw bool
+	}{
+		{"home win", 2, 1, true, false, false},
+		{"away win", 0, 3, false, true, false},
+		{"draw", 1, 1, false, false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &repository.ResultOverride{ID: 7, HomeGoals: tt.home, AwayGoals: tt.away}
+			result := matchResultFromOverride(o)
+
+			assert.Equal(t, tt.home, result.HomeGoals)
+			assert.Equal(t, tt.away, result.AwayGoals)
+			assert.Equal(t, tt.homeWin, result.HomeWin)
+			assert.Equal(t, tt.awayWin, result.AwayWin)
+			assert.Equal(t, tt.draw, result.Draw)
+			assert.Same(t, o, result.Override)
+		})
+	}
+}
+
+// TestOverrideAlertContext tests that the approval trail is attached to the alert context
+func TestOverrideAlertContext(t *testing.T) {
+	created := time.Date(2025, 3, 1, 20, 0, 0, 0, time.UTC).Unix()
+	o := &repository.ResultOverride{
+		ID:          12,
+		HomeGoals:   2,
+		AwayGoals:   0,
+		Reason:      "fixture missing from feed",
+		EvidenceURL: "https://example.com/match",
+		CreatedBy:   "alice",
+		ApprovedBy:  "bob",
+		Trail: []repository.OverrideEvent{
+			{Action: "created", Actor: "alice", Note: "fixture missing from feed", CreatedAt: created},
+			{Action: "approved", Actor: "bob", CreatedAt: created + 60},
+		},
+	}
+
+	ctx := overrideAlertContext(o)
+
+	assert.Equal(t, int64(12), ctx["override_id"])
+	assert.Equal(t, "2-0", ctx["score"])
+	assert.Equal(t, "alice", ctx["created_by"])
+	assert.Equal(t, "bob", ctx["approved_by"])
+	assert.Equal(t, "https://example.com/match", ctx["evidence_url"])
+	assert.Equal(t, []string{
+		"2025-03-01T20:00:00Z created by alice: fixture missing from feed",
+		"2025-03-01T20:01:00Z approved by bob",
+	}, ctx["trail"])
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
 	"sync"
@@ -11,7 +12,10 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/pitchone/sportsbook/internal/datasource"
+	"github.com/pitchone/sportsbook/internal/graphql"
+	"github.com/pitchone/sportsbook/internal/repository"
 	"github.com/pitchone/sportsbook/pkg/bindings"
+	"github.com/pitchone/sportsbook/pkg/results"
 	"go.uber.org/zap"
 )
 
@@ -19,6 +23,14 @@ import (
 type SettleTask struct {
 	keeper     *Keeper
 	dataSource datasource.ResultProvider
+
+	// Operator-supplied results, used in place of the data source
+	manualResults   map[common.Address]*MatchResult
+	manualResultsMu sync.RWMutex
+
+	// Markets whose settle tx was dropped by a reorg, retried on the next run
+	requeued  map[common.Address]*MarketToSettle
+	requeueMu sync.Mutex
 }
 
 // MarketToSettle represents a market that needs to be settled
@@ -40,13 +52,18 @@ type MatchResult struct {
 	HomeWin   bool
 	AwayWin   bool
 	Draw      bool
+
+	// Override is set when the result comes from an approved manual override
+	Override *repository.ResultOverride
 }
 
 // NewSettleTask creates a new SettleTask instance
 func NewSettleTask(keeper *Keeper, dataSource datasource.ResultProvider) *SettleTask {
 	return &SettleTask{
-		keeper:     keeper,
-		dataSource: dataSource,
+		keeper:        keeper,
+		dataSource:    dataSource,
+		manualResults: make(map[common.Address]*MatchResult),
+		requeued:      make(map[common.Address]*MarketToSettle),
 	}
 }
 
@@ -60,6 +77,7 @@ func (t *SettleTask) Execute(ctx context.Context) error {
 		t.keeper.logger.Error("failed to get markets to settle", zap.Error(err))
 		return fmt.Errorf("failed to get markets to settle: %w", err)
 	}
+	markets = t.takeRequeued(markets)
 
 	if len(markets) == 0 {
 		t.keeper.logger.Debug("no markets to settle")
@@ -90,54 +108,107 @@ func (t *SettleTask) getMarketsToSettle(ctx context.Context) ([]*MarketToSettle,
 
 	markets := make([]*MarketToSettle, 0, len(subgraphMarkets))
 	for _, m := range subgraphMarkets {
-		// 解析时间戳
-		var kickoffTimeUnix, matchEndTimeUnix int64
-		if m.KickoffTime != "" {
-			fmt.Sscanf(m.KickoffTime, "%d", &kickoffTimeUnix)
-		}
-		if m.MatchEndTime != "" {
-			fmt.Sscanf(m.MatchEndTime, "%d", &matchEndTimeUnix)
+		if t.keeper.skipList.Contains(m.Address()) {
+			t.keeper.logger.Info("market on skip list, not settling",
+				zap.String("market", m.ID),
+			)
+			continue
 		}
 
-		// 确定版本（默认 v3，Subgraph 的市场都是 V3 Factory 创建的）
-		version := m.Version
-		if version == "" {
-			version = "v3"
-		}
+		markets = append(markets, newMarketToSettle(m))
+	}
 
-		// 构建 MarketParams（用于 OU/AH 市场）
-		marketParams := make(map[string]interface{})
-		if m.TemplateID == "OU" || m.TemplateID == "AH" {
-			marketParams["type"] = m.TemplateID
-			// 解析 line（千分位表示）
-			if m.Line != "" {
-				var lineValue int64
-				fmt.Sscanf(m.Line, "%d", &lineValue)
-				marketParams["line"] = float64(lineValue)
-			}
-			marketParams["isHalfLine"] = m.IsHalfLine
-		}
+	return markets, nil
+}
 
-		markets = append(markets, &MarketToSettle{
-			MarketAddress: common.HexToAddress(m.ID),
-			EventID:       m.MatchID,
-			MatchStart:    time.Unix(kickoffTimeUnix, 0),
-			MatchEnd:      time.Unix(matchEndTimeUnix, 0),
-			OracleAddress: m.OracleAddress(),
-			MarketParams:  marketParams,
-			Version:       version,
-		})
+// newMarketToSettle converts a Subgraph market into a settlement job
+func newMarketToSettle(m graphql.Market) *MarketToSettle {
+	// 解析时间戳
+	var kickoffTimeUnix, matchEndTimeUnix int64
+	if m.KickoffTime != "" {
+		fmt.Sscanf(m.KickoffTime, "%d", &kickoffTimeUnix)
+	}
+	if m.MatchEndTime != "" {
+		fmt.Sscanf(m.MatchEndTime, "%d", &matchEndTimeUnix)
 	}
 
-	return markets, nil
+	// 确定版本（默认 v3，Subgraph 的市场都是 V3 Factory 创建的）
+	version := m.Version
+	if version == "" {
+		version = "v3"
+	}
+
+	// 构建 MarketParams（用于 OU/AH 市场）
+	marketParams := make(map[string]interface{})
+	if m.TemplateID == "OU" || m.TemplateID == "AH" {
+		marketParams["type"] = m.TemplateID
+		// 解析 line（千分位表示）
+		if m.Line != "" {
+			var lineValue int64
+			fmt.Sscanf(m.Line, "%d", &lineValue)
+			marketParams["line"] = float64(lineValue)
+		}
+		marketParams["isHalfLine"] = m.IsHalfLine
+	}
+
+	return &MarketToSettle{
+		MarketAddress: common.HexToAddress(m.ID),
+		EventID:       m.MatchID,
+		MatchStart:    time.Unix(kickoffTimeUnix, 0),
+		MatchEnd:      time.Unix(matchEndTimeUnix, 0),
+		OracleAddress: m.OracleAddress(),
+		MarketParams:  marketParams,
+		Version:       version,
+	}
 }
 
 // settleMarket routes settlement to V2 or V3 based on market version
 func (t *SettleTask) settleMarket(ctx context.Context, market *MarketToSettle) error {
+	var err error
 	if market.Version == "v3" {
-		return t.settleMarketV3(ctx, market)
+		err = t.settleMarketV3(ctx, market)
+	} else {
+		err = t.settleMarketV2(ctx, market)
+	}
+
+	if errors.Is(err, ErrTransactionReorged) {
+		t.requeue(market)
+	} else if err == nil {
+		t.clearManualResult(market.MarketAddress)
+	}
+	return err
+}
+
+// requeue schedules a market for another settle attempt on the next run
+func (t *SettleTask) requeue(market *MarketToSettle) {
+	t.requeueMu.Lock()
+	defer t.requeueMu.Unlock()
+
+	t.requeued[market.MarketAddress] = market
+	actionsRequeued.WithLabelValues("settle").Inc()
+
+	t.keeper.logger.Warn("market re-queued for settlement after reorg",
+		zap.String("market", market.MarketAddress.Hex()),
+	)
+}
+
+// takeRequeued appends re-queued markets not already in the list and clears the queue
+func (t *SettleTask) takeRequeued(markets []*MarketToSettle) []*MarketToSettle {
+	t.requeueMu.Lock()
+	defer t.requeueMu.Unlock()
+
+	seen := make(map[common.Address]bool, len(markets))
+	for _, m := range markets {
+		seen[m.MarketAddress] = true
 	}
-	return t.settleMarketV2(ctx, market)
+	for addr, m := range t.requeued {
+		if !seen[addr] && !t.keeper.skipList.Contains(addr) {
+			markets = append(markets, m)
+		}
+		delete(t.requeued, addr)
+	}
+
+	return markets
 }
 
 // settleMarketV2 proposes the result to the oracle (for V2 markets)
@@ -150,8 +221,8 @@ func (t *SettleTask) settleMarketV2(ctx context.Context, market *MarketToSettle)
 		return fmt.Errorf("invalid oracle address: zero address")
 	}
 
-	// Get match result from data source
-	result, err := t.fetchMatchResult(ctx, market.EventID)
+	// Get match result (manual override or data source)
+	result, err := t.matchResultFor(ctx, market)
 	if err != nil {
 		return fmt.Errorf("failed to fetch match result: %w", err)
 	}
@@ -261,6 +332,12 @@ func (t *SettleTask) settleMarketV2(ctx context.Context, market *MarketToSettle)
 		return fmt.Errorf("propose transaction failed: status %d", receipt.Status)
 	}
 
+	// Re-check the receipt once it is buried under the configured confirmation depth
+	receipt, err = t.keeper.waitForConfirmations(ctx, "settle", market.MarketAddress, receipt)
+	if err != nil {
+		return fmt.Errorf("failed to confirm propose transaction: %w", err)
+	}
+
 	t.keeper.logger.Info("propose transaction confirmed",
 		zap.String("market", market.MarketAddress.Hex()),
 		zap.String("txHash", tx.Hash().Hex()),
@@ -268,6 +345,8 @@ func (t *SettleTask) settleMarketV2(ctx context.Context, market *MarketToSettle)
 		zap.Uint64("gasUsed", receipt.GasUsed),
 	)
 
+	t.recordOverrideApplied(ctx, market, result, tx.Hash())
+
 	// 状态由链上事件自动更新到 Subgraph，无需手动更新数据库
 
 	return nil
@@ -280,8 +359,8 @@ func (t *SettleTask) settleMarketV3(ctx context.Context, market *MarketToSettle)
 		return fmt.Errorf("invalid market address: zero address")
 	}
 
-	// Get match result from data source
-	result, err := t.fetchMatchResult(ctx, market.EventID)
+	// Get match result (manual override or data source)
+	result, err := t.matchResultFor(ctx, market)
 	if err != nil {
 		return fmt.Errorf("failed to fetch match result: %w", err)
 	}
@@ -351,6 +430,12 @@ func (t *SettleTask) settleMarketV3(ctx context.Context, market *MarketToSettle)
 		return fmt.Errorf("resolve transaction failed: status %d", receipt.Status)
 	}
 
+	// Re-check the receipt once it is buried under the configured confirmation depth
+	receipt, err = t.keeper.waitForConfirmations(ctx, "settle", market.MarketAddress, receipt)
+	if err != nil {
+		return fmt.Errorf("failed to confirm resolve transaction: %w", err)
+	}
+
 	t.keeper.logger.Info("V3 resolve transaction confirmed",
 		zap.String("market", market.MarketAddress.Hex()),
 		zap.String("txHash", tx.Hash().Hex()),
@@ -358,6 +443,8 @@ func (t *SettleTask) settleMarketV3(ctx context.Context, market *MarketToSettle)
 		zap.Uint64("gasUsed", receipt.GasUsed),
 	)
 
+	t.recordOverrideApplied(ctx, market, result, tx.Hash())
+
 	// 状态由链上事件自动更新到 Subgraph，无需手动更新数据库
 
 	return nil
@@ -365,19 +452,203 @@ func (t *SettleTask) settleMarketV3(ctx context.Context, market *MarketToSettle)
 
 // encodeMatchResult encodes match result as abi.encode(uint256 homeScore, uint256 awayScore)
 func encodeMatchResult(homeGoals, awayGoals uint8) ([]byte, error) {
-	// ABI encode two uint256 values (homeScore, awayScore)
-	// Each uint256 is 32 bytes, left-padded with zeros
-	result := make([]byte, 64)
+	return results.EncodeMatchResult(homeGoals, awayGoals)
+}
 
-	// Encode homeGoals as uint256 (32 bytes, big-endian)
-	homeBytes := big.NewInt(int64(homeGoals)).Bytes()
-	copy(result[32-len(homeBytes):32], homeBytes)
+// resultOverrideStore persists manual result overrides pending two-person approval
+type resultOverrideStore interface {
+	CreateOverride(ctx context.Context, o *repository.ResultOverride) (int64, error)
+	GetApprovedOverride(ctx context.Context, marketAddress string) (*repository.ResultOverride, error)
+	MarkOverrideApplied(ctx context.Context, id int64, actor, txHash string) error
+}
 
-	// Encode awayGoals as uint256 (32 bytes, big-endian)
-	awayBytes := big.NewInt(int64(awayGoals)).Bytes()
-	copy(result[64-len(awayBytes):64], awayBytes)
+// OverridesEnabled reports whether manual results must go through the
+// two-person approval flow of the overrides store
+func (t *SettleTask) OverridesEnabled() bool {
+	return t.keeper.overridesRepo != nil
+}
 
-	return result, nil
+// SubmitManualResult stores an operator-supplied result as a pending override.
+// It is settled by a scheduled run once a different operator approves it.
+func (t *SettleTask) SubmitManualResult(ctx context.Context, marketAddr common.Address, result *MatchResult, reason, evidenceURL string) (int64, error) {
+	if t.keeper.overridesRepo == nil {
+		return 0, errors.New("result overrides are not enabled")
+	}
+
+	id, err := t.keeper.overridesRepo.CreateOverride(ctx, &repository.ResultOverride{
+		MarketAddress: marketAddr.Hex(),
+		HomeGoals:     result.HomeGoals,
+		AwayGoals:     result.AwayGoals,
+		ExtraTime:     result.ExtraTime,
+		Reason:        reason,
+		EvidenceURL:   evidenceURL,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	t.keeper.logger.Info("manual result submitted for approval",
+		zap.String("market", marketAddr.Hex()),
+		zap.Int64("overrideId", id),
+		zap.Uint8("home_goals", result.HomeGoals),
+		zap.Uint8("away_goals", result.AwayGoals),
+	)
+	return id, nil
+}
+
+// SettleWithManualResult settles a locked market with an operator-supplied
+// result, going through the same V2/V3 settlement path as scheduled runs.
+// It is refused when the overrides store is enabled; use SubmitManualResult.
+func (t *SettleTask) SettleWithManualResult(ctx context.Context, marketAddr common.Address, result *MatchResult) error {
+	if t.OverridesEnabled() {
+		return errors.New("result overrides are enabled, manual results require two-person approval")
+	}
+
+	m, err := t.keeper.graphClient.GetMarketByAddress(ctx, marketAddr)
+	if err != nil {
+		return fmt.Errorf("failed to query market: %w", err)
+	}
+	if m == nil {
+		return fmt.Errorf("market not found: %s", marketAddr.Hex())
+	}
+	if m.State != "Locked" {
+		return fmt.Errorf("market %s is %s, only locked markets can be settled", marketAddr.Hex(), m.State)
+	}
+
+	result.HomeWin = result.HomeGoals > result.AwayGoals
+	result.AwayWin = result.AwayGoals > result.HomeGoals
+	result.Draw = result.HomeGoals == result.AwayGoals
+
+	t.manualResultsMu.Lock()
+	t.manualResults[marketAddr] = result
+	t.manualResultsMu.Unlock()
+
+	t.keeper.logger.Info("settling market with manual result",
+		zap.String("market", marketAddr.Hex()),
+		zap.Uint8("home_goals", result.HomeGoals),
+		zap.Uint8("away_goals", result.AwayGoals),
+	)
+
+	err = t.settleMarket(ctx, newMarketToSettle(*m))
+
+	// Keep the manual result for the re-queued attempt if the tx was reorged out
+	if !errors.Is(err, ErrTransactionReorged) {
+		t.clearManualResult(marketAddr)
+	}
+
+	return err
+}
+
+// clearManualResult removes an operator-supplied result once it has been used
+func (t *SettleTask) clearManualResult(marketAddr common.Address) {
+	t.manualResultsMu.Lock()
+	defer t.manualResultsMu.Unlock()
+	delete(t.manualResults, marketAddr)
+}
+
+// matchResultFor returns the result to settle a market with. In order of
+// precedence: an approved override from the overrides store, a manual result
+// from the admin API, then the external data source.
+func (t *SettleTask) matchResultFor(ctx context.Context, market *MarketToSettle) (*MatchResult, error) {
+	if t.keeper.overridesRepo != nil {
+		override, err := t.keeper.overridesRepo.GetApprovedOverride(ctx, market.MarketAddress.Hex())
+		if err != nil {
+			// Don't fall back to the data source: the override may exist to correct it
+			return nil, fmt.Errorf("failed to check result overrides: %w", err)
+		}
+		if override != nil {
+			// An approved override replaces any single-operator manual result
+			t.clearManualResult(market.MarketAddress)
+
+			t.keeper.logger.Warn("using approved result override",
+				zap.String("market", market.MarketAddress.Hex()),
+				zap.String("eventID", market.EventID),
+				zap.Int64("overrideId", override.ID),
+				zap.Uint8("home_goals", override.HomeGoals),
+				zap.Uint8("away_goals", override.AwayGoals),
+				zap.String("createdBy", override.CreatedBy),
+				zap.String("approvedBy", override.ApprovedBy),
+			)
+			return matchResultFromOverride(override), nil
+		}
+	}
+
+	t.manualResultsMu.RLock()
+	result, ok := t.manualResults[market.MarketAddress]
+	t.manualResultsMu.RUnlock()
+
+	if ok {
+		return result, nil
+	}
+
+	return t.fetchMatchResult(ctx, market.EventID)
+}
+
+// matchResultFromOverride converts an approved override into a match result
+func matchResultFromOverride(o *repository.ResultOverride) *MatchResult {
+	return &MatchResult{
+		HomeGoals: o.HomeGoals,
+		AwayGoals: o.AwayGoals,
+		ExtraTime: o.ExtraTime,
+		HomeWin:   o.HomeGoals > o.AwayGoals,
+		AwayWin:   o.AwayGoals > o.HomeGoals,
+		Draw:      o.HomeGoals == o.AwayGoals,
+		Override:  o,
+	}
+}
+
+// recordOverrideApplied marks an override as applied after a successful
+// settlement and reports its full approval trail to the log and alerts
+func (t *SettleTask) recordOverrideApplied(ctx context.Context, market *MarketToSettle, result *MatchResult, txHash common.Hash) {
+	if result.Override == nil {
+		return
+	}
+	o := result.Override
+
+	t.keeper.logger.Warn("market settled with result override",
+		zap.String("market", market.MarketAddress.Hex()),
+		zap.String("txHash", txHash.Hex()),
+		zap.Int64("overrideId", o.ID),
+		zap.String("reason", o.Reason),
+		zap.String("evidenceUrl", o.EvidenceURL),
+		zap.String("createdBy", o.CreatedBy),
+		zap.String("approvedBy", o.ApprovedBy),
+		zap.Any("trail", o.Trail),
+	)
+
+	if t.keeper.overridesRepo != nil {
+		actor := t.keeper.web3Client.GetAccount().Hex()
+		if err := t.keeper.overridesRepo.MarkOverrideApplied(ctx, o.ID, actor, txHash.Hex()); err != nil {
+			t.keeper.logger.Error("failed to mark override applied",
+				zap.Int64("overrideId", o.ID),
+				zap.Error(err),
+			)
+		}
+	}
+
+	t.keeper.sendAlert(ctx, NewManualOverrideAlert(market.MarketAddress, txHash, overrideAlertContext(o)))
+}
+
+// overrideAlertContext builds the alert context for an applied override
+func overrideAlertContext(o *repository.ResultOverride) map[string]interface{} {
+	trail := make([]string, 0, len(o.Trail))
+	for _, e := range o.Trail {
+		entry := fmt.Sprintf("%s %s by %s", time.Unix(e.CreatedAt, 0).UTC().Format(time.RFC3339), e.Action, e.Actor)
+		if e.Note != "" {
+			entry += ": " + e.Note
+		}
+		trail = append(trail, entry)
+	}
+
+	return map[string]interface{}{
+		"override_id":  o.ID,
+		"score":        fmt.Sprintf("%d-%d", o.HomeGoals, o.AwayGoals),
+		"reason":       o.Reason,
+		"evidence_url": o.EvidenceURL,
+		"created_by":   o.CreatedBy,
+		"approved_by":  o.ApprovedBy,
+		"trail":        trail,
+	}
 }
 
 // fetchMatchResult fetches the match result from external data source
@@ -272,58 +272,6 @@ func TestSettleTask_FetchMatchResult(t *testing.T) {
 	})
 }
 
-// TestSettleTask_UpdateMarketStatus tests updating market status in database
-func TestSettleTask_UpdateMarketStatus(t *testing.T) {
-	t.Run("updates status in database", func(t *testing.T) {
-		if !isDatabaseAvailable() {
-			t.Skip("Database not available, skipping test")
-		}
-
-		cfg := &Config{
-			ChainID:          31337,
-			RPCEndpoint:      "http://localhost:8545",
-			PrivateKey:       "0xac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80",
-			GasLimit:         500000,
-			MaxGasPrice:      "100",
-			TaskInterval:     60,
-			LockLeadTime:     300,
-			FinalizeDelay:    7200,
-			MaxConcurrent:    10,
-			RetryAttempts:    3,
-			RetryDelay:       5,
-			DatabaseURL:      "postgresql://p1:p1@localhost/p1?sslmode=disable",
-			HealthCheckPort:  8081,
-			MetricsPort:      9091,
-			AlertsEnabled:    false,
-		}
-
-		keeper, err := NewKeeper(cfg)
-		require.NoError(t, err)
-		shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 5*time.Second)
-		defer shutdownCancel()
-		defer keeper.Shutdown(shutdownCtx)
-
-		task := NewSettleTask(keeper, keeper.dataSource)
-
-		ctx := context.Background()
-		marketAddr := common.HexToAddress("0x1234567890123456789012345678901234567890")
-		txHash := common.HexToHash("0xabcdefabcdefabcdefabcdefabcdefabcdefabcdefabcdefabcdefabcdefabcd")
-		result := &MatchResult{
-			HomeGoals: 3,
-			AwayGoals: 2,
-			ExtraTime: false,
-			HomeWin:   true,
-			AwayWin:   false,
-			Draw:      false,
-		}
-
-		// This will fail since the market doesn't exist in database
-		err = task.updateMarketStatus(ctx, marketAddr, "Proposed", txHash, result)
-		assert.Error(t, err, "Should fail when market doesn't exist in database")
-		assert.Contains(t, err.Error(), "no market found", "Error should be about market not found")
-	})
-}
-
 // TestSettleTask_SettleMarketV3 tests settling a V3 market
 func TestSettleTask_SettleMarketV3(t *testing.T) {
 	t.Run("handles invalid market address for V3", func(t *testing.T) {
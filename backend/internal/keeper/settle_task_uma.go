@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
 	"time"
@@ -36,6 +37,7 @@ func (t *SettleTaskUMA) Execute(ctx context.Context) error {
 		t.keeper.logger.Error("failed to get markets to settle", zap.Error(err))
 		return fmt.Errorf("failed to get markets to settle: %w", err)
 	}
+	markets = t.takeRequeued(markets)
 
 	if len(markets) == 0 {
 		t.keeper.logger.Debug("no markets to settle")
@@ -63,8 +65,8 @@ func (t *SettleTaskUMA) proposeResultToUMA(ctx context.Context, market *MarketTo
 		return fmt.Errorf("invalid oracle address: zero address")
 	}
 
-	// Get match result from data source
-	result, err := t.fetchMatchResult(ctx, market.EventID)
+	// Get match result (manual override or data source)
+	result, err := t.matchResultFor(ctx, market)
 	if err != nil {
 		return fmt.Errorf("failed to fetch match result: %w", err)
 	}
@@ -179,6 +181,12 @@ func (t *SettleTaskUMA) proposeResultToUMA(ctx context.Context, market *MarketTo
 		return fmt.Errorf("UMA propose transaction failed: status %d", receipt.Status)
 	}
 
+	// Re-check the receipt once it is buried under the configured confirmation depth
+	receipt, err = t.keeper.waitForConfirmations(ctx, "settle", market.MarketAddress, receipt)
+	if err != nil {
+		return fmt.Errorf("failed to confirm UMA propose transaction: %w", err)
+	}
+
 	t.keeper.logger.Info("UMA propose transaction confirmed",
 		zap.String("market", market.MarketAddress.Hex()),
 		zap.String("txHash", tx.Hash().Hex()),
@@ -186,6 +194,8 @@ func (t *SettleTaskUMA) proposeResultToUMA(ctx context.Context, market *MarketTo
 		zap.Uint64("gasUsed", receipt.GasUsed),
 	)
 
+	t.recordOverrideApplied(ctx, market, result, tx.Hash())
+
 	// 状态由链上事件自动更新到 Subgraph，无需手动更新数据库
 
 	return nil
@@ -244,6 +254,9 @@ func (t *SettleTaskUMA) processMarketsParallelUMA(ctx context.Context, markets [
 							zap.String("market", job.Market.MarketAddress.Hex()),
 							zap.Error(err),
 						)
+						if errors.Is(err, ErrTransactionReorged) {
+							t.requeue(job.Market)
+						}
 					} else {
 						t.keeper.logger.Info("worker UMA proposal succeeded",
 							zap.Int("worker_id", workerID),
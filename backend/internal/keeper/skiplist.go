@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// SkipEntry describes a market excluded from automated lock/settle runs
+type SkipEntry struct {
+	Market  common.Address `json:"market"`
+	Reason  string         `json:"reason"`
+	AddedBy string         `json:"addedBy"`
+	AddedAt time.Time      `json:"addedAt"`
+}
+
+// MarketSkipList holds markets that the lock and settle tasks must ignore.
+// Contains and List are safe to call on a nil list (nothing is skipped).
+type MarketSkipList struct {
+	mu      sync.RWMutex
+	entries map[common.Address]*SkipEntry
+}
+
+// NewMarketSkipList creates an empty skip list
+func NewMarketSkipList() *MarketSkipList {
+	return &MarketSkipList{
+		entries: make(map[common.Address]*SkipEntry),
+	}
+}
+
+// Add puts a market on the skip list, replacing any existing entry
+func (l *MarketSkipList) Add(market common.Address, reason, addedBy string) *SkipEntry {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	entry := &SkipEntry{
+		Market:  market,
+		Reason:  reason,
+		AddedBy: addedBy,
+		AddedAt: time.Now().UTC(),
+	}
+	l.entries[market] = entry
+	return entry
+}
+
+// Remove takes a market off the skip list and reports whether it was present
+func (l *MarketSkipList) Remove(market common.Address) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if _, ok := l.entries[market]; !ok {
+		return false
+	}
+	delete(l.entries, market)
+	return true
+}
+
+// Contains reports whether a market is on the skip list
+func (l *MarketSkipList) Contains(market common.Address) bool {
+	if l == nil {
+		return false
+	}
+
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	_, ok := l.entries[market]
+	return ok
+}
+
+// List returns all skip entries ordered by the time they were added
+func (l *MarketSkipList) List() []SkipEntry {
+	if l == nil {
+		return nil
+	}
+
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	entries := make([]SkipEntry, 0, len(l.entries))
+	for _, entry := range l.entries {
+		entries = append(entries, *entry)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].AddedAt.Before(entries[j].AddedAt)
+	})
+
+	return entries
+}
@@ -0,0 +1,129 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// HistoricalBlock 历史查询固定的区块
+type HistoricalBlock struct {
+	Number    uint64
+	Timestamp time.Time
+}
+
+// headerReader 读取区块头（ethclient.Client 实现）
+type headerReader interface {
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// timestampLayouts --at 支持的时间格式（无时区时按本地时区解析）
+var timestampLayouts = []string{
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02",
+}
+
+// ParseTimestamp 解析 --at：Unix 秒、RFC3339 或本地时间 "2006-01-02 15:04[:05]" / "2006-01-02"
+func ParseTimestamp(s string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return time.Time{}, fmt.Errorf("时间不能为空")
+	}
+	if secs, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return time.Unix(secs, 0), nil
+	}
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	for _, layout := range timestampLayouts {
+		if t, err := time.ParseInLocation(layout, s, time.Local); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("无效的时间: %s (支持 Unix 秒、RFC3339 或 \"2006-01-02 15:04:05\")", s)
+}
+
+// BlockAtTime 二分查找时间戳不晚于 t 的最后一个区块；t 晚于最新区块时返回最新区块
+func BlockAtTime(ctx context.Context, headers headerReader, t time.Time) (*HistoricalBlock, error) {
+	latest, err := headers.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("获取最新区块失败: %w", err)
+	}
+	target := uint64(t.Unix())
+	if latest.Time <= target {
+		return blockFromHeader(latest), nil
+	}
+
+	genesis, err := headerAt(ctx, headers, 0)
+	if err != nil {
+		return nil, err
+	}
+	if genesis.Time > target {
+		return nil, fmt.Errorf("%s 早于创世区块（%s）", t.Format(time.RFC3339), time.Unix(int64(genesis.Time), 0).Format(time.RFC3339))
+	}
+
+	// 不变式：lo 的时间戳 <= target，hi 的时间戳 > target
+	lo, hi := uint64(0), latest.Number.Uint64()
+	best := genesis
+	for hi-lo > 1 {
+		mid := lo + (hi-lo)/2
+		header, err := headerAt(ctx, headers, mid)
+		if err != nil {
+			return nil, err
+		}
+		if header.Time <= target {
+			lo, best = mid, header
+		} else {
+			hi = mid
+		}
+	}
+	return blockFromHeader(best), nil
+}
+
+// headerAt 读取指定高度的区块头
+func headerAt(ctx context.Context, headers headerReader, number uint64) (*types.Header, error) {
+	header, err := headers.HeaderByNumber(ctx, new(big.Int).SetUint64(number))
+	if err != nil {
+		return nil, fmt.Errorf("获取区块 %d 失败: %w", number, err)
+	}
+	return header, nil
+}
+
+func blockFromHeader(header *types.Header) *HistoricalBlock {
+	return &HistoricalBlock{
+		Number:    header.Number.Uint64(),
+		Timestamp: time.Unix(int64(header.Time), 0),
+	}
+}
+
+// missingStateErrors 节点已裁剪历史状态时的常见错误信息（geth / erigon / reth / 各 RPC 服务商）
+var missingStateErrors = []string{
+	"missing trie node",
+	"historical state",
+	"state is not available",
+	"state not available",
+	"state histories",
+	"pruned",
+	"archive",
+}
+
+// IsMissingStateError 判断错误是否因 RPC 节点没有该区块的历史状态（需要归档节点）
+func IsMissingStateError(err error) bool {
+	if err == nil {
+		return false
+	}
+	msg := strings.ToLower(err.Error())
+	for _, s := range missingStateErrors {
+		if strings.Contains(msg, s) {
+			return true
+		}
+	}
+	return false
+}
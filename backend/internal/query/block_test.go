@@ -0,0 +1,126 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeHeaders 区块 i 的时间戳为 times[i]
+type fakeHeaders struct {
+	times []uint64
+	reads int
+}
+
+func (f *fakeHeaders) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	f.reads++
+	n := uint64(len(f.times) - 1)
+	if number != nil {
+		n = number.Uint64()
+	}
+	if n >= uint64(len(f.times)) {
+		return nil, fmt.Errorf("block %d not found", n)
+	}
+	return &types.Header{Number: new(big.Int).SetUint64(n), Time: f.times[n]}, nil
+}
+
+func TestBlockAtTime(t *testing.T) {
+	// 12 秒出块，区块 5-6 之间停了 60 秒
+	times := []uint64{1000, 1012, 1024, 1036, 1048, 1060, 1120, 1132, 1144, 1156}
+	headers := &fakeHeaders{times: times}
+
+	cases := []struct {
+		at   int64
+		want uint64
+	}{
+		{1000, 0},
+		{1011, 0},
+		{1012, 1},
+		{1059, 4},
+		{1060, 5},
+		{1119, 5},
+		{1120, 6},
+		{1156, 9},
+		{9999, 9}, // 晚于最新区块
+	}
+	for _, tc := range cases {
+		block, err := BlockAtTime(context.Background(), headers, time.Unix(tc.at, 0))
+		require.NoError(t, err, "at %d", tc.at)
+		assert.Equal(t, tc.want, block.Number, "at %d", tc.at)
+		assert.Equal(t, int64(times[tc.want]), block.Timestamp.Unix())
+	}
+
+	_, err := BlockAtTime(context.Background(), headers, time.Unix(999, 0))
+	assert.Error(t, err)
+}
+
+func TestBlockAtTime_LogarithmicReads(t *testing.T) {
+	times := make([]uint64, 1_000_000)
+	for i := range times {
+		times[i] = 1_700_000_000 + uint64(i)*2
+	}
+	headers := &fakeHeaders{times: times}
+
+	block, err := BlockAtTime(context.Background(), headers, time.Unix(1_700_000_000+123_457, 0))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(61_728), block.Number)
+	assert.LessOrEqual(t, headers.reads, 25)
+}
+
+func TestResolveBlock(t *testing.T) {
+	headers := &fakeHeaders{times: []uint64{1000, 1012, 1024}}
+
+	block, err := resolveBlock(context.Background(), headers, 0, "")
+	require.NoError(t, err)
+	assert.Nil(t, block)
+
+	block, err = resolveBlock(context.Background(), headers, 1, "")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), block.Number)
+	assert.Equal(t, int64(1012), block.Timestamp.Unix())
+
+	block, err = resolveBlock(context.Background(), headers, 0, "1020")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), block.Number)
+
+	_, err = resolveBlock(context.Background(), headers, 1, "1020")
+	assert.Error(t, err)
+	_, err = resolveBlock(context.Background(), headers, 7, "")
+	assert.Error(t, err)
+}
+
+func TestParseTimestamp(t *testing.T) {
+	ts, err := ParseTimestamp("1718000000")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1718000000), ts.Unix())
+
+	ts, err = ParseTimestamp("2024-06-10T06:13:20Z")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1718000000), ts.Unix())
+
+	ts, err = ParseTimestamp("2024-06-10 14:13:20")
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 6, 10, 14, 13, 20, 0, time.Local), ts)
+
+	ts, err = ParseTimestamp("2024-06-10")
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 6, 10, 0, 0, 0, 0, time.Local), ts)
+
+	_, err = ParseTimestamp("kickoff")
+	assert.Error(t, err)
+}
+
+func TestIsMissingStateError(t *testing.T) {
+	assert.True(t, IsMissingStateError(errors.New("missing trie node 1a2b (path )")))
+	assert.True(t, IsMissingStateError(fmt.Errorf("查询失败: %w", errors.New("historical state 0xabc is not available"))))
+	assert.True(t, IsMissingStateError(errors.New("required historical state unavailable (reexec=128)")))
+	assert.False(t, IsMissingStateError(errors.New("execution reverted")))
+	assert.False(t, IsMissingStateError(nil))
+}
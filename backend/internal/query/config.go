@@ -31,6 +31,8 @@ type ContractAddresses struct {
 	ReferralRegistry   common.Address `mapstructure:"referral_registry"`
 	FeeRouter          common.Address `mapstructure:"fee_router"`
 	Factory            common.Address `mapstructure:"factory"`
+	BettingRouter      common.Address `mapstructure:"betting_router"`
+	Multicall3         common.Address `mapstructure:"multicall3"` // 可选，默认 0xcA11...CA11
 }
 
 // TemplateIDs 模板 ID
@@ -57,6 +59,8 @@ func (c *ContractAddresses) GetAllContracts() map[string]common.Address {
 		"ReferralRegistry":   c.ReferralRegistry,
 		"FeeRouter":          c.FeeRouter,
 		"Factory":            c.Factory,
+		"BettingRouter":      c.BettingRouter,
+		"Multicall3":         c.Multicall3,
 	}
 }
 
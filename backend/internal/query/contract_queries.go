@@ -14,9 +14,11 @@ func (s *Service) GetUSDCInfo(ctx context.Context) (*USDCInfo, error) {
 		return nil, fmt.Errorf("USDC 合约未配置")
 	}
 
-	opts := s.callOpts(ctx)
+	batch := &callBatch{}
+	totalSupplyCall := batch.add(s.contracts.USDC, erc20ABI, "totalSupply")
+	s.runBatch(ctx, batch)
 
-	totalSupply, err := s.usdc.TotalSupply(opts)
+	totalSupply, err := callResult[*big.Int](totalSupplyCall, 0)
 	if err != nil {
 		return nil, fmt.Errorf("获取总供应量失败: %w", err)
 	}
@@ -35,7 +37,11 @@ func (s *Service) GetUSDCBalance(ctx context.Context, addr common.Address) (*big
 		return nil, fmt.Errorf("USDC 合约未配置")
 	}
 
-	return s.usdc.BalanceOf(s.callOpts(ctx), addr)
+	batch := &callBatch{}
+	balanceCall := batch.add(s.contracts.USDC, erc20ABI, "balanceOf", addr)
+	s.runBatch(ctx, batch)
+
+	return callResult[*big.Int](balanceCall, 0)
 }
 
 // GetVaultInfo 获取 Vault 信息
@@ -44,14 +50,17 @@ func (s *Service) GetVaultInfo(ctx context.Context) (*VaultInfo, error) {
 		return nil, fmt.Errorf("ERC4626Provider 合约未配置")
 	}
 
-	opts := s.callOpts(ctx)
+	batch := &callBatch{}
+	totalAssetsCall := batch.add(s.contracts.ERC4626Provider, providerABI, "totalAssets")
+	totalSharesCall := batch.add(s.contracts.ERC4626Provider, providerABI, "totalSupply")
+	s.runBatch(ctx, batch)
 
-	totalAssets, err := s.provider.TotalAssets(opts)
+	totalAssets, err := callResult[*big.Int](totalAssetsCall, 0)
 	if err != nil {
 		return nil, fmt.Errorf("获取总资产失败: %w", err)
 	}
 
-	totalShares, err := s.provider.TotalSupply(opts)
+	totalShares, err := callResult[*big.Int](totalSharesCall, 0)
 	if err != nil {
 		return nil, fmt.Errorf("获取总份额失败: %w", err)
 	}
@@ -85,30 +94,32 @@ func (s *Service) GetFeeRouterConfig(ctx context.Context) (*FeeRouterConfig, err
 		return nil, fmt.Errorf("FeeRouter 合约未配置")
 	}
 
-	opts := s.callOpts(ctx)
+	batch := &callBatch{}
+	feeSplitCall := batch.add(s.contracts.FeeRouter, feeRouterABI, "feeSplit")
+	recipientsCall := batch.add(s.contracts.FeeRouter, feeRouterABI, "recipients")
+	s.runBatch(ctx, batch)
 
 	// 获取费用分配比例
-	feeSplit, err := s.feeRouter.FeeSplit(opts)
-	if err != nil {
-		return nil, fmt.Errorf("获取费用分配比例失败: %w", err)
+	if feeSplitCall.err != nil {
+		return nil, fmt.Errorf("获取费用分配比例失败: %w", feeSplitCall.err)
 	}
 
 	// 获取接收地址
-	recipients, err := s.feeRouter.Recipients(opts)
-	if err != nil {
-		return nil, fmt.Errorf("获取接收地址失败: %w", err)
+	if recipientsCall.err != nil {
+		return nil, fmt.Errorf("获取接收地址失败: %w", recipientsCall.err)
 	}
 
-	return &FeeRouterConfig{
-		LpBps:        feeSplit.LpBps,
-		PromoBps:     feeSplit.PromoBps,
-		InsuranceBps: feeSplit.InsuranceBps,
-		TreasuryBps:  feeSplit.TreasuryBps,
-		LpVault:      recipients.LpVault,
-		PromoPool:    recipients.PromoPool,
-		Insurance:    recipients.InsuranceFund,
-		Treasury:     recipients.Treasury,
-	}, nil
+	config := &FeeRouterConfig{}
+	config.LpBps, _ = callResult[*big.Int](feeSplitCall, 0)
+	config.PromoBps, _ = callResult[*big.Int](feeSplitCall, 1)
+	config.InsuranceBps, _ = callResult[*big.Int](feeSplitCall, 2)
+	config.TreasuryBps, _ = callResult[*big.Int](feeSplitCall, 3)
+	config.LpVault, _ = callResult[common.Address](recipientsCall, 0)
+	config.PromoPool, _ = callResult[common.Address](recipientsCall, 1)
+	config.Insurance, _ = callResult[common.Address](recipientsCall, 2)
+	config.Treasury, _ = callResult[common.Address](recipientsCall, 3)
+
+	return config, nil
 }
 
 // GetReferralInfo 获取用户推荐信息
@@ -117,22 +128,26 @@ func (s *Service) GetReferralInfo(ctx context.Context, user common.Address) (*Re
 		return nil, fmt.Errorf("ReferralRegistry 合约未配置")
 	}
 
-	opts := s.callOpts(ctx)
+	batch := &callBatch{}
+	referrerCall := batch.add(s.contracts.ReferralRegistry, referralABI, "referrer", user)
+	referralCountCall := batch.add(s.contracts.ReferralRegistry, referralABI, "referralCount", user)
+	totalRewardsCall := batch.add(s.contracts.ReferralRegistry, referralABI, "totalReferralRewards", user)
+	s.runBatch(ctx, batch)
 
 	// 获取推荐人
-	referrer, err := s.referral.Referrer(opts, user)
+	referrer, err := callResult[common.Address](referrerCall, 0)
 	if err != nil {
 		return nil, fmt.Errorf("获取推荐人失败: %w", err)
 	}
 
 	// 获取下级数量
-	referralCount, err := s.referral.ReferralCount(opts, user)
+	referralCount, err := callResult[*big.Int](referralCountCall, 0)
 	if err != nil {
 		return nil, fmt.Errorf("获取下级数量失败: %w", err)
 	}
 
 	// 获取总奖励
-	totalRewards, err := s.referral.TotalReferralRewards(opts, user)
+	totalRewards, err := callResult[*big.Int](totalRewardsCall, 0)
 	if err != nil {
 		totalRewards = big.NewInt(0) // 如果获取失败，默认为 0
 	}
@@ -4,10 +4,10 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"strings"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
-
-	"github.com/pitchone/sportsbook/pkg/bindings"
 )
 
 // GetFactoryInfo 获取工厂信息
@@ -16,16 +16,14 @@ func (s *Service) GetFactoryInfo(ctx context.Context) (*FactoryInfo, error) {
 		return nil, fmt.Errorf("Factory 合约未配置")
 	}
 
-	opts := s.callOpts(ctx)
-
-	marketCount, err := s.factory.GetMarketCount(opts)
+	marketCount, err := s.marketCount(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("获取市场数量失败: %w", err)
 	}
 
 	return &FactoryInfo{
 		Address:     s.contracts.Factory,
-		MarketCount: marketCount.Uint64(),
+		MarketCount: marketCount,
 	}, nil
 }
 
@@ -33,29 +31,36 @@ func (s *Service) GetFactoryInfo(ctx context.Context) (*FactoryInfo, error) {
 func (s *Service) ListTemplates(ctx context.Context) ([]*TemplateInfo, error) {
 	templates := s.templates.GetAllTemplates()
 	result := make([]*TemplateInfo, 0, len(templates))
+	calls := make([]*contractCall, 0, len(templates))
+	batch := &callBatch{}
 
 	for name, id := range templates {
 		if isZeroBytes32(id) {
 			continue
 		}
 
-		info := &TemplateInfo{
+		result = append(result, &TemplateInfo{
 			ID:     id,
 			Name:   name,
 			Active: true, // 假设所有配置的模板都是激活的
-		}
+		})
 
-		// 如果 Factory 可用，尝试获取更多信息
+		// 如果 Factory 可用，批量获取更多信息
 		if s.factory != nil {
-			opts := s.callOpts(ctx)
-			templateInfo, err := s.factory.Templates(opts, id)
-			if err == nil {
-				info.Implementation = templateInfo.Implementation
-				info.Active = templateInfo.Active
-			}
+			calls = append(calls, batch.add(s.contracts.Factory, factoryABI, "templates", id))
 		}
+	}
 
-		result = append(result, info)
+	if len(calls) > 0 {
+		s.runBatch(ctx, batch)
+		for i, call := range calls {
+			if implementation, err := callResult[common.Address](call, 0); err == nil {
+				result[i].Implementation = implementation
+			}
+			if active, err := callResult[bool](call, 3); err == nil {
+				result[i].Active = active
+			}
+		}
 	}
 
 	return result, nil
@@ -67,27 +72,21 @@ func (s *Service) GetTemplateInfo(ctx context.Context, templateID [32]byte) (*Te
 		return nil, fmt.Errorf("Factory 合约未配置")
 	}
 
-	opts := s.callOpts(ctx)
+	batch := &callBatch{}
+	call := batch.add(s.contracts.Factory, factoryABI, "templates", templateID)
+	s.runBatch(ctx, batch)
 
-	templateInfo, err := s.factory.Templates(opts, templateID)
+	implementation, err := callResult[common.Address](call, 0)
 	if err != nil {
 		return nil, fmt.Errorf("获取模板信息失败: %w", err)
 	}
-
-	// 查找模板名称
-	name := "Unknown"
-	for n, id := range s.templates.GetAllTemplates() {
-		if id == templateID {
-			name = n
-			break
-		}
-	}
+	active, _ := callResult[bool](call, 3)
 
 	return &TemplateInfo{
 		ID:             templateID,
-		Name:           name,
-		Implementation: templateInfo.Implementation,
-		Active:         templateInfo.Active,
+		Name:           s.templateName(templateID),
+		Implementation: implementation,
+		Active:         active,
 	}, nil
 }
 
@@ -97,15 +96,13 @@ func (s *Service) ListMarkets(ctx context.Context, opts *ListMarketsOptions) ([]
 		return nil, fmt.Errorf("Factory 合约未配置")
 	}
 
-	callOpts := s.callOpts(ctx)
-
 	// 获取市场总数
-	count, err := s.factory.GetMarketCount(callOpts)
+	total, err := s.marketCount(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("获取市场数量失败: %w", err)
 	}
 
-	if count.Uint64() == 0 {
+	if total == 0 {
 		return []*MarketSummary{}, nil
 	}
 
@@ -121,28 +118,24 @@ func (s *Service) ListMarkets(ctx context.Context, opts *ListMarketsOptions) ([]
 
 	// 计算结束索引
 	end := offset + limit
-	total := count.Uint64()
 	if end > total {
 		end = total
 	}
+	if offset >= end {
+		return []*MarketSummary{}, nil
+	}
 
-	result := make([]*MarketSummary, 0, end-offset)
-
-	// 遍历市场
-	for i := offset; i < end; i++ {
-		marketAddr, err := s.factory.Markets(callOpts, big.NewInt(int64(i)))
-		if err != nil {
-			continue
-		}
+	summaries := s.loadMarketSummaries(ctx, s.listMarketAddresses(ctx, offset, end))
+	result := make([]*MarketSummary, 0, len(summaries))
 
-		summary, err := s.getMarketSummary(ctx, marketAddr)
-		if err != nil {
+	for _, summary := range summaries {
+		if summary == nil {
 			continue
 		}
 
 		// 应用过滤条件
 		if opts != nil {
-			if opts.Status != nil && summary.Status != *opts.Status {
+			if opts.Status != "" && !strings.EqualFold(summary.StatusName, opts.Status) {
 				continue
 			}
 			if opts.TemplateID != nil && summary.TemplateID != *opts.TemplateID {
@@ -158,44 +151,129 @@ func (s *Service) ListMarkets(ctx context.Context, opts *ListMarketsOptions) ([]
 
 // getMarketSummary 获取市场摘要
 func (s *Service) getMarketSummary(ctx context.Context, addr common.Address) (*MarketSummary, error) {
-	market, err := bindings.NewMarketBaseV2(addr, s.client)
-	if err != nil {
-		return nil, err
+	summary := s.loadMarketSummaries(ctx, []common.Address{addr})[0]
+	if summary == nil {
+		return nil, fmt.Errorf("获取市场 %s 摘要失败", addr.Hex())
 	}
+	return summary, nil
+}
 
-	opts := s.callOpts(ctx)
+// loadMarketSummaries 批量获取市场摘要，读取状态失败的市场对应位置为 nil
+func (s *Service) loadMarketSummaries(ctx context.Context, addrs []common.Address) []*MarketSummary {
+	s.detectMarketVersions(ctx, addrs)
 
-	status, err := market.Status(opts)
-	if err != nil {
-		return nil, err
+	type summaryCalls struct {
+		version  MarketVersion
+		status   *contractCall
+		kickoff  *contractCall
+		matchID  *contractCall // 仅 V3
+		template *contractCall // 未配置 Factory 时为 nil
 	}
 
-	// 从 Factory 获取模板 ID
-	var templateID [32]byte
-	if s.factory != nil {
-		templateID, err = s.factory.MarketTemplate(opts, addr)
+	batch := &callBatch{}
+	pending := make([]summaryCalls, len(addrs))
+	for i, addr := range addrs {
+		version := s.cachedMarketVersion(addr)
+		contractABI := marketABI(version)
+
+		pending[i] = summaryCalls{
+			version:  version,
+			status:   batch.add(addr, contractABI, "status"),
+			kickoff:  batch.add(addr, contractABI, "kickoffTime"),
+			template: s.addTemplateCall(batch, addr),
+		}
+		if version == MarketVersionV3 {
+			pending[i].matchID = batch.add(addr, marketV3ABI, "matchId")
+		}
+	}
+
+	s.runBatch(ctx, batch)
+
+	results := make([]*MarketSummary, len(addrs))
+	for i, addr := range addrs {
+		p := pending[i]
+
+		status, err := callResult[uint8](p.status, 0)
 		if err != nil {
-			// 某些市场可能没有 templateId，使用零值
-			templateID = [32]byte{}
+			continue
+		}
+
+		templateID, templateName := s.templateOf(p.template)
+		summary := &MarketSummary{
+			Address:      addr,
+			TemplateID:   templateID,
+			TemplateName: templateName,
+			Status:       status,
+			StatusName:   marketStatusName(p.version, status),
+			Version:      p.version,
+			KickoffTime:  unixTime(p.kickoff),
+		}
+		if p.matchID != nil {
+			summary.MatchID, _ = callResult[string](p.matchID, 0)
+		}
+
+		results[i] = summary
+	}
+
+	return results
+}
+
+// listMarketAddresses 批量读取 Factory 中下标 [start, end) 的市场地址，读取失败的下标被跳过
+func (s *Service) listMarketAddresses(ctx context.Context, start, end uint64) []common.Address {
+	batch := &callBatch{}
+	for i := start; i < end; i++ {
+		batch.add(s.contracts.Factory, factoryABI, "markets", new(big.Int).SetUint64(i))
+	}
+	s.runBatch(ctx, batch)
+
+	addrs := make([]common.Address, 0, len(batch.calls))
+	for _, call := range batch.calls {
+		if addr, err := callResult[common.Address](call, 0); err == nil {
+			addrs = append(addrs, addr)
 		}
 	}
+	return addrs
+}
+
+// marketCount 读取 Factory 中的市场数量
+func (s *Service) marketCount(ctx context.Context) (uint64, error) {
+	batch := &callBatch{}
+	call := batch.add(s.contracts.Factory, factoryABI, "getMarketCount")
+	s.runBatch(ctx, batch)
+
+	count, err := callResult[*big.Int](call, 0)
+	if err != nil {
+		return 0, err
+	}
+	return count.Uint64(), nil
+}
+
+// addTemplateCall 添加从 Factory 读取市场模板 ID 的调用，未配置 Factory 时返回 nil
+func (s *Service) addTemplateCall(batch *callBatch, market common.Address) *contractCall {
+	if s.factory == nil {
+		return nil
+	}
+	return batch.add(s.contracts.Factory, factoryABI, "marketTemplate", market)
+}
 
-	// 查找模板名称
-	templateName := "Unknown"
+// templateOf 读取模板 ID 调用结果并查找模板名称
+func (s *Service) templateOf(call *contractCall) ([32]byte, string) {
+	var templateID [32]byte
+	if call != nil {
+		// 某些市场可能没有 templateId，使用零值
+		templateID, _ = callResult[[32]byte](call, 0)
+	}
+	return templateID, s.templateName(templateID)
+}
+
+// templateName 查找配置中的模板名称
+func (s *Service) templateName(templateID [32]byte) string {
 	for name, id := range s.templates.GetAllTemplates() {
 		if id == templateID {
-			templateName = name
-			break
+			return name
 		}
 	}
-
-	return &MarketSummary{
-		Address:      addr,
-		TemplateID:   templateID,
-		TemplateName: templateName,
-		Status:       status,
-		StatusName:   getStatusName(status),
-	}, nil
+	return "Unknown"
 }
 
 // GetMarketCount 获取市场数量
@@ -204,12 +282,7 @@ func (s *Service) GetMarketCount(ctx context.Context) (uint64, error) {
 		return 0, fmt.Errorf("Factory 合约未配置")
 	}
 
-	count, err := s.factory.GetMarketCount(s.callOpts(ctx))
-	if err != nil {
-		return 0, err
-	}
-
-	return count.Uint64(), nil
+	return s.marketCount(ctx)
 }
 
 // GetMarketsByStatus 按状态统计市场
@@ -218,13 +291,13 @@ func (s *Service) GetMarketsByStatus(ctx context.Context) (map[string]uint64, er
 		return nil, fmt.Errorf("Factory 合约未配置")
 	}
 
-	callOpts := s.callOpts(ctx)
-	count, err := s.factory.GetMarketCount(callOpts)
+	count, err := s.marketCount(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	stats := map[string]uint64{
+		"Created":   0,
 		"Open":      0,
 		"Locked":    0,
 		"Resolved":  0,
@@ -232,29 +305,37 @@ func (s *Service) GetMarketsByStatus(ctx context.Context) (map[string]uint64, er
 		"Cancelled": 0,
 	}
 
-	for i := uint64(0); i < count.Uint64(); i++ {
-		marketAddr, err := s.factory.Markets(callOpts, big.NewInt(int64(i)))
-		if err != nil {
-			continue
-		}
+	addrs := s.listMarketAddresses(ctx, 0, count)
+	s.detectMarketVersions(ctx, addrs)
 
-		market, err := bindings.NewMarketBaseV2(marketAddr, s.client)
-		if err != nil {
-			continue
-		}
+	batch := &callBatch{}
+	versions := make([]MarketVersion, len(addrs))
+	for i, addr := range addrs {
+		versions[i] = s.cachedMarketVersion(addr)
+		batch.add(addr, marketABI(versions[i]), "status")
+	}
+	s.runBatch(ctx, batch)
 
-		status, err := market.Status(callOpts)
+	for i, call := range batch.calls {
+		status, err := callResult[uint8](call, 0)
 		if err != nil {
 			continue
 		}
-
-		statusName := getStatusName(status)
-		stats[statusName]++
+		stats[marketStatusName(versions[i], status)]++
 	}
 
 	return stats, nil
 }
 
+// unixTime 读取时间戳调用结果，失败或为 0 时返回零值
+func unixTime(call *contractCall) time.Time {
+	ts, err := callResult[*big.Int](call, 0)
+	if err != nil || ts == nil || ts.Sign() <= 0 {
+		return time.Time{}
+	}
+	return time.Unix(ts.Int64(), 0)
+}
+
 // getStatusName 获取状态名称
 func getStatusName(status uint8) string {
 	switch status {
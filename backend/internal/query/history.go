@@ -0,0 +1,491 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/pitchone/sportsbook/internal/graphql"
+)
+
+// 历史数据来源（history.source）
+const (
+	HistorySourceSubgraph = "subgraph" // 默认：Subgraph 的 Position / Order / OutcomeVolume / GlobalStats
+	HistorySourceSQL      = "sql"      // database.url 中由 cmd/indexer 写入的 orders 表
+)
+
+// subgraphPageSize 每次 Subgraph 查询的分页大小（The Graph 单次上限 1000）
+const subgraphPageSize = 1000
+
+// topMarketsWindow 热门市场统计的时间窗口
+const topMarketsWindow = 7 * 24 * time.Hour
+
+// historySource 头寸、订单与交易量等历史数据的来源
+// 头寸、订单与累计数据可按 block 读取历史状态（0 表示最新）
+type historySource interface {
+	MarketPositions(ctx context.Context, market common.Address, block uint64) ([]*Position, error)
+	UserOrders(ctx context.Context, user common.Address, limit int, block uint64) ([]*Order, error)
+	VolumeStats(ctx context.Context, period string, now time.Time) ([]*VolumeData, error)
+	Totals(ctx context.Context, block uint64) (*historyTotals, error)
+	TopMarkets(ctx context.Context, since time.Time, limit int) ([]common.Address, error)
+}
+
+// historyTotals 平台累计交易数据，未知字段为 nil
+type historyTotals struct {
+	Volume *big.Int
+	Fees   *big.Int
+	Users  uint64
+}
+
+// newHistorySource 按 history.source 选择历史数据来源；未配置对应连接时返回 nil
+func newHistorySource(source, subgraphURL string, db *sql.DB) (historySource, error) {
+	switch strings.ToLower(source) {
+	case "", HistorySourceSubgraph:
+		if subgraphURL == "" {
+			return nil, nil
+		}
+		return &subgraphHistory{client: graphql.NewClient(subgraphURL)}, nil
+	case HistorySourceSQL:
+		if db == nil {
+			return nil, nil
+		}
+		return &sqlHistory{db: db}, nil
+	default:
+		return nil, fmt.Errorf("无效的历史数据来源: %s (支持: %s, %s)", source, HistorySourceSubgraph, HistorySourceSQL)
+	}
+}
+
+// volumeWindowStart 返回交易量统计周期对应的起始时间
+func volumeWindowStart(period string, now time.Time) (time.Time, error) {
+	switch period {
+	case "daily":
+		return now.AddDate(0, 0, -30), nil
+	case "weekly":
+		return now.AddDate(0, 0, -12*7), nil
+	case "monthly":
+		return now.AddDate(0, -12, 0), nil
+	default:
+		return time.Time{}, fmt.Errorf("无效的时间周期: %s (支持: daily, weekly, monthly)", period)
+	}
+}
+
+// volumePeriodKey 返回订单时间所属的统计周期（UTC）：日期、所在周的周一或月份
+func volumePeriodKey(period string, t time.Time) string {
+	t = t.UTC()
+	switch period {
+	case "weekly":
+		offset := (int(t.Weekday()) + 6) % 7
+		return t.AddDate(0, 0, -offset).Format("2006-01-02")
+	case "monthly":
+		return t.Format("2006-01")
+	default:
+		return t.Format("2006-01-02")
+	}
+}
+
+// bucketVolume 按周期汇总订单，结果按周期倒序
+func bucketVolume(orders []*Order, period string) []*VolumeData {
+	buckets := make(map[string]*VolumeData)
+	users := make(map[string]map[common.Address]struct{})
+
+	for _, o := range orders {
+		key := volumePeriodKey(period, o.Timestamp)
+		data, ok := buckets[key]
+		if !ok {
+			data = &VolumeData{Period: key, Volume: big.NewInt(0)}
+			buckets[key] = data
+			users[key] = make(map[common.Address]struct{})
+		}
+		if o.Amount != nil {
+			data.Volume.Add(data.Volume, o.Amount)
+		}
+		data.OrderCount++
+		users[key][o.User] = struct{}{}
+	}
+
+	results := make([]*VolumeData, 0, len(buckets))
+	for key, data := range buckets {
+		data.UniqueUsers = uint64(len(users[key]))
+		results = append(results, data)
+	}
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].Period > results[j].Period
+	})
+	return results
+}
+
+// ============================================================================
+// Subgraph
+// ============================================================================
+
+// subgraphHistory 从 Subgraph 读取历史数据
+type subgraphHistory struct {
+	client *graphql.Client
+}
+
+func (h *subgraphHistory) MarketPositions(ctx context.Context, market common.Address, block uint64) ([]*Position, error) {
+	results := make([]*Position, 0)
+	for skip := 0; ; skip += subgraphPageSize {
+		page, err := h.client.GetMarketPositions(ctx, market, subgraphPageSize, skip, block)
+		if err != nil {
+			return nil, fmt.Errorf("查询 Subgraph 头寸失败: %w", err)
+		}
+		for _, p := range page {
+			results = append(results, &Position{
+				Owner:     p.Owner.Address(),
+				OutcomeID: uint64(p.Outcome),
+				Balance:   graphql.ParseBigInt(p.Balance),
+				CostBasis: graphql.ParseDecimal(p.TotalInvested, 6),
+			})
+		}
+		if len(page) < subgraphPageSize {
+			return results, nil
+		}
+	}
+}
+
+func (h *subgraphHistory) UserOrders(ctx context.Context, user common.Address, limit int, block uint64) ([]*Order, error) {
+	results := make([]*Order, 0)
+	for skip := 0; len(results) < limit; skip += subgraphPageSize {
+		first := subgraphPageSize
+		if remaining := limit - len(results); remaining < first {
+			first = remaining
+		}
+		page, err := h.client.GetUserOrders(ctx, user, first, skip, block)
+		if err != nil {
+			return nil, fmt.Errorf("查询 Subgraph 订单失败: %w", err)
+		}
+		for i := range page {
+			results = append(results, orderFromGraph(&page[i]))
+		}
+		if len(page) < first {
+			break
+		}
+	}
+	return results, nil
+}
+
+func (h *subgraphHistory) VolumeStats(ctx context.Context, period string, now time.Time) ([]*VolumeData, error) {
+	start, err := volumeWindowStart(period, now)
+	if err != nil {
+		return nil, err
+	}
+
+	orders := make([]*Order, 0)
+	for skip := 0; ; skip += subgraphPageSize {
+		page, err := h.client.GetOrdersByTimeRange(ctx, start.Unix(), now.Unix()+1, subgraphPageSize, skip)
+		if err != nil {
+			return nil, fmt.Errorf("查询 Subgraph 订单失败: %w", err)
+		}
+		for i := range page {
+			orders = append(orders, orderFromGraph(&page[i]))
+		}
+		if len(page) < subgraphPageSize {
+			break
+		}
+	}
+
+	return bucketVolume(orders, period), nil
+}
+
+func (h *subgraphHistory) Totals(ctx context.Context, block uint64) (*historyTotals, error) {
+	stats, err := h.client.GetGlobalStats(ctx, block)
+	if err != nil {
+		return nil, fmt.Errorf("查询 Subgraph 全局统计失败: %w", err)
+	}
+	if stats == nil {
+		return &historyTotals{}, nil
+	}
+	return &historyTotals{
+		Volume: graphql.ParseDecimal(stats.TotalVolume, 6),
+		Fees:   graphql.ParseDecimal(stats.TotalFees, 6),
+		Users:  uint64(stats.TotalUsers),
+	}, nil
+}
+
+func (h *subgraphHistory) TopMarkets(ctx context.Context, since time.Time, limit int) ([]common.Address, error) {
+	// OutcomeVolume 为累计值：窗口内有成交的市场按累计交易量排名
+	volumes := make(map[common.Address]*big.Int)
+	for skip := 0; ; skip += subgraphPageSize {
+		page, err := h.client.GetOutcomeVolumesUpdatedSince(ctx, since.Unix(), subgraphPageSize, skip)
+		if err != nil {
+			return nil, fmt.Errorf("查询 Subgraph 交易量失败: %w", err)
+		}
+		for _, v := range page {
+			market := v.Market.Address()
+			if volumes[market] == nil {
+				volumes[market] = big.NewInt(0)
+			}
+			volumes[market].Add(volumes[market], graphql.ParseDecimal(v.Volume, 6))
+		}
+		if len(page) < subgraphPageSize {
+			break
+		}
+	}
+
+	return rankMarkets(volumes, limit), nil
+}
+
+// rankMarkets 按交易量倒序返回前 limit 个市场
+func rankMarkets(volumes map[common.Address]*big.Int, limit int) []common.Address {
+	markets := make([]common.Address, 0, len(volumes))
+	for market := range volumes {
+		markets = append(markets, market)
+	}
+	sort.Slice(markets, func(i, j int) bool {
+		if cmp := volumes[markets[i]].Cmp(volumes[markets[j]]); cmp != 0 {
+			return cmp > 0
+		}
+		return markets[i].Hex() < markets[j].Hex()
+	})
+	if limit > 0 && len(markets) > limit {
+		markets = markets[:limit]
+	}
+	return markets
+}
+
+// orderFromGraph 将 Subgraph 订单转换为 Order（金额 6 位小数，价格 1e18 精度）
+func orderFromGraph(o *graphql.Order) *Order {
+	order := &Order{
+		ID:        o.ID,
+		Market:    o.Market.Address(),
+		User:      o.User.Address(),
+		OutcomeID: uint64(o.Outcome),
+		Amount:    graphql.ParseDecimal(o.Amount, 6),
+		Shares:    graphql.ParseBigInt(o.Shares),
+		Price:     graphql.ParseDecimal(o.Price, 18),
+		Timestamp: time.Unix(graphql.ParseBigInt(o.Timestamp).Int64(), 0),
+	}
+	if o.TransactionHash != "" {
+		order.TxHash = common.HexToHash(o.TransactionHash)
+	}
+	return order
+}
+
+// ============================================================================
+// SQL（cmd/indexer 写入的 orders 表）
+// ============================================================================
+
+// Timescale 连续聚合（pkg/db/timescale.sql），存在时按日聚合读取交易量
+const (
+	userVolumeView   = "order_volume_daily"
+	marketVolumeView = "market_volume_daily"
+)
+
+// sqlHistory 从数据库 orders 表读取历史数据
+// 地址以小写 hex 存储，时间戳为 Unix 秒，stake 为 USDC 最小单位
+type sqlHistory struct {
+	db *sql.DB
+
+	aggregatesOnce sync.Once
+	aggregates     bool
+}
+
+// hasAggregates 检测 Timescale 连续聚合是否存在（首次调用时查询一次）
+func (h *sqlHistory) hasAggregates(ctx context.Context) bool {
+	h.aggregatesOnce.Do(func() {
+		var user, market bool
+		err := h.db.QueryRowContext(ctx,
+			"SELECT to_regclass($1) IS NOT NULL, to_regclass($2) IS NOT NULL",
+			userVolumeView, marketVolumeView,
+		).Scan(&user, &market)
+		h.aggregates = err == nil && user && market
+	})
+	return h.aggregates
+}
+
+func (h *sqlHistory) MarketPositions(ctx context.Context, market common.Address, block uint64) ([]*Position, error) {
+	// orders 表只记录下注，不含转账与赎回，无法还原头寸
+	return nil, fmt.Errorf("SQL 数据源不支持头寸查询，请使用 history.source=%s", HistorySourceSubgraph)
+}
+
+func (h *sqlHistory) UserOrders(ctx context.Context, user common.Address, limit int, block uint64) ([]*Order, error) {
+	// block 为 0 时不限制区块
+	query := `
+		SELECT id, market_id, outcome, stake, shares, timestamp, tx_hash
+		FROM orders
+		WHERE user_address = $1 AND ($3 = 0 OR block_number <= $3)
+		ORDER BY block_number DESC, log_index DESC
+		LIMIT $2
+	`
+
+	rows, err := h.db.QueryContext(ctx, query, strings.ToLower(user.Hex()), limit, int64(block))
+	if err != nil {
+		return nil, fmt.Errorf("查询订单失败: %w", err)
+	}
+	defer rows.Close()
+
+	results := make([]*Order, 0)
+
+	for rows.Next() {
+		var order Order
+		var marketHex, stake, shares, txHashHex string
+		var timestamp int64
+
+		if err := rows.Scan(&order.ID, &marketHex, &order.OutcomeID, &stake, &shares, &timestamp, &txHashHex); err != nil {
+			return nil, fmt.Errorf("读取订单失败: %w", err)
+		}
+
+		order.User = user
+		order.Market = common.HexToAddress(marketHex)
+		order.Amount = parseNumeric(stake)
+		order.Shares = parseNumeric(shares)
+		order.Price = orderPrice(order.Amount, order.Shares)
+		order.Timestamp = time.Unix(timestamp, 0)
+		order.TxHash = common.HexToHash(txHashHex)
+
+		results = append(results, &order)
+	}
+
+	return results, rows.Err()
+}
+
+func (h *sqlHistory) VolumeStats(ctx context.Context, period string, now time.Time) ([]*VolumeData, error) {
+	start, err := volumeWindowStart(period, now)
+	if err != nil {
+		return nil, err
+	}
+	unit := map[string]string{"daily": "day", "weekly": "week", "monthly": "month"}[period]
+
+	// 周期按 UTC 截断（week 从周一开始），与 Subgraph 数据源的分桶一致
+	query := `
+		SELECT
+			date_trunc($1, to_timestamp(timestamp) AT TIME ZONE 'UTC') AS period,
+			COALESCE(SUM(stake), 0)::TEXT AS volume,
+			COUNT(*) AS order_count,
+			COUNT(DISTINCT user_address) AS unique_users
+		FROM orders
+		WHERE timestamp >= $2
+		GROUP BY period
+		ORDER BY period DESC
+	`
+	if h.hasAggregates(ctx) {
+		// 连续聚合按 UTC 日分桶，起始日整日计入
+		query = `
+			SELECT
+				date_trunc($1, bucket AT TIME ZONE 'UTC') AS period,
+				COALESCE(SUM(volume), 0)::TEXT AS volume,
+				COALESCE(SUM(order_count), 0)::BIGINT AS order_count,
+				COUNT(DISTINCT user_address) AS unique_users
+			FROM ` + userVolumeView + `
+			WHERE bucket >= date_trunc('day', to_timestamp($2))
+			GROUP BY period
+			ORDER BY period DESC
+		`
+	}
+
+	rows, err := h.db.QueryContext(ctx, query, unit, start.Unix())
+	if err != nil {
+		return nil, fmt.Errorf("查询交易量失败: %w", err)
+	}
+	defer rows.Close()
+
+	results := make([]*VolumeData, 0)
+
+	for rows.Next() {
+		var data VolumeData
+		var bucket time.Time
+		var volume string
+
+		if err := rows.Scan(&bucket, &volume, &data.OrderCount, &data.UniqueUsers); err != nil {
+			return nil, fmt.Errorf("读取交易量失败: %w", err)
+		}
+
+		data.Period = volumePeriodKey(period, bucket)
+		data.Volume = parseNumeric(volume)
+		results = append(results, &data)
+	}
+
+	return results, rows.Err()
+}
+
+func (h *sqlHistory) Totals(ctx context.Context, block uint64) (*historyTotals, error) {
+	// block 为 0 时不限制区块
+	query := `
+		SELECT
+			COALESCE(SUM(stake), 0)::TEXT,
+			COALESCE(SUM(fee), 0)::TEXT,
+			COUNT(DISTINCT user_address)
+		FROM orders
+		WHERE $1 = 0 OR block_number <= $1
+	`
+
+	var volume, fees string
+	var users uint64
+	if err := h.db.QueryRowContext(ctx, query, int64(block)).Scan(&volume, &fees, &users); err != nil {
+		return nil, fmt.Errorf("查询累计交易数据失败: %w", err)
+	}
+
+	return &historyTotals{
+		Volume: parseNumeric(volume),
+		Fees:   parseNumeric(fees),
+		Users:  users,
+	}, nil
+}
+
+func (h *sqlHistory) TopMarkets(ctx context.Context, since time.Time, limit int) ([]common.Address, error) {
+	query := `
+		SELECT market_id, SUM(stake) AS total_volume
+		FROM orders
+		WHERE timestamp >= $1
+		GROUP BY market_id
+		ORDER BY total_volume DESC, market_id
+		LIMIT $2
+	`
+	if h.hasAggregates(ctx) {
+		// 连续聚合按 UTC 日分桶，起始日整日计入
+		query = `
+			SELECT market_id, SUM(volume) AS total_volume
+			FROM ` + marketVolumeView + `
+			WHERE bucket >= date_trunc('day', to_timestamp($1))
+			GROUP BY market_id
+			ORDER BY total_volume DESC, market_id
+			LIMIT $2
+		`
+	}
+
+	rows, err := h.db.QueryContext(ctx, query, since.Unix(), limit)
+	if err != nil {
+		return nil, fmt.Errorf("查询热门市场失败: %w", err)
+	}
+	defer rows.Close()
+
+	markets := make([]common.Address, 0)
+
+	for rows.Next() {
+		var marketHex, volume string
+
+		if err := rows.Scan(&marketHex, &volume); err != nil {
+			return nil, fmt.Errorf("读取热门市场失败: %w", err)
+		}
+
+		markets = append(markets, common.HexToAddress(marketHex))
+	}
+
+	return markets, rows.Err()
+}
+
+// parseNumeric 解析 NUMERIC(78, 0) 的文本值，无法解析时返回 nil
+func parseNumeric(s string) *big.Int {
+	v, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return nil
+	}
+	return v
+}
+
+// orderPrice 订单成交价（1e18 精度），与 Subgraph 的 amount / shares 一致
+func orderPrice(amount, shares *big.Int) *big.Int {
+	if amount == nil || shares == nil || shares.Sign() == 0 {
+		return big.NewInt(0)
+	}
+	price := new(big.Int).Mul(amount, big.NewInt(1e18))
+	return price.Quo(price, shares)
+}
@@ -0,0 +1,101 @@
+package query
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pitchone/sportsbook/internal/graphql"
+)
+
+func testOrder(user common.Address, amount int64, ts string) *Order {
+	t, err := time.Parse(time.RFC3339, ts)
+	if err != nil {
+		panic(err)
+	}
+	return &Order{User: user, Amount: big.NewInt(amount), Timestamp: t}
+}
+
+func TestBucketVolume(t *testing.T) {
+	alice := common.HexToAddress("0x01")
+	bob := common.HexToAddress("0x02")
+	orders := []*Order{
+		testOrder(alice, 100, "2024-03-04T00:00:00Z"), // 周一
+		testOrder(bob, 200, "2024-03-10T23:59:59Z"),   // 同一周的周日
+		testOrder(alice, 300, "2024-03-10T12:00:00Z"),
+		testOrder(alice, 400, "2024-02-29T08:00:00Z"),
+	}
+
+	daily := bucketVolume(orders, "daily")
+	require.Len(t, daily, 3)
+	assert.Equal(t, "2024-03-10", daily[0].Period)
+	assert.Equal(t, big.NewInt(500), daily[0].Volume)
+	assert.Equal(t, uint64(2), daily[0].OrderCount)
+	assert.Equal(t, uint64(2), daily[0].UniqueUsers)
+	assert.Equal(t, "2024-02-29", daily[2].Period)
+
+	weekly := bucketVolume(orders, "weekly")
+	require.Len(t, weekly, 2)
+	assert.Equal(t, "2024-03-04", weekly[0].Period)
+	assert.Equal(t, big.NewInt(600), weekly[0].Volume)
+	assert.Equal(t, uint64(3), weekly[0].OrderCount)
+	assert.Equal(t, "2024-02-26", weekly[1].Period)
+
+	monthly := bucketVolume(orders, "monthly")
+	require.Len(t, monthly, 2)
+	assert.Equal(t, "2024-03", monthly[0].Period)
+	assert.Equal(t, uint64(2), monthly[0].UniqueUsers)
+	assert.Equal(t, "2024-02", monthly[1].Period)
+	assert.Equal(t, big.NewInt(400), monthly[1].Volume)
+}
+
+func TestVolumeWindowStart_InvalidPeriod(t *testing.T) {
+	_, err := volumeWindowStart("yearly", time.Now())
+	assert.Error(t, err)
+}
+
+func TestRankMarkets(t *testing.T) {
+	volumes := map[common.Address]*big.Int{
+		testMarketA: big.NewInt(10),
+		testMarketB: big.NewInt(30),
+		testBroken:  big.NewInt(20),
+	}
+
+	assert.Equal(t, []common.Address{testMarketB, testBroken}, rankMarkets(volumes, 2))
+	assert.Len(t, rankMarkets(volumes, 0), 3)
+}
+
+func TestOrderFromGraph(t *testing.T) {
+	order := orderFromGraph(&graphql.Order{
+		ID:              "0xabc-1",
+		Market:          graphql.Market{ID: testMarketA.Hex()},
+		User:            graphql.User{ID: "0x0000000000000000000000000000000000000001"},
+		Outcome:         2,
+		Amount:          "12.5",
+		Shares:          "13000000",
+		Price:           "0.45",
+		Timestamp:       "1700000000",
+		TransactionHash: "0x" + "11" + "00000000000000000000000000000000000000000000000000000000000000",
+	})
+
+	assert.Equal(t, testMarketA, order.Market)
+	assert.Equal(t, uint64(2), order.OutcomeID)
+	assert.Equal(t, big.NewInt(12_500_000), order.Amount)
+	assert.Equal(t, big.NewInt(13_000_000), order.Shares)
+	assert.Equal(t, "450000000000000000", order.Price.String())
+	assert.Equal(t, int64(1700000000), order.Timestamp.Unix())
+	assert.Equal(t, byte(0x11), order.TxHash[0])
+}
+
+func TestOrderPrice(t *testing.T) {
+	// 10 USDC 买入 15e18 份额，与 Subgraph 的 amount / shares 相同
+	amount := parseNumeric("10000000")
+	shares := parseNumeric("15000000000000000000")
+	assert.Equal(t, "666666", orderPrice(amount, shares).String())
+	assert.Equal(t, "0", orderPrice(amount, big.NewInt(0)).String())
+	assert.Nil(t, parseNumeric("1.5"))
+}
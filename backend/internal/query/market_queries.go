@@ -4,71 +4,56 @@ import (
 	"context"
 	"fmt"
 	"math/big"
-	"time"
 
 	"github.com/ethereum/go-ethereum/common"
-
-	"github.com/pitchone/sportsbook/pkg/bindings"
 )
 
 // GetMarketInfo 获取市场详情
 func (s *Service) GetMarketInfo(ctx context.Context, addr common.Address) (*MarketInfo, error) {
-	market, err := bindings.NewMarketBaseV2(addr, s.client)
-	if err != nil {
-		return nil, fmt.Errorf("初始化市场合约失败: %w", err)
+	if s.getMarketVersion(ctx, addr) == MarketVersionV3 {
+		return s.getMarketInfoV3(ctx, addr)
 	}
 
-	opts := s.callOpts(ctx)
+	batch := &callBatch{}
+	statusCall := batch.add(addr, marketV2ABI, "status")
+	outcomeCountCall := batch.add(addr, marketV2ABI, "outcomeCount")
+	kickoffCall := batch.add(addr, marketV2ABI, "kickoffTime")
+	feeRateCall := batch.add(addr, marketV2ABI, "feeRate")
+	winningCall := batch.add(addr, marketV2ABI, "winningOutcome")
+	liquidityCall := batch.add(addr, marketV2ABI, "totalLiquidity")
+	templateCall := s.addTemplateCall(batch, addr)
+	s.runBatch(ctx, batch)
 
 	// 获取基本信息
-	status, err := market.Status(opts)
+	status, err := callResult[uint8](statusCall, 0)
 	if err != nil {
 		return nil, fmt.Errorf("获取状态失败: %w", err)
 	}
 
-	outcomeCount, err := market.OutcomeCount(opts)
+	outcomeCount, err := callResult[*big.Int](outcomeCountCall, 0)
 	if err != nil {
 		return nil, fmt.Errorf("获取结果数量失败: %w", err)
 	}
 
-	// 从 Factory 获取模板 ID
-	var templateID [32]byte
-	if s.factory != nil {
-		templateID, _ = s.factory.MarketTemplate(opts, addr)
-	}
-
-	// 查找模板名称
-	templateName := "Unknown"
-	for name, id := range s.templates.GetAllTemplates() {
-		if id == templateID {
-			templateName = name
-			break
-		}
-	}
-
-	// 获取开球时间
-	var kickoffTime time.Time
-	if kickoff, err := market.KickoffTime(opts); err == nil && kickoff != nil && kickoff.Sign() > 0 {
-		kickoffTime = time.Unix(kickoff.Int64(), 0)
-	}
+	templateID, templateName := s.templateOf(templateCall)
 
 	// 获取费率
 	var feeRate uint64
-	if rate, err := market.FeeRate(opts); err == nil && rate != nil {
+	if rate, err := callResult[*big.Int](feeRateCall, 0); err == nil && rate != nil {
 		feeRate = rate.Uint64()
 	}
 
 	// 获取获胜结果（如果已结算）
 	winningOutcome := int64(-1)
 	if status >= 2 { // Resolved 或更高状态
-		if outcome, err := market.WinningOutcome(opts); err == nil && outcome != nil {
+		if outcome, err := callResult[*big.Int](winningCall, 0); err == nil && outcome != nil {
 			winningOutcome = outcome.Int64()
 		}
 	}
 
 	// 获取总流动性
 	totalLiquidity := big.NewInt(0)
-	if liquidity, err := market.TotalLiquidity(opts); err == nil {
+	if liquidity, err := callResult[*big.Int](liquidityCall, 0); err == nil {
 		totalLiquidity = liquidity
 	}
 
@@ -76,66 +61,60 @@ func (s *Service) GetMarketInfo(ctx context.Context, addr common.Address) (*Mark
 		Address:        addr,
 		TemplateID:     templateID,
 		TemplateName:   templateName,
-		KickoffTime:    kickoffTime,
+		KickoffTime:    unixTime(kickoffCall),
 		Status:         status,
 		StatusName:     getStatusName(status),
 		OutcomeCount:   outcomeCount.Uint64(),
 		WinningOutcome: winningOutcome,
 		TotalLiquidity: totalLiquidity,
 		FeeRate:        feeRate,
+		Version:        MarketVersionV2,
 	}, nil
 }
 
 // GetMarketPrices 获取市场赔率
 func (s *Service) GetMarketPrices(ctx context.Context, addr common.Address) ([]*OutcomePrice, error) {
-	market, err := bindings.NewMarketBaseV2(addr, s.client)
-	if err != nil {
-		return nil, fmt.Errorf("初始化市场合约失败: %w", err)
+	if s.getMarketVersion(ctx, addr) == MarketVersionV3 {
+		return s.getMarketPricesV3(ctx, addr)
 	}
 
-	opts := s.callOpts(ctx)
+	// 获取结果数量，并从 Factory 获取模板 ID 以确定结果名称
+	batch := &callBatch{}
+	outcomeCountCall := batch.add(addr, marketV2ABI, "outcomeCount")
+	templateCall := s.addTemplateCall(batch, addr)
+	s.runBatch(ctx, batch)
 
-	// 获取结果数量
-	outcomeCount, err := market.OutcomeCount(opts)
+	outcomeCount, err := callResult[*big.Int](outcomeCountCall, 0)
 	if err != nil {
 		return nil, fmt.Errorf("获取结果数量失败: %w", err)
 	}
 
-	// 从 Factory 获取模板 ID 以确定结果名称
-	var templateID [32]byte
-	if s.factory != nil {
-		templateID, _ = s.factory.MarketTemplate(opts, addr)
-	}
-
 	templateName := ""
-	for name, id := range s.templates.GetAllTemplates() {
-		if id == templateID {
-			templateName = name
-			break
-		}
+	if templateCall != nil {
+		_, templateName = s.templateOf(templateCall)
 	}
 
 	results := make([]*OutcomePrice, 0, outcomeCount.Uint64())
 
 	// 尝试使用 WDL 模板获取价格
 	if templateName == "wdl" {
-		wdlMarket, err := bindings.NewWDLTemplate(addr, s.client)
-		if err == nil {
-			prices, err := wdlMarket.GetAllPrices(opts)
-			if err == nil {
-				for i, price := range prices {
-					odds, impliedProb := calculateOddsFromPrice(price)
-					results = append(results, &OutcomePrice{
-						OutcomeID:   uint64(i),
-						OutcomeName: getOutcomeName(templateName, uint64(i)),
-						Price:       price,
-						Odds:        odds,
-						ImpliedProb: impliedProb,
-						Reserve:     big.NewInt(0),
-					})
-				}
-				return results, nil
+		prices := &callBatch{}
+		pricesCall := prices.add(addr, wdlTemplateABI, "getAllPrices")
+		s.runBatch(ctx, prices)
+
+		if all, err := callResult[[3]*big.Int](pricesCall, 0); err == nil {
+			for i, price := range all {
+				odds, impliedProb := calculateOddsFromPrice(price)
+				results = append(results, &OutcomePrice{
+					OutcomeID:   uint64(i),
+					OutcomeName: getOutcomeName(templateName, uint64(i)),
+					Price:       price,
+					Odds:        odds,
+					ImpliedProb: impliedProb,
+					Reserve:     big.NewInt(0),
+				})
 			}
+			return results, nil
 		}
 	}
 
@@ -172,32 +151,39 @@ func calculateOddsFromPrice(price *big.Int) (odds float64, impliedProb float64)
 	return odds, impliedProb * 100
 }
 
-// GetMarketPositions 获取市场头寸分布
+// GetMarketPositions 获取市场头寸分布（按余额倒序）
 func (s *Service) GetMarketPositions(ctx context.Context, addr common.Address) ([]*Position, error) {
-	// 这需要通过事件日志或数据库来获取
-	// 暂时返回空列表
-	return []*Position{}, nil
+	history, err := s.historySourceOrErr()
+	if err != nil {
+		return nil, err
+	}
+	return history.MarketPositions(ctx, addr, s.pinnedBlock())
 }
 
 // GetUserMarketPosition 获取用户在特定市场的头寸
 func (s *Service) GetUserMarketPosition(ctx context.Context, market common.Address, user common.Address) ([]*Position, error) {
-	marketContract, err := bindings.NewMarketBaseV2(market, s.client)
-	if err != nil {
-		return nil, fmt.Errorf("初始化市场合约失败: %w", err)
-	}
-
-	opts := s.callOpts(ctx)
+	contractABI := marketABI(s.getMarketVersion(ctx, market))
 
 	// 获取结果数量
-	outcomeCount, err := marketContract.OutcomeCount(opts)
+	batch := &callBatch{}
+	outcomeCountCall := batch.add(market, contractABI, "outcomeCount")
+	s.runBatch(ctx, batch)
+
+	outcomeCount, err := callResult[*big.Int](outcomeCountCall, 0)
 	if err != nil {
 		return nil, fmt.Errorf("获取结果数量失败: %w", err)
 	}
 
+	balances := &callBatch{}
+	for i := uint64(0); i < outcomeCount.Uint64(); i++ {
+		balances.add(market, contractABI, "balanceOf", user, new(big.Int).SetUint64(i))
+	}
+	s.runBatch(ctx, balances)
+
 	results := make([]*Position, 0)
 
-	for i := uint64(0); i < outcomeCount.Uint64(); i++ {
-		balance, err := marketContract.BalanceOf(opts, user, big.NewInt(int64(i)))
+	for i, call := range balances.calls {
+		balance, err := callResult[*big.Int](call, 0)
 		if err != nil {
 			continue
 		}
@@ -205,7 +191,7 @@ func (s *Service) GetUserMarketPosition(ctx context.Context, market common.Addre
 		if balance.Sign() > 0 {
 			results = append(results, &Position{
 				Owner:     user,
-				OutcomeID: i,
+				OutcomeID: uint64(i),
 				Balance:   balance,
 			})
 		}
@@ -0,0 +1,332 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/pitchone/sportsbook/pkg/bindings"
+)
+
+// MarketVersion 市场合约版本
+type MarketVersion uint8
+
+const (
+	MarketVersionV2 MarketVersion = 2 // MarketBase_V2 及其模板
+	MarketVersionV3 MarketVersion = 3 // Market_V3（PricingStrategy + ResultMapper）
+)
+
+// v3StatusNames Market_V3 状态枚举（比 V2 多一个 Created，其余依次后移）
+var v3StatusNames = []string{"Created", "Open", "Locked", "Resolved", "Finalized", "Cancelled"}
+
+// detectMarketVersions 批量检测未缓存市场的版本：能读到非零 pricingStrategy 的为 Market_V3，结果按地址缓存
+func (s *Service) detectMarketVersions(ctx context.Context, addrs []common.Address) {
+	batch := &callBatch{}
+	calls := make(map[common.Address]*contractCall)
+	for _, addr := range addrs {
+		if _, ok := s.versions.Load(addr); ok {
+			continue
+		}
+		if _, ok := calls[addr]; ok {
+			continue
+		}
+		calls[addr] = batch.add(addr, marketV3ABI, "pricingStrategy")
+	}
+	if len(calls) == 0 {
+		return
+	}
+
+	s.runBatch(ctx, batch)
+
+	for addr, call := range calls {
+		version := MarketVersionV2
+		if strategy, err := callResult[common.Address](call, 0); err == nil && !isZeroAddress(strategy) {
+			version = MarketVersionV3
+		}
+		s.versions.Store(addr, version)
+	}
+}
+
+// getMarketVersion 检测单个市场的版本
+func (s *Service) getMarketVersion(ctx context.Context, addr common.Address) MarketVersion {
+	s.detectMarketVersions(ctx, []common.Address{addr})
+	return s.cachedMarketVersion(addr)
+}
+
+// cachedMarketVersion 读取已检测的市场版本，未检测时按 V2 处理
+func (s *Service) cachedMarketVersion(addr common.Address) MarketVersion {
+	if v, ok := s.versions.Load(addr); ok {
+		return v.(MarketVersion)
+	}
+	return MarketVersionV2
+}
+
+// marketABI 按版本返回市场合约 ABI（status / outcomeCount / balanceOf 等在两个版本中签名一致）
+func marketABI(version MarketVersion) *abi.ABI {
+	if version == MarketVersionV3 {
+		return marketV3ABI
+	}
+	return marketV2ABI
+}
+
+// marketStatusName 按版本获取状态名称
+func marketStatusName(version MarketVersion, status uint8) string {
+	if version == MarketVersionV3 {
+		return getV3StatusName(status)
+	}
+	return getStatusName(status)
+}
+
+// getV3StatusName 获取 Market_V3 状态名称
+func getV3StatusName(status uint8) string {
+	if int(status) < len(v3StatusNames) {
+		return v3StatusNames[status]
+	}
+	return fmt.Sprintf("Unknown(%d)", status)
+}
+
+// getMarketInfoV3 获取 Market_V3 详情
+func (s *Service) getMarketInfoV3(ctx context.Context, addr common.Address) (*MarketInfo, error) {
+	batch := &callBatch{}
+	statusCall := batch.add(addr, marketV3ABI, "status")
+	rulesCall := batch.add(addr, marketV3ABI, "getOutcomeRules")
+	statsCall := batch.add(addr, marketV3ABI, "getStats")
+	matchIDCall := batch.add(addr, marketV3ABI, "matchId")
+	kickoffCall := batch.add(addr, marketV3ABI, "kickoffTime")
+	pausedCall := batch.add(addr, marketV3ABI, "paused")
+	strategyCall := batch.add(addr, marketV3ABI, "pricingStrategy")
+	mapperCall := batch.add(addr, marketV3ABI, "resultMapper")
+	settlementCall := batch.add(addr, marketV3ABI, "getSettlementResult")
+	templateCall := s.addTemplateCall(batch, addr)
+	s.runBatch(ctx, batch)
+
+	status, err := callResult[uint8](statusCall, 0)
+	if err != nil {
+		return nil, fmt.Errorf("获取状态失败: %w", err)
+	}
+
+	rules, err := callResult[[]bindings.IMarketV3OutcomeRule](rulesCall, 0)
+	if err != nil {
+		return nil, fmt.Errorf("获取结果规则失败: %w", err)
+	}
+
+	stats, err := callResult[bindings.IMarketV3MarketStats](statsCall, 0)
+	if err != nil {
+		return nil, fmt.Errorf("获取市场统计失败: %w", err)
+	}
+
+	templateID, templateName := s.templateOf(templateCall)
+
+	info := &MarketInfo{
+		Address:        addr,
+		TemplateID:     templateID,
+		TemplateName:   templateName,
+		KickoffTime:    unixTime(kickoffCall),
+		Status:         status,
+		StatusName:     getV3StatusName(status),
+		OutcomeCount:   uint64(len(rules)),
+		WinningOutcome: -1,
+		TotalLiquidity: stats.TotalLiquidity,
+		Version:        MarketVersionV3,
+	}
+	info.MatchID, _ = callResult[string](matchIDCall, 0)
+
+	v3 := &MarketV3Info{
+		BorrowedAmount: stats.BorrowedAmount,
+		TotalBetAmount: stats.TotalBetAmount,
+		Outcomes:       make([]*OutcomeRuleInfo, 0, len(rules)),
+	}
+	for i, rule := range rules {
+		v3.Outcomes = append(v3.Outcomes, &OutcomeRuleInfo{
+			OutcomeID:   uint64(i),
+			Name:        rule.Name,
+			PayoutType:  getPayoutTypeName(rule.PayoutType),
+			TotalBet:    bigAt(stats.TotalBetPerOutcome, i),
+			TotalShares: bigAt(stats.TotalSharesPerOutcome, i),
+		})
+	}
+	v3.Paused, _ = callResult[bool](pausedCall, 0)
+	v3.PricingStrategy, _ = callResult[common.Address](strategyCall, 0)
+	v3.ResultMapper, _ = callResult[common.Address](mapperCall, 0)
+
+	// 定价策略与赛果映射器的元数据
+	details := &callBatch{}
+	var strategyTypeCall, mapperTypeCall, mapperVersionCall, mapperParamsCall *contractCall
+	if !isZeroAddress(v3.PricingStrategy) {
+		strategyTypeCall = details.add(v3.PricingStrategy, pricingStrategyABI, "strategyType")
+	}
+	if !isZeroAddress(v3.ResultMapper) {
+		mapperTypeCall = details.add(v3.ResultMapper, resultMapperABI, "mapperType")
+		mapperVersionCall = details.add(v3.ResultMapper, resultMapperABI, "version")
+		mapperParamsCall = details.add(v3.ResultMapper, resultMapperABI, "getParams")
+	}
+	s.runBatch(ctx, details)
+
+	if strategyTypeCall != nil {
+		v3.PricingStrategyType, _ = callResult[string](strategyTypeCall, 0)
+	}
+	if mapperTypeCall != nil {
+		v3.MapperType, _ = callResult[string](mapperTypeCall, 0)
+		v3.MapperVersion, _ = callResult[string](mapperVersionCall, 0)
+		if params, err := callResult[[]byte](mapperParamsCall, 0); err == nil {
+			v3.MapperParams = decodeMapperParams(v3.MapperType, params)
+		}
+	}
+
+	// 结算结果
+	if result, err := callResult[bindings.IMarketV3SettlementResult](settlementCall, 0); err == nil && result.Resolved {
+		v3.Settlement = newSettlementResultInfo(v3.MapperType, result)
+		if len(v3.Settlement.OutcomeIDs) == 1 {
+			info.WinningOutcome = int64(v3.Settlement.OutcomeIDs[0])
+		}
+	}
+
+	info.V3 = v3
+	return info, nil
+}
+
+// getMarketPricesV3 获取 Market_V3 赔率，价格由基点换算为 1e18 精度
+func (s *Service) getMarketPricesV3(ctx context.Context, addr common.Address) ([]*OutcomePrice, error) {
+	batch := &callBatch{}
+	rulesCall := batch.add(addr, marketV3ABI, "getOutcomeRules")
+	pricesCall := batch.add(addr, marketV3ABI, "getAllPrices")
+	s.runBatch(ctx, batch)
+
+	rules, err := callResult[[]bindings.IMarketV3OutcomeRule](rulesCall, 0)
+	if err != nil {
+		return nil, fmt.Errorf("获取结果规则失败: %w", err)
+	}
+
+	prices, err := callResult[[]*big.Int](pricesCall, 0)
+	if err != nil {
+		return nil, fmt.Errorf("获取价格失败: %w", err)
+	}
+
+	bpsToWad := big.NewInt(1e14)
+	results := make([]*OutcomePrice, 0, len(rules))
+	for i, rule := range rules {
+		price := new(big.Int).Mul(bigAt(prices, i), bpsToWad)
+		odds, impliedProb := calculateOddsFromPrice(price)
+		results = append(results, &OutcomePrice{
+			OutcomeID:   uint64(i),
+			OutcomeName: rule.Name,
+			Price:       price,
+			Odds:        odds,
+			ImpliedProb: impliedProb,
+			Reserve:     big.NewInt(0),
+		})
+	}
+
+	return results, nil
+}
+
+// loadOutcomeNamesV3 批量获取 Market_V3 各结果名称，非 V3 或读取失败的市场不在结果中
+func (s *Service) loadOutcomeNamesV3(ctx context.Context, addrs []common.Address) map[common.Address][]string {
+	batch := &callBatch{}
+	calls := make(map[common.Address]*contractCall)
+	for _, addr := range addrs {
+		if _, ok := calls[addr]; ok || s.cachedMarketVersion(addr) != MarketVersionV3 {
+			continue
+		}
+		calls[addr] = batch.add(addr, marketV3ABI, "getOutcomeRules")
+	}
+	s.runBatch(ctx, batch)
+
+	names := make(map[common.Address][]string, len(calls))
+	for addr, call := range calls {
+		rules, err := callResult[[]bindings.IMarketV3OutcomeRule](call, 0)
+		if err != nil {
+			continue
+		}
+		for _, rule := range rules {
+			names[addr] = append(names[addr], rule.Name)
+		}
+	}
+	return names
+}
+
+// newSettlementResultInfo 转换结算结果，按比分结算的 Mapper 解码原始赛果
+func newSettlementResultInfo(mapperType string, result bindings.IMarketV3SettlementResult) *SettlementResultInfo {
+	info := &SettlementResultInfo{
+		OutcomeIDs: make([]uint64, 0, len(result.OutcomeIds)),
+		Weights:    make([]uint64, 0, len(result.Weights)),
+		RawResult:  hexutil.Encode(result.RawResult),
+	}
+	for _, id := range result.OutcomeIds {
+		info.OutcomeIDs = append(info.OutcomeIDs, id.Uint64())
+	}
+	for _, w := range result.Weights {
+		info.Weights = append(info.Weights, w.Uint64())
+	}
+	if result.SettledAt != nil && result.SettledAt.Sign() > 0 {
+		info.SettledAt = time.Unix(result.SettledAt.Int64(), 0)
+	}
+
+	// IDENTITY 的原始赛果是 outcomeId，其余 Mapper 均为 (homeScore, awayScore)
+	if mapperType != "IDENTITY" && len(result.RawResult) == 64 {
+		home := new(big.Int).SetBytes(result.RawResult[:32])
+		away := new(big.Int).SetBytes(result.RawResult[32:])
+		info.Score = fmt.Sprintf("%s-%s", home, away)
+	}
+
+	return info
+}
+
+// decodeMapperParams 解码 Mapper 参数：OU / AH 为 int256 盘口线（1000 = 1 球），SCORE 为 uint256 最大进球数
+func decodeMapperParams(mapperType string, params []byte) string {
+	if len(params) == 0 {
+		return ""
+	}
+
+	var typeName, label string
+	switch strings.ToUpper(mapperType) {
+	case "OU", "AH":
+		typeName, label = "int256", "line"
+	case "SCORE":
+		typeName, label = "uint256", "maxGoals"
+	default:
+		return hexutil.Encode(params)
+	}
+
+	t, err := abi.NewType(typeName, "", nil)
+	if err != nil {
+		return hexutil.Encode(params)
+	}
+	values, err := abi.Arguments{{Type: t}}.Unpack(params)
+	if err != nil || len(values) != 1 {
+		return hexutil.Encode(params)
+	}
+
+	value := values[0].(*big.Int)
+	if label == "line" {
+		line, _ := new(big.Float).Quo(new(big.Float).SetInt(value), big.NewFloat(1000)).Float64()
+		return fmt.Sprintf("line=%g", line)
+	}
+	return fmt.Sprintf("%s=%s", label, value)
+}
+
+// getPayoutTypeName 获取赔付类型名称
+func getPayoutTypeName(payoutType uint8) string {
+	switch payoutType {
+	case 0:
+		return "WINNER"
+	case 1:
+		return "REFUND"
+	default:
+		return fmt.Sprintf("Unknown(%d)", payoutType)
+	}
+}
+
+// bigAt 安全读取数组元素，越界返回 0
+func bigAt(values []*big.Int, i int) *big.Int {
+	if i < len(values) && values[i] != nil {
+		return values[i]
+	}
+	return big.NewInt(0)
+}
@@ -51,6 +51,14 @@ type ReferralInfo struct {
 	BoundAt       time.Time      `json:"boundAt"`
 }
 
+// UserRewardWeek 用户单周奖励
+type UserRewardWeek struct {
+	Week      uint64              `json:"week"`
+	Amount    *big.Int            `json:"amount"`    // Merkle 叶子金额（各类别之和）
+	ScaleBps  uint64              `json:"scaleBps"`  // 领取时的缩放比例
+	Breakdown map[string]*big.Int `json:"breakdown"` // 奖励类别 -> 金额
+}
+
 // ReferralStats 推荐系统统计
 type ReferralStats struct {
 	TotalReferrals      uint64   `json:"totalReferrals"`
@@ -81,6 +89,7 @@ type MarketSummary struct {
 	TemplateName string         `json:"templateName"`
 	Status       uint8          `json:"status"`
 	StatusName   string         `json:"statusName"`
+	Version      MarketVersion  `json:"version"`
 	MatchID      string         `json:"matchId"`
 	HomeTeam     string         `json:"homeTeam"`
 	AwayTeam     string         `json:"awayTeam"`
@@ -102,6 +111,41 @@ type MarketInfo struct {
 	WinningOutcome int64          `json:"winningOutcome"` // -1 表示未结算
 	TotalLiquidity *big.Int       `json:"totalLiquidity"`
 	FeeRate        uint64         `json:"feeRate"`
+	Version        MarketVersion  `json:"version"`
+	V3             *MarketV3Info  `json:"v3,omitempty"` // 仅 Market_V3
+}
+
+// MarketV3Info Market_V3 扩展信息
+type MarketV3Info struct {
+	PricingStrategy     common.Address        `json:"pricingStrategy"`
+	PricingStrategyType string                `json:"pricingStrategyType"` // CPMM / LMSR / PARIMUTUEL
+	ResultMapper        common.Address        `json:"resultMapper"`
+	MapperType          string                `json:"mapperType"` // WDL / OU / AH / SCORE / ODD_EVEN / IDENTITY
+	MapperVersion       string                `json:"mapperVersion"`
+	MapperParams        string                `json:"mapperParams"` // 解码后的参数，如 line=2.5
+	Paused              bool                  `json:"paused"`
+	BorrowedAmount      *big.Int              `json:"borrowedAmount"`
+	TotalBetAmount      *big.Int              `json:"totalBetAmount"`
+	Outcomes            []*OutcomeRuleInfo    `json:"outcomes"`
+	Settlement          *SettlementResultInfo `json:"settlement,omitempty"` // 未结算时为空
+}
+
+// OutcomeRuleInfo Market_V3 结果规则及统计
+type OutcomeRuleInfo struct {
+	OutcomeID   uint64   `json:"outcomeId"`
+	Name        string   `json:"name"`
+	PayoutType  string   `json:"payoutType"` // WINNER / REFUND
+	TotalBet    *big.Int `json:"totalBet"`
+	TotalShares *big.Int `json:"totalShares"`
+}
+
+// SettlementResultInfo Market_V3 结算结果
+type SettlementResultInfo struct {
+	OutcomeIDs []uint64  `json:"outcomeIds"`
+	Weights    []uint64  `json:"weights"` // 基点
+	RawResult  string    `json:"rawResult"`
+	Score      string    `json:"score,omitempty"` // 按比分结算的 Mapper 解码为 主-客
+	SettledAt  time.Time `json:"settledAt"`
 }
 
 // OutcomePrice 结果赔率
@@ -164,8 +208,19 @@ type VolumeData struct {
 
 // ListMarketsOptions 市场列表选项
 type ListMarketsOptions struct {
-	Status     *uint8    // 筛选状态
+	Status     string    // 筛选状态名（Open / Locked / ...），V2 与 V3 状态编号不同，按名称比较
 	TemplateID *[32]byte // 筛选模板
 	Offset     uint64    // 分页偏移
 	Limit      uint64    // 分页大小
 }
+
+// MarketSnapshot 市场实时快照（watch 界面按周期刷新）
+type MarketSnapshot struct {
+	Info         *MarketInfo     `json:"info"`
+	Prices       []*OutcomePrice `json:"prices"`
+	Volume       *big.Int        `json:"volume"`       // 累计下注额，仅 Market_V3
+	Liability    []*big.Int      `json:"liability"`    // 各结果获胜时的应付赔付，仅 Market_V3
+	MaxLiability *big.Int        `json:"maxLiability"` // Liability 最大值
+	BlockNumber  uint64          `json:"blockNumber"`
+	FetchedAt    time.Time       `json:"fetchedAt"`
+}
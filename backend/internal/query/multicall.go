@@ -0,0 +1,228 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/pitchone/sportsbook/pkg/bindings"
+)
+
+// DefaultMulticall3Address Multicall3 在各 EVM 链上的统一部署地址
+var DefaultMulticall3Address = common.HexToAddress("0xcA11bde05977b3631167028862bE2a173976CA11")
+
+// DefaultMulticallBatchSize 每次 aggregate3 的默认调用数
+const DefaultMulticallBatchSize = 500
+
+// 查询使用的合约 ABI
+var (
+	marketV2ABI        = mustParseABI(bindings.MarketBaseV2MetaData)
+	marketV3ABI        = mustParseABI(bindings.MarketV3MetaData)
+	factoryABI         = mustParseABI(bindings.MarketFactoryMetaData)
+	wdlTemplateABI     = mustParseABI(bindings.WDLTemplateMetaData)
+	erc20ABI           = mustParseABI(bindings.IERC20MetaData)
+	providerABI        = mustParseABI(bindings.ERC4626LiquidityProviderMetaData)
+	feeRouterABI       = mustParseABI(bindings.FeeRouterMetaData)
+	referralABI        = mustParseABI(bindings.ReferralRegistryMetaData)
+	pricingStrategyABI = mustParseABI(bindings.IPricingStrategyMetaData)
+	resultMapperABI    = mustParseABI(bindings.IResultMapperMetaData)
+)
+
+func mustParseABI(meta *bind.MetaData) *abi.ABI {
+	parsed, err := meta.GetAbi()
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}
+
+// contractCall 一次只读合约调用及其结果
+type contractCall struct {
+	target common.Address
+	abi    *abi.ABI
+	method string
+	args   []interface{}
+
+	data   []byte        // 编码后的 calldata
+	values []interface{} // 解码后的返回值
+	err    error         // 编码、执行或解码失败原因
+}
+
+// callBatch 一组待执行的只读调用
+type callBatch struct {
+	calls []*contractCall
+}
+
+// add 添加调用，执行后通过返回的 contractCall 读取结果
+func (b *callBatch) add(target common.Address, contractABI *abi.ABI, method string, args ...interface{}) *contractCall {
+	c := &contractCall{target: target, abi: contractABI, method: method, args: args}
+	b.calls = append(b.calls, c)
+	return c
+}
+
+// callResult 读取调用的第 i 个返回值
+func callResult[T any](c *contractCall, i int) (T, error) {
+	var zero T
+	if c.err != nil {
+		return zero, c.err
+	}
+	if i >= len(c.values) {
+		return zero, fmt.Errorf("%s 返回值数量不足", c.method)
+	}
+	if v, ok := c.values[i].(T); ok {
+		return v, nil
+	}
+	out, ok := abi.ConvertType(c.values[i], new(T)).(*T)
+	if !ok {
+		return zero, fmt.Errorf("%s 返回值类型不匹配", c.method)
+	}
+	return *out, nil
+}
+
+// multicaller 通过 Multicall3.aggregate3 批量执行只读调用
+type multicaller struct {
+	caller    bind.ContractCaller
+	contract  *bindings.Multicall3Caller // nil 表示链上未部署 Multicall3，逐个 eth_call
+	batchSize int
+}
+
+// newMulticaller 创建聚合调用器；address 上（block 时）没有合约代码时退化为逐个调用
+func newMulticaller(ctx context.Context, caller bind.ContractCaller, address common.Address, block *big.Int, batchSize int) (*multicaller, error) {
+	if batchSize <= 0 {
+		batchSize = DefaultMulticallBatchSize
+	}
+	m := &multicaller{caller: caller, batchSize: batchSize}
+
+	if isZeroAddress(address) {
+		address = DefaultMulticall3Address
+	}
+	code, err := caller.CodeAt(ctx, address, block)
+	if err != nil {
+		return nil, fmt.Errorf("检查 Multicall3 合约失败: %w", err)
+	}
+	if len(code) == 0 {
+		return m, nil
+	}
+
+	m.contract, err = bindings.NewMulticall3Caller(address, caller)
+	if err != nil {
+		return nil, fmt.Errorf("初始化 Multicall3 合约失败: %w", err)
+	}
+	return m, nil
+}
+
+// aggregate 执行一组调用：按 batchSize 分块聚合，单个调用失败只记录在该调用上
+func (m *multicaller) aggregate(opts *bind.CallOpts, calls []*contractCall) {
+	pending := make([]*contractCall, 0, len(calls))
+	for _, c := range calls {
+		data, err := c.abi.Pack(c.method, c.args...)
+		if err != nil {
+			c.err = fmt.Errorf("编码 %s 失败: %w", c.method, err)
+			continue
+		}
+		c.data = data
+		pending = append(pending, c)
+	}
+
+	if m.contract == nil {
+		for _, c := range pending {
+			m.callDirect(opts, c)
+		}
+		return
+	}
+
+	for start := 0; start < len(pending); start += m.batchSize {
+		end := start + m.batchSize
+		if end > len(pending) {
+			end = len(pending)
+		}
+		m.aggregateChunk(opts, pending[start:end])
+	}
+}
+
+// aggregateChunk 聚合一个分块；整块失败（如超出 gas 上限或响应过大）时二分重试，
+// 单个调用仍失败则退化为直接 eth_call
+func (m *multicaller) aggregateChunk(opts *bind.CallOpts, chunk []*contractCall) {
+	if opts.Context != nil && opts.Context.Err() != nil {
+		for _, c := range chunk {
+			c.err = opts.Context.Err()
+		}
+		return
+	}
+
+	calls := make([]bindings.IMulticall3Call3, len(chunk))
+	for i, c := range chunk {
+		calls[i] = bindings.IMulticall3Call3{Target: c.target, AllowFailure: true, CallData: c.data}
+	}
+
+	var out []interface{}
+	raw := &bindings.Multicall3CallerRaw{Contract: m.contract}
+	err := raw.Call(opts, &out, "aggregate3", calls)
+	if err != nil {
+		if len(chunk) == 1 {
+			m.callDirect(opts, chunk[0])
+			return
+		}
+		mid := len(chunk) / 2
+		m.aggregateChunk(opts, chunk[:mid])
+		m.aggregateChunk(opts, chunk[mid:])
+		return
+	}
+
+	results := *abi.ConvertType(out[0], new([]bindings.IMulticall3Result)).(*[]bindings.IMulticall3Result)
+	if len(results) != len(chunk) {
+		for _, c := range chunk {
+			c.err = fmt.Errorf("Multicall3 返回 %d 个结果，期望 %d", len(results), len(chunk))
+		}
+		return
+	}
+
+	for i, result := range results {
+		if !result.Success {
+			chunk[i].err = fmt.Errorf("调用 %s.%s 失败", chunk[i].target.Hex(), chunk[i].method)
+			continue
+		}
+		chunk[i].decode(result.ReturnData)
+	}
+}
+
+// callDirect 直接 eth_call 单个调用
+func (m *multicaller) callDirect(opts *bind.CallOpts, c *contractCall) {
+	ctx := opts.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	target := c.target
+	data, err := m.caller.CallContract(ctx, ethereum.CallMsg{From: opts.From, To: &target, Data: c.data}, opts.BlockNumber)
+	if err != nil {
+		c.err = fmt.Errorf("调用 %s.%s 失败: %w", c.target.Hex(), c.method, err)
+		return
+	}
+	c.decode(data)
+}
+
+// decode 解码返回数据
+func (c *contractCall) decode(data []byte) {
+	if len(data) == 0 {
+		c.err = errors.New(c.method + " 返回为空（目标地址可能不是合约）")
+		return
+	}
+	values, err := c.abi.Unpack(c.method, data)
+	if err != nil {
+		c.err = fmt.Errorf("解码 %s 失败: %w", c.method, err)
+		return
+	}
+	c.values = values
+}
+
+// runBatch 执行一组调用，读取状态与 callOpts 一致
+func (s *Service) runBatch(ctx context.Context, b *callBatch) {
+	s.multicall.aggregate(s.callOpts(ctx), b.calls)
+}
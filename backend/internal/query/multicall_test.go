@@ -0,0 +1,161 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pitchone/sportsbook/pkg/bindings"
+)
+
+var (
+	testMarketA = common.HexToAddress("0x00000000000000000000000000000000000000a1")
+	testMarketB = common.HexToAddress("0x00000000000000000000000000000000000000b2")
+	testBroken  = common.HexToAddress("0x00000000000000000000000000000000000000ee")
+)
+
+// fakeChain 模拟 Multicall3 与市场合约：市场返回 status = 地址末字节，testBroken 总是回滚
+type fakeChain struct {
+	deployed      bool
+	maxBatch      int // aggregate3 超过该调用数时整体失败，模拟 gas / 响应上限
+	aggregates    int
+	directCalls   int
+	maxAggregated int
+}
+
+func (f *fakeChain) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	if contract == DefaultMulticall3Address && f.deployed {
+		return []byte{0x60}, nil
+	}
+	return nil, nil
+}
+
+func (f *fakeChain) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	if *call.To != DefaultMulticall3Address {
+		f.directCalls++
+		return f.execute(*call.To, call.Data)
+	}
+
+	multicallABI, err := bindings.Multicall3MetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	args, err := multicallABI.Methods["aggregate3"].Inputs.Unpack(call.Data[4:])
+	if err != nil {
+		return nil, err
+	}
+	calls := args[0].([]struct {
+		Target       common.Address `json:"target"`
+		AllowFailure bool           `json:"allowFailure"`
+		CallData     []byte         `json:"callData"`
+	})
+
+	f.aggregates++
+	if f.maxBatch > 0 && len(calls) > f.maxBatch {
+		return nil, errors.New("out of gas")
+	}
+	if len(calls) > f.maxAggregated {
+		f.maxAggregated = len(calls)
+	}
+
+	results := make([]bindings.IMulticall3Result, len(calls))
+	for i, c := range calls {
+		data, err := f.execute(c.Target, c.CallData)
+		results[i] = bindings.IMulticall3Result{Success: err == nil, ReturnData: data}
+	}
+	return multicallABI.Methods["aggregate3"].Outputs.Pack(results)
+}
+
+func (f *fakeChain) execute(target common.Address, data []byte) ([]byte, error) {
+	if target == testBroken {
+		return nil, errors.New("execution reverted")
+	}
+	method, err := marketV2ABI.MethodById(data[:4])
+	if err != nil || method.Name != "status" {
+		return nil, errors.New("execution reverted")
+	}
+	return method.Outputs.Pack(target[19])
+}
+
+func statusBatch(targets ...common.Address) *callBatch {
+	batch := &callBatch{}
+	for _, target := range targets {
+		batch.add(target, marketV2ABI, "status")
+	}
+	return batch
+}
+
+func TestMulticaller_PartialFailure(t *testing.T) {
+	chain := &fakeChain{deployed: true}
+	m, err := newMulticaller(context.Background(), chain, common.Address{}, nil, 0)
+	require.NoError(t, err)
+	require.NotNil(t, m.contract)
+
+	batch := statusBatch(testMarketA, testBroken, testMarketB)
+	m.aggregate(&bind.CallOpts{Context: context.Background()}, batch.calls)
+
+	assert.Equal(t, 1, chain.aggregates)
+	assert.Equal(t, 0, chain.directCalls)
+
+	status, err := callResult[uint8](batch.calls[0], 0)
+	require.NoError(t, err)
+	assert.Equal(t, uint8(0xa1), status)
+
+	_, err = callResult[uint8](batch.calls[1], 0)
+	assert.Error(t, err)
+
+	status, err = callResult[uint8](batch.calls[2], 0)
+	require.NoError(t, err)
+	assert.Equal(t, uint8(0xb2), status)
+}
+
+func TestMulticaller_ChunksAndSplitsOversizedBatches(t *testing.T) {
+	// 分块大小 4，但链上每次最多接受 3 个调用：失败的块被二分重试
+	chain := &fakeChain{deployed: true, maxBatch: 3}
+	m, err := newMulticaller(context.Background(), chain, common.Address{}, nil, 4)
+	require.NoError(t, err)
+
+	targets := make([]common.Address, 10)
+	for i := range targets {
+		targets[i] = common.BigToAddress(big.NewInt(int64(i + 1)))
+	}
+	batch := statusBatch(targets...)
+	m.aggregate(&bind.CallOpts{Context: context.Background()}, batch.calls)
+
+	assert.LessOrEqual(t, chain.maxAggregated, 3)
+	assert.Equal(t, 0, chain.directCalls)
+	for i, call := range batch.calls {
+		status, err := callResult[uint8](call, 0)
+		require.NoError(t, err)
+		assert.Equal(t, uint8(i+1), status)
+	}
+}
+
+func TestMulticaller_FallsBackWithoutMulticall3(t *testing.T) {
+	chain := &fakeChain{}
+	m, err := newMulticaller(context.Background(), chain, common.Address{}, nil, 0)
+	require.NoError(t, err)
+	assert.Nil(t, m.contract)
+
+	batch := statusBatch(testMarketA, testBroken)
+	batch.add(testMarketA, marketV2ABI, "status", big.NewInt(1)) // 参数数量错误，编码失败
+	m.aggregate(&bind.CallOpts{Context: context.Background()}, batch.calls)
+
+	assert.Equal(t, 0, chain.aggregates)
+	assert.Equal(t, 2, chain.directCalls)
+
+	status, err := callResult[uint8](batch.calls[0], 0)
+	require.NoError(t, err)
+	assert.Equal(t, uint8(0xa1), status)
+	_, err = callResult[uint8](batch.calls[1], 0)
+	assert.Error(t, err)
+	_, err = callResult[uint8](batch.calls[2], 0)
+	assert.ErrorContains(t, err, "编码")
+}
@@ -0,0 +1,365 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/pitchone/sportsbook/pkg/bindings"
+)
+
+// DefaultRiskMaxGoals 风险矩阵默认的单方最大进球数
+const DefaultRiskMaxGoals = 5
+
+// weightBase 结算权重基数（10000 = 100%）
+const weightBase = 10000
+
+// 生成的绑定中缺少的方法：IPricingStrategy.calculatePayout 与 ILiquidityVault_V3.availableLiquidity
+var (
+	payoutStrategyABI = mustParseABI(&bind.MetaData{ABI: `[{"type":"function","name":"calculatePayout","stateMutability":"pure","inputs":[{"name":"outcomeId","type":"uint256"},{"name":"shares","type":"uint256"},{"name":"totalSharesPerOutcome","type":"uint256[]"},{"name":"totalLiquidity","type":"uint256"},{"name":"payoutType","type":"uint8"}],"outputs":[{"name":"payout","type":"uint256"}]}]`})
+	liquidityVaultABI = mustParseABI(&bind.MetaData{ABI: `[{"type":"function","name":"availableLiquidity","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint256"}]}]`})
+)
+
+// MatchRisk 一场比赛所有 Market_V3 在各比分下的赔付与盈亏
+type MatchRisk struct {
+	MatchID            string            `json:"matchId"`
+	MaxGoals           int               `json:"maxGoals"`
+	Markets            []*MarketRisk     `json:"markets"`
+	Skipped            []*MarketRisk     `json:"skipped,omitempty"` // 已结算、已取消或读取失败的市场
+	Scores             []*ScoreRisk      `json:"scores"`            // 按主队进球、客队进球排列
+	TotalBetAmount     *big.Int          `json:"totalBetAmount"`
+	Worst              *ScoreRisk        `json:"worst,omitempty"` // 庄家盈亏最低的比分
+	Best               *ScoreRisk        `json:"best,omitempty"`  // 庄家盈亏最高的比分
+	Vaults             []*VaultLiquidity `json:"vaults"`
+	AvailableLiquidity *big.Int          `json:"availableLiquidity"` // 各 Vault 可用流动性之和
+	Warnings           []string          `json:"warnings,omitempty"`
+}
+
+// MarketRisk 参与风险计算的单个市场
+type MarketRisk struct {
+	Address        common.Address `json:"address"`
+	MatchID        string         `json:"matchId"`
+	StatusName     string         `json:"statusName"`
+	MapperType     string         `json:"mapperType"`
+	MapperParams   string         `json:"mapperParams"`
+	TotalBetAmount *big.Int       `json:"totalBetAmount"`
+	OutcomeNames   []string       `json:"outcomeNames"`
+	OutcomePayouts []*big.Int     `json:"outcomePayouts"` // 各结果全部份额获胜时的赔付
+	Note           string         `json:"note,omitempty"`
+}
+
+// Label 市场简称，如 "OU line=2.5"
+func (m *MarketRisk) Label() string {
+	if m.MapperParams == "" {
+		return m.MapperType
+	}
+	return m.MapperType + " " + m.MapperParams
+}
+
+// ScoreRisk 某一比分下的赔付与盈亏
+type ScoreRisk struct {
+	Home          int        `json:"home"`
+	Away          int        `json:"away"`
+	Payout        *big.Int   `json:"payout"`        // 需支付给获胜者的总额
+	PnL           *big.Int   `json:"pnl"`           // 庄家净盈亏 = 投注总额 - 赔付
+	Liability     *big.Int   `json:"liability"`     // 亏损额，盈利时为 0
+	MarketPayouts []*big.Int `json:"marketPayouts"` // 与 MatchRisk.Markets 对应
+}
+
+// Score 比分文本，如 "2-1"
+func (r *ScoreRisk) Score() string {
+	return fmt.Sprintf("%d-%d", r.Home, r.Away)
+}
+
+// VaultLiquidity Vault 可用流动性
+type VaultLiquidity struct {
+	Address            common.Address `json:"address"`
+	AvailableLiquidity *big.Int       `json:"availableLiquidity"`
+}
+
+// riskMarketCalls 单个市场风险计算所需的调用
+type riskMarketCalls struct {
+	risk     *MarketRisk
+	stats    bindings.IMarketV3MarketStats
+	rules    []bindings.IMarketV3OutcomeRule
+	strategy common.Address
+	mapper   common.Address
+
+	mapperType   *contractCall
+	mapperParams *contractCall
+	payouts      []*contractCall
+	previews     []*contractCall // 按 Scores 顺序
+}
+
+// GetMatchRisk 计算一场比赛所有 Market_V3 在 0..maxGoals 比分网格上的赔付与盈亏
+// 赛果由各市场的 ResultMapper.previewResult 映射，赔付由 PricingStrategy.calculatePayout 按当前份额计算
+func (s *Service) GetMatchRisk(ctx context.Context, matchID string, maxGoals int) (*MatchRisk, error) {
+	if s.factory == nil {
+		return nil, fmt.Errorf("Factory 合约未配置")
+	}
+	if maxGoals <= 0 {
+		maxGoals = DefaultRiskMaxGoals
+	}
+
+	count, err := s.marketCount(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("获取市场数量失败: %w", err)
+	}
+
+	risk := &MatchRisk{
+		MatchID:            matchID,
+		MaxGoals:           maxGoals,
+		TotalBetAmount:     big.NewInt(0),
+		AvailableLiquidity: big.NewInt(0),
+	}
+
+	// 找出该比赛的 V3 市场
+	addrs := s.listMarketAddresses(ctx, 0, count)
+	var matched []common.Address
+	for i, summary := range s.loadMarketSummaries(ctx, addrs) {
+		if summary == nil || summary.Version != MarketVersionV3 || !isMatchMarket(matchID, summary.MatchID) {
+			continue
+		}
+		switch summary.StatusName {
+		case "Resolved", "Finalized", "Cancelled":
+			risk.Skipped = append(risk.Skipped, &MarketRisk{
+				Address:    addrs[i],
+				MatchID:    summary.MatchID,
+				StatusName: summary.StatusName,
+				Note:       "赛果已确定",
+			})
+			continue
+		}
+		matched = append(matched, addrs[i])
+	}
+	if len(matched) == 0 && len(risk.Skipped) == 0 {
+		return nil, fmt.Errorf("没有找到比赛 %s 的 Market_V3 市场", matchID)
+	}
+
+	// 比分网格
+	for home := 0; home <= maxGoals; home++ {
+		for away := 0; away <= maxGoals; away++ {
+			risk.Scores = append(risk.Scores, &ScoreRisk{Home: home, Away: away})
+		}
+	}
+
+	// 第一轮：市场状态、统计、规则与依赖合约
+	type marketCalls struct {
+		status, stats, rules, strategy, mapper, vault *contractCall
+	}
+	batch := &callBatch{}
+	calls := make([]marketCalls, len(matched))
+	for i, addr := range matched {
+		calls[i] = marketCalls{
+			status:   batch.add(addr, marketV3ABI, "status"),
+			stats:    batch.add(addr, marketV3ABI, "getStats"),
+			rules:    batch.add(addr, marketV3ABI, "getOutcomeRules"),
+			strategy: batch.add(addr, marketV3ABI, "pricingStrategy"),
+			mapper:   batch.add(addr, marketV3ABI, "resultMapper"),
+			vault:    batch.add(addr, marketV3ABI, "vault"),
+		}
+	}
+	s.runBatch(ctx, batch)
+
+	vaults := make(map[common.Address]bool)
+	var vaultOrder []common.Address
+	pending := make([]*riskMarketCalls, 0, len(matched))
+	for i, addr := range matched {
+		c := calls[i]
+		status, _ := callResult[uint8](c.status, 0)
+		m := &MarketRisk{Address: addr, StatusName: getV3StatusName(status)}
+
+		stats, err := callResult[bindings.IMarketV3MarketStats](c.stats, 0)
+		if err != nil {
+			m.Note = fmt.Sprintf("读取市场统计失败: %v", err)
+			risk.Skipped = append(risk.Skipped, m)
+			continue
+		}
+		rules, err := callResult[[]bindings.IMarketV3OutcomeRule](c.rules, 0)
+		if err != nil {
+			m.Note = fmt.Sprintf("读取结果规则失败: %v", err)
+			risk.Skipped = append(risk.Skipped, m)
+			continue
+		}
+		strategy, _ := callResult[common.Address](c.strategy, 0)
+		mapper, _ := callResult[common.Address](c.mapper, 0)
+		if isZeroAddress(strategy) || isZeroAddress(mapper) {
+			m.Note = "未配置 PricingStrategy 或 ResultMapper"
+			risk.Skipped = append(risk.Skipped, m)
+			continue
+		}
+
+		if vault, err := callResult[common.Address](c.vault, 0); err == nil && !isZeroAddress(vault) && !vaults[vault] {
+			vaults[vault] = true
+			vaultOrder = append(vaultOrder, vault)
+		}
+
+		m.TotalBetAmount = stats.TotalBetAmount
+		for _, rule := range rules {
+			m.OutcomeNames = append(m.OutcomeNames, rule.Name)
+		}
+		pending = append(pending, &riskMarketCalls{risk: m, stats: stats, rules: rules, strategy: strategy, mapper: mapper})
+	}
+
+	// 市场本身没有配置 Vault 时使用配置中的 Vault
+	if len(vaultOrder) == 0 && !isZeroAddress(s.contracts.Vault) {
+		vaultOrder = append(vaultOrder, s.contracts.Vault)
+	}
+
+	// 第二轮：Mapper 元数据、各结果赔付、各比分映射结果与 Vault 流动性
+	details := &callBatch{}
+	for _, p := range pending {
+		p.mapperType = details.add(p.mapper, resultMapperABI, "mapperType")
+		p.mapperParams = details.add(p.mapper, resultMapperABI, "getParams")
+
+		shares := make([]*big.Int, len(p.rules))
+		for i := range p.rules {
+			shares[i] = bigAt(p.stats.TotalSharesPerOutcome, i)
+		}
+		for i, rule := range p.rules {
+			p.payouts = append(p.payouts, details.add(p.strategy, payoutStrategyABI, "calculatePayout",
+				big.NewInt(int64(i)), shares[i], shares, p.stats.TotalLiquidity, rule.PayoutType))
+		}
+		for _, score := range risk.Scores {
+			p.previews = append(p.previews, details.add(p.mapper, resultMapperABI, "previewResult",
+				big.NewInt(int64(score.Home)), big.NewInt(int64(score.Away))))
+		}
+	}
+	vaultCalls := make([]*contractCall, len(vaultOrder))
+	for i, vault := range vaultOrder {
+		vaultCalls[i] = details.add(vault, liquidityVaultABI, "availableLiquidity")
+	}
+	s.runBatch(ctx, details)
+
+	for i, vault := range vaultOrder {
+		available, err := callResult[*big.Int](vaultCalls[i], 0)
+		if err != nil {
+			risk.Warnings = append(risk.Warnings, fmt.Sprintf("读取 Vault %s 可用流动性失败: %v", vault.Hex(), err))
+			continue
+		}
+		risk.Vaults = append(risk.Vaults, &VaultLiquidity{Address: vault, AvailableLiquidity: available})
+		risk.AvailableLiquidity.Add(risk.AvailableLiquidity, available)
+	}
+
+	// 每个比分下各市场的赔付
+	payouts := make([][]*big.Int, len(risk.Scores))
+	for _, p := range pending {
+		m := p.risk
+		m.MapperType, _ = callResult[string](p.mapperType, 0)
+		if params, err := callResult[[]byte](p.mapperParams, 0); err == nil {
+			m.MapperParams = decodeMapperParams(m.MapperType, params)
+		}
+
+		failed := false
+		for i, call := range p.payouts {
+			payout, err := callResult[*big.Int](call, 0)
+			if err != nil {
+				risk.Warnings = append(risk.Warnings, fmt.Sprintf("市场 %s 结果 %d 赔付计算失败: %v", m.Address.Hex(), i, err))
+				failed = true
+				break
+			}
+			m.OutcomePayouts = append(m.OutcomePayouts, payout)
+		}
+		if failed {
+			m.Note = "赔付计算失败"
+			risk.Skipped = append(risk.Skipped, m)
+			continue
+		}
+
+		// 无法映射的比分按 0 赔付计算，每个市场合并为一条警告
+		column := make([]*big.Int, len(risk.Scores))
+		var unmapped []string
+		var firstErr error
+		for i, call := range p.previews {
+			ids, err := callResult[[]*big.Int](call, 0)
+			if err == nil {
+				var weights []*big.Int
+				if weights, err = callResult[[]*big.Int](call, 1); err == nil {
+					column[i], err = settlementPayout(m.OutcomePayouts, ids, weights)
+				}
+			}
+			if err != nil {
+				if firstErr == nil {
+					firstErr = err
+				}
+				unmapped = append(unmapped, risk.Scores[i].Score())
+				column[i] = big.NewInt(0)
+			}
+		}
+		if len(unmapped) > 0 {
+			risk.Warnings = append(risk.Warnings, fmt.Sprintf("市场 %s 比分 %s 无法映射（%v），按 0 赔付计算",
+				m.Label(), strings.Join(unmapped, ", "), firstErr))
+		}
+
+		risk.Markets = append(risk.Markets, m)
+		risk.TotalBetAmount.Add(risk.TotalBetAmount, m.TotalBetAmount)
+		for i := range risk.Scores {
+			payouts[i] = append(payouts[i], column[i])
+		}
+	}
+
+	for i, score := range risk.Scores {
+		risk.Scores[i] = newScoreRisk(score.Home, score.Away, risk.TotalBetAmount, payouts[i])
+	}
+	risk.Worst, risk.Best = scoreExtremes(risk.Scores)
+
+	return risk, nil
+}
+
+// isMatchMarket 市场 matchId 是否属于该比赛：完全相同，或以 "<matchId>_" 开头（如 _OU_2.5、_AH_-0.5）
+func isMatchMarket(matchID, marketMatchID string) bool {
+	return marketMatchID == matchID || strings.HasPrefix(marketMatchID, matchID+"_")
+}
+
+// settlementPayout 按映射结果计算市场总赔付：Σ outcomePayouts[id] * weight / 10000
+// 与 Market_V3._calculateTotalExpectedPayout 一致；映射出的结果不在市场规则内时返回错误
+func settlementPayout(outcomePayouts []*big.Int, ids, weights []*big.Int) (*big.Int, error) {
+	if len(ids) != len(weights) {
+		return nil, fmt.Errorf("previewResult 结果与权重数量不一致")
+	}
+	total := big.NewInt(0)
+	for i, id := range ids {
+		if !id.IsUint64() || id.Uint64() >= uint64(len(outcomePayouts)) {
+			return nil, fmt.Errorf("结果 %s 超出市场结果数量 %d", id, len(outcomePayouts))
+		}
+		payout := new(big.Int).Mul(outcomePayouts[id.Uint64()], weights[i])
+		total.Add(total, payout.Div(payout, big.NewInt(weightBase)))
+	}
+	return total, nil
+}
+
+// newScoreRisk 汇总某一比分下各市场的赔付
+func newScoreRisk(home, away int, totalBet *big.Int, marketPayouts []*big.Int) *ScoreRisk {
+	r := &ScoreRisk{
+		Home:          home,
+		Away:          away,
+		Payout:        big.NewInt(0),
+		Liability:     big.NewInt(0),
+		MarketPayouts: marketPayouts,
+	}
+	for _, payout := range marketPayouts {
+		r.Payout.Add(r.Payout, payout)
+	}
+	r.PnL = new(big.Int).Sub(totalBet, r.Payout)
+	if r.PnL.Sign() < 0 {
+		r.Liability.Neg(r.PnL)
+	}
+	return r
+}
+
+// scoreExtremes 返回庄家盈亏最低与最高的比分，盈亏相同时取先出现者
+func scoreExtremes(scores []*ScoreRisk) (worst, best *ScoreRisk) {
+	for _, r := range scores {
+		if worst == nil || r.PnL.Cmp(worst.PnL) < 0 {
+			worst = r
+		}
+		if best == nil || r.PnL.Cmp(best.PnL) > 0 {
+			best = r
+		}
+	}
+	return worst, best
+}
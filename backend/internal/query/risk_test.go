@@ -0,0 +1,67 @@
+package query
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func bigs(values ...int64) []*big.Int {
+	out := make([]*big.Int, len(values))
+	for i, v := range values {
+		out[i] = big.NewInt(v)
+	}
+	return out
+}
+
+func TestIsMatchMarket(t *testing.T) {
+	match := "EPL_2425_R20_MUN_vs_MCI"
+	assert.True(t, isMatchMarket(match, match))
+	assert.True(t, isMatchMarket(match, match+"_OU_2.5"))
+	assert.True(t, isMatchMarket(match, match+"_AH_-0.5"))
+	assert.False(t, isMatchMarket(match, "EPL_2425_R20_MUN_vs_MCIX_WDL"))
+	assert.False(t, isMatchMarket(match, "EPL_2425_R20_LIV_vs_CHE_WDL"))
+}
+
+func TestSettlementPayout(t *testing.T) {
+	payouts := bigs(1_000_000, 2_000_000, 3_000_000)
+
+	// 单一结果全额赔付
+	total, err := settlementPayout(payouts, bigs(1), bigs(weightBase))
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(2_000_000), total)
+
+	// 亚盘半赢半走：两个结果各一半权重
+	total, err = settlementPayout(payouts, bigs(0, 2), bigs(5000, 5000))
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(2_000_000), total)
+
+	// Score Mapper 的 Other（999）超出结果数量
+	_, err = settlementPayout(payouts, bigs(999), bigs(weightBase))
+	assert.ErrorContains(t, err, "超出市场结果数量")
+
+	_, err = settlementPayout(payouts, bigs(0), nil)
+	assert.Error(t, err)
+}
+
+func TestNewScoreRisk(t *testing.T) {
+	loss := newScoreRisk(2, 1, big.NewInt(5_000_000), bigs(4_000_000, 3_000_000))
+	assert.Equal(t, "2-1", loss.Score())
+	assert.Equal(t, big.NewInt(7_000_000), loss.Payout)
+	assert.Equal(t, big.NewInt(-2_000_000), loss.PnL)
+	assert.Equal(t, big.NewInt(2_000_000), loss.Liability)
+
+	profit := newScoreRisk(0, 0, big.NewInt(5_000_000), bigs(1_000_000, 0))
+	assert.Equal(t, big.NewInt(4_000_000), profit.PnL)
+	assert.Equal(t, big.NewInt(0), profit.Liability)
+
+	worst, best := scoreExtremes([]*ScoreRisk{profit, loss})
+	assert.Same(t, loss, worst)
+	assert.Same(t, profit, best)
+
+	worst, best = scoreExtremes(nil)
+	assert.Nil(t, worst)
+	assert.Nil(t, best)
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"math/big"
 	"strings"
+	"sync"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
@@ -31,6 +33,18 @@ type Service struct {
 	factory  *bindings.MarketFactory
 	feeRouter *bindings.FeeRouter
 	referral *bindings.ReferralRegistry
+
+	// 市场版本缓存（地址 -> MarketVersion）
+	versions sync.Map
+
+	// 批量只读调用
+	multicall *multicaller
+
+	// 头寸、订单与交易量等历史数据（history.source 选择，未配置时为 nil）
+	history historySource
+
+	// 历史查询固定的区块（--block / --at），nil 表示最新状态
+	block *HistoricalBlock
 }
 
 // NewService 创建查询服务
@@ -63,6 +77,8 @@ func NewService(ctx context.Context) (*Service, error) {
 		ReferralRegistry:   common.HexToAddress(viper.GetString("contracts.referral_registry")),
 		FeeRouter:          common.HexToAddress(viper.GetString("contracts.fee_router")),
 		Factory:            common.HexToAddress(viper.GetString("contracts.factory")),
+		BettingRouter:      common.HexToAddress(viper.GetString("contracts.betting_router")),
+		Multicall3:         common.HexToAddress(viper.GetString("contracts.multicall3")),
 	}
 
 	// 加载模板 ID
@@ -81,6 +97,18 @@ func NewService(ctx context.Context) (*Service, error) {
 		return nil, err
 	}
 
+	// 历史查询：--block 或 --at 固定所有链上读取的区块
+	s.block, err = resolveBlock(ctx, client, viper.GetUint64("block"), viper.GetString("at"))
+	if err != nil {
+		return nil, err
+	}
+
+	// 初始化 Multicall3（未配置时使用统一部署地址，链上不存在时逐个调用）
+	s.multicall, err = newMulticaller(ctx, client, s.contracts.Multicall3, s.blockNumber(), viper.GetInt("multicall.batch_size"))
+	if err != nil {
+		return nil, err
+	}
+
 	// 可选：连接数据库
 	dbURL := viper.GetString("database.url")
 	if dbURL != "" {
@@ -90,6 +118,12 @@ func NewService(ctx context.Context) (*Service, error) {
 		}
 	}
 
+	// 历史数据来源：默认 Subgraph，history.source=sql 时使用数据库 orders 表
+	s.history, err = newHistorySource(viper.GetString("history.source"), viper.GetString("subgraph.url"), s.db)
+	if err != nil {
+		return nil, err
+	}
+
 	return s, nil
 }
 
@@ -160,6 +194,11 @@ func (s *Service) GetChainID() int64 {
 	return s.chainID
 }
 
+// Block 返回历史查询固定的区块，查询最新状态时为 nil
+func (s *Service) Block() *HistoricalBlock {
+	return s.block
+}
+
 // GetContractAddresses 获取所有合约地址
 func (s *Service) GetContractAddresses() *ContractAddresses {
 	return &s.contracts
@@ -170,9 +209,55 @@ func (s *Service) GetTemplateIDs() *TemplateIDs {
 	return &s.templates
 }
 
-// callOpts 返回默认调用选项
+// historySourceOrErr 返回已配置的历史数据来源
+func (s *Service) historySourceOrErr() (historySource, error) {
+	if s.history == nil {
+		return nil, fmt.Errorf("历史数据来源未配置（设置 subgraph.url，或 history.source=sql 并配置 database.url）")
+	}
+	return s.history, nil
+}
+
+// callOpts 返回默认调用选项；历史查询时固定到指定区块
 func (s *Service) callOpts(ctx context.Context) *bind.CallOpts {
-	return &bind.CallOpts{Context: ctx}
+	return &bind.CallOpts{Context: ctx, BlockNumber: s.blockNumber()}
+}
+
+// blockNumber 返回历史查询的区块号，查询最新状态时为 nil
+func (s *Service) blockNumber() *big.Int {
+	if s.block == nil {
+		return nil
+	}
+	return new(big.Int).SetUint64(s.block.Number)
+}
+
+// pinnedBlock 返回 Subgraph 时间旅行查询的区块号，0 表示最新
+func (s *Service) pinnedBlock() uint64 {
+	if s.block == nil {
+		return 0
+	}
+	return s.block.Number
+}
+
+// resolveBlock 解析 --block / --at；都未设置时返回 nil
+func resolveBlock(ctx context.Context, headers headerReader, number uint64, at string) (*HistoricalBlock, error) {
+	switch {
+	case number > 0 && at != "":
+		return nil, fmt.Errorf("--block 与 --at 不能同时使用")
+	case number > 0:
+		header, err := headerAt(ctx, headers, number)
+		if err != nil {
+			return nil, err
+		}
+		return blockFromHeader(header), nil
+	case at != "":
+		t, err := ParseTimestamp(at)
+		if err != nil {
+			return nil, err
+		}
+		return BlockAtTime(ctx, headers, t)
+	default:
+		return nil, nil
+	}
 }
 
 // parseBytes32 解析 bytes32 字符串
@@ -0,0 +1,65 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// GetMarketSnapshot 获取市场详情、赔率、下注额与赔付敞口的快照
+func (s *Service) GetMarketSnapshot(ctx context.Context, addr common.Address) (*MarketSnapshot, error) {
+	info, err := s.GetMarketInfo(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	prices, err := s.GetMarketPrices(ctx, addr)
+	if err != nil {
+		return nil, fmt.Errorf("获取赔率失败: %w", err)
+	}
+
+	snapshot := &MarketSnapshot{
+		Info:      info,
+		Prices:    prices,
+		FetchedAt: time.Now(),
+	}
+
+	if info.V3 != nil {
+		snapshot.Volume = info.V3.TotalBetAmount
+		snapshot.Liability, snapshot.MaxLiability = outcomeLiability(info.TotalLiquidity, info.V3.Outcomes)
+	}
+
+	if s.block != nil {
+		snapshot.BlockNumber = s.block.Number
+	} else if s.client != nil {
+		if block, err := s.client.BlockNumber(ctx); err == nil {
+			snapshot.BlockNumber = block
+		}
+	}
+
+	return snapshot, nil
+}
+
+// outcomeLiability 估算各结果获胜时的应付赔付
+// 各定价策略的 WINNER 赔付均为 shares * totalLiquidity / totalWinningShares，
+// 即某结果有持仓时其持有人分配整个资金池，无持仓时赔付为 0
+func outcomeLiability(totalLiquidity *big.Int, outcomes []*OutcomeRuleInfo) ([]*big.Int, *big.Int) {
+	liability := make([]*big.Int, 0, len(outcomes))
+	maxLiability := big.NewInt(0)
+
+	for _, o := range outcomes {
+		payout := big.NewInt(0)
+		if o.TotalShares != nil && o.TotalShares.Sign() > 0 && totalLiquidity != nil {
+			payout = new(big.Int).Set(totalLiquidity)
+		}
+		liability = append(liability, payout)
+		if payout.Cmp(maxLiability) > 0 {
+			maxLiability = payout
+		}
+	}
+
+	return liability, maxLiability
+}
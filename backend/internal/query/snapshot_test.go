@@ -0,0 +1,24 @@
+package query
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOutcomeLiability(t *testing.T) {
+	outcomes := []*OutcomeRuleInfo{
+		{OutcomeID: 0, TotalShares: big.NewInt(1500)},
+		{OutcomeID: 1, TotalShares: big.NewInt(0)},
+		{OutcomeID: 2},
+	}
+
+	liability, maxLiability := outcomeLiability(big.NewInt(1_000_000), outcomes)
+	assert.Equal(t, []*big.Int{big.NewInt(1_000_000), big.NewInt(0), big.NewInt(0)}, liability)
+	assert.Equal(t, big.NewInt(1_000_000), maxLiability)
+
+	liability, maxLiability = outcomeLiability(big.NewInt(1_000_000), nil)
+	assert.Empty(t, liability)
+	assert.Equal(t, big.NewInt(0), maxLiability)
+}
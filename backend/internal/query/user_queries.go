@@ -2,12 +2,12 @@ package query
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"math/big"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
-
-	"github.com/pitchone/sportsbook/pkg/bindings"
 )
 
 // GetUserBalance 获取用户 USDC 余额
@@ -16,58 +16,94 @@ func (s *Service) GetUserBalance(ctx context.Context, user common.Address) (*big
 }
 
 // GetUserAllPositions 获取用户在所有市场的头寸
+// 市场地址、结果数量与余额分三轮批量读取，只为有头寸的市场加载摘要
 func (s *Service) GetUserAllPositions(ctx context.Context, user common.Address) ([]*UserPosition, error) {
 	if s.factory == nil {
 		return nil, fmt.Errorf("Factory 合约未配置")
 	}
 
-	callOpts := s.callOpts(ctx)
-
 	// 获取市场总数
-	count, err := s.factory.GetMarketCount(callOpts)
+	count, err := s.marketCount(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("获取市场数量失败: %w", err)
 	}
 
-	results := make([]*UserPosition, 0)
+	markets := s.listMarketAddresses(ctx, 0, count)
+	s.detectMarketVersions(ctx, markets)
+
+	// 获取每个市场的结果数量
+	outcomeCounts := &callBatch{}
+	for _, market := range markets {
+		outcomeCounts.add(market, marketABI(s.cachedMarketVersion(market)), "outcomeCount")
+	}
+	s.runBatch(ctx, outcomeCounts)
 
-	// 遍历所有市场检查用户头寸
-	for i := uint64(0); i < count.Uint64(); i++ {
-		marketAddr, err := s.factory.Markets(callOpts, big.NewInt(int64(i)))
+	// 检查每个结果的头寸
+	type balanceKey struct {
+		market    common.Address
+		outcomeID uint64
+	}
+	balances := &callBatch{}
+	keys := make([]balanceKey, 0)
+	for i, market := range markets {
+		outcomeCount, err := callResult[*big.Int](outcomeCounts.calls[i], 0)
 		if err != nil {
 			continue
 		}
 
-		market, err := bindings.NewMarketBaseV2(marketAddr, s.client)
-		if err != nil {
-			continue
+		contractABI := marketABI(s.cachedMarketVersion(market))
+		for j := uint64(0); j < outcomeCount.Uint64(); j++ {
+			balances.add(market, contractABI, "balanceOf", user, new(big.Int).SetUint64(j))
+			keys = append(keys, balanceKey{market: market, outcomeID: j})
 		}
+	}
+	s.runBatch(ctx, balances)
 
-		outcomeCount, err := market.OutcomeCount(callOpts)
-		if err != nil {
+	type holding struct {
+		balanceKey
+		balance *big.Int
+	}
+	holdings := make([]holding, 0)
+	held := make([]common.Address, 0)
+	for i, call := range balances.calls {
+		balance, err := callResult[*big.Int](call, 0)
+		if err != nil || balance.Sign() <= 0 {
 			continue
 		}
+		if len(held) == 0 || held[len(held)-1] != keys[i].market {
+			held = append(held, keys[i].market)
+		}
+		holdings = append(holdings, holding{balanceKey: keys[i], balance: balance})
+	}
 
-		// 获取市场摘要
-		summary, _ := s.getMarketSummary(ctx, marketAddr)
+	// 获取市场摘要；V3 市场的结果名称来自合约的 outcome 规则
+	summaries := make(map[common.Address]*MarketSummary, len(held))
+	for i, summary := range s.loadMarketSummaries(ctx, held) {
+		if summary != nil {
+			summaries[held[i]] = summary
+		}
+	}
+	outcomeNames := s.loadOutcomeNamesV3(ctx, held)
 
-		// 检查每个结果的头寸
-		for j := uint64(0); j < outcomeCount.Uint64(); j++ {
-			balance, err := market.BalanceOf(callOpts, user, big.NewInt(int64(j)))
-			if err != nil {
-				continue
-			}
+	results := make([]*UserPosition, 0, len(holdings))
+	for _, h := range holdings {
+		summary, ok := summaries[h.market]
+		if !ok {
+			continue
+		}
 
-			if balance.Sign() > 0 {
-				results = append(results, &UserPosition{
-					Market:      marketAddr,
-					MarketInfo:  summary,
-					OutcomeID:   j,
-					OutcomeName: getOutcomeName(summary.TemplateName, j),
-					Balance:     balance,
-				})
-			}
+		outcomeName := getOutcomeName(summary.TemplateName, h.outcomeID)
+		if names := outcomeNames[h.market]; h.outcomeID < uint64(len(names)) {
+			outcomeName = names[h.outcomeID]
 		}
+
+		results = append(results, &UserPosition{
+			Market:      h.market,
+			MarketInfo:  summary,
+			OutcomeID:   h.outcomeID,
+			OutcomeName: outcomeName,
+			Balance:     h.balance,
+		})
 	}
 
 	return results, nil
@@ -78,73 +114,69 @@ func (s *Service) GetUserReferralInfo(ctx context.Context, user common.Address)
 	return s.GetReferralInfo(ctx, user)
 }
 
-// GetUserOrders 获取用户订单历史（需要数据库）
+// GetUserOrders 获取用户订单历史（按时间倒序）
 func (s *Service) GetUserOrders(ctx context.Context, user common.Address, limit int) ([]*Order, error) {
+	history, err := s.historySourceOrErr()
+	if err != nil {
+		return nil, err
+	}
+	return history.UserOrders(ctx, user, limit, s.pinnedBlock())
+}
+
+// GetUserRewardHistory 获取用户每周奖励及类别明细（需要数据库），按周倒序
+// 明细来自 reward_entries，早期未记录明细的周只返回总额
+func (s *Service) GetUserRewardHistory(ctx context.Context, user common.Address) ([]*UserRewardWeek, error) {
 	if s.db == nil {
-		return nil, fmt.Errorf("数据库未配置，无法查询历史订单")
+		return nil, fmt.Errorf("数据库未配置，无法查询奖励")
 	}
 
 	query := `
-		SELECT id, market, outcome_id, amount, shares, price, timestamp, tx_hash
-		FROM orders
-		WHERE user_address = $1
-		ORDER BY timestamp DESC
-		LIMIT $2
+		SELECT p.week, p.amount::TEXT, d.scale_bps, e.reward_type, e.amount::TEXT
+		FROM merkle_proofs p
+		JOIN reward_distributions d ON d.week = p.week
+		LEFT JOIN reward_entries e ON e.week = p.week AND e.user_address = p.user_address
+		WHERE p.user_address = $1
+		ORDER BY p.week DESC
 	`
 
-	rows, err := s.db.QueryContext(ctx, query, user.Hex(), limit)
+	rows, err := s.db.QueryContext(ctx, query, strings.ToLower(user.Hex()))
 	if err != nil {
-		return nil, fmt.Errorf("查询订单失败: %w", err)
+		return nil, fmt.Errorf("查询奖励失败: %w", err)
 	}
 	defer rows.Close()
 
-	results := make([]*Order, 0)
+	results := make([]*UserRewardWeek, 0)
+	var current *UserRewardWeek
 
 	for rows.Next() {
-		var order Order
-		var marketHex, txHashHex string
-
-		err := rows.Scan(
-			&order.ID,
-			&marketHex,
-			&order.OutcomeID,
-			&order.Amount,
-			&order.Shares,
-			&order.Price,
-			&order.Timestamp,
-			&txHashHex,
-		)
-		if err != nil {
-			continue
-		}
-
-		order.User = user
-		order.Market = common.HexToAddress(marketHex)
-		order.TxHash = common.HexToHash(txHashHex)
+		var week, scaleBps uint64
+		var amount string
+		var rewardType, typeAmount sql.NullString
 
-		results = append(results, &order)
-	}
+		if err := rows.Scan(&week, &amount, &scaleBps, &rewardType, &typeAmount); err != nil {
+			return nil, fmt.Errorf("读取奖励失败: %w", err)
+		}
 
-	return results, nil
-}
+		if current == nil || current.Week != week {
+			total, _ := new(big.Int).SetString(amount, 10)
+			current = &UserRewardWeek{
+				Week:      week,
+				Amount:    total,
+				ScaleBps:  scaleBps,
+				Breakdown: make(map[string]*big.Int),
+			}
+			results = append(results, current)
+		}
 
-// GetUserRewards 获取用户奖励状态（需要数据库）
-func (s *Service) GetUserRewards(ctx context.Context, user common.Address) (*big.Int, error) {
-	if s.db == nil {
-		return nil, fmt.Errorf("数据库未配置，无法查询奖励")
+		if rewardType.Valid {
+			value, _ := new(big.Int).SetString(typeAmount.String, 10)
+			current.Breakdown[rewardType.String] = value
+		}
 	}
 
-	query := `
-		SELECT COALESCE(SUM(amount), 0)
-		FROM rewards
-		WHERE user_address = $1 AND claimed = false
-	`
-
-	var total big.Int
-	err := s.db.QueryRowContext(ctx, query, user.Hex()).Scan(&total)
-	if err != nil {
+	if err := rows.Err(); err != nil {
 		return nil, fmt.Errorf("查询奖励失败: %w", err)
 	}
 
-	return &total, nil
+	return results, nil
 }
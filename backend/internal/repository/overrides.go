@@ -0,0 +1,339 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Override statuses
+const (
+	OverrideStatusPending  = "pending"
+	OverrideStatusApproved = "approved"
+	OverrideStatusRejected = "rejected"
+	OverrideStatusApplied  = "applied"
+)
+
+var (
+	// ErrOverrideNotFound is returned when an override does not exist
+	ErrOverrideNotFound = errors.New("result override not found")
+	// ErrOverrideSelfApproval is returned when the creator tries to approve their own override
+	ErrOverrideSelfApproval = errors.New("override must be approved by a different operator")
+	// ErrOverrideNotPending is returned when approving or rejecting a non-pending override
+	ErrOverrideNotPending = errors.New("override is not pending")
+	// ErrOverrideActiveExists is returned when a market already has a pending or approved override
+	ErrOverrideActiveExists = errors.New("market already has an active override")
+)
+
+// ResultOverride is a manually entered match result for a market
+type ResultOverride struct {
+	ID            int64           `json:"id"`
+	MarketAddress string          `json:"marketAddress"`
+	HomeGoals     uint8           `json:"homeGoals"`
+	AwayGoals     uint8           `json:"awayGoals"`
+	ExtraTime     bool            `json:"extraTime"`
+	Reason        string          `json:"reason"`
+	EvidenceURL   string          `json:"evidenceUrl"`
+	Status        string          `json:"status"`
+	CreatedBy     string          `json:"createdBy"`
+	CreatedAt     int64           `json:"createdAt"`
+	ApprovedBy    string          `json:"approvedBy,omitempty"`
+	ApprovedAt    int64           `json:"approvedAt,omitempty"`
+	AppliedAt     int64           `json:"appliedAt,omitempty"`
+	SettleTxHash  string          `json:"settleTxHash,omitempty"`
+	Trail         []OverrideEvent `json:"trail,omitempty"`
+}
+
+// OverrideEvent is a single step in an override's approval trail
+type OverrideEvent struct {
+	Action    string `json:"action"`
+	Actor     string `json:"actor"`
+	Note      string `json:"note,omitempty"`
+	CreatedAt int64  `json:"createdAt"`
+}
+
+// OverridesRepository handles database operations for result overrides
+type OverridesRepository struct {
+	db *sql.DB
+}
+
+// NewOverridesRepository creates a new OverridesRepository
+func NewOverridesRepository(db *sql.DB) *OverridesRepository {
+	return &OverridesRepository{db: db}
+}
+
+const overrideColumns = `
+	id, market_address, home_goals, away_goals, extra_time, reason, evidence_url, status,
+	created_by, created_at, COALESCE(approved_by, ''), COALESCE(approved_at, 0),
+	COALESCE(applied_at, 0), COALESCE(settle_tx_hash, '')`
+
+// CreateOverride stores a new pending override and returns its ID.
+// The creator is the database session user, not o.CreatedBy, so operators
+// cannot claim another identity; o.CreatedBy is filled in on success.
+func (r *OverridesRepository) CreateOverride(ctx context.Context, o *ResultOverride) (int64, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	now := time.Now().Unix()
+	market := strings.ToLower(o.MarketAddress)
+
+	var active int
+	err = tx.QueryRowContext(ctx, `
+		SELECT COUNT(*) FROM result_overrides
+		WHERE market_address = $1 AND status IN ('pending', 'approved')`, market).Scan(&active)
+	if err != nil {
+		return 0, fmt.Errorf("failed to check active overrides: %w", err)
+	}
+	if active > 0 {
+		return 0, ErrOverrideActiveExists
+	}
+
+	var id int64
+	var creator string
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO result_overrides (
+			market_address, home_goals, away_goals, extra_time, reason, evidence_url,
+			status, created_by, created_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, session_user, $8)
+		RETURNING id, created_by`,
+		market, o.HomeGoals, o.AwayGoals, o.ExtraTime, o.Reason, o.EvidenceURL,
+		OverrideStatusPending, now,
+	).Scan(&id, &creator)
+	if err != nil {
+		return 0, fmt.Errorf("failed to insert override: %w", err)
+	}
+
+	if err := insertOverrideEvent(ctx, tx, id, "created", creator, o.Reason, now); err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit override: %w", err)
+	}
+
+	o.CreatedBy = creator
+	return id, nil
+}
+
+// ApproveOverride approves a pending override as the database session user,
+// which must differ from the creator. It returns the approver.
+func (r *OverridesRepository) ApproveOverride(ctx context.Context, id int64, note string) (string, error) {
+	return r.review(ctx, id, note, OverrideStatusApproved)
+}
+
+// RejectOverride rejects a pending override as the database session user and returns it
+func (r *OverridesRepository) RejectOverride(ctx context.Context, id int64, note string) (string, error) {
+	return r.review(ctx, id, note, OverrideStatusRejected)
+}
+
+// review moves a pending override to approved or rejected
+func (r *OverridesRepository) review(ctx context.Context, id int64, note, status string) (string, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var currentStatus, createdBy, actor string
+	err = tx.QueryRowContext(ctx, `
+		SELECT status, created_by, session_user FROM result_overrides WHERE id = $1 FOR UPDATE`, id,
+	).Scan(&currentStatus, &createdBy, &actor)
+	if err == sql.ErrNoRows {
+		return "", ErrOverrideNotFound
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to load override: %w", err)
+	}
+
+	if currentStatus != OverrideStatusPending {
+		return "", fmt.Errorf("%w (status: %s)", ErrOverrideNotPending, currentStatus)
+	}
+	if status == OverrideStatusApproved && createdBy == actor {
+		return "", ErrOverrideSelfApproval
+	}
+
+	now := time.Now().Unix()
+	if status == OverrideStatusApproved {
+		_, err = tx.ExecContext(ctx, `
+			UPDATE result_overrides SET status = $1, approved_by = session_user, approved_at = $2
+			WHERE id = $3`, status, now, id)
+	} else {
+		_, err = tx.ExecContext(ctx, `
+			UPDATE result_overrides SET status = $1 WHERE id = $2`, status, id)
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to update override: %w", err)
+	}
+
+	if err := insertOverrideEvent(ctx, tx, id, status, actor, note, now); err != nil {
+		return "", err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return "", fmt.Errorf("failed to commit override review: %w", err)
+	}
+
+	return actor, nil
+}
+
+// GetApprovedOverride returns the approved override for a market with its trail,
+// or nil if there is none
+func (r *OverridesRepository) GetApprovedOverride(ctx context.Context, marketAddress string) (*ResultOverride, error) {
+	row := r.db.QueryRowContext(ctx, `
+		SELECT `+overrideColumns+`
+		FROM result_overrides
+		WHERE market_address = $1 AND status = $2
+		ORDER BY approved_at DESC
+		LIMIT 1`, strings.ToLower(marketAddress), OverrideStatusApproved)
+
+	o, err := scanOverride(row)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to query approved override: %w", err)
+	}
+
+	if o.Trail, err = r.getTrail(ctx, o.ID); err != nil {
+		return nil, err
+	}
+
+	return o, nil
+}
+
+// GetOverride returns an override by ID with its trail
+func (r *OverridesRepository) GetOverride(ctx context.Context, id int64) (*ResultOverride, error) {
+	row := r.db.QueryRowContext(ctx, `
+		SELECT `+overrideColumns+` FROM result_overrides WHERE id = $1`, id)
+
+	o, err := scanOverride(row)
+	if err == sql.ErrNoRows {
+		return nil, ErrOverrideNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to query override: %w", err)
+	}
+
+	if o.Trail, err = r.getTrail(ctx, o.ID); err != nil {
+		return nil, err
+	}
+
+	return o, nil
+}
+
+// ListOverrides returns overrides, optionally filtered by status, newest first
+func (r *OverridesRepository) ListOverrides(ctx context.Context, status string, limit int) ([]ResultOverride, error) {
+	query := `SELECT ` + overrideColumns + ` FROM result_overrides`
+	args := []interface{}{}
+	if status != "" {
+		query += ` WHERE status = $1`
+		args = append(args, status)
+	}
+	query += fmt.Sprintf(` ORDER BY created_at DESC LIMIT %d`, limit)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list overrides: %w", err)
+	}
+	defer rows.Close()
+
+	var overrides []ResultOverride
+	for rows.Next() {
+		o, err := scanOverride(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan override: %w", err)
+		}
+		overrides = append(overrides, *o)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return overrides, nil
+}
+
+// MarkOverrideApplied records that an approved override was used to settle its market
+func (r *OverridesRepository) MarkOverrideApplied(ctx context.Context, id int64, actor, txHash string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	now := time.Now().Unix()
+	res, err := tx.ExecContext(ctx, `
+		UPDATE result_overrides SET status = $1, applied_at = $2, settle_tx_hash = $3
+		WHERE id = $4 AND status = $5`,
+		OverrideStatusApplied, now, txHash, id, OverrideStatusApproved)
+	if err != nil {
+		return fmt.Errorf("failed to mark override applied: %w", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return fmt.Errorf("override %d is not approved", id)
+	}
+
+	if err := insertOverrideEvent(ctx, tx, id, OverrideStatusApplied, actor, txHash, now); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit override apply: %w", err)
+	}
+
+	return nil
+}
+
+// getTrail loads the approval trail of an override in chronological order
+func (r *OverridesRepository) getTrail(ctx context.Context, id int64) ([]OverrideEvent, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT action, actor, COALESCE(note, ''), created_at
+		FROM result_override_events
+		WHERE override_id = $1
+		ORDER BY created_at, id`, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query override trail: %w", err)
+	}
+	defer rows.Close()
+
+	var trail []OverrideEvent
+	for rows.Next() {
+		var e OverrideEvent
+		if err := rows.Scan(&e.Action, &e.Actor, &e.Note, &e.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan override event: %w", err)
+		}
+		trail = append(trail, e)
+	}
+
+	return trail, rows.Err()
+}
+
+// insertOverrideEvent appends an event to an override's trail
+func insertOverrideEvent(ctx context.Context, tx *sql.Tx, id int64, action, actor, note string, at int64) error {
+	_, err := tx.ExecContext(ctx, `
+		INSERT INTO result_override_events (override_id, action, actor, note, created_at)
+		VALUES ($1, $2, $3, $4, $5)`, id, action, actor, note, at)
+	if err != nil {
+		return fmt.Errorf("failed to insert override event: %w", err)
+	}
+	return nil
+}
+
+// scanOverride scans a row selected with overrideColumns
+func scanOverride(row interface{ Scan(...interface{}) error }) (*ResultOverride, error) {
+	var o ResultOverride
+	err := row.Scan(
+		&o.ID, &o.MarketAddress, &o.HomeGoals, &o.AwayGoals, &o.ExtraTime, &o.Reason, &o.EvidenceURL, &o.Status,
+		&o.CreatedBy, &o.CreatedAt, &o.ApprovedBy, &o.ApprovedAt, &o.AppliedAt, &o.SettleTxHash,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &o, nil
+}
@@ -0,0 +1,694 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrRewardProofNotFound is returned when a user has no entry in a week's distribution
+var ErrRewardProofNotFound = errors.New("reward proof not found")
+
+// RewardProof is a user's entry in a published weekly distribution
+type RewardProof struct {
+	Week        uint64   `json:"week"`
+	UserAddress string   `json:"user"`
+	Amount      string   `json:"amount"`
+	Proof       []string `json:"proof"`
+	MerkleRoot  string   `json:"merkleRoot"`
+	ScaleBps    uint64   `json:"scaleBps"`
+	CreatedAt   int64    `json:"createdAt"`
+}
+
+// RewardsRepository handles database operations for persisted reward proofs
+type RewardsRepository struct {
+	db *sql.DB
+}
+
+// NewRewardsRepository creates a new RewardsRepository
+func NewRewardsRepository(db *sql.DB) *RewardsRepository {
+	return &RewardsRepository{db: db}
+}
+
+const rewardProofColumns = `
+	p.week, p.user_address, p.amount::TEXT, p.proof, d.merkle_root, d.scale_bps, p.created_at`
+
+// GetProof returns a user's proof for a week. Weeks whose root is not yet
+// published on-chain are treated as not found, since claiming them reverts.
+func (r *RewardsRepository) GetProof(ctx context.Context, week uint64, userAddress string) (*RewardProof, error) {
+	row := r.db.QueryRowContext(ctx, `
+		SELECT `+rewardProofColumns+`
+		FROM merkle_proofs p
+		JOIN reward_distributions d ON d.week = p.week
+		WHERE p.week = $1 AND p.user_address = $2 AND d.status = $3`,
+		week, strings.ToLower(userAddress), DistributionPublished)
+
+	proof, err := scanRewardProof(row)
+	if err == sql.ErrNoRows {
+		return nil, ErrRewardProofNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to query reward proof: %w", err)
+	}
+	return proof, nil
+}
+
+// ListProofs returns a user's proofs in published weeks, newest week first
+func (r *RewardsRepository) ListProofs(ctx context.Context, userAddress string) ([]RewardProof, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+rewardProofColumns+`
+		FROM merkle_proofs p
+		JOIN reward_distributions d ON d.week = p.week
+		WHERE p.user_address = $1 AND d.status = $2
+		ORDER BY p.week DESC`, strings.ToLower(userAddress), DistributionPublished)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query reward proofs: %w", err)
+	}
+	defer rows.Close()
+
+	var proofs []RewardProof
+	for rows.Next() {
+		proof, err := scanRewardProof(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan reward proof: %w", err)
+		}
+		proofs = append(proofs, *proof)
+	}
+
+	return proofs, rows.Err()
+}
+
+func scanRewardProof(row interface{ Scan(...interface{}) error }) (*RewardProof, error) {
+	var p RewardProof
+	var proofJSON []byte
+	if err := row.Scan(
+		&p.Week, &p.UserAddress, &p.Amount, &proofJSON, &p.MerkleRoot, &p.ScaleBps, &p.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(proofJSON, &p.Proof); err != nil {
+		return nil, fmt.Errorf("invalid proof JSON: %w", err)
+	}
+	return &p, nil
+}
+
+// ErrRewardDistributionNotFound is returned when no distribution was saved for a week
+var ErrRewardDistributionNotFound = errors.New("reward distribution not found")
+
+// ErrRewardDistributionPublished is returned when re-saving a week whose root is already on-chain
+var ErrRewardDistributionPublished = errors.New("reward distribution already published")
+
+// RewardDistribution is a weekly Merkle distribution with all of its entries
+type RewardDistribution struct {
+	Week        uint64
+	MerkleRoot  string
+	TotalAmount string
+	Recipients  int
+	ScaleBps    uint64
+	CreatedAt   int64
+	Entries     []RewardDistributionEntry
+
+	// CategoryScaleBps is the budget scale applied to each reward type, already
+	// included in the entry amounts
+	CategoryScaleBps map[string]uint64
+
+	// CampaignSpending is what each campaign spent in the week, recorded
+	// on-chain once the root is published
+	CampaignSpending []CampaignSpending
+}
+
+// RewardDistributionEntry is a single user's leaf in a distribution
+type RewardDistributionEntry struct {
+	UserAddress string
+	Amount      string
+	Breakdown   map[string]string // reward type -> amount, empty when not tracked
+	Proof       []string
+	LeafIndex   int // position of the leaf in the hash-sorted leaf list, -1 for rows saved before schema V4
+}
+
+// SaveDistribution writes the distribution summary, per-user proofs and category
+// breakdown in one transaction. Re-saving a week replaces all of its entries,
+// unless its root is already published, which returns ErrRewardDistributionPublished.
+func (r *RewardsRepository) SaveDistribution(ctx context.Context, dist *RewardDistribution) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Proofs of a published week must keep matching the on-chain root
+	var status string
+	err = tx.QueryRowContext(ctx, `
+		SELECT status FROM reward_distributions WHERE week = $1 FOR UPDATE`, dist.Week,
+	).Scan(&status)
+	if err != nil && err != sql.ErrNoRows {
+		return fmt.Errorf("failed to check distribution status: %w", err)
+	}
+	if status == DistributionPublished {
+		return fmt.Errorf("%w: week %d", ErrRewardDistributionPublished, dist.Week)
+	}
+
+	var categoryScales []byte
+	if len(dist.CategoryScaleBps) > 0 {
+		if categoryScales, err = json.Marshal(dist.CategoryScaleBps); err != nil {
+			return fmt.Errorf("failed to encode category scales: %w", err)
+		}
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO reward_distributions (
+			week, merkle_root, total_amount, recipients,
+			scale_bps, category_scale_bps, created_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (week) DO UPDATE SET
+			merkle_root = EXCLUDED.merkle_root,
+			total_amount = EXCLUDED.total_amount,
+			recipients = EXCLUDED.recipients,
+			scale_bps = EXCLUDED.scale_bps,
+			category_scale_bps = EXCLUDED.category_scale_bps,
+			updated_at = EXCLUDED.created_at`,
+		dist.Week, dist.MerkleRoot, dist.TotalAmount, dist.Recipients, dist.ScaleBps, categoryScales, dist.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save distribution: %w", err)
+	}
+
+	// Proofs from a previous build of the week are invalid against the new root
+	if _, err := tx.ExecContext(ctx, `DELETE FROM merkle_proofs WHERE week = $1`, dist.Week); err != nil {
+		return fmt.Errorf("failed to clear proofs: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM reward_entries WHERE week = $1`, dist.Week); err != nil {
+		return fmt.Errorf("failed to clear reward entries: %w", err)
+	}
+
+	proofStmt, err := tx.PrepareContext(ctx, `
+		INSERT INTO merkle_proofs (week, user_address, amount, leaf_index, proof, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)`)
+	if err != nil {
+		return fmt.Errorf("failed to prepare proof insert: %w", err)
+	}
+	defer proofStmt.Close()
+
+	entryStmt, err := tx.PrepareContext(ctx, `
+		INSERT INTO reward_entries (week, user_address, amount, reward_type, created_at)
+		VALUES ($1, $2, $3, $4, $5)`)
+	if err != nil {
+		return fmt.Errorf("failed to prepare reward entry insert: %w", err)
+	}
+	defer entryStmt.Close()
+
+	// Spending that is already recorded on-chain is kept, pending rows follow the new build
+	if _, err := tx.ExecContext(ctx, `
+		DELETE FROM campaign_spending WHERE week = $1 AND status = $2`,
+		dist.Week, CampaignSpendingPending,
+	); err != nil {
+		return fmt.Errorf("failed to clear campaign spending: %w", err)
+	}
+	for _, spending := range dist.CampaignSpending {
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO campaign_spending (campaign_id, week, amount, status, updated_at)
+			VALUES ($1, $2, $3, $4, $5)
+			ON CONFLICT (campaign_id, week) DO NOTHING`,
+			strings.ToLower(spending.CampaignID), dist.Week, spending.Amount, CampaignSpendingPending, dist.CreatedAt,
+		); err != nil {
+			return fmt.Errorf("failed to save spending for campaign %s: %w", spending.CampaignID, err)
+		}
+	}
+
+	for _, entry := range dist.Entries {
+		user := strings.ToLower(entry.UserAddress)
+
+		proofJSON, err := json.Marshal(entry.Proof)
+		if err != nil {
+			return fmt.Errorf("failed to encode proof: %w", err)
+		}
+		if _, err := proofStmt.ExecContext(ctx,
+			dist.Week, user, entry.Amount, entry.LeafIndex, proofJSON, dist.CreatedAt,
+		); err != nil {
+			return fmt.Errorf("failed to save proof for %s: %w", user, err)
+		}
+
+		for rewardType, amount := range entry.Breakdown {
+			if _, err := entryStmt.ExecContext(ctx,
+				dist.Week, user, amount, rewardType, dist.CreatedAt,
+			); err != nil {
+				return fmt.Errorf("failed to save %s reward for %s: %w", rewardType, user, err)
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit distribution: %w", err)
+	}
+
+	return nil
+}
+
+// GetDistribution loads a saved distribution with its entries ordered by leaf index
+func (r *RewardsRepository) GetDistribution(ctx context.Context, week uint64) (*RewardDistribution, error) {
+	var dist RewardDistribution
+	var categoryScales []byte
+	err := r.db.QueryRowContext(ctx, `
+		SELECT week, merkle_root, total_amount::TEXT, recipients, scale_bps, category_scale_bps, created_at
+		FROM reward_distributions
+		WHERE week = $1`, week,
+	).Scan(&dist.Week, &dist.MerkleRoot, &dist.TotalAmount, &dist.Recipients, &dist.ScaleBps, &categoryScales, &dist.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, ErrRewardDistributionNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to query distribution: %w", err)
+	}
+	if len(categoryScales) > 0 {
+		if err := json.Unmarshal(categoryScales, &dist.CategoryScaleBps); err != nil {
+			return nil, fmt.Errorf("invalid category scales: %w", err)
+		}
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT user_address, amount::TEXT, COALESCE(leaf_index, -1), proof
+		FROM merkle_proofs
+		WHERE week = $1
+		ORDER BY leaf_index NULLS LAST, user_address`, week)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query proofs: %w", err)
+	}
+	defer rows.Close()
+
+	byUser := make(map[string]int)
+	for rows.Next() {
+		var entry RewardDistributionEntry
+		var proofJSON []byte
+		if err := rows.Scan(&entry.UserAddress, &entry.Amount, &entry.LeafIndex, &proofJSON); err != nil {
+			return nil, fmt.Errorf("failed to scan proof: %w", err)
+		}
+		if err := json.Unmarshal(proofJSON, &entry.Proof); err != nil {
+			return nil, fmt.Errorf("invalid proof JSON: %w", err)
+		}
+		byUser[entry.UserAddress] = len(dist.Entries)
+		dist.Entries = append(dist.Entries, entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	breakdownRows, err := r.db.QueryContext(ctx, `
+		SELECT user_address, reward_type, amount::TEXT
+		FROM reward_entries
+		WHERE week = $1`, week)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query reward entries: %w", err)
+	}
+	defer breakdownRows.Close()
+
+	for breakdownRows.Next() {
+		var user, rewardType, amount string
+		if err := breakdownRows.Scan(&user, &rewardType, &amount); err != nil {
+			return nil, fmt.Errorf("failed to scan reward entry: %w", err)
+		}
+		i, ok := byUser[user]
+		if !ok {
+			continue
+		}
+		if dist.Entries[i].Breakdown == nil {
+			dist.Entries[i].Breakdown = make(map[string]string)
+		}
+		dist.Entries[i].Breakdown[rewardType] = amount
+	}
+
+	return &dist, breakdownRows.Err()
+}
+
+// ListDistributionWeeks returns the weeks with a saved distribution up to and including through
+func (r *RewardsRepository) ListDistributionWeeks(ctx context.Context, through uint64) ([]uint64, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT week FROM reward_distributions
+		WHERE week <= $1
+		ORDER BY week`, through)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query distribution weeks: %w", err)
+	}
+	defer rows.Close()
+
+	var weeks []uint64
+	for rows.Next() {
+		var week uint64
+		if err := rows.Scan(&week); err != nil {
+			return nil, fmt.Errorf("failed to scan distribution week: %w", err)
+		}
+		weeks = append(weeks, week)
+	}
+
+	return weeks, rows.Err()
+}
+
+// Campaign spending statuses
+const (
+	CampaignSpendingPending  = "pending"
+	CampaignSpendingRecorded = "recorded"
+)
+
+// CampaignSpending is a campaign's spending for one week and its on-chain recording state
+type CampaignSpending struct {
+	CampaignID string
+	Week       uint64
+	Amount     string
+	Status     string
+	TxHash     string // last recordSpending transaction sent, empty if none
+	Attempts   int
+	LastError  string
+}
+
+// Distribution publish statuses
+const (
+	DistributionPending   = "pending"   // saved, root not published yet
+	DistributionPublished = "published" // root confirmed on-chain
+	DistributionFailed    = "failed"    // last publication attempt failed, retried on the next run
+)
+
+// GetDistributionStatus returns the publish status of a week's distribution
+func (r *RewardsRepository) GetDistributionStatus(ctx context.Context, week uint64) (string, error) {
+	var status string
+	err := r.db.QueryRowContext(ctx, `
+		SELECT COALESCE(status, $2) FROM reward_distributions WHERE week = $1`,
+		week, DistributionPending,
+	).Scan(&status)
+	if err == sql.ErrNoRows {
+		return "", ErrRewardDistributionNotFound
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to query distribution status: %w", err)
+	}
+	return status, nil
+}
+
+// MarkDistributionPublished records that a week's root is on-chain. txHash and
+// blockNumber may be empty when the publication was found on-chain after the fact.
+func (r *RewardsRepository) MarkDistributionPublished(ctx context.Context, week uint64, txHash string, blockNumber uint64, publishedAt int64) error {
+	res, err := r.db.ExecContext(ctx, `
+		UPDATE reward_distributions
+		SET status = $5,
+			tx_hash = COALESCE(NULLIF($2, ''), tx_hash),
+			block_number = COALESCE(NULLIF($3::BIGINT, 0), block_number),
+			published_at = $4,
+			publish_error = NULL
+		WHERE week = $1`, week, txHash, int64(blockNumber), publishedAt, DistributionPublished)
+	if err != nil {
+		return fmt.Errorf("failed to mark distribution published: %w", err)
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return ErrRewardDistributionNotFound
+	}
+	return nil
+}
+
+// MarkDistributionFailed records why publishing a week's root failed. Published
+// distributions are never downgraded.
+func (r *RewardsRepository) MarkDistributionFailed(ctx context.Context, week uint64, message string, now int64) error {
+	_, err := r.db.ExecContext(ctx, `
+		UPDATE reward_distributions
+		SET status = $2, publish_error = $3, updated_at = $4
+		WHERE week = $1 AND status IS DISTINCT FROM $5`,
+		week, DistributionFailed, message, now, DistributionPublished)
+	if err != nil {
+		return fmt.Errorf("failed to mark distribution failed: %w", err)
+	}
+	return nil
+}
+
+// Distribution budget statuses; NULL when no PayoutScaler budget was reserved
+const (
+	DistributionBudgetReserved = "reserved" // calculateScale done, markBudgetUsed not yet
+	DistributionBudgetUsed     = "used"     // markBudgetUsed done after publication
+)
+
+// SetDistributionBudgetStatus records the PayoutScaler budget state of a week.
+// A week whose budget is already used is never set back to reserved.
+func (r *RewardsRepository) SetDistributionBudgetStatus(ctx context.Context, week uint64, status string, now int64) error {
+	_, err := r.db.ExecContext(ctx, `
+		UPDATE reward_distributions
+		SET budget_status = $2, updated_at = $3
+		WHERE week = $1 AND budget_status IS DISTINCT FROM $4`,
+		week, status, now, DistributionBudgetUsed)
+	if err != nil {
+		return fmt.Errorf("failed to set distribution budget status: %w", err)
+	}
+	return nil
+}
+
+// ListReservedBudgetWeeks returns published weeks whose reserved budget is not
+// marked used yet, oldest first
+func (r *RewardsRepository) ListReservedBudgetWeeks(ctx context.Context) ([]uint64, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT week FROM reward_distributions
+		WHERE status = $1 AND budget_status = $2
+		ORDER BY week`, DistributionPublished, DistributionBudgetReserved)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query reserved budget weeks: %w", err)
+	}
+	defer rows.Close()
+
+	var weeks []uint64
+	for rows.Next() {
+		var week uint64
+		if err := rows.Scan(&week); err != nil {
+			return nil, fmt.Errorf("failed to scan reserved budget week: %w", err)
+		}
+		weeks = append(weeks, week)
+	}
+
+	return weeks, rows.Err()
+}
+
+// ListDueCampaignSpending returns pending spending of weeks whose root is published,
+// oldest week first
+func (r *RewardsRepository) ListDueCampaignSpending(ctx context.Context) ([]CampaignSpending, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT s.campaign_id, s.week, s.amount::TEXT, s.status,
+		       COALESCE(s.tx_hash, ''), s.attempts, COALESCE(s.last_error, '')
+		FROM campaign_spending s
+		JOIN reward_distributions d ON d.week = s.week
+		WHERE s.status = $1 AND d.status = $2
+		ORDER BY s.week, s.campaign_id`, CampaignSpendingPending, DistributionPublished)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query campaign spending: %w", err)
+	}
+	defer rows.Close()
+
+	var spendings []CampaignSpending
+	for rows.Next() {
+		var s CampaignSpending
+		if err := rows.Scan(
+			&s.CampaignID, &s.Week, &s.Amount, &s.Status, &s.TxHash, &s.Attempts, &s.LastError,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan campaign spending: %w", err)
+		}
+		spendings = append(spendings, s)
+	}
+
+	return spendings, rows.Err()
+}
+
+// SumUnrecordedCampaignSpending returns, per campaign, the spending saved for
+// weeks other than excludeWeek that is not yet recorded on-chain, so it is not
+// yet subtracted from getRemainingBudget
+func (r *RewardsRepository) SumUnrecordedCampaignSpending(ctx context.Context, excludeWeek uint64) (map[string]string, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT campaign_id, SUM(amount)::TEXT
+		FROM campaign_spending
+		WHERE status = $1 AND week <> $2
+		GROUP BY campaign_id`, CampaignSpendingPending, excludeWeek)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query unrecorded campaign spending: %w", err)
+	}
+	defer rows.Close()
+
+	sums := make(map[string]string)
+	for rows.Next() {
+		var campaignID, amount string
+		if err := rows.Scan(&campaignID, &amount); err != nil {
+			return nil, fmt.Errorf("failed to scan unrecorded campaign spending: %w", err)
+		}
+		sums[strings.ToLower(campaignID)] = amount
+	}
+
+	return sums, rows.Err()
+}
+
+// SetCampaignSpendingTx stores the recordSpending transaction just sent, before
+// waiting for it, so a restart checks that transaction instead of sending another
+func (r *RewardsRepository) SetCampaignSpendingTx(ctx context.Context, campaignID string, week uint64, txHash string, now int64) error {
+	_, err := r.db.ExecContext(ctx, `
+		UPDATE campaign_spending
+		SET tx_hash = $3, attempts = attempts + 1, updated_at = $4
+		WHERE campaign_id = $1 AND week = $2 AND status = $5`,
+		strings.ToLower(campaignID), week, txHash, now, CampaignSpendingPending)
+	if err != nil {
+		return fmt.Errorf("failed to save campaign spending tx: %w", err)
+	}
+	return nil
+}
+
+// MarkCampaignSpendingRecorded marks a campaign's spending for a week as recorded on-chain
+func (r *RewardsRepository) MarkCampaignSpendingRecorded(ctx context.Context, campaignID string, week uint64, txHash string, now int64) error {
+	_, err := r.db.ExecContext(ctx, `
+		UPDATE campaign_spending
+		SET status = $3, tx_hash = $4, last_error = NULL, updated_at = $5
+		WHERE campaign_id = $1 AND week = $2`,
+		strings.ToLower(campaignID), week, CampaignSpendingRecorded, txHash, now)
+	if err != nil {
+		return fmt.Errorf("failed to mark campaign spending recorded: %w", err)
+	}
+	return nil
+}
+
+// SetCampaignSpendingError stores why the last recording attempt failed; the row stays pending
+func (r *RewardsRepository) SetCampaignSpendingError(ctx context.Context, campaignID string, week uint64, message string, now int64) error {
+	_, err := r.db.ExecContext(ctx, `
+		UPDATE campaign_spending
+		SET last_error = $3, updated_at = $4
+		WHERE campaign_id = $1 AND week = $2 AND status = $5`,
+		strings.ToLower(campaignID), week, message, now, CampaignSpendingPending)
+	if err != nil {
+		return fmt.Errorf("failed to save campaign spending error: %w", err)
+	}
+	return nil
+}
+
+// ErrCumulativeDistributionNotFound is returned when no cumulative distribution was saved for an epoch
+var ErrCumulativeDistributionNotFound = errors.New("cumulative distribution not found")
+
+// CumulativeDistribution is an epoch root committing to every user's lifetime reward total
+type CumulativeDistribution struct {
+	Epoch       uint64
+	MerkleRoot  string
+	TotalAmount string // sum of all cumulative amounts
+	EpochAmount string // rewards added in this epoch
+	Recipients  int
+	CreatedAt   int64
+	Entries     []CumulativeDistributionEntry
+}
+
+// CumulativeDistributionEntry is a single user's leaf in a cumulative distribution
+type CumulativeDistributionEntry struct {
+	UserAddress      string
+	CumulativeAmount string
+	EpochAmount      string
+	Proof            []string
+	LeafIndex        int
+}
+
+// SaveCumulativeDistribution writes the epoch summary and per-user proofs in one
+// transaction. Re-saving an epoch atomically replaces the summary and all of its proofs.
+func (r *RewardsRepository) SaveCumulativeDistribution(ctx context.Context, dist *CumulativeDistribution) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// A previous build of the epoch is replaced as a whole: its proofs are invalid
+	// against the new root and its publication fields belong to the old root
+	if _, err := tx.ExecContext(ctx, `DELETE FROM cumulative_proofs WHERE epoch = $1`, dist.Epoch); err != nil {
+		return fmt.Errorf("failed to clear cumulative proofs: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM cumulative_distributions WHERE epoch = $1`, dist.Epoch); err != nil {
+		return fmt.Errorf("failed to clear cumulative distribution: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO cumulative_distributions (
+			epoch, merkle_root, total_amount, epoch_amount, recipients, created_at
+		) VALUES ($1, $2, $3, $4, $5, $6)`,
+		dist.Epoch, dist.MerkleRoot, dist.TotalAmount, dist.EpochAmount, dist.Recipients, dist.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save cumulative distribution: %w", err)
+	}
+
+	stmt, err := tx.PrepareContext(ctx, `
+		INSERT INTO cumulative_proofs (epoch, user_address, cumulative_amount, epoch_amount, leaf_index, proof, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`)
+	if err != nil {
+		return fmt.Errorf("failed to prepare cumulative proof insert: %w", err)
+	}
+	defer stmt.Close()
+
+	for _, entry := range dist.Entries {
+		user := strings.ToLower(entry.UserAddress)
+
+		proofJSON, err := json.Marshal(entry.Proof)
+		if err != nil {
+			return fmt.Errorf("failed to encode proof: %w", err)
+		}
+		if _, err := stmt.ExecContext(ctx,
+			dist.Epoch, user, entry.CumulativeAmount, entry.EpochAmount, entry.LeafIndex, proofJSON, dist.CreatedAt,
+		); err != nil {
+			return fmt.Errorf("failed to save cumulative proof for %s: %w", user, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit cumulative distribution: %w", err)
+	}
+
+	return nil
+}
+
+// GetCumulativeDistribution loads a saved cumulative distribution with its entries ordered by leaf index
+func (r *RewardsRepository) GetCumulativeDistribution(ctx context.Context, epoch uint64) (*CumulativeDistribution, error) {
+	return r.getCumulativeDistribution(ctx, `
+		SELECT epoch, merkle_root, total_amount::TEXT, epoch_amount::TEXT, recipients, created_at
+		FROM cumulative_distributions
+		WHERE epoch = $1`, epoch)
+}
+
+// GetLatestCumulativeDistribution loads the newest cumulative distribution before the given epoch
+func (r *RewardsRepository) GetLatestCumulativeDistribution(ctx context.Context, before uint64) (*CumulativeDistribution, error) {
+	return r.getCumulativeDistribution(ctx, `
+		SELECT epoch, merkle_root, total_amount::TEXT, epoch_amount::TEXT, recipients, created_at
+		FROM cumulative_distributions
+		WHERE epoch < $1
+		ORDER BY epoch DESC
+		LIMIT 1`, before)
+}
+
+func (r *RewardsRepository) getCumulativeDistribution(ctx context.Context, query string, arg uint64) (*CumulativeDistribution, error) {
+	var dist CumulativeDistribution
+	err := r.db.QueryRowContext(ctx, query, arg).Scan(
+		&dist.Epoch, &dist.MerkleRoot, &dist.TotalAmount, &dist.EpochAmount, &dist.Recipients, &dist.CreatedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, ErrCumulativeDistributionNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to query cumulative distribution: %w", err)
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT user_address, cumulative_amount::TEXT, epoch_amount::TEXT, leaf_index, proof
+		FROM cumulative_proofs
+		WHERE epoch = $1
+		ORDER BY leaf_index`, dist.Epoch)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query cumulative proofs: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var entry CumulativeDistributionEntry
+		var proofJSON []byte
+		if err := rows.Scan(&entry.UserAddress, &entry.CumulativeAmount, &entry.EpochAmount, &entry.LeafIndex, &proofJSON); err != nil {
+			return nil, fmt.Errorf("failed to scan cumulative proof: %w", err)
+		}
+		if err := json.Unmarshal(proofJSON, &entry.Proof); err != nil {
+			return nil, fmt.Errorf("invalid proof JSON: %w", err)
+		}
+		dist.Entries = append(dist.Entries, entry)
+	}
+
+	return &dist, rows.Err()
+}
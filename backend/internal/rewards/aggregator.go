@@ -3,12 +3,15 @@ package rewards
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"math/big"
+	"sort"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pitchone/sportsbook/internal/graphql"
+	"github.com/pitchone/sportsbook/internal/repository"
 )
 
 // RewardType 奖励类型
@@ -31,57 +34,94 @@ type UserReward struct {
 
 // Aggregator 奖励聚合器
 type Aggregator struct {
-	graphClient *graphql.Client // 用于查询 orders/quests/campaigns
-	db          *sql.DB         // 仅用于 reward_distributions
+	graphClient   *graphql.Client               // 用于查询 orders/quests/campaigns
+	repo          *repository.RewardsRepository // 分配数据持久化
+	tradingPolicy *TradingPolicy                // 交易奖励策略
+	calendar      *EpochCalendar                // 奖励周期日历
+
+	campaignRules    *CampaignRules                   // 活动参与奖励规则
+	campaignBudget   CampaignBudgetReader             // 活动剩余预算（链上），nil 时使用 Subgraph 数据
+	campaignSpending UnrecordedCampaignSpendingReader // 尚未记录上链的活动支出
 }
 
-// NewAggregator 创建聚合器
+// NewAggregator 创建聚合器（使用默认交易奖励策略）
 func NewAggregator(graphClient *graphql.Client, db *sql.DB) *Aggregator {
-	return &Aggregator{
-		graphClient: graphClient,
-		db:          db,
+	a := &Aggregator{
+		graphClient:   graphClient,
+		repo:          repository.NewRewardsRepository(db),
+		tradingPolicy: DefaultTradingPolicy(),
+		calendar:      DefaultEpochCalendar(),
 	}
+	a.campaignSpending = a.repo
+	return a
 }
 
-// AggregateWeeklyRewards 聚合指定周的所有奖励
+// SetEpochCalendar 替换奖励周期日历（周期 ID 同时用作分配 ID 与 RewardsDistributor 的 week 参数）
+func (a *Aggregator) SetEpochCalendar(calendar *EpochCalendar) {
+	a.calendar = calendar
+}
+
+// Calendar 返回奖励周期日历
+func (a *Aggregator) Calendar() *EpochCalendar {
+	return a.calendar
+}
+
+// SetTradingPolicy 替换交易奖励策略
+func (a *Aggregator) SetTradingPolicy(policy *TradingPolicy) {
+	a.tradingPolicy = policy
+}
+
+// SetCampaignRules 配置活动参与奖励规则；budget 为 nil 时以 Subgraph 的剩余预算封顶
+func (a *Aggregator) SetCampaignRules(rules *CampaignRules, budget CampaignBudgetReader) {
+	a.campaignRules = rules
+	a.campaignBudget = budget
+}
+
+// AggregateWeeklyRewards 聚合指定周的所有奖励（不缩放）
 func (a *Aggregator) AggregateWeeklyRewards(ctx context.Context, week uint64) ([]RewardEntry, error) {
+	byCategory, err := a.AggregateWeeklyRewardsByCategory(ctx, week)
+	if err != nil {
+		return nil, err
+	}
+
+	// 合并所有奖励并转换为 RewardEntry
+	return rewardEntries(week, mergeRewards(byCategory)), nil
+}
+
+// AggregateWeeklyRewardsByCategory 按奖励类别聚合指定周的奖励，供预算缩放按类别计算
+func (a *Aggregator) AggregateWeeklyRewardsByCategory(ctx context.Context, week uint64) (map[RewardType]map[common.Address]*big.Int, error) {
+	byCategory, _, err := a.aggregateWeekly(ctx, week)
+	return byCategory, err
+}
+
+// aggregateWeekly 按类别聚合指定周的奖励，同时返回各活动的参与奖励发放结果
+func (a *Aggregator) aggregateWeekly(ctx context.Context, week uint64) (map[RewardType]map[common.Address]*big.Int, []*CampaignPayout, error) {
 	// 计算周时间范围
-	weekStart, weekEnd := GetWeekRange(week)
+	weekStart, weekEnd := a.calendar.Range(week)
 
 	// 1. 聚合推荐返佣
 	referralRewards, err := a.aggregateReferralRewards(ctx, weekStart, weekEnd)
 	if err != nil {
-		return nil, fmt.Errorf("failed to aggregate referral rewards: %w", err)
+		return nil, nil, fmt.Errorf("failed to aggregate referral rewards: %w", err)
 	}
 
 	// 2. 聚合交易奖励（基于交易量）
 	tradingRewards, err := a.aggregateTradingRewards(ctx, weekStart, weekEnd)
 	if err != nil {
-		return nil, fmt.Errorf("failed to aggregate trading rewards: %w", err)
+		return nil, nil, fmt.Errorf("failed to aggregate trading rewards: %w", err)
 	}
 
 	// 3. 聚合活动奖励
-	campaignRewards, err := a.aggregateCampaignRewards(ctx, weekStart, weekEnd)
+	campaignRewards, campaigns, err := a.aggregateCampaignRewards(ctx, weekStart, weekEnd)
 	if err != nil {
-		return nil, fmt.Errorf("failed to aggregate campaign rewards: %w", err)
-	}
-
-	// 合并所有奖励
-	userRewards := mergeRewards(referralRewards, tradingRewards, campaignRewards)
-
-	// 转换为 RewardEntry
-	entries := make([]RewardEntry, 0, len(userRewards))
-	for user, reward := range userRewards {
-		if reward.TotalRewards.Cmp(big.NewInt(0)) > 0 {
-			entries = append(entries, RewardEntry{
-				User:   user,
-				Week:   week,
-				Amount: reward.TotalRewards.String(),
-			})
-		}
+		return nil, nil, fmt.Errorf("failed to aggregate campaign rewards: %w", err)
 	}
 
-	return entries, nil
+	return map[RewardType]map[common.Address]*big.Int{
+		RewardTypeReferral: referralRewards,
+		RewardTypeTrading:  tradingRewards,
+		RewardTypeCampaign: campaignRewards,
+	}, campaigns, nil
 }
 
 // aggregateReferralRewards 聚合推荐返佣（从 Subgraph 查询）
@@ -108,10 +148,61 @@ func (a *Aggregator) aggregateReferralRewards(ctx context.Context, weekStart, we
 	return rewards, nil
 }
 
-// aggregateTradingRewards 聚合交易奖励（基于交易量，从 Subgraph 查询）
+// aggregateTradingRewards 按交易奖励策略计算交易奖励（从 Subgraph 查询订单）
 func (a *Aggregator) aggregateTradingRewards(ctx context.Context, weekStart, weekEnd time.Time) (map[common.Address]*big.Int, error) {
-	// 从 Subgraph 分页查询订单
-	userVolumes := make(map[common.Address]*big.Int)
+	explanations, err := a.evaluateTradingRewards(ctx, weekStart, weekEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	rewards := make(map[common.Address]*big.Int)
+	for user, explanation := range explanations {
+		if explanation.Reward.Sign() > 0 {
+			rewards[user] = explanation.Reward
+		}
+	}
+
+	return rewards, nil
+}
+
+// ExplainTradingRewards 返回指定周每个下单用户的交易奖励计算明细（按地址排序）
+func (a *Aggregator) ExplainTradingRewards(ctx context.Context, week uint64) ([]*TradingExplanation, error) {
+	weekStart, weekEnd := a.calendar.Range(week)
+
+	explanations, err := a.evaluateTradingRewards(ctx, weekStart, weekEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*TradingExplanation, 0, len(explanations))
+	for _, explanation := range explanations {
+		result = append(result, explanation)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].User.Hex() < result[j].User.Hex()
+	})
+
+	return result, nil
+}
+
+// evaluateTradingRewards 查询时间范围内的订单并按策略计算
+func (a *Aggregator) evaluateTradingRewards(ctx context.Context, weekStart, weekEnd time.Time) (map[common.Address]*TradingExplanation, error) {
+	orders, err := a.fetchOrders(ctx, weekStart, weekEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	tradingOrders := make([]TradingOrder, 0, len(orders))
+	for _, order := range orders {
+		tradingOrders = append(tradingOrders, tradingOrderFromGraph(order))
+	}
+
+	return a.tradingPolicy.Evaluate(tradingOrders), nil
+}
+
+// fetchOrders 分页查询时间范围内的所有订单
+func (a *Aggregator) fetchOrders(ctx context.Context, weekStart, weekEnd time.Time) ([]graphql.Order, error) {
+	var all []graphql.Order
 
 	first := 1000
 	skip := 0
@@ -122,22 +213,7 @@ func (a *Aggregator) aggregateTradingRewards(ctx context.Context, weekStart, wee
 			return nil, fmt.Errorf("failed to query Subgraph for orders: %w", err)
 		}
 
-		if len(orders) == 0 {
-			break
-		}
-
-		// 聚合用户交易量
-		for _, order := range orders {
-			user := order.User.Address()
-			amount := graphql.ParseBigInt(order.Amount)
-
-			if existing, ok := userVolumes[user]; ok {
-				userVolumes[user] = new(big.Int).Add(existing, amount)
-			} else {
-				userVolumes[user] = amount
-			}
-		}
-
+		all = append(all, orders...)
 		skip += first
 
 		// 如果返回数量少于请求数量，说明已经没有更多数据
@@ -146,38 +222,26 @@ func (a *Aggregator) aggregateTradingRewards(ctx context.Context, weekStart, wee
 		}
 	}
 
-	// 计算奖励：只有交易量 >= 1000 USDC（1000000000 with 6 decimals）的用户才有奖励
-	rewards := make(map[common.Address]*big.Int)
-	minVolume := big.NewInt(1000000000) // 1000 USDC
-
-	for user, volume := range userVolumes {
-		if volume.Cmp(minVolume) >= 0 {
-			// 奖励 = 交易量 * 0.1% (示例)
-			reward := new(big.Int).Div(volume, big.NewInt(1000))
-			rewards[user] = reward
-		}
-	}
-
-	return rewards, nil
+	return all, nil
 }
 
 // aggregateCampaignRewards 聚合活动奖励（Campaign + Quest）
-func (a *Aggregator) aggregateCampaignRewards(ctx context.Context, weekStart, weekEnd time.Time) (map[common.Address]*big.Int, error) {
+func (a *Aggregator) aggregateCampaignRewards(ctx context.Context, weekStart, weekEnd time.Time) (map[common.Address]*big.Int, []*CampaignPayout, error) {
 	rewards := make(map[common.Address]*big.Int)
 
 	// 1. 聚合 Quest 完成奖励
 	questRewards, err := a.aggregateQuestRewards(ctx, weekStart, weekEnd)
 	if err != nil {
-		return nil, fmt.Errorf("failed to aggregate quest rewards: %w", err)
+		return nil, nil, fmt.Errorf("failed to aggregate quest rewards: %w", err)
 	}
 	for user, amount := range questRewards {
 		rewards[user] = amount
 	}
 
 	// 2. 聚合 Campaign 参与奖励
-	campaignRewards, err := a.aggregateCampaignParticipationRewards(ctx, weekStart, weekEnd)
+	campaignRewards, campaigns, err := a.aggregateCampaignParticipationRewards(ctx, weekStart, weekEnd)
 	if err != nil {
-		return nil, fmt.Errorf("failed to aggregate campaign rewards: %w", err)
+		return nil, nil, fmt.Errorf("failed to aggregate campaign rewards: %w", err)
 	}
 	for user, amount := range campaignRewards {
 		if existing, ok := rewards[user]; ok {
@@ -187,7 +251,7 @@ func (a *Aggregator) aggregateCampaignRewards(ctx context.Context, weekStart, we
 		}
 	}
 
-	return rewards, nil
+	return rewards, campaigns, nil
 }
 
 // aggregateQuestRewards 聚合任务完成奖励（从 Subgraph 查询）
@@ -214,20 +278,63 @@ func (a *Aggregator) aggregateQuestRewards(ctx context.Context, weekStart, weekE
 	return rewards, nil
 }
 
-// aggregateCampaignParticipationRewards 聚合活动参与奖励
-// TODO: 当 Subgraph 支持 Campaign 参与索引时，添加相应的 GraphQL 查询
-// 目前返回空，活动奖励通过 Quest 系统领取
-func (a *Aggregator) aggregateCampaignParticipationRewards(ctx context.Context, weekStart, weekEnd time.Time) (map[common.Address]*big.Int, error) {
-	// Campaign 参与奖励目前由 Quest 系统处理
-	// 如果需要独立的 Campaign 奖励追踪，需要扩展 Subgraph schema
-	return make(map[common.Address]*big.Int), nil
+// aggregateCampaignParticipationRewards 按活动规则聚合活动参与奖励（每个活动以剩余预算封顶）
+func (a *Aggregator) aggregateCampaignParticipationRewards(ctx context.Context, weekStart, weekEnd time.Time) (map[common.Address]*big.Int, []*CampaignPayout, error) {
+	payouts, err := a.evaluateCampaignRewards(ctx, weekStart, weekEnd)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rewards := make(map[common.Address]*big.Int)
+	for _, payout := range payouts {
+		for user, amount := range payout.rewards {
+			if existing, ok := rewards[user]; ok {
+				rewards[user] = new(big.Int).Add(existing, amount)
+			} else {
+				rewards[user] = amount
+			}
+		}
+	}
+
+	return rewards, payouts, nil
+}
+
+// RewardTypes 所有奖励类别（导出与展示顺序）
+var RewardTypes = []RewardType{RewardTypeReferral, RewardTypeTrading, RewardTypeCampaign}
+
+// add 累加指定类别的奖励
+func (r *UserReward) add(rewardType RewardType, amount *big.Int) {
+	switch rewardType {
+	case RewardTypeReferral:
+		r.ReferralRewards.Add(r.ReferralRewards, amount)
+	case RewardTypeTrading:
+		r.TradingRewards.Add(r.TradingRewards, amount)
+	case RewardTypeCampaign:
+		r.CampaignRewards.Add(r.CampaignRewards, amount)
+	}
+	r.TotalRewards.Add(r.TotalRewards, amount)
+}
+
+// Breakdown 返回非零的各类别奖励金额
+func (r *UserReward) Breakdown() map[RewardType]string {
+	breakdown := make(map[RewardType]string)
+	for rewardType, amount := range map[RewardType]*big.Int{
+		RewardTypeReferral: r.ReferralRewards,
+		RewardTypeTrading:  r.TradingRewards,
+		RewardTypeCampaign: r.CampaignRewards,
+	} {
+		if amount.Sign() > 0 {
+			breakdown[rewardType] = amount.String()
+		}
+	}
+	return breakdown
 }
 
-// mergeRewards 合并多个奖励映射
-func mergeRewards(rewardMaps ...map[common.Address]*big.Int) map[common.Address]*UserReward {
+// mergeRewards 按用户合并各类别奖励，保留类别明细
+func mergeRewards(byCategory map[RewardType]map[common.Address]*big.Int) map[common.Address]*UserReward {
 	result := make(map[common.Address]*UserReward)
 
-	for _, rewardMap := range rewardMaps {
+	for rewardType, rewardMap := range byCategory {
 		for user, amount := range rewardMap {
 			if _, exists := result[user]; !exists {
 				result[user] = &UserReward{
@@ -239,27 +346,40 @@ func mergeRewards(rewardMaps ...map[common.Address]*big.Int) map[common.Address]
 				}
 			}
 
-			result[user].TotalRewards.Add(result[user].TotalRewards, amount)
+			result[user].add(rewardType, amount)
 		}
 	}
 
 	return result
 }
 
-// GetWeekRange 计算周时间范围
+// rewardEntries 将用户奖励转换为按地址排序的 RewardEntry（跳过零金额）
+func rewardEntries(week uint64, userRewards map[common.Address]*UserReward) []RewardEntry {
+	entries := make([]RewardEntry, 0, len(userRewards))
+	for user, reward := range userRewards {
+		if reward.TotalRewards.Sign() > 0 {
+			entries = append(entries, RewardEntry{
+				User:      user,
+				Week:      week,
+				Amount:    reward.TotalRewards.String(),
+				Breakdown: reward.Breakdown(),
+			})
+		}
+	}
+	sortEntries(entries)
+
+	return entries
+}
+
+// GetWeekRange 按默认日历计算周时间范围
 // week 0 = 从 2024-01-01 00:00:00 UTC 开始
 func GetWeekRange(week uint64) (start, end time.Time) {
-	epoch := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
-	start = epoch.Add(time.Duration(week) * 7 * 24 * time.Hour)
-	end = start.Add(7 * 24 * time.Hour)
-	return start, end
+	return DefaultEpochCalendar().Range(week)
 }
 
-// GetCurrentWeek 获取当前周编号
+// GetCurrentWeek 按默认日历获取当前周编号
 func GetCurrentWeek() uint64 {
-	epoch := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
-	duration := time.Since(epoch)
-	return uint64(duration.Hours() / (7 * 24))
+	return DefaultEpochCalendar().Current()
 }
 
 // CalculateScaleBps 根据可用预算计算缩放比例
@@ -287,57 +407,215 @@ func CalculateScaleBps(totalRewards *big.Int, availableBudget *big.Int) uint64 {
 }
 
 // SaveDistribution 保存分配数据到数据库
-func (a *Aggregator) SaveDistribution(ctx context.Context, dist *MerkleDistribution) error {
-	query := `
-		INSERT INTO reward_distributions (
-			week, merkle_root, total_amount, recipients,
-			scale_bps, created_at
-		) VALUES ($1, $2, $3, $4, $5, $6)
-		ON CONFLICT (week) DO UPDATE SET
-			merkle_root = EXCLUDED.merkle_root,
-			total_amount = EXCLUDED.total_amount,
-			recipients = EXCLUDED.recipients,
-			scale_bps = EXCLUDED.scale_bps,
-			updated_at = EXCLUDED.created_at
-	`
-
-	_, err := a.db.ExecContext(ctx, query,
-		dist.Week,
-		dist.Root,
-		dist.TotalAmount,
-		dist.Recipients,
-		dist.ScaleBps,
-		dist.CreatedAt,
-	)
-
-	return err
-}
-
-// GetDistribution 从数据库获取分配数据
-func (a *Aggregator) GetDistribution(ctx context.Context, week uint64) (*MerkleDistribution, error) {
-	query := `
-		SELECT week, merkle_root, total_amount, recipients, scale_bps, created_at
-		FROM reward_distributions
-		WHERE week = $1
-	`
-
-	var dist MerkleDistribution
-	err := a.db.QueryRowContext(ctx, query, week).Scan(
-		&dist.Week,
-		&dist.Root,
-		&dist.TotalAmount,
-		&dist.Recipients,
-		&dist.ScaleBps,
-		&dist.CreatedAt,
-	)
-
-	if err == sql.ErrNoRows {
-		return nil, fmt.Errorf("distribution not found for week %d", week)
+// 分配汇总、每个用户的金额、证明、叶子下标与各活动待记录的扣减在同一事务中写入；
+// plan 为 nil 时不写入活动扣减
+func (a *Aggregator) SaveDistribution(ctx context.Context, dist *MerkleDistribution, plan *ScalePlan) error {
+	record, err := distributionRecord(dist)
+	if err != nil {
+		return err
+	}
+	if plan != nil {
+		for _, payout := range plan.Campaigns {
+			if payout.Spent == nil || payout.Spent.Sign() == 0 {
+				continue
+			}
+			record.CampaignSpending = append(record.CampaignSpending, repository.CampaignSpending{
+				CampaignID: payout.CampaignID,
+				Week:       dist.Week,
+				Amount:     payout.Spent.String(),
+			})
+		}
+	}
+	return a.repo.SaveDistribution(ctx, record)
+}
+
+// PublishStatus 返回该周分配的发布状态（repository.DistributionPending/Published/Failed），
+// 未保存时返回 ErrDistributionNotFound
+func (a *Aggregator) PublishStatus(ctx context.Context, week uint64) (string, error) {
+	status, err := a.repo.GetDistributionStatus(ctx, week)
+	if errors.Is(err, repository.ErrRewardDistributionNotFound) {
+		return "", fmt.Errorf("%w for week %d", ErrDistributionNotFound, week)
+	}
+	return status, err
+}
+
+// MarkPublished 记录该周 Root 已上链，之后该周的活动扣减才会被记录。
+// 事后在链上发现已发布时 txHash 为零值、blockNumber 为 0
+func (a *Aggregator) MarkPublished(ctx context.Context, week uint64, txHash common.Hash, blockNumber uint64) error {
+	var hash string
+	if txHash != (common.Hash{}) {
+		hash = txHash.Hex()
+	}
+	return a.repo.MarkDistributionPublished(ctx, week, hash, blockNumber, time.Now().Unix())
+}
+
+// MarkPublishFailed 记录该周发布失败的原因，下次运行时重新发布
+func (a *Aggregator) MarkPublishFailed(ctx context.Context, week uint64, reason error) error {
+	return a.repo.MarkDistributionFailed(ctx, week, reason.Error(), time.Now().Unix())
+}
+
+// MarkBudgetReserved 记录该周已通过 calculateScale 锁定预算，Root 上链后需 markBudgetUsed
+func (a *Aggregator) MarkBudgetReserved(ctx context.Context, week uint64) error {
+	return a.repo.SetDistributionBudgetStatus(ctx, week, repository.DistributionBudgetReserved, time.Now().Unix())
+}
+
+// MarkBudgetUsed 记录该周已调用 markBudgetUsed，之后不再重复扣减
+func (a *Aggregator) MarkBudgetUsed(ctx context.Context, week uint64) error {
+	return a.repo.SetDistributionBudgetStatus(ctx, week, repository.DistributionBudgetUsed, time.Now().Unix())
+}
+
+// ReservedBudgetWeeks 返回 Root 已发布但锁定的预算尚未扣减的周
+func (a *Aggregator) ReservedBudgetWeeks(ctx context.Context) ([]uint64, error) {
+	return a.repo.ListReservedBudgetWeeks(ctx)
+}
+
+// RecordCampaignSpending 为已发布周的待记录活动扣减调用 recordSpending，返回本轮记录成功的条数
+func (a *Aggregator) RecordCampaignSpending(ctx context.Context, ledger *CampaignLedger) (int, error) {
+	return ledger.RecordSpending(ctx, a.repo)
+}
+
+// BuildWeeklyDistribution 聚合指定周奖励，按预算池缩放各类别金额后构建 Merkle 分配。
+// scaler 为 nil 时不做预算缩放。没有奖励时返回 nil 分配。
+// 缩放已计入叶子金额，分配的 ScaleBps 固定为 10000。
+func (a *Aggregator) BuildWeeklyDistribution(ctx context.Context, week uint64, scaler *BudgetScaler) (*MerkleDistribution, *ScalePlan, error) {
+	byCategory, campaigns, err := a.aggregateWeekly(ctx, week)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pools := DefaultCategoryPools()
+	plan := &ScalePlan{Week: week, CategoryScales: make(map[RewardType]uint64)}
+	if scaler != nil {
+		pools = scaler.Pools()
+		if plan, err = scaler.Plan(ctx, week, CategoryTotals(byCategory)); err != nil {
+			return nil, nil, fmt.Errorf("failed to plan budget scaling: %w", err)
+		}
+	}
+
+	entries := plan.BuildEntries(week, byCategory, pools)
+
+	// 活动奖励随活动类别一起缩放，记录实际发放金额供 recordSpending 使用
+	campaignScaleBps, ok := plan.CategoryScales[RewardTypeCampaign]
+	if !ok {
+		campaignScaleBps = 10000
+	}
+	for _, payout := range campaigns {
+		payout.Spent = new(big.Int).Mul(payout.Paid, new(big.Int).SetUint64(campaignScaleBps))
+		payout.Spent.Div(payout.Spent, big.NewInt(10000))
+	}
+	plan.Campaigns = campaigns
+	if len(entries) == 0 {
+		return nil, plan, nil
+	}
+
+	dist, err := BuildDistribution(week, entries, 10000)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(plan.CategoryScales) > 0 {
+		dist.CategoryScaleBps = plan.CategoryScales
+	}
+
+	return dist, plan, nil
+}
+
+// distributionRecord 将 MerkleDistribution 转换为数据库记录
+func distributionRecord(dist *MerkleDistribution) (*repository.RewardDistribution, error) {
+	record := &repository.RewardDistribution{
+		Week:        dist.Week,
+		MerkleRoot:  dist.Root,
+		TotalAmount: dist.TotalAmount,
+		Recipients:  dist.Recipients,
+		ScaleBps:    dist.ScaleBps,
+		CreatedAt:   dist.CreatedAt,
+		Entries:     make([]repository.RewardDistributionEntry, 0, len(dist.Entries)),
+	}
+
+	if len(dist.CategoryScaleBps) > 0 {
+		record.CategoryScaleBps = make(map[string]uint64, len(dist.CategoryScaleBps))
+		for category, scaleBps := range dist.CategoryScaleBps {
+			record.CategoryScaleBps[string(category)] = scaleBps
+		}
+	}
+
+	for _, entry := range dist.Entries {
+		proof, ok := dist.Proofs[entry.User.Hex()]
+		if !ok {
+			return nil, fmt.Errorf("missing proof for %s", entry.User.Hex())
+		}
+		recordEntry := repository.RewardDistributionEntry{
+			UserAddress: entry.User.Hex(),
+			Amount:      entry.Amount,
+			Proof:       proof,
+			LeafIndex:   dist.LeafIndexes[entry.User.Hex()],
+		}
+		if len(entry.Breakdown) > 0 {
+			recordEntry.Breakdown = make(map[string]string, len(entry.Breakdown))
+			for rewardType, amount := range entry.Breakdown {
+				recordEntry.Breakdown[string(rewardType)] = amount
+			}
+		}
+		record.Entries = append(record.Entries, recordEntry)
 	}
 
+	return record, nil
+}
+
+// ErrDistributionNotFound 该周没有保存的分配数据
+var ErrDistributionNotFound = errors.New("distribution not found")
+
+// GetDistribution 从数据库加载完整的分配数据（含条目与证明），可重新导出或发布
+func (a *Aggregator) GetDistribution(ctx context.Context, week uint64) (*MerkleDistribution, error) {
+	record, err := a.repo.GetDistribution(ctx, week)
+	if errors.Is(err, repository.ErrRewardDistributionNotFound) {
+		return nil, fmt.Errorf("%w for week %d", ErrDistributionNotFound, week)
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	return &dist, nil
+	return distributionFromRecord(record), nil
+}
+
+// distributionFromRecord 将数据库记录还原为 MerkleDistribution（条目顺序与构建时一致）
+func distributionFromRecord(record *repository.RewardDistribution) *MerkleDistribution {
+	dist := &MerkleDistribution{
+		Week:        record.Week,
+		Root:        record.MerkleRoot,
+		TotalAmount: record.TotalAmount,
+		Recipients:  record.Recipients,
+		ScaleBps:    record.ScaleBps,
+		Entries:     make([]RewardEntry, 0, len(record.Entries)),
+		Proofs:      make(map[string][]string, len(record.Entries)),
+		LeafIndexes: make(map[string]int, len(record.Entries)),
+		CreatedAt:   record.CreatedAt,
+	}
+
+	if len(record.CategoryScaleBps) > 0 {
+		dist.CategoryScaleBps = make(map[RewardType]uint64, len(record.CategoryScaleBps))
+		for category, scaleBps := range record.CategoryScaleBps {
+			dist.CategoryScaleBps[RewardType(category)] = scaleBps
+		}
+	}
+
+	for _, entry := range record.Entries {
+		user := common.HexToAddress(entry.UserAddress)
+		rewardEntry := RewardEntry{
+			User:   user,
+			Week:   record.Week,
+			Amount: entry.Amount,
+		}
+		if len(entry.Breakdown) > 0 {
+			rewardEntry.Breakdown = make(map[RewardType]string, len(entry.Breakdown))
+			for rewardType, amount := range entry.Breakdown {
+				rewardEntry.Breakdown[RewardType(rewardType)] = amount
+			}
+		}
+		dist.Entries = append(dist.Entries, rewardEntry)
+		dist.Proofs[user.Hex()] = entry.Proof
+		dist.LeafIndexes[user.Hex()] = entry.LeafIndex
+	}
+	sortEntries(dist.Entries)
+
+	return dist
 }
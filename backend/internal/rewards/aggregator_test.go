@@ -0,0 +1,46 @@
+package rewards
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeRewards(t *testing.T) {
+	alice := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	bob := common.HexToAddress("0x2222222222222222222222222222222222222222")
+
+	merged := mergeRewards(map[RewardType]map[common.Address]*big.Int{
+		RewardTypeReferral: {alice: big.NewInt(100)},
+		RewardTypeTrading:  {alice: big.NewInt(200), bob: big.NewInt(50)},
+		RewardTypeCampaign: {alice: big.NewInt(300)},
+	})
+
+	require.Len(t, merged, 2)
+	assert.Equal(t, big.NewInt(100), merged[alice].ReferralRewards)
+	assert.Equal(t, big.NewInt(200), merged[alice].TradingRewards)
+	assert.Equal(t, big.NewInt(300), merged[alice].CampaignRewards)
+	assert.Equal(t, big.NewInt(600), merged[alice].TotalRewards)
+	assert.Equal(t, map[RewardType]string{RewardTypeTrading: "50"}, merged[bob].Breakdown())
+}
+
+func TestRewardEntries(t *testing.T) {
+	alice := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	bob := common.HexToAddress("0x2222222222222222222222222222222222222222")
+
+	entries := rewardEntries(9, mergeRewards(map[RewardType]map[common.Address]*big.Int{
+		RewardTypeTrading:  {bob: big.NewInt(0)},
+		RewardTypeReferral: {alice: big.NewInt(7)},
+		RewardTypeCampaign: {alice: big.NewInt(3)},
+	}))
+
+	// 零金额用户不生成条目
+	require.Len(t, entries, 1)
+	assert.Equal(t, RewardEntry{User: alice, Week: 9, Amount: "10", Breakdown: map[RewardType]string{
+		RewardTypeReferral: "7",
+		RewardTypeCampaign: "3",
+	}}, entries[0])
+}
@@ -0,0 +1,430 @@
+package rewards
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+
+	"github.com/pitchone/sportsbook/pkg/bindings"
+)
+
+// BudgetPool PayoutScaler 预算池（与合约 BudgetPool 枚举一致）
+type BudgetPool uint8
+
+const (
+	BudgetPoolPromo     BudgetPool = 0 // 推广池（推荐返佣、交易奖励）
+	BudgetPoolCampaign  BudgetPool = 1 // 活动池
+	BudgetPoolQuest     BudgetPool = 2 // 任务池
+	BudgetPoolInsurance BudgetPool = 3 // 保险基金
+)
+
+var budgetPoolNames = map[BudgetPool]string{
+	BudgetPoolPromo:     "promo",
+	BudgetPoolCampaign:  "campaign",
+	BudgetPoolQuest:     "quest",
+	BudgetPoolInsurance: "insurance",
+}
+
+func (p BudgetPool) String() string {
+	if name, ok := budgetPoolNames[p]; ok {
+		return name
+	}
+	return fmt.Sprintf("pool(%d)", uint8(p))
+}
+
+// ParseBudgetPool 解析预算池名称（promo/campaign/quest/insurance）
+func ParseBudgetPool(name string) (BudgetPool, error) {
+	for pool, poolName := range budgetPoolNames {
+		if strings.EqualFold(name, poolName) {
+			return pool, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown budget pool: %s", name)
+}
+
+// DefaultCategoryPools 奖励类别到预算池的默认映射
+func DefaultCategoryPools() map[RewardType]BudgetPool {
+	return map[RewardType]BudgetPool{
+		RewardTypeReferral: BudgetPoolPromo,
+		RewardTypeTrading:  BudgetPoolPromo,
+		RewardTypeCampaign: BudgetPoolCampaign,
+	}
+}
+
+// PoolScale 单个预算池的缩放结果
+type PoolScale struct {
+	Pool       BudgetPool   `json:"pool"`
+	Categories []RewardType `json:"categories"`
+	Requested  *big.Int     `json:"requested"` // 池内各类别奖励之和
+	Available  *big.Int     `json:"available"` // getBudgetStatus.available
+	ScaleBps   uint64       `json:"scaleBps"`  // previewScale 结果
+	Payout     *big.Int     `json:"payout"`    // 缩放后实际写入叶子的金额之和
+}
+
+// ScalePlan 一周奖励的预算缩放方案
+type ScalePlan struct {
+	Week           uint64                `json:"week"`
+	Pools          []*PoolScale          `json:"pools"`
+	CategoryScales map[RewardType]uint64 `json:"categoryScales"`
+
+	// Campaigns 各活动参与奖励的发放结果（发布后按 Spent 调用 recordSpending）
+	Campaigns []*CampaignPayout `json:"campaigns,omitempty"`
+}
+
+// MinScaleBps 返回所有预算池中最低的缩放比例（无奖励时为 10000）
+func (p *ScalePlan) MinScaleBps() uint64 {
+	min := uint64(10000)
+	for _, pool := range p.Pools {
+		if pool.ScaleBps < min {
+			min = pool.ScaleBps
+		}
+	}
+	return min
+}
+
+// BuildEntries 按类别缩放比例计算每个用户的奖励并合并为条目，同时统计各池实际发放金额。
+// 未出现在方案中的类别按 100% 发放。
+func (p *ScalePlan) BuildEntries(week uint64, byCategory map[RewardType]map[common.Address]*big.Int, pools map[RewardType]BudgetPool) []RewardEntry {
+	payouts := make(map[BudgetPool]*big.Int)
+	scaledByCategory := make(map[RewardType]map[common.Address]*big.Int, len(byCategory))
+
+	for category, rewards := range byCategory {
+		scaleBps, ok := p.CategoryScales[category]
+		if !ok {
+			scaleBps = 10000
+		}
+		pool, pooled := pools[category]
+
+		scaledRewards := make(map[common.Address]*big.Int, len(rewards))
+		for user, amount := range rewards {
+			scaled := new(big.Int).Mul(amount, new(big.Int).SetUint64(scaleBps))
+			scaled.Div(scaled, big.NewInt(10000))
+			scaledRewards[user] = scaled
+
+			if pooled {
+				if _, exists := payouts[pool]; !exists {
+					payouts[pool] = new(big.Int)
+				}
+				payouts[pool].Add(payouts[pool], scaled)
+			}
+		}
+		scaledByCategory[category] = scaledRewards
+	}
+
+	for _, pool := range p.Pools {
+		pool.Payout = new(big.Int)
+		if payout, ok := payouts[pool.Pool]; ok {
+			pool.Payout = payout
+		}
+	}
+
+	return rewardEntries(week, mergeRewards(scaledByCategory))
+}
+
+// DistributionPlan 由已保存的分配还原预算方案，用于重新发布已保存的周而不重新聚合。
+// 各池 Payout 为条目明细中该池类别金额之和，缩放比例取分配记录的类别比例（缺省 10000），
+// Requested 按比例反推。未映射到预算池或没有明细的金额不计入。
+func DistributionPlan(dist *MerkleDistribution, pools map[RewardType]BudgetPool) *ScalePlan {
+	plan := &ScalePlan{Week: dist.Week, CategoryScales: make(map[RewardType]uint64)}
+
+	byPool := make(map[BudgetPool]*PoolScale)
+	for _, entry := range dist.Entries {
+		for category, value := range entry.Breakdown {
+			pool, ok := pools[category]
+			if !ok {
+				continue
+			}
+			amount, ok := new(big.Int).SetString(value, 10)
+			if !ok || amount.Sign() == 0 {
+				continue
+			}
+
+			scale, exists := byPool[pool]
+			if !exists {
+				scale = &PoolScale{Pool: pool, Requested: new(big.Int), Payout: new(big.Int), ScaleBps: 10000}
+				byPool[pool] = scale
+				plan.Pools = append(plan.Pools, scale)
+			}
+			if _, seen := plan.CategoryScales[category]; !seen {
+				scaleBps := dist.CategoryScaleBps[category]
+				if scaleBps == 0 {
+					scaleBps = 10000
+				}
+				plan.CategoryScales[category] = scaleBps
+				scale.Categories = append(scale.Categories, category)
+				if scaleBps < scale.ScaleBps {
+					scale.ScaleBps = scaleBps
+				}
+			}
+			scale.Payout.Add(scale.Payout, amount)
+		}
+	}
+
+	sort.Slice(plan.Pools, func(i, j int) bool { return plan.Pools[i].Pool < plan.Pools[j].Pool })
+	for _, pool := range plan.Pools {
+		sort.Slice(pool.Categories, func(i, j int) bool { return pool.Categories[i] < pool.Categories[j] })
+
+		// 向上取整，保证按该比例缩放后不低于实际发放金额
+		bps := new(big.Int).SetUint64(pool.ScaleBps)
+		pool.Requested.Mul(pool.Payout, big.NewInt(10000))
+		pool.Requested.Add(pool.Requested, new(big.Int).Sub(bps, big.NewInt(1)))
+		pool.Requested.Div(pool.Requested, bps)
+	}
+
+	return plan
+}
+
+// budgetCaller PayoutScaler 只读接口（bindings.PayoutScalerCaller 实现）
+type budgetCaller interface {
+	GetBudgetStatus(opts *bind.CallOpts, pool uint8) (struct {
+		Total     *big.Int
+		Used      *big.Int
+		Pending   *big.Int
+		Available *big.Int
+	}, error)
+	PreviewScale(opts *bind.CallOpts, pool uint8, requestedAmount *big.Int) (struct {
+		ScaleBps     *big.Int
+		ScaledAmount *big.Int
+	}, error)
+}
+
+// planScale 根据各类别奖励总额和预算池状态计算缩放方案
+// 映射到同一预算池的类别共享该池预算，使用相同的缩放比例
+func planScale(ctx context.Context, caller budgetCaller, week uint64, totals map[RewardType]*big.Int, pools map[RewardType]BudgetPool) (*ScalePlan, error) {
+	plan := &ScalePlan{Week: week, CategoryScales: make(map[RewardType]uint64)}
+
+	byPool := make(map[BudgetPool]*PoolScale)
+	for category, total := range totals {
+		if total == nil || total.Sign() == 0 {
+			continue
+		}
+		pool, ok := pools[category]
+		if !ok {
+			return nil, fmt.Errorf("no budget pool configured for %s rewards", category)
+		}
+		if _, exists := byPool[pool]; !exists {
+			byPool[pool] = &PoolScale{Pool: pool, Requested: new(big.Int)}
+			plan.Pools = append(plan.Pools, byPool[pool])
+		}
+		byPool[pool].Categories = append(byPool[pool].Categories, category)
+		byPool[pool].Requested.Add(byPool[pool].Requested, total)
+	}
+
+	sort.Slice(plan.Pools, func(i, j int) bool { return plan.Pools[i].Pool < plan.Pools[j].Pool })
+
+	opts := &bind.CallOpts{Context: ctx}
+	for _, pool := range plan.Pools {
+		sort.Slice(pool.Categories, func(i, j int) bool { return pool.Categories[i] < pool.Categories[j] })
+
+		status, err := caller.GetBudgetStatus(opts, uint8(pool.Pool))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get %s budget status: %w", pool.Pool, err)
+		}
+		pool.Available = status.Available
+
+		preview, err := caller.PreviewScale(opts, uint8(pool.Pool), pool.Requested)
+		if err != nil {
+			return nil, fmt.Errorf("failed to preview %s scale: %w", pool.Pool, err)
+		}
+		// previewScale 在预算不足且未启用自动缩放时返回 0
+		if preview.ScaleBps.Sign() == 0 {
+			return nil, fmt.Errorf("%s budget insufficient (available %s, requested %s) and auto-scale disabled",
+				pool.Pool, pool.Available, pool.Requested)
+		}
+		pool.ScaleBps = preview.ScaleBps.Uint64()
+
+		for _, category := range pool.Categories {
+			plan.CategoryScales[category] = pool.ScaleBps
+		}
+	}
+
+	return plan, nil
+}
+
+// CategoryTotals 计算各类别奖励总额
+func CategoryTotals(byCategory map[RewardType]map[common.Address]*big.Int) map[RewardType]*big.Int {
+	totals := make(map[RewardType]*big.Int, len(byCategory))
+	for category, rewards := range byCategory {
+		total := new(big.Int)
+		for _, amount := range rewards {
+			total.Add(total, amount)
+		}
+		totals[category] = total
+	}
+	return totals
+}
+
+// BudgetScaler 基于 PayoutScaler 合约的预算缩放器
+type BudgetScaler struct {
+	client     *ethclient.Client
+	scaler     *bindings.PayoutScaler
+	privateKey string
+	chainID    *big.Int
+	pools      map[RewardType]BudgetPool
+}
+
+// NewBudgetScaler 创建预算缩放器；pools 为空时使用 DefaultCategoryPools
+// 写操作（Reserve/MarkUsed）需要 privateKey 对应账户拥有 OPERATOR_ROLE
+func NewBudgetScaler(rpcURL string, scalerAddr common.Address, privateKey string, pools map[RewardType]BudgetPool) (*BudgetScaler, error) {
+	client, err := ethclient.Dial(rpcURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to RPC: %w", err)
+	}
+
+	chainID, err := client.ChainID(context.Background())
+	if err != nil {
+		client.Close()
+		return nil, fmt.Errorf("failed to get chain ID: %w", err)
+	}
+
+	scaler, err := bindings.NewPayoutScaler(scalerAddr, client)
+	if err != nil {
+		client.Close()
+		return nil, fmt.Errorf("failed to bind PayoutScaler: %w", err)
+	}
+
+	if len(pools) == 0 {
+		pools = DefaultCategoryPools()
+	}
+
+	return &BudgetScaler{
+		client:     client,
+		scaler:     scaler,
+		privateKey: privateKey,
+		chainID:    chainID,
+		pools:      pools,
+	}, nil
+}
+
+// Pools 返回类别到预算池的映射
+func (b *BudgetScaler) Pools() map[RewardType]BudgetPool {
+	return b.pools
+}
+
+// Plan 读取预算池状态并计算各类别缩放比例（只读）
+func (b *BudgetScaler) Plan(ctx context.Context, week uint64, totals map[RewardType]*big.Int) (*ScalePlan, error) {
+	return planScale(ctx, &b.scaler.PayoutScalerCaller, week, totals, b.pools)
+}
+
+// Reserve 在发布 Root 之前调用 calculateScale 锁定各池的待发放预算。
+// 若链上确定的缩放比例低于方案（预算在计划后被消耗），返回错误，不应继续发布。
+// PayoutScaler 没有释放预算的接口：calculateScale 会记录该周的缩放比例，
+// 已有记录的池说明之前的发布尝试已锁定过预算，直接复用而不重复累加待发放金额。
+func (b *BudgetScaler) Reserve(ctx context.Context, plan *ScalePlan) error {
+	week := new(big.Int).SetUint64(plan.Week)
+
+	for _, pool := range plan.Pools {
+		scaleBps, err := b.scaler.GetPeriodScale(&bind.CallOpts{Context: ctx}, uint8(pool.Pool), week)
+		if err != nil {
+			return fmt.Errorf("failed to read %s period scale: %w", pool.Pool, err)
+		}
+
+		if scaleBps.Sign() == 0 {
+			auth, err := b.transactor(ctx)
+			if err != nil {
+				return err
+			}
+
+			tx, err := b.scaler.CalculateScale(auth, uint8(pool.Pool), week, pool.Requested)
+			if err != nil {
+				return fmt.Errorf("failed to send calculateScale for %s: %w", pool.Pool, err)
+			}
+			if err := b.waitMined(ctx, tx); err != nil {
+				return fmt.Errorf("calculateScale for %s: %w", pool.Pool, err)
+			}
+
+			scaleBps, err = b.scaler.GetPeriodScale(&bind.CallOpts{Context: ctx}, uint8(pool.Pool), week)
+			if err != nil {
+				return fmt.Errorf("failed to read %s period scale: %w", pool.Pool, err)
+			}
+		}
+		if scaleBps.Uint64() < pool.ScaleBps {
+			return fmt.Errorf("%s budget changed since planning: on-chain scale %d bps < planned %d bps",
+				pool.Pool, scaleBps.Uint64(), pool.ScaleBps)
+		}
+	}
+
+	return nil
+}
+
+// MarkUsed 在 Root 发布后调用 markBudgetUsed，按各池实际发放金额扣减预算
+func (b *BudgetScaler) MarkUsed(ctx context.Context, plan *ScalePlan) error {
+	week := new(big.Int).SetUint64(plan.Week)
+
+	for _, pool := range plan.Pools {
+		if pool.Payout == nil || pool.Payout.Sign() == 0 {
+			continue
+		}
+
+		auth, err := b.transactor(ctx)
+		if err != nil {
+			return err
+		}
+
+		tx, err := b.scaler.MarkBudgetUsed(auth, uint8(pool.Pool), week, pool.Payout)
+		if err != nil {
+			return fmt.Errorf("failed to send markBudgetUsed for %s: %w", pool.Pool, err)
+		}
+		if err := b.waitMined(ctx, tx); err != nil {
+			return fmt.Errorf("markBudgetUsed for %s: %w", pool.Pool, err)
+		}
+	}
+
+	return nil
+}
+
+// transactor 创建交易签名选项
+func (b *BudgetScaler) transactor(ctx context.Context) (*bind.TransactOpts, error) {
+	if b.privateKey == "" {
+		return nil, fmt.Errorf("private key required to update PayoutScaler")
+	}
+	return newTransactor(ctx, b.privateKey, b.chainID)
+}
+
+// waitMined 等待交易上链并检查执行状态
+func (b *BudgetScaler) waitMined(ctx context.Context, tx *types.Transaction) error {
+	return waitMined(ctx, b.client, tx)
+}
+
+// newTransactor 使用十六进制私钥创建交易签名选项
+func newTransactor(ctx context.Context, privateKeyHex string, chainID *big.Int) (*bind.TransactOpts, error) {
+	privateKey, err := crypto.HexToECDSA(strings.TrimPrefix(privateKeyHex, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid private key: %w", err)
+	}
+
+	auth, err := bind.NewKeyedTransactorWithChainID(privateKey, chainID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create transactor: %w", err)
+	}
+	auth.Context = ctx
+
+	return auth, nil
+}
+
+// waitMined 等待交易上链并检查执行状态
+func waitMined(ctx context.Context, backend bind.DeployBackend, tx *types.Transaction) error {
+	receipt, err := bind.WaitMined(ctx, backend, tx)
+	if err != nil {
+		return fmt.Errorf("failed to wait for transaction %s: %w", tx.Hash().Hex(), err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return fmt.Errorf("transaction %s reverted", tx.Hash().Hex())
+	}
+	return nil
+}
+
+// Close 关闭连接
+func (b *BudgetScaler) Close() {
+	if b.client != nil {
+		b.client.Close()
+	}
+}
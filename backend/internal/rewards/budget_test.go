@@ -0,0 +1,216 @@
+package rewards
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeBudgetCaller 模拟 PayoutScaler.previewScale 的计算逻辑
+type fakeBudgetCaller struct {
+	available map[BudgetPool]int64
+	autoScale map[BudgetPool]bool
+	previews  map[BudgetPool]*big.Int // 记录请求金额
+}
+
+func (f *fakeBudgetCaller) GetBudgetStatus(opts *bind.CallOpts, pool uint8) (struct {
+	Total     *big.Int
+	Used      *big.Int
+	Pending   *big.Int
+	Available *big.Int
+}, error) {
+	available := big.NewInt(f.available[BudgetPool(pool)])
+	return struct {
+		Total     *big.Int
+		Used      *big.Int
+		Pending   *big.Int
+		Available *big.Int
+	}{Total: available, Used: new(big.Int), Pending: new(big.Int), Available: available}, nil
+}
+
+func (f *fakeBudgetCaller) PreviewScale(opts *bind.CallOpts, pool uint8, requested *big.Int) (struct {
+	ScaleBps     *big.Int
+	ScaledAmount *big.Int
+}, error) {
+	f.previews[BudgetPool(pool)] = requested
+
+	out := struct {
+		ScaleBps     *big.Int
+		ScaledAmount *big.Int
+	}{ScaleBps: new(big.Int), ScaledAmount: new(big.Int)}
+
+	available := big.NewInt(f.available[BudgetPool(pool)])
+	if available.Cmp(requested) >= 0 {
+		out.ScaleBps = big.NewInt(10000)
+	} else if f.autoScale[BudgetPool(pool)] {
+		out.ScaleBps = new(big.Int).Div(new(big.Int).Mul(available, big.NewInt(10000)), requested)
+		if out.ScaleBps.Int64() < 1000 {
+			out.ScaleBps = big.NewInt(1000)
+		}
+	}
+	return out, nil
+}
+
+func TestPlanScale(t *testing.T) {
+	caller := &fakeBudgetCaller{
+		available: map[BudgetPool]int64{BudgetPoolPromo: 3000, BudgetPoolCampaign: 5000},
+		autoScale: map[BudgetPool]bool{BudgetPoolPromo: true},
+		previews:  map[BudgetPool]*big.Int{},
+	}
+	totals := map[RewardType]*big.Int{
+		RewardTypeReferral: big.NewInt(1000),
+		RewardTypeTrading:  big.NewInt(3000),
+		RewardTypeCampaign: big.NewInt(2000),
+	}
+
+	plan, err := planScale(context.Background(), caller, 12, totals, DefaultCategoryPools())
+	require.NoError(t, err)
+
+	// 推荐与交易共享推广池：请求 4000，可用 3000 -> 75%
+	assert.Equal(t, big.NewInt(4000), caller.previews[BudgetPoolPromo])
+	require.Len(t, plan.Pools, 2)
+	assert.Equal(t, BudgetPoolPromo, plan.Pools[0].Pool)
+	assert.Equal(t, []RewardType{RewardTypeReferral, RewardTypeTrading}, plan.Pools[0].Categories)
+	assert.Equal(t, uint64(7500), plan.Pools[0].ScaleBps)
+	assert.Equal(t, uint64(10000), plan.Pools[1].ScaleBps)
+
+	assert.Equal(t, map[RewardType]uint64{
+		RewardTypeReferral: 7500,
+		RewardTypeTrading:  7500,
+		RewardTypeCampaign: 10000,
+	}, plan.CategoryScales)
+	assert.Equal(t, uint64(7500), plan.MinScaleBps())
+}
+
+func TestPlanScale_InsufficientWithoutAutoScale(t *testing.T) {
+	caller := &fakeBudgetCaller{
+		available: map[BudgetPool]int64{BudgetPoolCampaign: 100},
+		autoScale: map[BudgetPool]bool{},
+		previews:  map[BudgetPool]*big.Int{},
+	}
+
+	_, err := planScale(context.Background(), caller, 12,
+		map[RewardType]*big.Int{RewardTypeCampaign: big.NewInt(1000)}, DefaultCategoryPools())
+	assert.ErrorContains(t, err, "auto-scale disabled")
+}
+
+func TestPlanScale_UnmappedCategory(t *testing.T) {
+	caller := &fakeBudgetCaller{previews: map[BudgetPool]*big.Int{}}
+
+	_, err := planScale(context.Background(), caller, 12,
+		map[RewardType]*big.Int{RewardType("quest"): big.NewInt(1)}, DefaultCategoryPools())
+	assert.Error(t, err)
+
+	// 零金额的类别不参与计划
+	plan, err := planScale(context.Background(), caller, 12,
+		map[RewardType]*big.Int{RewardTypeTrading: new(big.Int)}, DefaultCategoryPools())
+	require.NoError(t, err)
+	assert.Empty(t, plan.Pools)
+	assert.Equal(t, uint64(10000), plan.MinScaleBps())
+}
+
+func TestScalePlan_BuildEntries(t *testing.T) {
+	alice := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	bob := common.HexToAddress("0x2222222222222222222222222222222222222222")
+
+	byCategory := map[RewardType]map[common.Address]*big.Int{
+		RewardTypeReferral: {alice: big.NewInt(1001)},
+		RewardTypeTrading:  {alice: big.NewInt(2000), bob: big.NewInt(1)},
+		RewardTypeCampaign: {bob: big.NewInt(500)},
+	}
+
+	plan := &ScalePlan{
+		Week: 12,
+		Pools: []*PoolScale{
+			{Pool: BudgetPoolPromo, ScaleBps: 5000},
+			{Pool: BudgetPoolCampaign, ScaleBps: 10000},
+		},
+		CategoryScales: map[RewardType]uint64{
+			RewardTypeReferral: 5000,
+			RewardTypeTrading:  5000,
+			RewardTypeCampaign: 10000,
+		},
+	}
+
+	entries := plan.BuildEntries(12, byCategory, DefaultCategoryPools())
+
+	// 每个类别单独向下取整后再合并：alice = 500 + 1000，bob = 0 + 500
+	require.Len(t, entries, 2)
+	assert.Equal(t, RewardEntry{User: alice, Week: 12, Amount: "1500", Breakdown: map[RewardType]string{
+		RewardTypeReferral: "500",
+		RewardTypeTrading:  "1000",
+	}}, entries[0])
+	assert.Equal(t, RewardEntry{User: bob, Week: 12, Amount: "500", Breakdown: map[RewardType]string{
+		RewardTypeCampaign: "500",
+	}}, entries[1])
+
+	// 实际发放金额用于 markBudgetUsed
+	assert.Equal(t, big.NewInt(1500), plan.Pools[0].Payout)
+	assert.Equal(t, big.NewInt(500), plan.Pools[1].Payout)
+}
+
+func TestDistributionPlan(t *testing.T) {
+	alice := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	bob := common.HexToAddress("0x2222222222222222222222222222222222222222")
+
+	dist := &MerkleDistribution{
+		Week: 12,
+		Entries: []RewardEntry{
+			{User: alice, Week: 12, Amount: "1500", Breakdown: map[RewardType]string{
+				RewardTypeReferral: "500",
+				RewardTypeTrading:  "1000",
+			}},
+			{User: bob, Week: 12, Amount: "500", Breakdown: map[RewardType]string{
+				RewardTypeCampaign: "500",
+			}},
+		},
+		CategoryScaleBps: map[RewardType]uint64{
+			RewardTypeReferral: 3000,
+			RewardTypeTrading:  3000,
+		},
+	}
+
+	plan := DistributionPlan(dist, DefaultCategoryPools())
+
+	// 与构建时 BuildEntries 统计的实际发放金额一致，未记录比例的类别按 100%
+	require.Len(t, plan.Pools, 2)
+	assert.Equal(t, BudgetPoolPromo, plan.Pools[0].Pool)
+	assert.Equal(t, []RewardType{RewardTypeReferral, RewardTypeTrading}, plan.Pools[0].Categories)
+	assert.Equal(t, big.NewInt(1500), plan.Pools[0].Payout)
+	assert.Equal(t, uint64(3000), plan.Pools[0].ScaleBps)
+	assert.Equal(t, big.NewInt(5000), plan.Pools[0].Requested)
+
+	assert.Equal(t, BudgetPoolCampaign, plan.Pools[1].Pool)
+	assert.Equal(t, big.NewInt(500), plan.Pools[1].Payout)
+	assert.Equal(t, uint64(10000), plan.Pools[1].ScaleBps)
+	assert.Equal(t, big.NewInt(500), plan.Pools[1].Requested)
+}
+
+func TestCategoryTotals(t *testing.T) {
+	byCategory := map[RewardType]map[common.Address]*big.Int{
+		RewardTypeTrading: {
+			common.HexToAddress("0x01"): big.NewInt(10),
+			common.HexToAddress("0x02"): big.NewInt(5),
+		},
+		RewardTypeCampaign: {},
+	}
+
+	assert.Equal(t, map[RewardType]*big.Int{
+		RewardTypeTrading:  big.NewInt(15),
+		RewardTypeCampaign: new(big.Int),
+	}, CategoryTotals(byCategory))
+}
+
+func TestParseBudgetPool(t *testing.T) {
+	pool, err := ParseBudgetPool("Campaign")
+	require.NoError(t, err)
+	assert.Equal(t, BudgetPoolCampaign, pool)
+
+	_, err = ParseBudgetPool("treasury")
+	assert.Error(t, err)
+}
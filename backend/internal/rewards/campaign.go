@@ -0,0 +1,522 @@
+package rewards
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/spf13/viper"
+
+	"github.com/pitchone/sportsbook/internal/graphql"
+	"github.com/pitchone/sportsbook/internal/repository"
+	"github.com/pitchone/sportsbook/pkg/bindings"
+)
+
+// 活动奖励发放方式
+const (
+	CampaignModePerParticipant = "per_participant" // 本周新参与者每人固定金额
+	CampaignModeProRata        = "pro_rata"        // 每周固定总额，按参与者本周下注额分配
+)
+
+// CampaignRule 单个活动的奖励规则
+type CampaignRule struct {
+	ID     string `mapstructure:"id" json:"id"`         // bytes32 活动 ID（0x 开头）
+	Mode   string `mapstructure:"mode" json:"mode"`     // per_participant | pro_rata
+	Amount uint64 `mapstructure:"amount" json:"amount"` // per_participant: 每人金额；pro_rata: 每周总额（USDC 最小单位）
+}
+
+// CampaignRules 活动奖励规则（未配置规则的活动不发放参与奖励）
+type CampaignRules struct {
+	Campaigns []CampaignRule `mapstructure:"campaigns" json:"campaigns"`
+}
+
+// LoadCampaignRules 从配置文件（YAML/JSON）加载活动奖励规则
+func LoadCampaignRules(path string) (*CampaignRules, error) {
+	v := viper.New()
+	v.SetConfigFile(path)
+	if err := v.ReadInConfig(); err != nil {
+		return nil, fmt.Errorf("failed to read campaign rules: %w", err)
+	}
+
+	rules := &CampaignRules{}
+	if err := v.Unmarshal(rules); err != nil {
+		return nil, fmt.Errorf("failed to parse campaign rules: %w", err)
+	}
+	if err := rules.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid campaign rules %s: %w", path, err)
+	}
+
+	return rules, nil
+}
+
+// Validate 校验规则配置
+func (r *CampaignRules) Validate() error {
+	seen := make(map[common.Hash]bool)
+	for i, rule := range r.Campaigns {
+		id, err := parseCampaignID(rule.ID)
+		if err != nil {
+			return fmt.Errorf("campaigns[%d]: %w", i, err)
+		}
+		if seen[id] {
+			return fmt.Errorf("campaigns[%d]: duplicate campaign %s", i, rule.ID)
+		}
+		seen[id] = true
+
+		if rule.Mode != CampaignModePerParticipant && rule.Mode != CampaignModeProRata {
+			return fmt.Errorf("campaigns[%d]: mode must be %q or %q, got %q",
+				i, CampaignModePerParticipant, CampaignModeProRata, rule.Mode)
+		}
+		if rule.Amount == 0 {
+			return fmt.Errorf("campaigns[%d]: amount must be positive", i)
+		}
+	}
+	return nil
+}
+
+// parseCampaignID 解析 bytes32 活动 ID
+func parseCampaignID(id string) (common.Hash, error) {
+	raw := strings.TrimPrefix(id, "0x")
+	if len(raw) != 64 {
+		return common.Hash{}, fmt.Errorf("invalid campaign id %q (expected 32-byte hex)", id)
+	}
+	if _, ok := new(big.Int).SetString(raw, 16); !ok {
+		return common.Hash{}, fmt.Errorf("invalid campaign id %q (expected 32-byte hex)", id)
+	}
+	return common.HexToHash(id), nil
+}
+
+// CampaignBudgetReader 活动剩余预算读取接口（由 bindings.CampaignCaller 实现）
+type CampaignBudgetReader interface {
+	GetRemainingBudget(opts *bind.CallOpts, campaignId [32]byte) (*big.Int, error)
+}
+
+// UnrecordedCampaignSpendingReader 读取其他周已计入分配、但尚未 recordSpending 上链的活动支出
+// （由 repository.RewardsRepository 实现），键为小写活动 ID
+type UnrecordedCampaignSpendingReader interface {
+	SumUnrecordedCampaignSpending(ctx context.Context, excludeWeek uint64) (map[string]string, error)
+}
+
+// CampaignPayout 单个活动本周的发放结果
+type CampaignPayout struct {
+	CampaignID   string   `json:"campaignId"`
+	Name         string   `json:"name"`
+	Mode         string   `json:"mode"`
+	Participants int      `json:"participants"` // 获得奖励的参与者数
+	Requested    *big.Int `json:"requested"`    // 按规则计算的总额
+	Remaining    *big.Int `json:"remaining"`    // 可用预算：getRemainingBudget 减去尚未记录上链的支出
+	Unrecorded   *big.Int `json:"unrecorded"`   // 其他周尚未记录上链的支出
+	Paid         *big.Int `json:"paid"`         // 按剩余预算封顶后计入分配的总额
+	Spent        *big.Int `json:"spent"`        // 经预算池缩放后实际发放的金额（recordSpending）
+
+	rewards map[common.Address]*big.Int
+}
+
+// evaluateCampaign 按规则计算单个活动本周的奖励，总额超过剩余预算时按比例缩减。
+// participations 为该活动在周结束前的所有参与记录，volumes 为参与者本周下注额。
+func evaluateCampaign(rule CampaignRule, campaign graphql.Campaign, participations []graphql.CampaignParticipation,
+	volumes map[common.Address]*big.Int, weekStart, weekEnd time.Time, remaining *big.Int) *CampaignPayout {
+	payout := &CampaignPayout{
+		CampaignID: rule.ID,
+		Name:       campaign.Name,
+		Mode:       rule.Mode,
+		Requested:  new(big.Int),
+		Remaining:  remaining,
+		Unrecorded: new(big.Int),
+		Paid:       new(big.Int),
+		rewards:    make(map[common.Address]*big.Int),
+	}
+
+	// 活动时间与本周无交集时不发放
+	if graphql.ParseBigInt(campaign.StartTime).Int64() >= weekEnd.Unix() ||
+		graphql.ParseBigInt(campaign.EndTime).Int64() <= weekStart.Unix() {
+		return payout
+	}
+
+	amount := new(big.Int).SetUint64(rule.Amount)
+	requested := make(map[common.Address]*big.Int)
+
+	switch rule.Mode {
+	case CampaignModePerParticipant:
+		for _, p := range participations {
+			if graphql.ParseBigInt(p.Timestamp).Int64() >= weekStart.Unix() {
+				requested[p.User.Address()] = new(big.Int).Set(amount)
+			}
+		}
+
+	case CampaignModeProRata:
+		totalVolume := new(big.Int)
+		for _, p := range participations {
+			user := p.User.Address()
+			if volume, ok := volumes[user]; ok && volume.Sign() > 0 && requested[user] == nil {
+				requested[user] = volume
+				totalVolume.Add(totalVolume, volume)
+			}
+		}
+		if totalVolume.Sign() == 0 {
+			return payout
+		}
+		for user, volume := range requested {
+			share := new(big.Int).Mul(amount, volume)
+			requested[user] = share.Div(share, totalVolume)
+		}
+	}
+
+	for _, reward := range requested {
+		payout.Requested.Add(payout.Requested, reward)
+	}
+
+	for user, reward := range requested {
+		// 超过剩余预算时按比例缩减
+		if remaining != nil && payout.Requested.Cmp(remaining) > 0 {
+			reward = new(big.Int).Mul(reward, remaining)
+			reward.Div(reward, payout.Requested)
+		}
+		if reward.Sign() == 0 {
+			continue
+		}
+		payout.rewards[user] = reward
+		payout.Paid.Add(payout.Paid, reward)
+	}
+	payout.Participants = len(payout.rewards)
+
+	return payout
+}
+
+// evaluateCampaignRewards 查询已配置活动的参与记录并计算本周奖励
+func (a *Aggregator) evaluateCampaignRewards(ctx context.Context, weekStart, weekEnd time.Time) ([]*CampaignPayout, error) {
+	if a.campaignRules == nil || len(a.campaignRules.Campaigns) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]string, 0, len(a.campaignRules.Campaigns))
+	for _, rule := range a.campaignRules.Campaigns {
+		ids = append(ids, rule.ID)
+	}
+
+	byCampaign := make(map[common.Hash][]graphql.CampaignParticipation)
+	campaigns := make(map[common.Hash]graphql.Campaign)
+
+	first := 1000
+	skip := 0
+	for {
+		participations, err := a.graphClient.GetCampaignParticipations(ctx, ids, weekEnd.Unix(), first, skip)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query Subgraph for campaign participations: %w", err)
+		}
+
+		for _, p := range participations {
+			id := common.HexToHash(p.Campaign.ID)
+			byCampaign[id] = append(byCampaign[id], p)
+			campaigns[id] = p.Campaign
+		}
+
+		skip += first
+		if len(participations) < first {
+			break
+		}
+	}
+
+	// 按比例分配的活动需要参与者本周下注额
+	var volumes map[common.Address]*big.Int
+	for _, rule := range a.campaignRules.Campaigns {
+		if rule.Mode == CampaignModeProRata {
+			orders, err := a.fetchOrders(ctx, weekStart, weekEnd)
+			if err != nil {
+				return nil, err
+			}
+			volumes = make(map[common.Address]*big.Int)
+			for _, order := range orders {
+				user := order.User.Address()
+				if _, ok := volumes[user]; !ok {
+					volumes[user] = new(big.Int)
+				}
+				volumes[user].Add(volumes[user], graphql.ParseDecimal(order.Amount, 6))
+			}
+			break
+		}
+	}
+
+	// 其他周的支出在 recordSpending 成功前不会反映在 getRemainingBudget 中
+	unrecorded, err := a.unrecordedCampaignSpending(ctx, a.calendar.EpochAt(weekStart))
+	if err != nil {
+		return nil, err
+	}
+
+	payouts := make([]*CampaignPayout, 0, len(a.campaignRules.Campaigns))
+	for _, rule := range a.campaignRules.Campaigns {
+		id := common.HexToHash(rule.ID)
+		participations, ok := byCampaign[id]
+		if !ok {
+			continue
+		}
+
+		pending := unrecorded[strings.ToLower(id.Hex())]
+		remaining, err := a.campaignRemainingBudget(ctx, id, campaigns[id], pending)
+		if err != nil {
+			return nil, err
+		}
+
+		payout := evaluateCampaign(rule, campaigns[id], participations, volumes, weekStart, weekEnd, remaining)
+		if pending != nil {
+			payout.Unrecorded = pending
+		}
+		if payout.Paid.Sign() > 0 {
+			payouts = append(payouts, payout)
+		}
+	}
+
+	sort.Slice(payouts, func(i, j int) bool { return payouts[i].CampaignID < payouts[j].CampaignID })
+
+	return payouts, nil
+}
+
+// campaignRemainingBudget 读取活动剩余预算并减去尚未记录上链的支出（不低于 0）；
+// 未配置链上读取时使用 Subgraph 的 remainingBudget
+func (a *Aggregator) campaignRemainingBudget(ctx context.Context, id common.Hash, campaign graphql.Campaign, unrecorded *big.Int) (*big.Int, error) {
+	var remaining *big.Int
+	if a.campaignBudget == nil {
+		remaining = graphql.ParseDecimal(campaign.RemainingBudget, 6)
+	} else {
+		var err error
+		remaining, err = a.campaignBudget.GetRemainingBudget(&bind.CallOpts{Context: ctx}, id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get remaining budget for campaign %s: %w", id.Hex(), err)
+		}
+	}
+
+	if unrecorded == nil || unrecorded.Sign() == 0 {
+		return remaining, nil
+	}
+	available := new(big.Int).Sub(remaining, unrecorded)
+	if available.Sign() < 0 {
+		available.SetInt64(0)
+	}
+	return available, nil
+}
+
+// unrecordedCampaignSpending 按活动汇总 week 以外尚未记录上链的支出。
+// 已发送但尚未确认的 recordSpending 也计入，宁可少发不超出预算
+func (a *Aggregator) unrecordedCampaignSpending(ctx context.Context, week uint64) (map[string]*big.Int, error) {
+	if a.campaignSpending == nil {
+		return nil, nil
+	}
+
+	sums, err := a.campaignSpending.SumUnrecordedCampaignSpending(ctx, week)
+	if err != nil {
+		return nil, err
+	}
+
+	unrecorded := make(map[string]*big.Int, len(sums))
+	for id, amount := range sums {
+		value, ok := new(big.Int).SetString(amount, 10)
+		if !ok {
+			return nil, fmt.Errorf("invalid unrecorded spending %q for campaign %s", amount, id)
+		}
+		unrecorded[strings.ToLower(id)] = value
+	}
+	return unrecorded, nil
+}
+
+// CampaignLedger 读取 Campaign 合约剩余预算，并在奖励发布后记录支出
+type CampaignLedger struct {
+	client     *ethclient.Client
+	campaign   *bindings.Campaign
+	privateKey string
+	chainID    *big.Int
+}
+
+// NewCampaignLedger 创建活动预算账本；RecordSpending 需要 privateKey 对应账户拥有 OPERATOR_ROLE
+func NewCampaignLedger(rpcURL string, campaignAddr common.Address, privateKey string) (*CampaignLedger, error) {
+	client, err := ethclient.Dial(rpcURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to RPC: %w", err)
+	}
+
+	chainID, err := client.ChainID(context.Background())
+	if err != nil {
+		client.Close()
+		return nil, fmt.Errorf("failed to get chain ID: %w", err)
+	}
+
+	campaign, err := bindings.NewCampaign(campaignAddr, client)
+	if err != nil {
+		client.Close()
+		return nil, fmt.Errorf("failed to bind Campaign: %w", err)
+	}
+
+	return &CampaignLedger{
+		client:     client,
+		campaign:   campaign,
+		privateKey: privateKey,
+		chainID:    chainID,
+	}, nil
+}
+
+// GetRemainingBudget 实现 CampaignBudgetReader
+func (l *CampaignLedger) GetRemainingBudget(opts *bind.CallOpts, campaignId [32]byte) (*big.Int, error) {
+	return l.campaign.GetRemainingBudget(opts, campaignId)
+}
+
+// CampaignSpendingStore 持久化每个活动每周的扣减记录状态（由 repository.RewardsRepository 实现）
+type CampaignSpendingStore interface {
+	ListDueCampaignSpending(ctx context.Context) ([]repository.CampaignSpending, error)
+	SetCampaignSpendingTx(ctx context.Context, campaignID string, week uint64, txHash string, now int64) error
+	MarkCampaignSpendingRecorded(ctx context.Context, campaignID string, week uint64, txHash string, now int64) error
+	SetCampaignSpendingError(ctx context.Context, campaignID string, week uint64, message string, now int64) error
+}
+
+// spendingTxState 已发送的 recordSpending 交易在链上的状态
+type spendingTxState int
+
+const (
+	spendingTxDropped   spendingTxState = iota // 节点上查不到（未发出或已被丢弃），需要重发
+	spendingTxPending                          // 仍在交易池中
+	spendingTxSucceeded                        // 已打包且执行成功
+	spendingTxReverted                         // 已打包但回滚，需要重发
+)
+
+// spendingChain recordSpending 的链上操作，测试中以内存实现替代
+type spendingChain interface {
+	// signRecordSpending 构建并签名交易但不发送，先落库交易哈希再发送
+	signRecordSpending(ctx context.Context, campaignID common.Hash, amount *big.Int) (*types.Transaction, error)
+	sendTransaction(ctx context.Context, tx *types.Transaction) error
+	waitMined(ctx context.Context, tx *types.Transaction) error
+	spendingTxState(ctx context.Context, txHash common.Hash) (spendingTxState, error)
+}
+
+// CanRecordSpending 是否配置了发送 recordSpending 所需的私钥
+func (l *CampaignLedger) CanRecordSpending() bool {
+	return l.privateKey != ""
+}
+
+// RecordSpending 为已发布周的待记录活动扣减调用 recordSpending（触发 CampaignBudgetSpent）。
+// 每条扣减的交易哈希在发送前落库，之后的每一轮先查询该交易：已成功则直接标记，
+// 仍在等待则跳过，被丢弃或回滚才重发，因此同一活动同一周只会成功记录一次。
+// 失败的条目保持待记录状态并保存错误信息，由下一轮重试。返回本轮记录成功的条数。
+func (l *CampaignLedger) RecordSpending(ctx context.Context, store CampaignSpendingStore) (int, error) {
+	if l.privateKey == "" {
+		return 0, fmt.Errorf("private key required to record campaign spending")
+	}
+	return recordCampaignSpending(ctx, l, store)
+}
+
+func recordCampaignSpending(ctx context.Context, chain spendingChain, store CampaignSpendingStore) (int, error) {
+	due, err := store.ListDueCampaignSpending(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	recorded := 0
+	var errs []error
+	for _, spending := range due {
+		ok, err := recordOneSpending(ctx, chain, store, spending)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("recordSpending for campaign %s week %d: %w", spending.CampaignID, spending.Week, err))
+			if err := store.SetCampaignSpendingError(ctx, spending.CampaignID, spending.Week, err.Error(), time.Now().Unix()); err != nil {
+				errs = append(errs, err)
+			}
+			continue
+		}
+		if ok {
+			recorded++
+		}
+	}
+
+	return recorded, errors.Join(errs...)
+}
+
+// recordOneSpending 处理单条扣减，返回是否已记录成功
+func recordOneSpending(ctx context.Context, chain spendingChain, store CampaignSpendingStore, spending repository.CampaignSpending) (bool, error) {
+	if spending.TxHash != "" {
+		state, err := chain.spendingTxState(ctx, common.HexToHash(spending.TxHash))
+		if err != nil {
+			return false, fmt.Errorf("failed to check transaction %s: %w", spending.TxHash, err)
+		}
+		switch state {
+		case spendingTxSucceeded:
+			return true, store.MarkCampaignSpendingRecorded(ctx, spending.CampaignID, spending.Week, spending.TxHash, time.Now().Unix())
+		case spendingTxPending:
+			return false, nil
+		}
+	}
+
+	amount, ok := new(big.Int).SetString(spending.Amount, 10)
+	if !ok {
+		return false, fmt.Errorf("invalid amount %q", spending.Amount)
+	}
+
+	tx, err := chain.signRecordSpending(ctx, common.HexToHash(spending.CampaignID), amount)
+	if err != nil {
+		return false, err
+	}
+	if err := store.SetCampaignSpendingTx(ctx, spending.CampaignID, spending.Week, tx.Hash().Hex(), time.Now().Unix()); err != nil {
+		return false, err
+	}
+	if err := chain.sendTransaction(ctx, tx); err != nil {
+		return false, fmt.Errorf("failed to send transaction: %w", err)
+	}
+	if err := chain.waitMined(ctx, tx); err != nil {
+		return false, err
+	}
+
+	return true, store.MarkCampaignSpendingRecorded(ctx, spending.CampaignID, spending.Week, tx.Hash().Hex(), time.Now().Unix())
+}
+
+func (l *CampaignLedger) signRecordSpending(ctx context.Context, campaignID common.Hash, amount *big.Int) (*types.Transaction, error) {
+	auth, err := newTransactor(ctx, l.privateKey, l.chainID)
+	if err != nil {
+		return nil, err
+	}
+	auth.NoSend = true
+
+	tx, err := l.campaign.RecordSpending(auth, campaignID, amount)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build transaction: %w", err)
+	}
+	return tx, nil
+}
+
+func (l *CampaignLedger) sendTransaction(ctx context.Context, tx *types.Transaction) error {
+	return l.client.SendTransaction(ctx, tx)
+}
+
+func (l *CampaignLedger) waitMined(ctx context.Context, tx *types.Transaction) error {
+	return waitMined(ctx, l.client, tx)
+}
+
+func (l *CampaignLedger) spendingTxState(ctx context.Context, txHash common.Hash) (spendingTxState, error) {
+	receipt, err := l.client.TransactionReceipt(ctx, txHash)
+	if err == nil {
+		if receipt.Status == types.ReceiptStatusSuccessful {
+			return spendingTxSucceeded, nil
+		}
+		return spendingTxReverted, nil
+	}
+	if !errors.Is(err, ethereum.NotFound) {
+		return 0, err
+	}
+
+	if _, _, err := l.client.TransactionByHash(ctx, txHash); err != nil {
+		if errors.Is(err, ethereum.NotFound) {
+			return spendingTxDropped, nil
+		}
+		return 0, err
+	}
+	return spendingTxPending, nil
+}
+
+// Close 关闭连接
+func (l *CampaignLedger) Close() {
+	if l.client != nil {
+		l.client.Close()
+	}
+}
@@ -0,0 +1,412 @@
+package rewards
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pitchone/sportsbook/internal/graphql"
+	"github.com/pitchone/sportsbook/internal/repository"
+)
+
+const testCampaignID = "0x00000000000000000000000000000000000000000000000000000000000000c1"
+
+var (
+	campaignWeekStart = time.Unix(1700000000, 0)
+	campaignWeekEnd   = campaignWeekStart.Add(7 * 24 * time.Hour)
+)
+
+func testCampaign() graphql.Campaign {
+	return graphql.Campaign{
+		ID:        testCampaignID,
+		Name:      "Opening Week",
+		StartTime: strconv.FormatInt(campaignWeekStart.Add(-24*time.Hour).Unix(), 10),
+		EndTime:   strconv.FormatInt(campaignWeekEnd.Add(24*time.Hour).Unix(), 10),
+	}
+}
+
+func participation(user common.Address, at time.Time) graphql.CampaignParticipation {
+	return graphql.CampaignParticipation{
+		User:      graphql.User{ID: user.Hex()},
+		Timestamp: strconv.FormatInt(at.Unix(), 10),
+	}
+}
+
+func TestEvaluateCampaign_PerParticipant(t *testing.T) {
+	rule := CampaignRule{ID: testCampaignID, Mode: CampaignModePerParticipant, Amount: 5000000}
+	participations := []graphql.CampaignParticipation{
+		participation(traderA, campaignWeekStart.Add(-time.Hour)), // 上周参与，已发放过
+		participation(traderB, campaignWeekStart.Add(time.Hour)),
+		participation(traderC, campaignWeekStart.Add(48*time.Hour)),
+	}
+
+	payout := evaluateCampaign(rule, testCampaign(), participations, nil, campaignWeekStart, campaignWeekEnd, usdc(100))
+	assert.Equal(t, 2, payout.Participants)
+	assert.Equal(t, usdc(10), payout.Requested)
+	assert.Equal(t, usdc(10), payout.Paid)
+	assert.Equal(t, map[common.Address]*big.Int{traderB: usdc(5), traderC: usdc(5)}, payout.rewards)
+}
+
+func TestEvaluateCampaign_ProRata(t *testing.T) {
+	rule := CampaignRule{ID: testCampaignID, Mode: CampaignModeProRata, Amount: 90000000}
+	participations := []graphql.CampaignParticipation{
+		participation(traderA, campaignWeekStart.Add(-time.Hour)),
+		participation(traderB, campaignWeekStart.Add(time.Hour)),
+		participation(traderC, campaignWeekStart.Add(time.Hour)), // 本周未下注
+	}
+	volumes := map[common.Address]*big.Int{
+		traderA: usdc(200),
+		traderB: usdc(100),
+	}
+
+	payout := evaluateCampaign(rule, testCampaign(), participations, volumes, campaignWeekStart, campaignWeekEnd, usdc(1000))
+	assert.Equal(t, 2, payout.Participants)
+	assert.Equal(t, usdc(90), payout.Paid)
+	assert.Equal(t, map[common.Address]*big.Int{traderA: usdc(60), traderB: usdc(30)}, payout.rewards)
+}
+
+func TestEvaluateCampaign_CappedByRemainingBudget(t *testing.T) {
+	rule := CampaignRule{ID: testCampaignID, Mode: CampaignModePerParticipant, Amount: 5000000}
+	participations := []graphql.CampaignParticipation{
+		participation(traderA, campaignWeekStart.Add(time.Hour)),
+		participation(traderB, campaignWeekStart.Add(time.Hour)),
+	}
+
+	// 请求 10 USDC，剩余 4 USDC：每人缩减到 2 USDC
+	payout := evaluateCampaign(rule, testCampaign(), participations, nil, campaignWeekStart, campaignWeekEnd, usdc(4))
+	assert.Equal(t, usdc(10), payout.Requested)
+	assert.Equal(t, usdc(4), payout.Paid)
+	assert.Equal(t, map[common.Address]*big.Int{traderA: usdc(2), traderB: usdc(2)}, payout.rewards)
+
+	// 预算耗尽时不发放
+	payout = evaluateCampaign(rule, testCampaign(), participations, nil, campaignWeekStart, campaignWeekEnd, new(big.Int))
+	assert.Equal(t, new(big.Int), payout.Paid)
+	assert.Zero(t, payout.Participants)
+}
+
+// fakeCampaignBudget 固定返回链上剩余预算
+type fakeCampaignBudget struct {
+	remaining *big.Int
+}
+
+func (f *fakeCampaignBudget) GetRemainingBudget(opts *bind.CallOpts, campaignId [32]byte) (*big.Int, error) {
+	return new(big.Int).Set(f.remaining), nil
+}
+
+// fakeUnrecordedSpending 按周保存尚未记录上链的支出
+type fakeUnrecordedSpending struct {
+	byWeek map[uint64]map[string]string
+}
+
+func (f *fakeUnrecordedSpending) SumUnrecordedCampaignSpending(ctx context.Context, excludeWeek uint64) (map[string]string, error) {
+	sums := make(map[string]*big.Int)
+	for week, rows := range f.byWeek {
+		if week == excludeWeek {
+			continue
+		}
+		for id, amount := range rows {
+			value, _ := new(big.Int).SetString(amount, 10)
+			if sums[id] == nil {
+				sums[id] = new(big.Int)
+			}
+			sums[id].Add(sums[id], value)
+		}
+	}
+	out := make(map[string]string, len(sums))
+	for id, sum := range sums {
+		out[id] = sum.String()
+	}
+	return out, nil
+}
+
+func TestCampaignRemainingBudget_SubtractsUnrecordedSpending(t *testing.T) {
+	ctx := context.Background()
+	id := common.HexToHash(testCampaignID)
+	rule := CampaignRule{ID: testCampaignID, Mode: CampaignModePerParticipant, Amount: 5000000}
+	participations := []graphql.CampaignParticipation{
+		participation(traderA, campaignWeekStart.Add(time.Hour)),
+		participation(traderB, campaignWeekStart.Add(time.Hour)),
+	}
+
+	// 链上剩余 12 USDC；第 300 周已计入 8 USDC，但 recordSpending 尚未成功
+	spending := &fakeUnrecordedSpending{byWeek: map[uint64]map[string]string{
+		300: {testCampaignID: usdc(8).String()},
+	}}
+	a := &Aggregator{campaignBudget: &fakeCampaignBudget{remaining: usdc(12)}, campaignSpending: spending}
+
+	unrecorded, err := a.unrecordedCampaignSpending(ctx, 301)
+	require.NoError(t, err)
+	remaining, err := a.campaignRemainingBudget(ctx, id, testCampaign(), unrecorded[testCampaignID])
+	require.NoError(t, err)
+	assert.Equal(t, usdc(4), remaining)
+
+	// 第 301 周只能发放剩余的 4 USDC，两周合计不超过预算
+	payout := evaluateCampaign(rule, testCampaign(), participations, nil, campaignWeekStart, campaignWeekEnd, remaining)
+	assert.Equal(t, usdc(4), payout.Paid)
+
+	// 重新生成第 300 周时不扣减该周自身的支出
+	unrecorded, err = a.unrecordedCampaignSpending(ctx, 300)
+	require.NoError(t, err)
+	remaining, err = a.campaignRemainingBudget(ctx, id, testCampaign(), unrecorded[testCampaignID])
+	require.NoError(t, err)
+	assert.Equal(t, usdc(12), remaining)
+
+	// 未记录的支出超过链上剩余时可用预算为 0
+	spending.byWeek[299] = map[string]string{testCampaignID: usdc(10).String()}
+	unrecorded, err = a.unrecordedCampaignSpending(ctx, 301)
+	require.NoError(t, err)
+	remaining, err = a.campaignRemainingBudget(ctx, id, testCampaign(), unrecorded[testCampaignID])
+	require.NoError(t, err)
+	assert.Zero(t, remaining.Sign())
+}
+
+func TestEvaluateCampaign_OutsideWeek(t *testing.T) {
+	rule := CampaignRule{ID: testCampaignID, Mode: CampaignModePerParticipant, Amount: 5000000}
+	campaign := testCampaign()
+	campaign.EndTime = strconv.FormatInt(campaignWeekStart.Unix(), 10)
+
+	payout := evaluateCampaign(rule, campaign, []graphql.CampaignParticipation{
+		participation(traderA, campaignWeekStart.Add(-time.Hour)),
+	}, nil, campaignWeekStart, campaignWeekEnd, usdc(100))
+	assert.Equal(t, new(big.Int), payout.Paid)
+	assert.Empty(t, payout.rewards)
+}
+
+func TestLoadCampaignRules(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "campaigns.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+campaigns:
+  - id: "`+testCampaignID+`"
+    mode: pro_rata
+    amount: 1000000000
+`), 0o600))
+
+	rules, err := LoadCampaignRules(path)
+	require.NoError(t, err)
+	assert.Equal(t, []CampaignRule{{ID: testCampaignID, Mode: CampaignModeProRata, Amount: 1000000000}}, rules.Campaigns)
+
+	require.NoError(t, os.WriteFile(path, []byte("campaigns:\n  - id: \"0x1234\"\n    mode: pro_rata\n    amount: 1\n"), 0o600))
+	_, err = LoadCampaignRules(path)
+	assert.ErrorContains(t, err, "campaign id")
+}
+
+func TestCampaignRules_Validate(t *testing.T) {
+	rules := &CampaignRules{Campaigns: []CampaignRule{{ID: testCampaignID, Mode: CampaignModePerParticipant, Amount: 1}}}
+	require.NoError(t, rules.Validate())
+
+	rules.Campaigns = append(rules.Campaigns, rules.Campaigns[0])
+	assert.ErrorContains(t, rules.Validate(), "duplicate")
+
+	rules.Campaigns = []CampaignRule{{ID: testCampaignID, Mode: "fixed", Amount: 1}}
+	assert.Error(t, rules.Validate())
+
+	rules.Campaigns = []CampaignRule{{ID: testCampaignID, Mode: CampaignModeProRata}}
+	assert.Error(t, rules.Validate())
+}
+
+// fakeSpendingStore 内存中的 campaign_spending 表（所有行所在周均视为已发布）
+type fakeSpendingStore struct {
+	rows []*repository.CampaignSpending
+}
+
+func (s *fakeSpendingStore) find(campaignID string, week uint64) *repository.CampaignSpending {
+	for _, row := range s.rows {
+		if row.CampaignID == campaignID && row.Week == week {
+			return row
+		}
+	}
+	return nil
+}
+
+func (s *fakeSpendingStore) ListDueCampaignSpending(ctx context.Context) ([]repository.CampaignSpending, error) {
+	var due []repository.CampaignSpending
+	for _, row := range s.rows {
+		if row.Status == repository.CampaignSpendingPending {
+			due = append(due, *row)
+		}
+	}
+	return due, nil
+}
+
+func (s *fakeSpendingStore) SetCampaignSpendingTx(ctx context.Context, campaignID string, week uint64, txHash string, now int64) error {
+	row := s.find(campaignID, week)
+	row.TxHash = txHash
+	row.Attempts++
+	return nil
+}
+
+func (s *fakeSpendingStore) MarkCampaignSpendingRecorded(ctx context.Context, campaignID string, week uint64, txHash string, now int64) error {
+	row := s.find(campaignID, week)
+	row.Status = repository.CampaignSpendingRecorded
+	row.TxHash = txHash
+	row.LastError = ""
+	return nil
+}
+
+func (s *fakeSpendingStore) SetCampaignSpendingError(ctx context.Context, campaignID string, week uint64, message string, now int64) error {
+	s.find(campaignID, week).LastError = message
+	return nil
+}
+
+// fakeSpendingChain 模拟 Campaign.recordSpending 与交易池
+type fakeSpendingChain struct {
+	nonce    uint64
+	states   map[common.Hash]spendingTxState
+	spent    map[common.Hash]*big.Int
+	amounts  map[common.Hash]*big.Int
+	targets  map[common.Hash]common.Hash
+	sendErrs []error // 依次返回给 sendTransaction 的错误
+	sent     int
+}
+
+func newFakeSpendingChain() *fakeSpendingChain {
+	return &fakeSpendingChain{
+		states:  make(map[common.Hash]spendingTxState),
+		spent:   make(map[common.Hash]*big.Int),
+		amounts: make(map[common.Hash]*big.Int),
+		targets: make(map[common.Hash]common.Hash),
+	}
+}
+
+func (c *fakeSpendingChain) signRecordSpending(ctx context.Context, campaignID common.Hash, amount *big.Int) (*types.Transaction, error) {
+	c.nonce++
+	tx := types.NewTx(&types.LegacyTx{Nonce: c.nonce, Value: amount})
+	c.amounts[tx.Hash()] = amount
+	c.targets[tx.Hash()] = campaignID
+	return tx, nil
+}
+
+func (c *fakeSpendingChain) sendTransaction(ctx context.Context, tx *types.Transaction) error {
+	if len(c.sendErrs) > 0 {
+		err := c.sendErrs[0]
+		c.sendErrs = c.sendErrs[1:]
+		if err != nil {
+			return err
+		}
+	}
+	c.sent++
+	c.mine(tx.Hash())
+	return nil
+}
+
+func (c *fakeSpendingChain) mine(txHash common.Hash) {
+	campaign := c.targets[txHash]
+	if c.spent[campaign] == nil {
+		c.spent[campaign] = new(big.Int)
+	}
+	c.spent[campaign].Add(c.spent[campaign], c.amounts[txHash])
+	c.states[txHash] = spendingTxSucceeded
+}
+
+func (c *fakeSpendingChain) waitMined(ctx context.Context, tx *types.Transaction) error {
+	if c.states[tx.Hash()] != spendingTxSucceeded {
+		return errors.New("transaction reverted")
+	}
+	return nil
+}
+
+func (c *fakeSpendingChain) spendingTxState(ctx context.Context, txHash common.Hash) (spendingTxState, error) {
+	return c.states[txHash], nil
+}
+
+func TestRecordCampaignSpendingRetriesUntilRecorded(t *testing.T) {
+	ctx := context.Background()
+	campaign := common.HexToHash(testCampaignID)
+	store := &fakeSpendingStore{rows: []*repository.CampaignSpending{
+		{CampaignID: testCampaignID, Week: 300, Amount: "5000", Status: repository.CampaignSpendingPending},
+	}}
+	chain := newFakeSpendingChain()
+	chain.sendErrs = []error{errors.New("connection reset")}
+
+	// 第一轮发送失败：保持待记录，保存错误
+	recorded, err := recordCampaignSpending(ctx, chain, store)
+	require.Error(t, err)
+	assert.Equal(t, 0, recorded)
+	row := store.find(testCampaignID, 300)
+	assert.Equal(t, repository.CampaignSpendingPending, row.Status)
+	assert.Contains(t, row.LastError, "connection reset")
+	assert.NotEmpty(t, row.TxHash)
+
+	// 第二轮：上一笔交易不在链上，重发成功
+	recorded, err = recordCampaignSpending(ctx, chain, store)
+	require.NoError(t, err)
+	assert.Equal(t, 1, recorded)
+	assert.Equal(t, repository.CampaignSpendingRecorded, row.Status)
+	assert.Empty(t, row.LastError)
+	assert.Equal(t, 2, row.Attempts)
+
+	// 第三轮：已记录，不再发送
+	recorded, err = recordCampaignSpending(ctx, chain, store)
+	require.NoError(t, err)
+	assert.Equal(t, 0, recorded)
+	assert.Equal(t, 1, chain.sent)
+	assert.Equal(t, big.NewInt(5000), chain.spent[campaign])
+}
+
+func TestRecordCampaignSpendingChecksSentTransaction(t *testing.T) {
+	ctx := context.Background()
+	campaign := common.HexToHash(testCampaignID)
+
+	tests := []struct {
+		name     string
+		state    spendingTxState
+		status   string
+		sent     int
+		spent    int64
+		recorded int
+	}{
+		// 发送后进程退出，交易已成功：直接标记，不重发
+		{name: "succeeded", state: spendingTxSucceeded, status: repository.CampaignSpendingRecorded, sent: 0, spent: 5000, recorded: 1},
+		// 仍在交易池中：等待下一轮
+		{name: "pending", state: spendingTxPending, status: repository.CampaignSpendingPending, sent: 0, spent: 0, recorded: 0},
+		// 回滚：重发
+		{name: "reverted", state: spendingTxReverted, status: repository.CampaignSpendingRecorded, sent: 1, spent: 5000, recorded: 1},
+		// 被丢弃：重发
+		{name: "dropped", state: spendingTxDropped, status: repository.CampaignSpendingRecorded, sent: 1, spent: 5000, recorded: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chain := newFakeSpendingChain()
+			tx, err := chain.signRecordSpending(ctx, campaign, big.NewInt(5000))
+			require.NoError(t, err)
+			if tt.state == spendingTxSucceeded {
+				chain.mine(tx.Hash())
+			} else {
+				chain.states[tx.Hash()] = tt.state
+			}
+
+			store := &fakeSpendingStore{rows: []*repository.CampaignSpending{{
+				CampaignID: testCampaignID,
+				Week:       300,
+				Amount:     "5000",
+				Status:     repository.CampaignSpendingPending,
+				TxHash:     tx.Hash().Hex(),
+				Attempts:   1,
+			}}}
+
+			recorded, err := recordCampaignSpending(ctx, chain, store)
+			require.NoError(t, err)
+			assert.Equal(t, tt.recorded, recorded)
+			assert.Equal(t, tt.status, store.find(testCampaignID, 300).Status)
+			assert.Equal(t, tt.sent, chain.sent)
+
+			spent := chain.spent[campaign]
+			if spent == nil {
+				spent = new(big.Int)
+			}
+			assert.Equal(t, big.NewInt(tt.spent), spent)
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package rewards
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// getBatchClaimedABI RewardsDistributor.getBatchClaimed(address user, uint256[] weekNumbers) returns (uint256[])
+const getBatchClaimedABI = `[{"type":"function","name":"getBatchClaimed","stateMutability":"view",
+	"inputs":[{"name":"user","type":"address"},{"name":"weekNumbers","type":"uint256[]"}],
+	"outputs":[{"name":"","type":"uint256[]"}]}]`
+
+// ClaimReader 查询 RewardsDistributor 上的已领取金额
+type ClaimReader struct {
+	caller          ethereum.ContractCaller
+	distributorAddr common.Address
+	abi             abi.ABI
+}
+
+// NewClaimReader 创建已领取金额查询器
+func NewClaimReader(caller ethereum.ContractCaller, distributorAddr common.Address) (*ClaimReader, error) {
+	parsed, err := abi.JSON(strings.NewReader(getBatchClaimedABI))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse ABI: %w", err)
+	}
+
+	return &ClaimReader{
+		caller:          caller,
+		distributorAddr: distributorAddr,
+		abi:             parsed,
+	}, nil
+}
+
+// GetBatchClaimed 返回用户在各周已领取的金额（与 weeks 顺序一致）
+func (c *ClaimReader) GetBatchClaimed(ctx context.Context, user common.Address, weeks []uint64) ([]*big.Int, error) {
+	if len(weeks) == 0 {
+		return nil, nil
+	}
+
+	weekNumbers := make([]*big.Int, len(weeks))
+	for i, week := range weeks {
+		weekNumbers[i] = new(big.Int).SetUint64(week)
+	}
+
+	data, err := c.abi.Pack("getBatchClaimed", user, weekNumbers)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack getBatchClaimed: %w", err)
+	}
+
+	result, err := c.caller.CallContract(ctx, ethereum.CallMsg{To: &c.distributorAddr, Data: data}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call getBatchClaimed: %w", err)
+	}
+
+	var claimed []*big.Int
+	if err := c.abi.UnpackIntoInterface(&claimed, "getBatchClaimed", result); err != nil {
+		return nil, fmt.Errorf("failed to unpack getBatchClaimed: %w", err)
+	}
+	if len(claimed) != len(weeks) {
+		return nil, fmt.Errorf("getBatchClaimed returned %d values for %d weeks", len(claimed), len(weeks))
+	}
+
+	return claimed, nil
+}
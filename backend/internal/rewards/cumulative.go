@@ -0,0 +1,325 @@
+package rewards
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/pitchone/sportsbook/internal/repository"
+)
+
+// 分配模式
+const (
+	DistributionModeWeekly     = "weekly"     // 每周独立的 Root，用户逐周领取
+	DistributionModeCumulative = "cumulative" // 周度 Root 照常发布，另外保存承诺用户累计总额的快照（尚无累计分发合约）
+)
+
+// cumulativeLeafArguments 累计叶子编码参数：abi.encode(address user, uint256 cumulativeAmount)
+var cumulativeLeafArguments = abi.Arguments{
+	{Type: mustNewType("address")},
+	{Type: mustNewType("uint256")},
+}
+
+// EncodeCumulativeLeaf 生成累计分配的叶子哈希（不含 epoch，金额不变时叶子跨 epoch 保持一致）
+// Solidity: keccak256(bytes.concat(keccak256(abi.encode(user, cumulativeAmount))))
+func EncodeCumulativeLeaf(user common.Address, cumulative *big.Int) (common.Hash, error) {
+	encoded, err := cumulativeLeafArguments.Pack(user, cumulative)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return crypto.Keccak256Hash(crypto.Keccak256(encoded)), nil
+}
+
+// CumulativeEntry 累计分配中单个用户的条目
+type CumulativeEntry struct {
+	User        common.Address `json:"user"`
+	Cumulative  string         `json:"cumulative"`  // 截至本 epoch 的累计总额（叶子金额）
+	EpochAmount string         `json:"epochAmount"` // 本 epoch 新增金额
+}
+
+// CumulativeDistribution 累计分配：Root 承诺每个用户截至 Epoch 的累计奖励总额
+type CumulativeDistribution struct {
+	Epoch       uint64              `json:"epoch"`
+	Root        string              `json:"root"`
+	TotalAmount string              `json:"totalAmount"` // 所有用户累计总额之和
+	EpochAmount string              `json:"epochAmount"` // 本 epoch 新增金额
+	Recipients  int                 `json:"recipients"`
+	Entries     []CumulativeEntry   `json:"entries"`
+	Proofs      map[string][]string `json:"proofs"`      // user -> hex proof
+	LeafIndexes map[string]int      `json:"leafIndexes"` // user -> 叶子下标（按哈希排序）
+	CreatedAt   int64               `json:"createdAt"`
+}
+
+// BuildCumulativeDistribution 在上一 epoch 的累计总额上加上本 epoch 的奖励，构建累计分配。
+// 本 epoch 没有新奖励的用户保留原累计金额，其叶子与上一 epoch 相同。
+func BuildCumulativeDistribution(epoch uint64, previous map[common.Address]*big.Int, entries []RewardEntry) (*CumulativeDistribution, error) {
+	totals := make(map[common.Address]*big.Int, len(previous)+len(entries))
+	for user, amount := range previous {
+		totals[user] = new(big.Int).Set(amount)
+	}
+
+	added := make(map[common.Address]*big.Int, len(entries))
+	for _, entry := range entries {
+		amount, ok := new(big.Int).SetString(entry.Amount, 10)
+		if !ok || amount.Sign() < 0 {
+			return nil, fmt.Errorf("invalid amount %q for %s", entry.Amount, entry.User.Hex())
+		}
+		if _, ok := totals[entry.User]; !ok {
+			totals[entry.User] = new(big.Int)
+		}
+		totals[entry.User].Add(totals[entry.User], amount)
+		if _, ok := added[entry.User]; !ok {
+			added[entry.User] = new(big.Int)
+		}
+		added[entry.User].Add(added[entry.User], amount)
+	}
+
+	// 叶子金额为累计总额，Week 字段仅用于排序与记录
+	leaves := make([]RewardEntry, 0, len(totals))
+	for user, total := range totals {
+		if total.Sign() > 0 {
+			leaves = append(leaves, RewardEntry{User: user, Week: epoch, Amount: total.String()})
+		}
+	}
+
+	tree, err := buildMerkleTree(leaves, func(entry RewardEntry, amount *big.Int) (common.Hash, error) {
+		return EncodeCumulativeLeaf(entry.User, amount)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to build merkle tree: %w", err)
+	}
+
+	dist := &CumulativeDistribution{
+		Epoch:       epoch,
+		Root:        tree.Root.Hex(),
+		Recipients:  len(leaves),
+		Entries:     make([]CumulativeEntry, 0, len(leaves)),
+		Proofs:      make(map[string][]string, len(leaves)),
+		LeafIndexes: tree.Index,
+	}
+
+	total := new(big.Int)
+	epochAmount := new(big.Int)
+	for _, leaf := range leaves { // buildMerkleTree 已按用户地址排序
+		newAmount := added[leaf.User]
+		if newAmount == nil {
+			newAmount = new(big.Int)
+		}
+		dist.Entries = append(dist.Entries, CumulativeEntry{
+			User:        leaf.User,
+			Cumulative:  leaf.Amount,
+			EpochAmount: newAmount.String(),
+		})
+		dist.Proofs[leaf.User.Hex()] = ToHexStrings(tree.Proofs[leaf.User.Hex()])
+
+		total.Add(total, totals[leaf.User])
+		epochAmount.Add(epochAmount, newAmount)
+	}
+	dist.TotalAmount = total.String()
+	dist.EpochAmount = epochAmount.String()
+
+	return dist, nil
+}
+
+// Totals 返回每个用户的累计总额（作为下一 epoch 的起点）
+func (d *CumulativeDistribution) Totals() map[common.Address]*big.Int {
+	totals := make(map[common.Address]*big.Int, len(d.Entries))
+	for _, entry := range d.Entries {
+		amount, ok := new(big.Int).SetString(entry.Cumulative, 10)
+		if ok {
+			totals[entry.User] = amount
+		}
+	}
+	return totals
+}
+
+// CumulativeClaimable 用户可领取金额 = 累计总额 - 已领取总额（不小于 0）。
+// 已领取总额包括用户在周度 RewardsDistributor 上领取的金额。
+// 供将来的累计分发合约使用；目前没有该合约，链上领取仍走周度 Root
+func CumulativeClaimable(cumulative, claimed *big.Int) *big.Int {
+	claimable := new(big.Int).Sub(cumulative, claimed)
+	if claimable.Sign() < 0 {
+		return new(big.Int)
+	}
+	return claimable
+}
+
+// weeklyPayable 周度分配中用户的实际可领取金额（合约按 scaleBps 缩放）
+func weeklyPayable(dist *MerkleDistribution, entry RewardEntry) (*big.Int, error) {
+	amount, ok := new(big.Int).SetString(entry.Amount, 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid amount %q for %s in week %d", entry.Amount, entry.User.Hex(), dist.Week)
+	}
+	amount.Mul(amount, new(big.Int).SetUint64(dist.ScaleBps))
+	return amount.Div(amount, big.NewInt(10000)), nil
+}
+
+// MigrateWeeklyDistributions 将已有的周度分配合并为 epoch 的累计分配。
+// 叶子金额为用户截至 epoch 的终身累计总额，不扣除已在周度合约上领取的金额：
+// 已领取部分由领取方（合约或 API）作为已领取总额扣除，叶子与之后逐 epoch 累加的结果保持一致。
+func MigrateWeeklyDistributions(epoch uint64, weekly []*MerkleDistribution) (*CumulativeDistribution, error) {
+	totals := make(map[common.Address]*big.Int)
+	for _, dist := range weekly {
+		if dist.Week > epoch {
+			return nil, fmt.Errorf("week %d is after migration epoch %d", dist.Week, epoch)
+		}
+		for _, entry := range dist.Entries {
+			amount, err := weeklyPayable(dist, entry)
+			if err != nil {
+				return nil, err
+			}
+			if _, ok := totals[entry.User]; !ok {
+				totals[entry.User] = new(big.Int)
+			}
+			totals[entry.User].Add(totals[entry.User], amount)
+		}
+	}
+
+	entries := make([]RewardEntry, 0, len(totals))
+	for user, total := range totals {
+		entries = append(entries, RewardEntry{User: user, Week: epoch, Amount: total.String()})
+	}
+
+	return BuildCumulativeDistribution(epoch, nil, entries)
+}
+
+// BuildCumulativeDistribution 在最近一次累计分配的基础上加入周度分配的金额，构建该周的累计分配。
+// 尚无累计分配但已有周度分配时返回错误，需先执行 MigrateToCumulative。
+func (a *Aggregator) BuildCumulativeDistribution(ctx context.Context, weekly *MerkleDistribution) (*CumulativeDistribution, error) {
+	var previous map[common.Address]*big.Int
+
+	record, err := a.repo.GetLatestCumulativeDistribution(ctx, weekly.Week)
+	switch {
+	case errors.Is(err, repository.ErrCumulativeDistributionNotFound):
+		if weekly.Week > 0 {
+			weeks, err := a.repo.ListDistributionWeeks(ctx, weekly.Week-1)
+			if err != nil {
+				return nil, err
+			}
+			if len(weeks) > 0 {
+				return nil, fmt.Errorf("found %d weekly distributions before week %d but no cumulative distribution, migrate them first", len(weeks), weekly.Week)
+			}
+		}
+	case err != nil:
+		return nil, err
+	default:
+		previous = cumulativeFromRecord(record).Totals()
+	}
+
+	entries := make([]RewardEntry, 0, len(weekly.Entries))
+	for _, entry := range weekly.Entries {
+		amount, err := weeklyPayable(weekly, entry)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, RewardEntry{User: entry.User, Week: weekly.Week, Amount: amount.String()})
+	}
+
+	return BuildCumulativeDistribution(weekly.Week, previous, entries)
+}
+
+// MigrateToCumulative 读取截至 through 周的所有周度分配，构建首个累计分配
+func (a *Aggregator) MigrateToCumulative(ctx context.Context, through uint64) (*CumulativeDistribution, error) {
+	weeks, err := a.repo.ListDistributionWeeks(ctx, through)
+	if err != nil {
+		return nil, err
+	}
+	if len(weeks) == 0 {
+		return nil, fmt.Errorf("no weekly distributions to migrate through week %d", through)
+	}
+
+	weekly := make([]*MerkleDistribution, 0, len(weeks))
+	for _, week := range weeks {
+		dist, err := a.GetDistribution(ctx, week)
+		if err != nil {
+			return nil, err
+		}
+		weekly = append(weekly, dist)
+	}
+
+	return MigrateWeeklyDistributions(through, weekly)
+}
+
+// SaveCumulativeDistribution 保存累计分配到数据库
+func (a *Aggregator) SaveCumulativeDistribution(ctx context.Context, dist *CumulativeDistribution) error {
+	record := &repository.CumulativeDistribution{
+		Epoch:       dist.Epoch,
+		MerkleRoot:  dist.Root,
+		TotalAmount: dist.TotalAmount,
+		EpochAmount: dist.EpochAmount,
+		Recipients:  dist.Recipients,
+		CreatedAt:   dist.CreatedAt,
+		Entries:     make([]repository.CumulativeDistributionEntry, 0, len(dist.Entries)),
+	}
+
+	for _, entry := range dist.Entries {
+		proof, ok := dist.Proofs[entry.User.Hex()]
+		if !ok {
+			return fmt.Errorf("missing proof for %s", entry.User.Hex())
+		}
+		record.Entries = append(record.Entries, repository.CumulativeDistributionEntry{
+			UserAddress:      entry.User.Hex(),
+			CumulativeAmount: entry.Cumulative,
+			EpochAmount:      entry.EpochAmount,
+			Proof:            proof,
+			LeafIndex:        dist.LeafIndexes[entry.User.Hex()],
+		})
+	}
+
+	return a.repo.SaveCumulativeDistribution(ctx, record)
+}
+
+// GetCumulativeDistribution 从数据库加载累计分配
+func (a *Aggregator) GetCumulativeDistribution(ctx context.Context, epoch uint64) (*CumulativeDistribution, error) {
+	record, err := a.repo.GetCumulativeDistribution(ctx, epoch)
+	if errors.Is(err, repository.ErrCumulativeDistributionNotFound) {
+		return nil, fmt.Errorf("cumulative distribution not found for epoch %d", epoch)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return cumulativeFromRecord(record), nil
+}
+
+// cumulativeFromRecord 将数据库记录还原为 CumulativeDistribution（条目按用户地址排序）
+func cumulativeFromRecord(record *repository.CumulativeDistribution) *CumulativeDistribution {
+	dist := &CumulativeDistribution{
+		Epoch:       record.Epoch,
+		Root:        record.MerkleRoot,
+		TotalAmount: record.TotalAmount,
+		EpochAmount: record.EpochAmount,
+		Recipients:  record.Recipients,
+		Entries:     make([]CumulativeEntry, 0, len(record.Entries)),
+		Proofs:      make(map[string][]string, len(record.Entries)),
+		LeafIndexes: make(map[string]int, len(record.Entries)),
+		CreatedAt:   record.CreatedAt,
+	}
+
+	for _, entry := range record.Entries {
+		user := common.HexToAddress(entry.UserAddress)
+		dist.Entries = append(dist.Entries, CumulativeEntry{
+			User:        user,
+			Cumulative:  entry.CumulativeAmount,
+			EpochAmount: entry.EpochAmount,
+		})
+		dist.Proofs[user.Hex()] = entry.Proof
+		dist.LeafIndexes[user.Hex()] = entry.LeafIndex
+	}
+
+	sortCumulativeEntries(dist.Entries)
+	return dist
+}
+
+// sortCumulativeEntries 按用户地址排序（与 sortEntries 顺序一致）
+func sortCumulativeEntries(entries []CumulativeEntry) {
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].User.Hex() < entries[j].User.Hex()
+	})
+}
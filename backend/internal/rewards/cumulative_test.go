@@ -0,0 +1,159 @@
+package rewards
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func weeklyEntries(week uint64, amounts map[common.Address]int64) []RewardEntry {
+	entries := make([]RewardEntry, 0, len(amounts))
+	for user, amount := range amounts {
+		entries = append(entries, RewardEntry{User: user, Week: week, Amount: big.NewInt(amount).String()})
+	}
+	return entries
+}
+
+// verifyCumulative 校验分配中每个用户的证明都能在该 epoch 的 Root 下通过
+func verifyCumulative(t *testing.T, dist *CumulativeDistribution) {
+	t.Helper()
+	root := common.HexToHash(dist.Root)
+	for _, entry := range dist.Entries {
+		amount, ok := new(big.Int).SetString(entry.Cumulative, 10)
+		require.True(t, ok)
+		leaf, err := EncodeCumulativeLeaf(entry.User, amount)
+		require.NoError(t, err)
+
+		proof := make([]common.Hash, 0, len(dist.Proofs[entry.User.Hex()]))
+		for _, h := range dist.Proofs[entry.User.Hex()] {
+			proof = append(proof, common.HexToHash(h))
+		}
+		assert.True(t, VerifyProof(proof, root, leaf), "proof for %s in epoch %d", entry.User.Hex(), dist.Epoch)
+	}
+}
+
+func cumulativeOf(t *testing.T, dist *CumulativeDistribution, user common.Address) string {
+	t.Helper()
+	for _, entry := range dist.Entries {
+		if entry.User == user {
+			return entry.Cumulative
+		}
+	}
+	t.Fatalf("%s not in epoch %d", user.Hex(), dist.Epoch)
+	return ""
+}
+
+func TestBuildCumulativeDistribution_AcrossEpochs(t *testing.T) {
+	epoch1, err := BuildCumulativeDistribution(1, nil, weeklyEntries(1, map[common.Address]int64{
+		traderA: 100,
+		traderB: 50,
+	}))
+	require.NoError(t, err)
+	verifyCumulative(t, epoch1)
+	assert.Equal(t, "150", epoch1.TotalAmount)
+	assert.Equal(t, "150", epoch1.EpochAmount)
+
+	// 第 2 个 epoch：A 新增 30，B 没有新奖励，C 首次获得奖励
+	epoch2, err := BuildCumulativeDistribution(2, epoch1.Totals(), weeklyEntries(2, map[common.Address]int64{
+		traderA: 30,
+		traderC: 20,
+	}))
+	require.NoError(t, err)
+	verifyCumulative(t, epoch2)
+
+	assert.Equal(t, 3, epoch2.Recipients)
+	assert.Equal(t, "130", cumulativeOf(t, epoch2, traderA))
+	assert.Equal(t, "50", cumulativeOf(t, epoch2, traderB))
+	assert.Equal(t, "20", cumulativeOf(t, epoch2, traderC))
+	assert.Equal(t, "200", epoch2.TotalAmount)
+	assert.Equal(t, "50", epoch2.EpochAmount)
+
+	// 上一 epoch 的 Totals 未被修改
+	assert.Equal(t, big.NewInt(100), epoch1.Totals()[traderA])
+
+	// 已领取 epoch1 的 100 后，只能再领取差额
+	assert.Equal(t, big.NewInt(30), CumulativeClaimable(big.NewInt(130), big.NewInt(100)))
+	assert.Equal(t, new(big.Int), CumulativeClaimable(big.NewInt(130), big.NewInt(200)))
+}
+
+func TestCumulativeProofStability(t *testing.T) {
+	previous := map[common.Address]*big.Int{traderA: big.NewInt(100), traderB: big.NewInt(50)}
+	added := weeklyEntries(2, map[common.Address]int64{traderA: 30, traderC: 20})
+
+	// 相同输入（任意顺序）重建得到相同的 Root 与证明
+	first, err := BuildCumulativeDistribution(2, previous, added)
+	require.NoError(t, err)
+	reversed := []RewardEntry{added[1], added[0]}
+	second, err := BuildCumulativeDistribution(2, previous, reversed)
+	require.NoError(t, err)
+	assert.Equal(t, first.Root, second.Root)
+	assert.Equal(t, first.Proofs, second.Proofs)
+	assert.Equal(t, first.Entries, second.Entries)
+
+	// 累计金额不变的用户叶子跨 epoch 保持一致；金额变化的用户旧叶子在新 Root 下失效
+	epoch1, err := BuildCumulativeDistribution(1, nil, weeklyEntries(1, map[common.Address]int64{traderA: 100, traderB: 50}))
+	require.NoError(t, err)
+
+	leafB, err := EncodeCumulativeLeaf(traderB, big.NewInt(50))
+	require.NoError(t, err)
+	oldLeafA, err := EncodeCumulativeLeaf(traderA, big.NewInt(100))
+	require.NoError(t, err)
+
+	proofOf := func(dist *CumulativeDistribution, user common.Address) []common.Hash {
+		proof := []common.Hash{}
+		for _, h := range dist.Proofs[user.Hex()] {
+			proof = append(proof, common.HexToHash(h))
+		}
+		return proof
+	}
+
+	assert.True(t, VerifyProof(proofOf(epoch1, traderB), common.HexToHash(epoch1.Root), leafB))
+	assert.True(t, VerifyProof(proofOf(first, traderB), common.HexToHash(first.Root), leafB))
+	assert.False(t, VerifyProof(proofOf(first, traderA), common.HexToHash(first.Root), oldLeafA))
+
+	// 累计叶子不包含 epoch，与周度叶子不同
+	weeklyLeaf, err := EncodeLeaf(traderB, 1, big.NewInt(50))
+	require.NoError(t, err)
+	assert.NotEqual(t, weeklyLeaf, leafB)
+}
+
+func TestBuildCumulativeDistribution_Invalid(t *testing.T) {
+	_, err := BuildCumulativeDistribution(1, nil, nil)
+	assert.Error(t, err)
+
+	_, err = BuildCumulativeDistribution(1, nil, []RewardEntry{{User: traderA, Amount: "-1"}})
+	assert.Error(t, err)
+}
+
+func TestMigrateWeeklyDistributions(t *testing.T) {
+	week1, err := BuildDistribution(1, weeklyEntries(1, map[common.Address]int64{traderA: 100, traderB: 50}), 10000)
+	require.NoError(t, err)
+	// 早期按 scaleBps 缩放发布：实际可领取 80%
+	week2, err := BuildDistribution(2, weeklyEntries(2, map[common.Address]int64{traderA: 100, traderC: 40}), 8000)
+	require.NoError(t, err)
+
+	migrated, err := MigrateWeeklyDistributions(2, []*MerkleDistribution{week1, week2})
+	require.NoError(t, err)
+	verifyCumulative(t, migrated)
+
+	// 叶子为终身累计总额，不扣除已在周度合约上领取的金额
+	assert.Equal(t, 3, migrated.Recipients)
+	assert.Equal(t, "180", cumulativeOf(t, migrated, traderA))
+	assert.Equal(t, "50", cumulativeOf(t, migrated, traderB))
+	assert.Equal(t, "32", cumulativeOf(t, migrated, traderC))
+
+	// 迁移后的 epoch 与逐 epoch 累加的结果一致
+	incremental, err := BuildCumulativeDistribution(2, map[common.Address]*big.Int{traderA: big.NewInt(100), traderB: big.NewInt(50)},
+		weeklyEntries(2, map[common.Address]int64{traderA: 80, traderC: 32}))
+	require.NoError(t, err)
+	assert.Equal(t, incremental.Root, migrated.Root)
+
+	// A 已在周度合约领取第 1 周的 100，剩余可领取 80
+	assert.Equal(t, big.NewInt(80), CumulativeClaimable(big.NewInt(180), big.NewInt(100)))
+
+	_, err = MigrateWeeklyDistributions(1, []*MerkleDistribution{week1, week2})
+	assert.ErrorContains(t, err, "after migration epoch")
+}
@@ -0,0 +1,226 @@
+package rewards
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// EpochPeriod 奖励周期日历的一段：从 Start 开始，每个周期长度为 Length。
+// 多段按时间顺序排列，后一段的 Start 必须落在前一段的周期边界上，周期 ID 跨段连续递增，
+// 因此切换周期长度后历史周期保留原 ID。
+type EpochPeriod struct {
+	Start    string `mapstructure:"start" json:"start"`       // 本地时间，如 2024-01-01 或 2024-01-01T00:00:00
+	Length   string `mapstructure:"length" json:"length"`     // Nd / Nmo，或 daily / weekly / monthly
+	Timezone string `mapstructure:"timezone" json:"timezone"` // IANA 时区，默认 UTC
+}
+
+// DefaultEpochPeriods 默认日历：从 2024-01-01 00:00 UTC 开始的 7 天周期（与早期硬编码的周编号一致）
+func DefaultEpochPeriods() []EpochPeriod {
+	return []EpochPeriod{{Start: "2024-01-01", Length: "weekly", Timezone: "UTC"}}
+}
+
+// epochSegment 解析后的日历段
+type epochSegment struct {
+	start   time.Time
+	days    int
+	months  int
+	firstID uint64 // 本段第一个周期的 ID
+}
+
+// at 返回本段第 n 个周期的开始时间（按日历日/月推进，跨夏令时保持本地时刻）
+func (s epochSegment) at(n uint64) time.Time {
+	return s.start.AddDate(0, s.months*int(n), s.days*int(n))
+}
+
+// indexAt 返回 t 所在的本段周期序号（t 不早于 start）
+func (s epochSegment) indexAt(t time.Time) uint64 {
+	var n int64
+	if s.months > 0 {
+		local := t.In(s.start.Location())
+		elapsed := (local.Year()-s.start.Year())*12 + int(local.Month()-s.start.Month())
+		n = int64(elapsed / s.months)
+	} else {
+		n = int64(t.Sub(s.start) / (time.Duration(s.days) * 24 * time.Hour))
+	}
+	if n < 0 {
+		n = 0
+	}
+
+	// 估算值可能因夏令时或月末偏差一个周期，按实际边界修正
+	for n > 0 && s.at(uint64(n)).After(t) {
+		n--
+	}
+	for !s.at(uint64(n + 1)).After(t) {
+		n++
+	}
+	return uint64(n)
+}
+
+// EpochCalendar 奖励周期日历：聚合时间范围、分配 ID 与 RewardsDistributor 的 week 参数均由其导出
+type EpochCalendar struct {
+	segments []epochSegment
+}
+
+// DefaultEpochCalendar 返回默认日历
+func DefaultEpochCalendar() *EpochCalendar {
+	calendar, err := NewEpochCalendar(DefaultEpochPeriods())
+	if err != nil {
+		panic(err)
+	}
+	return calendar
+}
+
+// NewEpochCalendar 从日历段创建日历；periods 为空时使用默认日历
+func NewEpochCalendar(periods []EpochPeriod) (*EpochCalendar, error) {
+	if len(periods) == 0 {
+		periods = DefaultEpochPeriods()
+	}
+
+	calendar := &EpochCalendar{}
+	for i, period := range periods {
+		segment, err := parseEpochPeriod(period)
+		if err != nil {
+			return nil, fmt.Errorf("epochs[%d]: %w", i, err)
+		}
+
+		if i > 0 {
+			prev := calendar.segments[i-1]
+			if !segment.start.After(prev.start) {
+				return nil, fmt.Errorf("epochs[%d]: start %s must be after epochs[%d]", i, period.Start, i-1)
+			}
+			n := prev.indexAt(segment.start)
+			if !prev.at(n).Equal(segment.start) {
+				return nil, fmt.Errorf("epochs[%d]: start %s is not on an epoch boundary of epochs[%d] (nearest %s)",
+					i, period.Start, i-1, prev.at(n).Format(time.RFC3339))
+			}
+			segment.firstID = prev.firstID + n
+		}
+		calendar.segments = append(calendar.segments, segment)
+	}
+
+	return calendar, nil
+}
+
+// parseEpochPeriod 解析单个日历段
+func parseEpochPeriod(period EpochPeriod) (epochSegment, error) {
+	location := time.UTC
+	if period.Timezone != "" {
+		loc, err := time.LoadLocation(period.Timezone)
+		if err != nil {
+			return epochSegment{}, fmt.Errorf("invalid timezone %q: %w", period.Timezone, err)
+		}
+		location = loc
+	}
+
+	var start time.Time
+	var err error
+	for _, layout := range []string{"2006-01-02", "2006-01-02T15:04:05", "2006-01-02 15:04:05"} {
+		if start, err = time.ParseInLocation(layout, period.Start, location); err == nil {
+			break
+		}
+	}
+	if err != nil {
+		return epochSegment{}, fmt.Errorf("invalid start %q (expected YYYY-MM-DD[THH:MM:SS])", period.Start)
+	}
+
+	segment := epochSegment{start: start}
+	length := strings.ToLower(strings.TrimSpace(period.Length))
+	switch {
+	case length == "daily":
+		segment.days = 1
+	case length == "weekly":
+		segment.days = 7
+	case length == "monthly":
+		segment.months = 1
+	case strings.HasSuffix(length, "mo"):
+		segment.months, err = strconv.Atoi(strings.TrimSuffix(length, "mo"))
+	case strings.HasSuffix(length, "d"):
+		segment.days, err = strconv.Atoi(strings.TrimSuffix(length, "d"))
+	default:
+		err = fmt.Errorf("unknown unit")
+	}
+	if err != nil || (segment.days <= 0 && segment.months <= 0) {
+		return epochSegment{}, fmt.Errorf("invalid length %q (expected Nd, Nmo, daily, weekly or monthly)", period.Length)
+	}
+
+	// 月末日期推进时会被 AddDate 归一化到下个月
+	if segment.months > 0 && start.Day() > 28 {
+		return epochSegment{}, fmt.Errorf("monthly epochs must start on day 1-28, got %s", period.Start)
+	}
+
+	return segment, nil
+}
+
+// LoadEpochCalendar 从配置文件（YAML/JSON 的 epochs 列表）加载日历
+func LoadEpochCalendar(path string) (*EpochCalendar, error) {
+	v := viper.New()
+	v.SetConfigFile(path)
+	if err := v.ReadInConfig(); err != nil {
+		return nil, fmt.Errorf("failed to read epoch calendar: %w", err)
+	}
+
+	var config struct {
+		Epochs []EpochPeriod `mapstructure:"epochs"`
+	}
+	if err := v.Unmarshal(&config); err != nil {
+		return nil, fmt.Errorf("failed to parse epoch calendar: %w", err)
+	}
+
+	calendar, err := NewEpochCalendar(config.Epochs)
+	if err != nil {
+		return nil, fmt.Errorf("invalid epoch calendar %s: %w", path, err)
+	}
+	return calendar, nil
+}
+
+// segmentFor 返回周期 ID 所在的日历段
+func (c *EpochCalendar) segmentFor(id uint64) epochSegment {
+	segment := c.segments[0]
+	for _, s := range c.segments[1:] {
+		if s.firstID > id {
+			break
+		}
+		segment = s
+	}
+	return segment
+}
+
+// Range 返回周期的时间范围 [start, end)
+func (c *EpochCalendar) Range(id uint64) (start, end time.Time) {
+	segment := c.segmentFor(id)
+	n := id - segment.firstID
+	return segment.at(n), segment.at(n + 1)
+}
+
+// EpochAt 返回 t 所在的周期 ID（早于日历起点时为 0）
+func (c *EpochCalendar) EpochAt(t time.Time) uint64 {
+	segment := c.segments[0]
+	if t.Before(segment.start) {
+		return 0
+	}
+	for _, s := range c.segments[1:] {
+		if t.Before(s.start) {
+			break
+		}
+		segment = s
+	}
+	return segment.firstID + segment.indexAt(t)
+}
+
+// Current 返回当前周期 ID
+func (c *EpochCalendar) Current() uint64 {
+	return c.EpochAt(time.Now())
+}
+
+// Previous 返回上一个已结束的周期 ID（默认处理的周期）
+func (c *EpochCalendar) Previous() uint64 {
+	current := c.Current()
+	if current == 0 {
+		return 0
+	}
+	return current - 1
+}
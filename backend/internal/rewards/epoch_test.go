@@ -0,0 +1,104 @@
+package rewards
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultEpochCalendar_MatchesLegacyWeeks(t *testing.T) {
+	calendar := DefaultEpochCalendar()
+	epoch := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// 与早期硬编码实现（2024-01-01 UTC 起每 7 天）一致
+	for _, week := range []uint64{0, 1, 45, 100, 1000} {
+		start, end := calendar.Range(week)
+		assert.True(t, start.Equal(epoch.Add(time.Duration(week)*7*24*time.Hour)), "week %d start", week)
+		assert.True(t, end.Equal(start.Add(7*24*time.Hour)), "week %d end", week)
+		assert.Equal(t, week, calendar.EpochAt(start))
+		assert.Equal(t, week, calendar.EpochAt(end.Add(-time.Second)))
+	}
+
+	assert.Equal(t, uint64(0), calendar.EpochAt(epoch.Add(-time.Hour)))
+}
+
+func TestEpochCalendar_SegmentsKeepHistoricalIDs(t *testing.T) {
+	calendar, err := NewEpochCalendar([]EpochPeriod{
+		{Start: "2024-01-01", Length: "weekly", Timezone: "UTC"},
+		{Start: "2024-03-04", Length: "daily", Timezone: "UTC"}, // 第 9 周开始
+		{Start: "2024-04-01", Length: "monthly", Timezone: "UTC"},
+	})
+	require.NoError(t, err)
+
+	// 切换前的周期 ID 不变
+	start, end := calendar.Range(8)
+	assert.Equal(t, time.Date(2024, 2, 26, 0, 0, 0, 0, time.UTC), start)
+	assert.Equal(t, time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC), end)
+
+	// 按天的周期从 9 开始编号
+	start, end = calendar.Range(9)
+	assert.Equal(t, time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC), start)
+	assert.Equal(t, time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC), end)
+	assert.Equal(t, uint64(36), calendar.EpochAt(time.Date(2024, 3, 31, 12, 0, 0, 0, time.UTC)))
+
+	// 3 月 4 日起共 28 个按天周期（9-36），4 月起按月（37 起）
+	start, end = calendar.Range(36)
+	assert.Equal(t, time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC), start)
+	assert.Equal(t, time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC), end)
+	start, end = calendar.Range(38)
+	assert.Equal(t, time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), start)
+	assert.Equal(t, time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC), end)
+	assert.Equal(t, uint64(38), calendar.EpochAt(time.Date(2024, 5, 31, 23, 59, 59, 0, time.UTC)))
+}
+
+func TestEpochCalendar_Timezone(t *testing.T) {
+	calendar, err := NewEpochCalendar([]EpochPeriod{{Start: "2024-03-01", Length: "1d", Timezone: "Europe/London"}})
+	require.NoError(t, err)
+
+	// 夏令时切换日（2024-03-31）周期只有 23 小时，但仍从本地零点开始
+	london, err := time.LoadLocation("Europe/London")
+	require.NoError(t, err)
+	start, end := calendar.Range(30)
+	assert.Equal(t, time.Date(2024, 3, 31, 0, 0, 0, 0, london), start)
+	assert.Equal(t, 23*time.Hour, end.Sub(start))
+	assert.Equal(t, uint64(31), calendar.EpochAt(time.Date(2024, 3, 31, 23, 30, 0, 0, time.UTC)))
+}
+
+func TestNewEpochCalendar_Invalid(t *testing.T) {
+	cases := [][]EpochPeriod{
+		{{Start: "2024/01/01", Length: "weekly"}},
+		{{Start: "2024-01-01", Length: "fortnightly"}},
+		{{Start: "2024-01-01", Length: "0d"}},
+		{{Start: "2024-01-31", Length: "monthly"}},
+		{{Start: "2024-01-01", Length: "weekly", Timezone: "Mars/Olympus"}},
+		// 不在前一段的周期边界上
+		{{Start: "2024-01-01", Length: "weekly"}, {Start: "2024-01-10", Length: "daily"}},
+		{{Start: "2024-01-08", Length: "weekly"}, {Start: "2024-01-01", Length: "daily"}},
+	}
+	for _, periods := range cases {
+		_, err := NewEpochCalendar(periods)
+		assert.Error(t, err, "%+v", periods)
+	}
+}
+
+func TestLoadEpochCalendar(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "epochs.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+epochs:
+  - start: "2024-01-01"
+    length: weekly
+  - start: "2024-01-15T00:00:00"
+    length: 2mo
+    timezone: UTC
+`), 0o600))
+
+	calendar, err := LoadEpochCalendar(path)
+	require.NoError(t, err)
+	start, end := calendar.Range(2)
+	assert.Equal(t, time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC), start)
+	assert.Equal(t, time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC), end)
+}
@@ -0,0 +1,63 @@
+package rewards
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// csvHeader CSV 导出列，amount 之后为各奖励类别明细（与 RewardTypes 顺序一致）
+var csvHeader = []string{"week", "user", "amount", "referral", "trading", "campaign", "leaf_index", "proof"}
+
+// WriteDistributionJSON 以 JSON 格式导出分配数据
+func WriteDistributionJSON(w io.Writer, dist *MerkleDistribution) error {
+	data, err := json.MarshalIndent(dist, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal distribution: %w", err)
+	}
+
+	_, err = w.Write(data)
+	return err
+}
+
+// WriteDistributionCSV 以 CSV 格式导出分配数据，每个用户一行，证明以 ";" 分隔
+func WriteDistributionCSV(w io.Writer, dist *MerkleDistribution) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(csvHeader); err != nil {
+		return err
+	}
+
+	for _, entry := range dist.Entries {
+		user := entry.User.Hex()
+		proof, ok := dist.Proofs[user]
+		if !ok {
+			return fmt.Errorf("missing proof for %s", user)
+		}
+
+		record := []string{
+			strconv.FormatUint(dist.Week, 10),
+			user,
+			entry.Amount,
+		}
+		for _, rewardType := range RewardTypes {
+			amount, ok := entry.Breakdown[rewardType]
+			if !ok {
+				amount = "0"
+			}
+			record = append(record, amount)
+		}
+		record = append(record,
+			strconv.Itoa(dist.LeafIndexes[user]),
+			strings.Join(proof, ";"),
+		)
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
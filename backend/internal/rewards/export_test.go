@@ -0,0 +1,95 @@
+package rewards
+
+import (
+	"bytes"
+	"encoding/csv"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testDistribution(t *testing.T) *MerkleDistribution {
+	entries := []RewardEntry{
+		{User: common.HexToAddress("0x3333333333333333333333333333333333333333"), Week: 7, Amount: "3000",
+			Breakdown: map[RewardType]string{RewardTypeCampaign: "3000"}},
+		{User: common.HexToAddress("0x1111111111111111111111111111111111111111"), Week: 7, Amount: "1000",
+			Breakdown: map[RewardType]string{RewardTypeReferral: "400", RewardTypeTrading: "600"}},
+		{User: common.HexToAddress("0x2222222222222222222222222222222222222222"), Week: 7, Amount: "2000"},
+	}
+
+	dist, err := BuildDistribution(7, entries, 8000)
+	require.NoError(t, err)
+	dist.CreatedAt = 1700000000
+	return dist
+}
+
+// TestDistributionRecordRoundTrip 数据库记录还原后导出结果与生成时一致
+func TestDistributionRecordRoundTrip(t *testing.T) {
+	dist := testDistribution(t)
+
+	record, err := distributionRecord(dist)
+	require.NoError(t, err)
+
+	// 数据库按叶子下标返回条目
+	sort.Slice(record.Entries, func(i, j int) bool {
+		return record.Entries[i].LeafIndex < record.Entries[j].LeafIndex
+	})
+	for i := range record.Entries {
+		record.Entries[i].UserAddress = strings.ToLower(record.Entries[i].UserAddress)
+	}
+
+	var original, restored bytes.Buffer
+	require.NoError(t, WriteDistributionJSON(&original, dist))
+	require.NoError(t, WriteDistributionJSON(&restored, distributionFromRecord(record)))
+	assert.Equal(t, original.String(), restored.String())
+
+	original.Reset()
+	restored.Reset()
+	require.NoError(t, WriteDistributionCSV(&original, dist))
+	require.NoError(t, WriteDistributionCSV(&restored, distributionFromRecord(record)))
+	assert.Equal(t, original.String(), restored.String())
+}
+
+func TestDistributionLeafIndexes(t *testing.T) {
+	dist := testDistribution(t)
+	tree, err := NewMerkleTree(dist.Entries)
+	require.NoError(t, err)
+
+	seen := map[int]bool{}
+	for _, entry := range dist.Entries {
+		index := dist.LeafIndexes[entry.User.Hex()]
+		assert.Equal(t, GenerateLeaf(entry.User, entry.Week, entry.Amount), tree.Leaves[index])
+		seen[index] = true
+	}
+	assert.Len(t, seen, len(dist.Entries))
+}
+
+func TestWriteDistributionCSV(t *testing.T) {
+	dist := testDistribution(t)
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteDistributionCSV(&buf, dist))
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 4)
+	assert.Equal(t, csvHeader, records[0])
+
+	// 条目按用户地址排序
+	first := records[1]
+	user := common.HexToAddress("0x1111111111111111111111111111111111111111").Hex()
+	assert.Equal(t, "7", first[0])
+	assert.Equal(t, user, first[1])
+	assert.Equal(t, "1000", first[2])
+	assert.Equal(t, []string{"400", "600", "0"}, first[3:6])
+	assert.Equal(t, strconv.Itoa(dist.LeafIndexes[user]), first[6])
+	assert.Equal(t, strings.Join(dist.Proofs[user], ";"), first[7])
+
+	// 没有明细的条目各类别为 0
+	assert.Equal(t, []string{"0", "0", "0"}, records[2][3:6])
+}
@@ -1,11 +1,14 @@
 package rewards
 
 import (
+	"bytes"
 	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
+	"math/big"
 	"sort"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 )
@@ -15,123 +18,182 @@ type RewardEntry struct {
 	User   common.Address // 用户地址
 	Week   uint64         // 周编号
 	Amount string         // 奖励金额（wei，字符串表示以支持大数）
+
+	// Breakdown 各奖励类别的金额（之和等于 Amount），不参与叶子编码
+	Breakdown map[RewardType]string `json:",omitempty"`
 }
 
 // MerkleTree 表示 Merkle 树
+// 布局与 OpenZeppelin StandardMerkleTree 一致：叶子按哈希升序排列，
+// 以扁平数组存储完全二叉树（根在下标 0，节点 i 的子节点为 2i+1 和 2i+2）
 type MerkleTree struct {
-	Leaves []common.Hash     // 叶子节点
-	Nodes  [][]common.Hash   // 所有层级的节点
-	Root   common.Hash       // Merkle 根
+	Leaves []common.Hash            // 叶子节点（按哈希升序）
+	Tree   []common.Hash            // 扁平树数组，叶子逆序存放在数组末尾
+	Root   common.Hash              // Merkle 根
 	Proofs map[string][]common.Hash // 用户地址 -> Merkle 证明
+	Index  map[string]int           // 用户地址 -> 叶子在 Leaves 中的下标
+}
+
+// leafArguments 叶子编码参数：abi.encode(address user, uint256 week, uint256 amount)
+var leafArguments = abi.Arguments{
+	{Type: mustNewType("address")},
+	{Type: mustNewType("uint256")},
+	{Type: mustNewType("uint256")},
+}
+
+func mustNewType(t string) abi.Type {
+	typ, err := abi.NewType(t, "", nil)
+	if err != nil {
+		panic(err)
+	}
+	return typ
 }
 
+// leafEncoder 将条目编码为叶子哈希（周度与累计分配的叶子格式不同）
+type leafEncoder func(entry RewardEntry, amount *big.Int) (common.Hash, error)
+
 // NewMerkleTree 从奖励条目创建 Merkle 树
 func NewMerkleTree(entries []RewardEntry) (*MerkleTree, error) {
+	return buildMerkleTree(entries, func(entry RewardEntry, amount *big.Int) (common.Hash, error) {
+		return EncodeLeaf(entry.User, entry.Week, amount)
+	})
+}
+
+// buildMerkleTree 按给定的叶子编码创建 Merkle 树
+func buildMerkleTree(entries []RewardEntry, encode leafEncoder) (*MerkleTree, error) {
 	if len(entries) == 0 {
 		return nil, fmt.Errorf("no entries provided")
 	}
 
 	// 按用户地址排序，确保确定性
-	sort.Slice(entries, func(i, j int) bool {
-		return entries[i].User.Hex() < entries[j].User.Hex()
-	})
+	sortEntries(entries)
 
 	// 生成叶子节点
+	leafOf := make(map[string]common.Hash, len(entries))
 	leaves := make([]common.Hash, len(entries))
 	for i, entry := range entries {
-		leaves[i] = GenerateLeaf(entry.User, entry.Week, entry.Amount)
+		amount, ok := new(big.Int).SetString(entry.Amount, 10)
+		if !ok {
+			return nil, fmt.Errorf("invalid amount %q for %s", entry.Amount, entry.User.Hex())
+		}
+		leaf, err := encode(entry, amount)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode leaf for %s: %w", entry.User.Hex(), err)
+		}
+		leaves[i] = leaf
+		leafOf[entry.User.Hex()] = leaf
 	}
 
-	// 构建 Merkle 树
+	// 叶子按哈希升序排序（StandardMerkleTree 默认 sortLeaves）
+	sort.Slice(leaves, func(i, j int) bool {
+		return bytes.Compare(leaves[i].Bytes(), leaves[j].Bytes()) < 0
+	})
+
+	treeArray := buildTree(leaves)
 	tree := &MerkleTree{
 		Leaves: leaves,
-		Nodes:  [][]common.Hash{leaves},
+		Tree:   treeArray,
+		Root:   treeArray[0],
 		Proofs: make(map[string][]common.Hash),
+		Index:  make(map[string]int),
 	}
 
-	// 逐层构建树
-	currentLevel := leaves
-	for len(currentLevel) > 1 {
-		nextLevel := make([]common.Hash, 0, (len(currentLevel)+1)/2)
-
-		for i := 0; i < len(currentLevel); i += 2 {
-			var nodeHash common.Hash
-
-			if i+1 < len(currentLevel) {
-				// 有两个子节点，计算父节点哈希
-				nodeHash = HashPair(currentLevel[i], currentLevel[i+1])
-			} else {
-				// 奇数个节点，复制最后一个节点
-				nodeHash = HashPair(currentLevel[i], currentLevel[i])
-			}
-
-			nextLevel = append(nextLevel, nodeHash)
+	// 为每个条目生成证明
+	for _, entry := range entries {
+		index, ok := tree.treeIndex(leafOf[entry.User.Hex()])
+		if !ok {
+			return nil, fmt.Errorf("leaf not found for %s", entry.User.Hex())
 		}
-
-		tree.Nodes = append(tree.Nodes, nextLevel)
-		currentLevel = nextLevel
+		tree.Proofs[entry.User.Hex()] = tree.generateProof(index)
+		tree.Index[entry.User.Hex()] = len(tree.Tree) - 1 - index
 	}
 
-	tree.Root = currentLevel[0]
+	return tree, nil
+}
 
-	// 为每个条目生成证明
-	for i, entry := range entries {
-		proof := tree.generateProof(i)
-		tree.Proofs[entry.User.Hex()] = proof
-	}
+// sortEntries 按用户地址排序条目（分配导出与数据库重新加载使用相同顺序）
+func sortEntries(entries []RewardEntry) {
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].User.Hex() < entries[j].User.Hex()
+	})
+}
 
-	return tree, nil
+// buildTree 按 StandardMerkleTree 的 makeMerkleTree 构建扁平树数组
+func buildTree(leaves []common.Hash) []common.Hash {
+	tree := make([]common.Hash, 2*len(leaves)-1)
+	for i, leaf := range leaves {
+		tree[len(tree)-1-i] = leaf
+	}
+	for i := len(tree) - 1 - len(leaves); i >= 0; i-- {
+		tree[i] = HashPair(tree[2*i+1], tree[2*i+2])
+	}
+	return tree
 }
 
-// GenerateLeaf 生成叶子节点哈希
+// EncodeLeaf 生成叶子节点哈希
 // Solidity: keccak256(bytes.concat(keccak256(abi.encode(user, week, amount))))
-func GenerateLeaf(user common.Address, week uint64, amount string) common.Hash {
-	// 编码参数 (user, week, amount)
-	encoded := crypto.Keccak256Hash(
-		common.LeftPadBytes(user.Bytes(), 32),
-		common.LeftPadBytes([]byte{byte(week)}, 32),
-		common.LeftPadBytes([]byte(amount), 32),
-	)
+func EncodeLeaf(user common.Address, week uint64, amount *big.Int) (common.Hash, error) {
+	encoded, err := leafArguments.Pack(user, new(big.Int).SetUint64(week), amount)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return crypto.Keccak256Hash(crypto.Keccak256(encoded)), nil
+}
 
-	// 再次哈希
-	return crypto.Keccak256Hash(encoded.Bytes())
+// GenerateLeaf 生成叶子节点哈希，amount 为十进制整数字符串（wei）
+// 非法金额返回零哈希（任何证明都无法通过验证）
+func GenerateLeaf(user common.Address, week uint64, amount string) common.Hash {
+	value, ok := new(big.Int).SetString(amount, 10)
+	if !ok {
+		return common.Hash{}
+	}
+	leaf, err := EncodeLeaf(user, week, value)
+	if err != nil {
+		return common.Hash{}
+	}
+	return leaf
 }
 
 // HashPair 计算一对节点的父节点哈希
-// 按照 Solidity 的 abi.encodePacked 规则排序
+// 与 OpenZeppelin MerkleProof 的 commutativeKeccak256 一致：较小的哈希在前
 func HashPair(a, b common.Hash) common.Hash {
-	if a.Hex() < b.Hex() {
+	if bytes.Compare(a.Bytes(), b.Bytes()) < 0 {
 		return crypto.Keccak256Hash(a.Bytes(), b.Bytes())
 	}
 	return crypto.Keccak256Hash(b.Bytes(), a.Bytes())
 }
 
-// generateProof 为指定索引生成 Merkle 证明
+// treeIndex 返回叶子在扁平树数组中的下标
+func (mt *MerkleTree) treeIndex(leaf common.Hash) (int, bool) {
+	i := sort.Search(len(mt.Leaves), func(i int) bool {
+		return bytes.Compare(mt.Leaves[i].Bytes(), leaf.Bytes()) >= 0
+	})
+	if i == len(mt.Leaves) || mt.Leaves[i] != leaf {
+		return 0, false
+	}
+	return len(mt.Tree) - 1 - i, true
+}
+
+// generateProof 为扁平树数组中的节点生成 Merkle 证明（自底向上的兄弟节点）
 func (mt *MerkleTree) generateProof(index int) []common.Hash {
 	proof := []common.Hash{}
 
-	for levelIdx := 0; levelIdx < len(mt.Nodes)-1; levelIdx++ {
-		level := mt.Nodes[levelIdx]
-
-		// 计算兄弟节点索引
-		siblingIdx := index ^ 1
-
-		if siblingIdx < len(level) {
-			// 有兄弟节点
-			proof = append(proof, level[siblingIdx])
-		} else {
-			// 奇数个节点，最后一个节点与自己配对
-			proof = append(proof, level[index])
+	for index > 0 {
+		// 奇数下标为左子节点，兄弟在右侧
+		sibling := index - 1
+		if index%2 == 1 {
+			sibling = index + 1
 		}
+		proof = append(proof, mt.Tree[sibling])
 
-		// 移动到父层
-		index /= 2
+		// 移动到父节点
+		index = (index - 1) / 2
 	}
 
 	return proof
 }
 
-// VerifyProof 验证 Merkle 证明
+// VerifyProof 验证 Merkle 证明（与 OpenZeppelin MerkleProof.verify 一致）
 func VerifyProof(proof []common.Hash, root common.Hash, leaf common.Hash) bool {
 	computedHash := leaf
 
@@ -178,14 +240,18 @@ func SerializeProof(proof []common.Hash) string {
 
 // MerkleDistribution 表示完整的周度奖励分配
 type MerkleDistribution struct {
-	Week        uint64                       `json:"week"`
-	Root        string                       `json:"root"`
-	TotalAmount string                       `json:"totalAmount"`
-	Recipients  int                          `json:"recipients"`
-	ScaleBps    uint64                       `json:"scaleBps"`
-	Entries     []RewardEntry                `json:"entries"`
-	Proofs      map[string][]string          `json:"proofs"` // user -> hex proof
-	CreatedAt   int64                        `json:"createdAt"`
+	Week        uint64              `json:"week"`
+	Root        string              `json:"root"`
+	TotalAmount string              `json:"totalAmount"`
+	Recipients  int                 `json:"recipients"`
+	ScaleBps    uint64              `json:"scaleBps"`
+	Entries     []RewardEntry       `json:"entries"`
+	Proofs      map[string][]string `json:"proofs"`      // user -> hex proof
+	LeafIndexes map[string]int      `json:"leafIndexes"` // user -> 叶子下标（按哈希排序）
+	CreatedAt   int64               `json:"createdAt"`
+
+	// CategoryScaleBps 各奖励类别的预算缩放比例，已计入条目金额（此时 ScaleBps 为 10000）
+	CategoryScaleBps map[RewardType]uint64 `json:"categoryScaleBps,omitempty"`
 }
 
 // BuildDistribution 构建完整的分配数据
@@ -199,9 +265,12 @@ func BuildDistribution(week uint64, entries []RewardEntry, scaleBps uint64) (*Me
 		return nil, fmt.Errorf("failed to build merkle tree: %w", err)
 	}
 
-	// 计算总金额
-	totalAmount := "0"
-	// 简化：这里应该实际累加，但为了示例我们直接使用字符串
+	// 计算总金额（NewMerkleTree 已校验金额格式）
+	total := new(big.Int)
+	for _, entry := range entries {
+		amount, _ := new(big.Int).SetString(entry.Amount, 10)
+		total.Add(total, amount)
+	}
 
 	// 转换证明为十六进制字符串
 	proofs := make(map[string][]string)
@@ -212,11 +281,12 @@ func BuildDistribution(week uint64, entries []RewardEntry, scaleBps uint64) (*Me
 	return &MerkleDistribution{
 		Week:        week,
 		Root:        tree.Root.Hex(),
-		TotalAmount: totalAmount,
+		TotalAmount: total.String(),
 		Recipients:  len(entries),
 		ScaleBps:    scaleBps,
 		Entries:     entries,
 		Proofs:      proofs,
+		LeafIndexes: tree.Index,
 		CreatedAt:   0, // 调用方设置
 	}, nil
 }
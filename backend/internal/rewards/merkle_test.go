@@ -1,10 +1,16 @@
 package rewards
 
 import (
+	"bytes"
 	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -98,6 +104,99 @@ func TestHashPair(t *testing.T) {
 	assert.Equal(t, hash1, hash2, "HashPair should be order-independent")
 }
 
+// TestBuildTree_OpenZeppelinVector 使用 @openzeppelin/merkle-tree README 中的示例
+// StandardMerkleTree.of([[0x1111…, 5e18], [0x2222…, 2.5e18]], ["address", "uint256"])
+func TestBuildTree_OpenZeppelinVector(t *testing.T) {
+	args := abi.Arguments{{Type: mustNewType("address")}, {Type: mustNewType("uint256")}}
+	leaf := func(addr string, amount string) common.Hash {
+		value, _ := new(big.Int).SetString(amount, 10)
+		encoded, err := args.Pack(common.HexToAddress(addr), value)
+		require.NoError(t, err)
+		return crypto.Keccak256Hash(crypto.Keccak256(encoded))
+	}
+
+	leaves := []common.Hash{
+		leaf("0x1111111111111111111111111111111111111111", "5000000000000000000"),
+		leaf("0x2222222222222222222222222222222222222222", "2500000000000000000"),
+	}
+	sort.Slice(leaves, func(i, j int) bool { return bytes.Compare(leaves[i].Bytes(), leaves[j].Bytes()) < 0 })
+
+	tree := buildTree(leaves)
+	assert.Equal(t, "0xd4dee0beab2d53f2cc83e567171bd2820e49898130a22622b10ead383e90bd77", tree[0].Hex())
+}
+
+// TestBuildTree_Layout 验证扁平数组布局：叶子倒序存放在数组末尾
+func TestBuildTree_Layout(t *testing.T) {
+	l0 := common.HexToHash("0x01")
+	l1 := common.HexToHash("0x02")
+	l2 := common.HexToHash("0x03")
+
+	tree := buildTree([]common.Hash{l0, l1, l2})
+	require.Len(t, tree, 5)
+
+	assert.Equal(t, []common.Hash{l2, l1, l0}, tree[2:])
+	assert.Equal(t, HashPair(l1, l0), tree[1])
+	assert.Equal(t, HashPair(HashPair(l1, l0), l2), tree[0])
+}
+
+func TestEncodeLeaf(t *testing.T) {
+	user := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	amount := big.NewInt(1000)
+
+	leaf, err := EncodeLeaf(user, 256, amount)
+	require.NoError(t, err)
+
+	// abi.encode(address, uint256, uint256)：三个 32 字节字
+	encoded := append(common.LeftPadBytes(user.Bytes(), 32), common.LeftPadBytes(big.NewInt(256).Bytes(), 32)...)
+	encoded = append(encoded, common.LeftPadBytes(amount.Bytes(), 32)...)
+	assert.Equal(t, crypto.Keccak256Hash(crypto.Keccak256(encoded)), leaf)
+
+	// 周数不再按单字节编码
+	other, err := EncodeLeaf(user, 0, amount)
+	require.NoError(t, err)
+	assert.NotEqual(t, leaf, other)
+
+	// GenerateLeaf 将金额解析为 uint256 而非字符串字节
+	assert.Equal(t, leaf, GenerateLeaf(user, 256, "1000"))
+	assert.Equal(t, common.Hash{}, GenerateLeaf(user, 256, "1e3"))
+}
+
+func TestNewMerkleTree_InvalidAmount(t *testing.T) {
+	entries := []RewardEntry{
+		{User: common.HexToAddress("0x1111111111111111111111111111111111111111"), Week: 0, Amount: "1000"},
+		{User: common.HexToAddress("0x2222222222222222222222222222222222222222"), Week: 0, Amount: "abc"},
+	}
+
+	_, err := NewMerkleTree(entries)
+	assert.Error(t, err)
+}
+
+func TestLoadArtifact(t *testing.T) {
+	dir := t.TempDir()
+	abiJSON := `[{"type":"function","name":"owner","inputs":[],"outputs":[{"name":"","type":"address"}],"stateMutability":"view"}]`
+
+	// Foundry 格式
+	forge := filepath.Join(dir, "forge.json")
+	require.NoError(t, os.WriteFile(forge, []byte(`{"abi":`+abiJSON+`,"bytecode":{"object":"0x6080"}}`), 0644))
+	artifact, err := LoadArtifact(forge)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0x60, 0x80}, artifact.Bytecode)
+	assert.Contains(t, artifact.ABI.Methods, "owner")
+
+	// Hardhat 格式
+	hardhat := filepath.Join(dir, "hardhat.json")
+	require.NoError(t, os.WriteFile(hardhat, []byte(`{"abi":`+abiJSON+`,"bytecode":"0x6080"}`), 0644))
+	artifact, err = LoadArtifact(hardhat)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0x60, 0x80}, artifact.Bytecode)
+
+	// 缺少 bytecode
+	empty := filepath.Join(dir, "empty.json")
+	require.NoError(t, os.WriteFile(empty, []byte(`{"abi":`+abiJSON+`,"bytecode":{"object":"0x"}}`), 0644))
+	_, err = LoadArtifact(empty)
+	assert.Error(t, err)
+}
+
 func TestBuildDistribution(t *testing.T) {
 	entries := []RewardEntry{
 		{User: common.HexToAddress("0x1111111111111111111111111111111111111111"), Week: 0, Amount: "1000"},
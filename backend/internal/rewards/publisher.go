@@ -2,27 +2,32 @@ package rewards
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
 	"time"
 
-	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethclient"
+
+	"github.com/pitchone/sportsbook/pkg/bindings"
 )
 
+// ErrWeekAlreadyPublished 该周已在链上发布相同的 Root（重试时跳过发布）
+var ErrWeekAlreadyPublished = errors.New("week already published")
+
 // Publisher 负责将 Merkle Root 发布到链上
 type Publisher struct {
-	client           *ethclient.Client
-	distributorAddr  common.Address
-	privateKey       string
-	chainID          *big.Int
+	client          *ethclient.Client
+	distributor     *bindings.RewardsDistributor
+	distributorAddr common.Address
+	privateKey      string
+	chainID         *big.Int
 }
 
-// NewPublisher 创建发布器
+// NewPublisher 创建发布器；privateKey 为空时只能查询和导出 Safe 交易
 func NewPublisher(rpcURL string, distributorAddr common.Address, privateKey string) (*Publisher, error) {
 	client, err := ethclient.Dial(rpcURL)
 	if err != nil {
@@ -31,105 +36,102 @@ func NewPublisher(rpcURL string, distributorAddr common.Address, privateKey stri
 
 	chainID, err := client.ChainID(context.Background())
 	if err != nil {
+		client.Close()
 		return nil, fmt.Errorf("failed to get chain ID: %w", err)
 	}
 
+	distributor, err := bindings.NewRewardsDistributor(distributorAddr, client)
+	if err != nil {
+		client.Close()
+		return nil, fmt.Errorf("failed to bind RewardsDistributor: %w", err)
+	}
+
 	return &Publisher{
 		client:          client,
+		distributor:     distributor,
 		distributorAddr: distributorAddr,
 		privateKey:      privateKey,
 		chainID:         chainID,
 	}, nil
 }
 
-// PublishRoot 发布 Merkle Root 到 RewardsDistributor 合约
-func (p *Publisher) PublishRoot(ctx context.Context, dist *MerkleDistribution) (*types.Transaction, error) {
-	// 解析私钥
-	privateKey, err := crypto.HexToECDSA(p.privateKey)
+// CheckPublished 发布前检查该周是否已有 Root：
+// 未发布返回 false；已发布相同 Root 返回 true；已发布不同的 Root 返回错误
+func (p *Publisher) CheckPublished(ctx context.Context, dist *MerkleDistribution) (bool, error) {
+	published, err := p.GetPublishedRoot(ctx, dist.Week)
 	if err != nil {
-		return nil, fmt.Errorf("invalid private key: %w", err)
+		return false, err
 	}
+	return comparePublishedRoot(dist, published)
+}
 
-	// 创建交易选项
-	auth, err := bind.NewKeyedTransactorWithChainID(privateKey, p.chainID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create transactor: %w", err)
+// comparePublishedRoot 比较链上已发布的 Root 与本地分配
+func comparePublishedRoot(dist *MerkleDistribution, published common.Hash) (bool, error) {
+	if published == (common.Hash{}) {
+		return false, nil
 	}
-
-	// 设置 Gas 参数（可选，根据网络情况调整）
-	auth.GasLimit = 200000
-	auth.Context = ctx
-
-	// 调用合约的 publishRoot 函数
-	// function publishRoot(uint256 week, bytes32 merkleRoot, uint256 totalAmount, uint256 scaleBps)
-	rootHash := common.HexToHash(dist.Root)
-	totalAmount, ok := new(big.Int).SetString(dist.TotalAmount, 10)
-	if !ok {
-		return nil, fmt.Errorf("invalid total amount: %s", dist.TotalAmount)
+	if published != common.HexToHash(dist.Root) {
+		return false, fmt.Errorf("week %d already published with a different root: on-chain %s, local %s",
+			dist.Week, published.Hex(), dist.Root)
 	}
+	return true, nil
+}
 
-	// 构造调用数据
-	// 这里简化处理，实际应该使用生成的 ABI 绑定
-	// 示例：使用手动构造的调用数据
-	callData := packPublishRootData(dist.Week, rootHash, totalAmount, big.NewInt(int64(dist.ScaleBps)))
+// PublishRoot 发布 Merkle Root 到 RewardsDistributor 合约。
+// 该周已发布相同 Root 时返回 ErrWeekAlreadyPublished，不会重复发送交易。
+func (p *Publisher) PublishRoot(ctx context.Context, dist *MerkleDistribution) (*types.Transaction, error) {
+	if p.privateKey == "" {
+		return nil, fmt.Errorf("private key required to publish root")
+	}
 
-	// 发送交易
-	nonce, err := p.client.PendingNonceAt(ctx, auth.From)
+	published, err := p.CheckPublished(ctx, dist)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get nonce: %w", err)
+		return nil, err
+	}
+	if published {
+		return nil, fmt.Errorf("%w: week %d root %s", ErrWeekAlreadyPublished, dist.Week, dist.Root)
 	}
 
-	gasPrice, err := p.client.SuggestGasPrice(ctx)
+	args, err := newPublishRootArgs(dist)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get gas price: %w", err)
+		return nil, err
 	}
 
-	tx := types.NewTransaction(
-		nonce,
-		p.distributorAddr,
-		big.NewInt(0), // 不发送 ETH
-		auth.GasLimit,
-		gasPrice,
-		callData,
-	)
-
-	signedTx, err := types.SignTx(tx, types.NewEIP155Signer(p.chainID), privateKey)
+	auth, err := newTransactor(ctx, p.privateKey, p.chainID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to sign transaction: %w", err)
+		return nil, err
 	}
 
-	if err := p.client.SendTransaction(ctx, signedTx); err != nil {
-		return nil, fmt.Errorf("failed to send transaction: %w", err)
+	// Gas 由绑定通过 eth_estimateGas 估算，合约 revert（如 WeekAlreadyPublished）会在此处暴露
+	tx, err := p.distributor.PublishRoot(auth, args.Week, args.Root, args.TotalAmount, args.ScaleBps)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send publishRoot: %w", err)
 	}
 
-	return signedTx, nil
+	return tx, nil
 }
 
-// packPublishRootData 打包 publishRoot 调用数据
-// function publishRoot(uint256 week, bytes32 merkleRoot, uint256 totalAmount, uint256 scaleBps)
-func packPublishRootData(week uint64, root common.Hash, totalAmount, scaleBps *big.Int) []byte {
-	// 函数选择器: keccak256("publishRoot(uint256,bytes32,uint256,uint256)")[:4]
-	// 简化处理，这里硬编码（实际应该从 ABI 生成）
-	selector := crypto.Keccak256([]byte("publishRoot(uint256,bytes32,uint256,uint256)"))[:4]
-
-	// 编码参数
-	data := make([]byte, 4+32*4) // 4 bytes selector + 4 * 32 bytes params
-	copy(data[0:4], selector)
-
-	// week (uint256)
-	weekBig := new(big.Int).SetUint64(week)
-	copy(data[4:36], common.LeftPadBytes(weekBig.Bytes(), 32))
-
-	// merkleRoot (bytes32)
-	copy(data[36:68], root.Bytes())
-
-	// totalAmount (uint256)
-	copy(data[68:100], common.LeftPadBytes(totalAmount.Bytes(), 32))
+// publishRootArgs publishRoot(uint256 week, bytes32 merkleRoot, uint256 totalAmount, uint256 scaleBps) 参数
+type publishRootArgs struct {
+	Week        *big.Int
+	Root        [32]byte
+	TotalAmount *big.Int
+	ScaleBps    *big.Int
+}
 
-	// scaleBps (uint256)
-	copy(data[100:132], common.LeftPadBytes(scaleBps.Bytes(), 32))
+// newPublishRootArgs 从分配数据构造 publishRoot 参数
+func newPublishRootArgs(dist *MerkleDistribution) (*publishRootArgs, error) {
+	totalAmount, ok := new(big.Int).SetString(dist.TotalAmount, 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid total amount: %s", dist.TotalAmount)
+	}
 
-	return data
+	return &publishRootArgs{
+		Week:        new(big.Int).SetUint64(dist.Week),
+		Root:        common.HexToHash(dist.Root),
+		TotalAmount: totalAmount,
+		ScaleBps:    new(big.Int).SetUint64(dist.ScaleBps),
+	}, nil
 }
 
 // WaitForConfirmation 等待交易确认
@@ -176,35 +178,56 @@ func (p *Publisher) WaitForConfirmation(ctx context.Context, tx *types.Transacti
 	return receipt, nil
 }
 
-// GetPublishedRoot 从合约查询已发布的 Root
-func (p *Publisher) GetPublishedRoot(ctx context.Context, week uint64) (common.Hash, error) {
-	// 调用合约的 weeklyRewards(week) 函数
-	// function weeklyRewards(uint256) returns (bytes32 merkleRoot, ...)
+// VerifyReceipt 校验回执中包含与分配数据一致的 RewardsRootPublished 事件
+func (p *Publisher) VerifyReceipt(receipt *types.Receipt, dist *MerkleDistribution) error {
+	args, err := newPublishRootArgs(dist)
+	if err != nil {
+		return err
+	}
 
-	// 构造调用数据
-	selector := crypto.Keccak256([]byte("weeklyRewards(uint256)"))[:4]
-	data := make([]byte, 4+32)
-	copy(data[0:4], selector)
-	weekBig := new(big.Int).SetUint64(week)
-	copy(data[4:36], common.LeftPadBytes(weekBig.Bytes(), 32))
+	for _, log := range receipt.Logs {
+		if log.Address != p.distributorAddr {
+			continue
+		}
+		event, err := p.distributor.ParseRewardsRootPublished(*log)
+		if err != nil {
+			continue // 其他事件
+		}
+		if event.Week.Cmp(args.Week) != 0 {
+			continue
+		}
 
-	// 调用合约
-	msg := ethereum.CallMsg{
-		To:   &p.distributorAddr,
-		Data: data,
+		if event.MerkleRoot != args.Root {
+			return fmt.Errorf("RewardsRootPublished root mismatch: expected %s, got %s",
+				dist.Root, common.Hash(event.MerkleRoot).Hex())
+		}
+		if event.TotalAmount.Cmp(args.TotalAmount) != 0 {
+			return fmt.Errorf("RewardsRootPublished total amount mismatch: expected %s, got %s",
+				args.TotalAmount, event.TotalAmount)
+		}
+		if event.ScaleBps.Cmp(args.ScaleBps) != 0 {
+			return fmt.Errorf("RewardsRootPublished scale mismatch: expected %s, got %s",
+				args.ScaleBps, event.ScaleBps)
+		}
+		return nil
 	}
 
-	result, err := p.client.CallContract(ctx, msg, nil)
+	return fmt.Errorf("no RewardsRootPublished event for week %d in transaction %s", dist.Week, receipt.TxHash.Hex())
+}
+
+// GetPublishedRoot 从合约查询已发布的 Root（未发布时为零值）
+func (p *Publisher) GetPublishedRoot(ctx context.Context, week uint64) (common.Hash, error) {
+	reward, err := p.distributor.WeeklyRewards(&bind.CallOpts{Context: ctx}, new(big.Int).SetUint64(week))
 	if err != nil {
-		return common.Hash{}, fmt.Errorf("failed to call contract: %w", err)
+		return common.Hash{}, fmt.Errorf("failed to call weeklyRewards: %w", err)
 	}
 
-	if len(result) < 32 {
-		return common.Hash{}, fmt.Errorf("invalid response length: %d", len(result))
-	}
+	return common.Hash(reward.MerkleRoot), nil
+}
 
-	// 解析 merkleRoot (第一个返回值)
-	return common.BytesToHash(result[0:32]), nil
+// SafeTransaction 导出未签名的 publishRoot 交易（Safe Transaction Builder 格式），由多签执行
+func (p *Publisher) SafeTransaction(dist *MerkleDistribution, safe common.Address) (*SafeBatch, error) {
+	return NewPublishRootSafeBatch(p.chainID, p.distributorAddr, safe, dist)
 }
 
 // Close 关闭连接
@@ -0,0 +1,133 @@
+package rewards
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pitchone/sportsbook/pkg/bindings"
+)
+
+var testDistributor = common.HexToAddress("0xdddddddddddddddddddddddddddddddddddddddd")
+
+func publishedDistribution() *MerkleDistribution {
+	return &MerkleDistribution{
+		Week:        12,
+		Root:        "0x1111111111111111111111111111111111111111111111111111111111111111",
+		TotalAmount: "1500000",
+		Recipients:  2,
+		ScaleBps:    10000,
+	}
+}
+
+// rootPublishedLog 构造 RewardsRootPublished 事件日志
+func rootPublishedLog(t *testing.T, week uint64, root common.Hash, total, scaleBps int64) *types.Log {
+	distributorABI, err := bindings.RewardsDistributorMetaData.GetAbi()
+	require.NoError(t, err)
+	event := distributorABI.Events["RewardsRootPublished"]
+
+	data, err := event.Inputs.NonIndexed().Pack(root, big.NewInt(total), big.NewInt(scaleBps), big.NewInt(1700000000))
+	require.NoError(t, err)
+
+	return &types.Log{
+		Address: testDistributor,
+		Topics:  []common.Hash{event.ID, common.BigToHash(new(big.Int).SetUint64(week))},
+		Data:    data,
+	}
+}
+
+func testPublisher(t *testing.T) *Publisher {
+	distributor, err := bindings.NewRewardsDistributor(testDistributor, nil)
+	require.NoError(t, err)
+	return &Publisher{distributor: distributor, distributorAddr: testDistributor, chainID: big.NewInt(1)}
+}
+
+func TestComparePublishedRoot(t *testing.T) {
+	dist := publishedDistribution()
+
+	published, err := comparePublishedRoot(dist, common.Hash{})
+	require.NoError(t, err)
+	assert.False(t, published)
+
+	published, err = comparePublishedRoot(dist, common.HexToHash(dist.Root))
+	require.NoError(t, err)
+	assert.True(t, published)
+
+	_, err = comparePublishedRoot(dist, common.HexToHash("0x02"))
+	assert.ErrorContains(t, err, "different root")
+}
+
+func TestPublisher_VerifyReceipt(t *testing.T) {
+	publisher := testPublisher(t)
+	dist := publishedDistribution()
+	root := common.HexToHash(dist.Root)
+
+	// 其他合约与其他周的事件被忽略
+	other := rootPublishedLog(t, 12, root, 1500000, 10000)
+	other.Address = common.HexToAddress("0x01")
+	receipt := &types.Receipt{Logs: []*types.Log{
+		other,
+		rootPublishedLog(t, 11, common.HexToHash("0x02"), 1, 10000),
+		rootPublishedLog(t, 12, root, 1500000, 10000),
+	}}
+	require.NoError(t, publisher.VerifyReceipt(receipt, dist))
+
+	receipt.Logs = []*types.Log{rootPublishedLog(t, 12, root, 1400000, 10000)}
+	assert.ErrorContains(t, publisher.VerifyReceipt(receipt, dist), "total amount mismatch")
+
+	receipt.Logs = []*types.Log{other}
+	assert.ErrorContains(t, publisher.VerifyReceipt(receipt, dist), "no RewardsRootPublished event")
+}
+
+func TestNewPublishRootSafeBatch(t *testing.T) {
+	dist := publishedDistribution()
+	safe := common.HexToAddress("0x5afe5afe5afe5afe5afe5afe5afe5afe5afe5afe")
+
+	batch, err := NewPublishRootSafeBatch(big.NewInt(11155111), testDistributor, safe, dist)
+	require.NoError(t, err)
+
+	assert.Equal(t, "11155111", batch.ChainID)
+	assert.Equal(t, safe.Hex(), batch.Meta.CreatedFromSafeAddress)
+	require.Len(t, batch.Transactions, 1)
+
+	tx := batch.Transactions[0]
+	assert.Equal(t, testDistributor.Hex(), tx.To)
+	assert.Equal(t, "0", tx.Value)
+	assert.Equal(t, "publishRoot", tx.ContractMethod.Name)
+	assert.Len(t, tx.ContractMethod.Inputs, 4)
+	assert.Equal(t, map[string]string{
+		"week":        "12",
+		"merkleRoot":  dist.Root,
+		"totalAmount": "1500000",
+		"scaleBps":    "10000",
+	}, tx.ContractInputsValues)
+
+	// 调用数据可按 ABI 解码回原参数
+	distributorABI, err := bindings.RewardsDistributorMetaData.GetAbi()
+	require.NoError(t, err)
+	data, err := hexutil.Decode(tx.Data)
+	require.NoError(t, err)
+	method, err := distributorABI.MethodById(data[:4])
+	require.NoError(t, err)
+	assert.Equal(t, "publishRoot", method.Name)
+	args, err := method.Inputs.Unpack(data[4:])
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(12), args[0])
+	assert.Equal(t, [32]byte(common.HexToHash(dist.Root)), args[1])
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteSafeBatch(&buf, batch))
+	var decoded map[string]interface{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+	assert.Equal(t, "1.0", decoded["version"])
+
+	_, err = NewPublishRootSafeBatch(big.NewInt(1), testDistributor, common.Address{}, &MerkleDistribution{TotalAmount: "x"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,60 @@
+package rewards
+
+import (
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/pitchone/sportsbook/internal/safetx"
+	"github.com/pitchone/sportsbook/pkg/bindings"
+)
+
+// Safe Transaction Builder 批量交易类型（定义见 safetx 包）
+type (
+	SafeBatch       = safetx.Batch
+	SafeBatchMeta   = safetx.Meta
+	SafeTransaction = safetx.Transaction
+	SafeMethod      = safetx.Method
+	SafeMethodArg   = safetx.MethodArg
+)
+
+// NewPublishRootSafeBatch 构造 publishRoot 的 Safe 批量交易；safe 为零地址时不填写来源 Safe
+func NewPublishRootSafeBatch(chainID *big.Int, distributor, safe common.Address, dist *MerkleDistribution) (*SafeBatch, error) {
+	args, err := newPublishRootArgs(dist)
+	if err != nil {
+		return nil, err
+	}
+
+	distributorABI, err := bindings.RewardsDistributorMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load RewardsDistributor ABI: %w", err)
+	}
+	method := distributorABI.Methods["publishRoot"]
+
+	data, err := distributorABI.Pack(method.Name, args.Week, args.Root, args.TotalAmount, args.ScaleBps)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack publishRoot: %w", err)
+	}
+
+	tx := safetx.NewTransaction(distributor, method, data, map[string]string{
+		"week":        args.Week.String(),
+		"merkleRoot":  common.Hash(args.Root).Hex(),
+		"totalAmount": args.TotalAmount.String(),
+		"scaleBps":    args.ScaleBps.String(),
+	})
+
+	return safetx.NewBatch(
+		chainID,
+		safe,
+		fmt.Sprintf("Publish rewards root for week %d", dist.Week),
+		fmt.Sprintf("root %s, %d recipients, total %s, scale %d bps", dist.Root, dist.Recipients, dist.TotalAmount, dist.ScaleBps),
+		tx,
+	), nil
+}
+
+// WriteSafeBatch 以 JSON 格式导出 Safe 批量交易
+func WriteSafeBatch(w io.Writer, batch *SafeBatch) error {
+	return safetx.Write(w, batch)
+}
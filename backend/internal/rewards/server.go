@@ -0,0 +1,254 @@
+package rewards
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/pitchone/sportsbook/internal/repository"
+)
+
+// ProofStore 已持久化证明的读取接口（由 repository.RewardsRepository 实现）
+// 只返回 Root 已发布上链的周，未发布的周领取会以 WeekNotPublished 回滚
+type ProofStore interface {
+	GetProof(ctx context.Context, week uint64, userAddress string) (*repository.RewardProof, error)
+	ListProofs(ctx context.Context, userAddress string) ([]repository.RewardProof, error)
+}
+
+// ClaimStatusReader 链上领取状态读取接口（由 ClaimReader 实现）
+type ClaimStatusReader interface {
+	GetBatchClaimed(ctx context.Context, user common.Address, weeks []uint64) ([]*big.Int, error)
+}
+
+// ProofResponse 单周奖励及证明
+type ProofResponse struct {
+	Week          uint64   `json:"week"`
+	User          string   `json:"user"`
+	Amount        string   `json:"amount"`    // Merkle 叶子中的金额（claim 参数）
+	Claimable     string   `json:"claimable"` // amount * scaleBps / 10000
+	ScaleBps      uint64   `json:"scaleBps"`
+	Claimed       bool     `json:"claimed"`       // getBatchClaimed > 0
+	ClaimedAmount string   `json:"claimedAmount"` // getBatchClaimed 返回值
+	Proof         []string `json:"proof"`
+	MerkleRoot    string   `json:"merkleRoot"`
+}
+
+// ClaimableResponse 用户所有未领取的奖励
+type ClaimableResponse struct {
+	User           string          `json:"user"`
+	TotalClaimable string          `json:"totalClaimable"`
+	Rewards        []ProofResponse `json:"rewards"`
+}
+
+// ProofServer 奖励证明查询服务
+//
+// 路由：
+//
+//	GET /rewards/{week}/{address}       指定周的金额、证明与领取状态
+//	GET /rewards/{address}/claimable    所有未领取周的证明（可直接用于 batchClaim）
+//
+// 响应携带 ETag，客户端可通过 If-None-Match 获取 304。
+type ProofServer struct {
+	store  ProofStore
+	claims ClaimStatusReader
+}
+
+// NewProofServer 创建证明查询服务
+func NewProofServer(store ProofStore, claims ClaimStatusReader) *ProofServer {
+	return &ProofServer{
+		store:  store,
+		claims: claims,
+	}
+}
+
+// Handler 返回 HTTP 处理器
+func (s *ProofServer) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /rewards/{week}/{address}", s.handleGetProof)
+	mux.HandleFunc("GET /rewards/{address}/claimable", s.handleClaimable)
+	return mux
+}
+
+// Run 启动服务，直到 ctx 取消
+func (s *ProofServer) Run(ctx context.Context, addr string) error {
+	server := &http.Server{
+		Addr:              addr,
+		Handler:           s.Handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	errChan := make(chan error, 1)
+	go func() {
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			errChan <- err
+		}
+		close(errChan)
+	}()
+
+	log.Printf("Rewards proof server listening on %s", addr)
+
+	select {
+	case err := <-errChan:
+		return fmt.Errorf("proof server error: %w", err)
+	case <-ctx.Done():
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	return server.Shutdown(shutdownCtx)
+}
+
+func (s *ProofServer) handleGetProof(w http.ResponseWriter, r *http.Request) {
+	week, err := strconv.ParseUint(r.PathValue("week"), 10, 64)
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid week")
+		return
+	}
+	user, ok := parseAddress(r.PathValue("address"))
+	if !ok {
+		writeJSONError(w, http.StatusBadRequest, "invalid address")
+		return
+	}
+
+	proof, err := s.store.GetProof(r.Context(), week, user.Hex())
+	if errors.Is(err, repository.ErrRewardProofNotFound) {
+		writeJSONError(w, http.StatusNotFound, fmt.Sprintf("no reward for %s in week %d", user.Hex(), week))
+		return
+	}
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	claimed, err := s.claims.GetBatchClaimed(r.Context(), user, []uint64{week})
+	if err != nil {
+		writeJSONError(w, http.StatusBadGateway, err.Error())
+		return
+	}
+
+	writeCachedJSON(w, r, newProofResponse(user, proof, claimed[0]))
+}
+
+func (s *ProofServer) handleClaimable(w http.ResponseWriter, r *http.Request) {
+	user, ok := parseAddress(r.PathValue("address"))
+	if !ok {
+		writeJSONError(w, http.StatusBadRequest, "invalid address")
+		return
+	}
+
+	proofs, err := s.store.ListProofs(r.Context(), user.Hex())
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	weeks := make([]uint64, len(proofs))
+	for i, proof := range proofs {
+		weeks[i] = proof.Week
+	}
+
+	claimed, err := s.claims.GetBatchClaimed(r.Context(), user, weeks)
+	if err != nil {
+		writeJSONError(w, http.StatusBadGateway, err.Error())
+		return
+	}
+
+	resp := ClaimableResponse{User: user.Hex(), Rewards: []ProofResponse{}}
+	total := new(big.Int)
+	for i := range proofs {
+		reward := newProofResponse(user, &proofs[i], claimed[i])
+		if reward.Claimed {
+			continue
+		}
+		if amount, ok := new(big.Int).SetString(reward.Claimable, 10); ok {
+			total.Add(total, amount)
+		}
+		resp.Rewards = append(resp.Rewards, reward)
+	}
+	resp.TotalClaimable = total.String()
+
+	writeCachedJSON(w, r, resp)
+}
+
+// newProofResponse 组合持久化证明与链上领取状态
+func newProofResponse(user common.Address, proof *repository.RewardProof, claimedAmount *big.Int) ProofResponse {
+	claimable := "0"
+	if amount, ok := new(big.Int).SetString(proof.Amount, 10); ok {
+		scaled := new(big.Int).Mul(amount, new(big.Int).SetUint64(proof.ScaleBps))
+		claimable = scaled.Div(scaled, big.NewInt(10000)).String()
+	}
+
+	return ProofResponse{
+		Week:          proof.Week,
+		User:          user.Hex(),
+		Amount:        proof.Amount,
+		Claimable:     claimable,
+		ScaleBps:      proof.ScaleBps,
+		Claimed:       claimedAmount.Sign() > 0,
+		ClaimedAmount: claimedAmount.String(),
+		Proof:         proof.Proof,
+		MerkleRoot:    proof.MerkleRoot,
+	}
+}
+
+// parseAddress 解析路径中的地址
+func parseAddress(raw string) (common.Address, bool) {
+	if !common.IsHexAddress(raw) {
+		return common.Address{}, false
+	}
+	return common.HexToAddress(raw), true
+}
+
+// writeCachedJSON 写入带 ETag 的 JSON 响应，If-None-Match 命中时返回 304
+// 领取状态随链上变化，因此使用 no-cache 要求客户端每次重新校验
+func writeCachedJSON(w http.ResponseWriter, r *http.Request, v interface{}) {
+	body, err := json.Marshal(v)
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	sum := sha256.Sum256(body)
+	etag := `"` + hex.EncodeToString(sum[:16]) + `"`
+
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", "no-cache")
+
+	if etagMatches(r.Header.Get("If-None-Match"), etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(append(body, '\n'))
+}
+
+// etagMatches 检查 If-None-Match 是否包含当前 ETag（忽略弱校验前缀）
+func etagMatches(header, etag string) bool {
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// writeJSONError 写入 JSON 错误响应
+func writeJSONError(w http.ResponseWriter, code int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(map[string]string{"error": message})
+}
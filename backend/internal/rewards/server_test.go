@@ -0,0 +1,153 @@
+package rewards
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pitchone/sportsbook/internal/repository"
+)
+
+// fakeProofStore 内存证明存储
+type fakeProofStore struct {
+	proofs []repository.RewardProof
+}
+
+func (f *fakeProofStore) GetProof(ctx context.Context, week uint64, userAddress string) (*repository.RewardProof, error) {
+	for i := range f.proofs {
+		if f.proofs[i].Week == week && strings.EqualFold(f.proofs[i].UserAddress, userAddress) {
+			return &f.proofs[i], nil
+		}
+	}
+	return nil, repository.ErrRewardProofNotFound
+}
+
+func (f *fakeProofStore) ListProofs(ctx context.Context, userAddress string) ([]repository.RewardProof, error) {
+	var out []repository.RewardProof
+	for _, p := range f.proofs {
+		if strings.EqualFold(p.UserAddress, userAddress) {
+			out = append(out, p)
+		}
+	}
+	return out, nil
+}
+
+// fakeClaims 按周返回已领取金额
+type fakeClaims struct {
+	claimed map[uint64]*big.Int
+}
+
+func (f *fakeClaims) GetBatchClaimed(ctx context.Context, user common.Address, weeks []uint64) ([]*big.Int, error) {
+	out := make([]*big.Int, len(weeks))
+	for i, week := range weeks {
+		out[i] = new(big.Int)
+		if amount, ok := f.claimed[week]; ok {
+			out[i] = amount
+		}
+	}
+	return out, nil
+}
+
+const testUser = "0x1111111111111111111111111111111111111111"
+
+func newTestProofServer() (*ProofServer, *fakeClaims) {
+	store := &fakeProofStore{proofs: []repository.RewardProof{
+		{Week: 3, UserAddress: testUser, Amount: "2000", Proof: []string{"0xaa"}, MerkleRoot: "0x03", ScaleBps: 5000},
+		{Week: 2, UserAddress: testUser, Amount: "1000", Proof: []string{"0xbb"}, MerkleRoot: "0x02", ScaleBps: 10000},
+		{Week: 1, UserAddress: testUser, Amount: "500", Proof: []string{"0xcc"}, MerkleRoot: "0x01", ScaleBps: 10000},
+	}}
+	claims := &fakeClaims{claimed: map[uint64]*big.Int{1: big.NewInt(500)}}
+	return NewProofServer(store, claims), claims
+}
+
+func doRequest(h http.Handler, path string, header map[string]string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	for k, v := range header {
+		req.Header.Set(k, v)
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestProofServer_GetProof(t *testing.T) {
+	server, _ := newTestProofServer()
+	h := server.Handler()
+
+	// 地址大小写不敏感
+	rec := doRequest(h, "/rewards/3/"+strings.ToUpper(testUser[2:]), nil)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	var resp ProofResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	assert.Equal(t, uint64(3), resp.Week)
+	assert.Equal(t, common.HexToAddress(testUser).Hex(), resp.User)
+	assert.Equal(t, "2000", resp.Amount)
+	assert.Equal(t, "1000", resp.Claimable)
+	assert.False(t, resp.Claimed)
+	assert.Equal(t, "0", resp.ClaimedAmount)
+	assert.Equal(t, []string{"0xaa"}, resp.Proof)
+	assert.NotEmpty(t, rec.Header().Get("ETag"))
+
+	rec = doRequest(h, "/rewards/1/"+testUser, nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	assert.True(t, resp.Claimed)
+	assert.Equal(t, "500", resp.ClaimedAmount)
+
+	assert.Equal(t, http.StatusNotFound, doRequest(h, "/rewards/9/"+testUser, nil).Code)
+	assert.Equal(t, http.StatusBadRequest, doRequest(h, "/rewards/x/"+testUser, nil).Code)
+	assert.Equal(t, http.StatusBadRequest, doRequest(h, "/rewards/3/0x1234", nil).Code)
+}
+
+func TestProofServer_Claimable(t *testing.T) {
+	server, _ := newTestProofServer()
+
+	rec := doRequest(server.Handler(), "/rewards/"+testUser+"/claimable", nil)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	var resp ClaimableResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+
+	// 第 1 周已领取，不再返回
+	require.Len(t, resp.Rewards, 2)
+	assert.Equal(t, uint64(3), resp.Rewards[0].Week)
+	assert.Equal(t, uint64(2), resp.Rewards[1].Week)
+	assert.Equal(t, "2000", resp.TotalClaimable)
+
+	// 没有奖励的用户返回空列表
+	rec = doRequest(server.Handler(), "/rewards/0x2222222222222222222222222222222222222222/claimable", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	assert.Empty(t, resp.Rewards)
+	assert.Equal(t, "0", resp.TotalClaimable)
+}
+
+func TestProofServer_ETag(t *testing.T) {
+	server, claims := newTestProofServer()
+	h := server.Handler()
+	path := "/rewards/" + testUser + "/claimable"
+
+	first := doRequest(h, path, nil)
+	etag := first.Header().Get("ETag")
+	require.NotEmpty(t, etag)
+	assert.Equal(t, "no-cache", first.Header().Get("Cache-Control"))
+
+	cached := doRequest(h, path, map[string]string{"If-None-Match": etag})
+	assert.Equal(t, http.StatusNotModified, cached.Code)
+	assert.Empty(t, cached.Body.String())
+
+	// 领取后内容变化，ETag 失效
+	claims.claimed[2] = big.NewInt(1000)
+	changed := doRequest(h, path, map[string]string{"If-None-Match": etag})
+	assert.Equal(t, http.StatusOK, changed.Code)
+	assert.NotEqual(t, etag, changed.Header().Get("ETag"))
+}
@@ -0,0 +1,371 @@
+package rewards
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/viper"
+
+	"github.com/pitchone/sportsbook/internal/graphql"
+)
+
+// 交易量计算方式
+const (
+	VolumeModeGross = "gross" // 所有订单金额之和
+	VolumeModeNet   = "net"   // 每个市场的净敞口：最大方向金额减去其余方向金额
+)
+
+// 订单/市场被排除的原因
+const (
+	ExcludedLowOdds      = "low_odds"      // 赔率低于 min_odds
+	ExcludedSelfReferral = "self_referral" // 推荐人为本人或关联地址
+	ExcludedHedged       = "hedged"        // 同一市场下注了多个方向
+	ExcludedLinkedFlow   = "linked_flow"   // 关联地址在同一市场下注了不同方向
+)
+
+// VolumeTier 交易量档位，加权交易量达到 MinVolume 时按 RateBps 计算奖励
+type VolumeTier struct {
+	MinVolume uint64 `mapstructure:"min_volume" json:"minVolume"` // USDC 最小单位（6 位小数）
+	RateBps   uint64 `mapstructure:"rate_bps" json:"rateBps"`     // 奖励 = 加权交易量 * RateBps / 10000
+}
+
+// TradingPolicy 交易奖励策略
+//
+// 每个用户的奖励计算步骤：
+//  1. 过滤低赔率订单和自我推荐订单
+//  2. 按市场汇总各方向金额，按 VolumeMode 计算市场交易量
+//  3. 排除对冲市场（本人多方向）和关联地址对倒市场（关联地址不同方向）
+//  4. 市场交易量乘以模板与联赛系数（bps）得到加权交易量
+//  5. 按加权交易量所在档位的比例计算奖励，低于最低档位不发放
+type TradingPolicy struct {
+	VolumeMode string       `mapstructure:"volume_mode" json:"volumeMode"`
+	Tiers      []VolumeTier `mapstructure:"tiers" json:"tiers"`
+
+	// 模板 / 联赛系数（10000 = 1x），键不区分大小写，未配置时为 10000
+	TemplateMultiplierBps map[string]uint64 `mapstructure:"template_multiplier_bps" json:"templateMultiplierBps,omitempty"`
+	LeagueMultiplierBps   map[string]uint64 `mapstructure:"league_multiplier_bps" json:"leagueMultiplierBps,omitempty"`
+
+	// MinOdds 最低小数赔率（如 1.2），0 表示不限制；赔率未知的订单不受限制
+	MinOdds float64 `mapstructure:"min_odds" json:"minOdds"`
+
+	ExcludeHedged       bool `mapstructure:"exclude_hedged" json:"excludeHedged"`
+	ExcludeSelfReferral bool `mapstructure:"exclude_self_referral" json:"excludeSelfReferral"`
+	ExcludeLinkedFlows  bool `mapstructure:"exclude_linked_flows" json:"excludeLinkedFlows"`
+
+	// LinkedAddresses 已知关联的地址组（同一实体控制的多个地址）
+	LinkedAddresses [][]string `mapstructure:"linked_addresses" json:"linkedAddresses,omitempty"`
+}
+
+// DefaultTradingPolicy 默认策略：净敞口交易量达到 1000 USDC 后按 0.1% 发放，
+// 排除对冲市场、自我推荐与关联地址对倒。总交易量（VolumeModeGross）需在策略文件中显式开启
+func DefaultTradingPolicy() *TradingPolicy {
+	return &TradingPolicy{
+		VolumeMode:          VolumeModeNet,
+		Tiers:               []VolumeTier{{MinVolume: 1000000000, RateBps: 10}},
+		ExcludeHedged:       true,
+		ExcludeSelfReferral: true,
+		ExcludeLinkedFlows:  true,
+	}
+}
+
+// LoadTradingPolicy 从配置文件（YAML/JSON）加载交易奖励策略，未配置的字段使用默认值
+func LoadTradingPolicy(path string) (*TradingPolicy, error) {
+	v := viper.New()
+	v.SetConfigFile(path)
+	if err := v.ReadInConfig(); err != nil {
+		return nil, fmt.Errorf("failed to read trading policy: %w", err)
+	}
+
+	policy := DefaultTradingPolicy()
+	if err := v.Unmarshal(policy); err != nil {
+		return nil, fmt.Errorf("failed to parse trading policy: %w", err)
+	}
+	if err := policy.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid trading policy %s: %w", path, err)
+	}
+
+	return policy, nil
+}
+
+// Validate 校验策略配置
+func (p *TradingPolicy) Validate() error {
+	if p.VolumeMode != VolumeModeGross && p.VolumeMode != VolumeModeNet {
+		return fmt.Errorf("volume_mode must be %q or %q, got %q", VolumeModeGross, VolumeModeNet, p.VolumeMode)
+	}
+	if len(p.Tiers) == 0 {
+		return fmt.Errorf("at least one tier is required")
+	}
+	for i, tier := range p.Tiers {
+		if tier.RateBps > 10000 {
+			return fmt.Errorf("tier %d: rate_bps must be <= 10000", i)
+		}
+		if i > 0 && tier.MinVolume <= p.Tiers[i-1].MinVolume {
+			return fmt.Errorf("tier %d: min_volume must be greater than the previous tier", i)
+		}
+	}
+	if p.MinOdds < 0 {
+		return fmt.Errorf("min_odds must be >= 0")
+	}
+	for i, group := range p.LinkedAddresses {
+		for _, addr := range group {
+			if !common.IsHexAddress(addr) {
+				return fmt.Errorf("linked_addresses[%d]: invalid address %q", i, addr)
+			}
+		}
+	}
+	return nil
+}
+
+// TradingOrder 参与交易奖励计算的订单
+type TradingOrder struct {
+	User     common.Address
+	Market   common.Address
+	Template string
+	League   string
+	Outcome  int
+	Amount   *big.Int       // USDC 最小单位
+	Odds     float64        // 小数赔率，0 表示未知
+	Referrer common.Address // 零地址表示无推荐人
+}
+
+// tradingOrderFromGraph 将 Subgraph 订单转换为 TradingOrder。
+// Subgraph 的 Order.referrer 目前恒为 null，推荐人取自 User.referrer
+// （由 ReferralRegistry 的 ReferralBound 事件写入）；Order.referrer 有值时优先使用
+func tradingOrderFromGraph(order graphql.Order) TradingOrder {
+	tradingOrder := TradingOrder{
+		User:     order.User.Address(),
+		Market:   order.Market.Address(),
+		Template: order.Market.TemplateID,
+		League:   leagueFromMatchID(order.Market.MatchID),
+		Outcome:  order.Outcome,
+		Amount:   graphql.ParseDecimal(order.Amount, 6),
+	}
+
+	// price 为隐含概率，赔率 = 1 / price
+	if price, err := strconv.ParseFloat(order.Price, 64); err == nil && price > 0 {
+		tradingOrder.Odds = 1 / price
+	}
+	if common.IsHexAddress(order.Referrer) {
+		tradingOrder.Referrer = common.HexToAddress(order.Referrer)
+	} else if common.IsHexAddress(order.User.Referrer) {
+		tradingOrder.Referrer = common.HexToAddress(order.User.Referrer)
+	}
+
+	return tradingOrder
+}
+
+// leagueFromMatchID 从赛事 ID 中提取联赛代码（EPL_2024_MUN_vs_MCI -> EPL）
+func leagueFromMatchID(matchID string) string {
+	league, _, _ := strings.Cut(matchID, "_")
+	return strings.ToUpper(league)
+}
+
+// MarketVolume 单个市场对交易量的贡献
+type MarketVolume struct {
+	Market        common.Address   `json:"market"`
+	Template      string           `json:"template"`
+	League        string           `json:"league"`
+	Outcomes      map[int]*big.Int `json:"outcomes"`      // 各方向金额（已过滤订单不计入）
+	Volume        *big.Int         `json:"volume"`        // 按 VolumeMode 计算的交易量
+	MultiplierBps uint64           `json:"multiplierBps"` // 模板系数 * 联赛系数
+	Weighted      *big.Int         `json:"weighted"`      // 计入档位的交易量
+	Excluded      string           `json:"excluded,omitempty"`
+}
+
+// TradingExplanation 用户交易奖励的计算明细
+type TradingExplanation struct {
+	User           common.Address  `json:"user"`
+	GrossVolume    *big.Int        `json:"grossVolume"`    // 所有订单金额
+	WeightedVolume *big.Int        `json:"weightedVolume"` // 各市场加权交易量之和
+	Tier           *VolumeTier     `json:"tier"`           // 命中的档位，未达到最低档位时为 nil
+	Reward         *big.Int        `json:"reward"`
+	Markets        []*MarketVolume `json:"markets"`
+	ExcludedOrders map[string]int  `json:"excludedOrders,omitempty"` // 原因 -> 订单数
+}
+
+// Evaluate 按策略计算每个用户的交易奖励及明细
+func (p *TradingPolicy) Evaluate(orders []TradingOrder) map[common.Address]*TradingExplanation {
+	linked := newLinkGroups(p.LinkedAddresses)
+
+	explanations := make(map[common.Address]*TradingExplanation)
+	markets := make(map[common.Address]map[common.Address]*MarketVolume)
+	groupOutcomes := make(map[common.Address]map[common.Address]map[int]bool) // group -> market -> outcomes
+
+	for _, order := range orders {
+		explanation, ok := explanations[order.User]
+		if !ok {
+			explanation = &TradingExplanation{
+				User:           order.User,
+				GrossVolume:    new(big.Int),
+				WeightedVolume: new(big.Int),
+				Reward:         new(big.Int),
+				ExcludedOrders: make(map[string]int),
+			}
+			explanations[order.User] = explanation
+			markets[order.User] = make(map[common.Address]*MarketVolume)
+		}
+		explanation.GrossVolume.Add(explanation.GrossVolume, order.Amount)
+
+		if p.MinOdds > 0 && order.Odds > 0 && order.Odds < p.MinOdds {
+			explanation.ExcludedOrders[ExcludedLowOdds]++
+			continue
+		}
+		if p.ExcludeSelfReferral && order.Referrer != (common.Address{}) && linked.same(order.User, order.Referrer) {
+			explanation.ExcludedOrders[ExcludedSelfReferral]++
+			continue
+		}
+
+		market, ok := markets[order.User][order.Market]
+		if !ok {
+			market = &MarketVolume{
+				Market:   order.Market,
+				Template: order.Template,
+				League:   order.League,
+				Outcomes: make(map[int]*big.Int),
+			}
+			markets[order.User][order.Market] = market
+		}
+		if _, ok := market.Outcomes[order.Outcome]; !ok {
+			market.Outcomes[order.Outcome] = new(big.Int)
+		}
+		market.Outcomes[order.Outcome].Add(market.Outcomes[order.Outcome], order.Amount)
+
+		group := linked.root(order.User)
+		if groupOutcomes[group] == nil {
+			groupOutcomes[group] = make(map[common.Address]map[int]bool)
+		}
+		if groupOutcomes[group][order.Market] == nil {
+			groupOutcomes[group][order.Market] = make(map[int]bool)
+		}
+		groupOutcomes[group][order.Market][order.Outcome] = true
+	}
+
+	for user, explanation := range explanations {
+		for _, market := range markets[user] {
+			market.Volume = p.marketVolume(market.Outcomes)
+			market.MultiplierBps = p.multiplierBps(market.Template, market.League)
+			market.Weighted = new(big.Int)
+
+			switch {
+			case p.ExcludeHedged && len(market.Outcomes) > 1:
+				market.Excluded = ExcludedHedged
+			case p.ExcludeLinkedFlows && linked.linked(user) && len(groupOutcomes[linked.root(user)][market.Market]) > 1:
+				market.Excluded = ExcludedLinkedFlow
+			default:
+				market.Weighted.Mul(market.Volume, new(big.Int).SetUint64(market.MultiplierBps))
+				market.Weighted.Div(market.Weighted, big.NewInt(10000))
+			}
+
+			explanation.WeightedVolume.Add(explanation.WeightedVolume, market.Weighted)
+			explanation.Markets = append(explanation.Markets, market)
+		}
+		sort.Slice(explanation.Markets, func(i, j int) bool {
+			return explanation.Markets[i].Market.Hex() < explanation.Markets[j].Market.Hex()
+		})
+
+		explanation.Tier = p.tierFor(explanation.WeightedVolume)
+		if explanation.Tier != nil {
+			explanation.Reward.Mul(explanation.WeightedVolume, new(big.Int).SetUint64(explanation.Tier.RateBps))
+			explanation.Reward.Div(explanation.Reward, big.NewInt(10000))
+		}
+	}
+
+	return explanations
+}
+
+// marketVolume 按 VolumeMode 计算单个市场的交易量
+func (p *TradingPolicy) marketVolume(outcomes map[int]*big.Int) *big.Int {
+	total := new(big.Int)
+	largest := new(big.Int)
+	for _, amount := range outcomes {
+		total.Add(total, amount)
+		if amount.Cmp(largest) > 0 {
+			largest = amount
+		}
+	}
+
+	if p.VolumeMode == VolumeModeGross {
+		return total
+	}
+
+	// 净敞口 = 最大方向 - 其余方向之和，不低于 0
+	net := new(big.Int).Sub(largest, new(big.Int).Sub(total, largest))
+	if net.Sign() < 0 {
+		net.SetInt64(0)
+	}
+	return net
+}
+
+// multiplierBps 模板系数与联赛系数的乘积（bps）
+func (p *TradingPolicy) multiplierBps(template, league string) uint64 {
+	return lookupBps(p.TemplateMultiplierBps, template) * lookupBps(p.LeagueMultiplierBps, league) / 10000
+}
+
+// lookupBps 不区分大小写查找系数，未配置时为 10000
+func lookupBps(multipliers map[string]uint64, key string) uint64 {
+	for k, bps := range multipliers {
+		if strings.EqualFold(k, key) {
+			return bps
+		}
+	}
+	return 10000
+}
+
+// tierFor 返回加权交易量命中的最高档位
+func (p *TradingPolicy) tierFor(volume *big.Int) *VolumeTier {
+	var matched *VolumeTier
+	for i := range p.Tiers {
+		if volume.Cmp(new(big.Int).SetUint64(p.Tiers[i].MinVolume)) >= 0 {
+			matched = &p.Tiers[i]
+		}
+	}
+	return matched
+}
+
+// linkGroups 关联地址并查集
+type linkGroups struct {
+	parent map[common.Address]common.Address
+}
+
+func newLinkGroups(groups [][]string) *linkGroups {
+	g := &linkGroups{parent: make(map[common.Address]common.Address)}
+	for _, group := range groups {
+		for i := 1; i < len(group); i++ {
+			g.union(common.HexToAddress(group[0]), common.HexToAddress(group[i]))
+		}
+	}
+	return g
+}
+
+func (g *linkGroups) root(addr common.Address) common.Address {
+	for {
+		parent, ok := g.parent[addr]
+		if !ok || parent == addr {
+			return addr
+		}
+		addr = parent
+	}
+}
+
+func (g *linkGroups) union(a, b common.Address) {
+	rootA, rootB := g.root(a), g.root(b)
+	if rootA != rootB {
+		g.parent[rootB] = rootA
+	}
+	g.parent[rootA] = rootA
+}
+
+// linked 地址是否属于某个关联组
+func (g *linkGroups) linked(addr common.Address) bool {
+	_, ok := g.parent[addr]
+	return ok
+}
+
+// same 两个地址是否为同一地址或属于同一关联组
+func (g *linkGroups) same(a, b common.Address) bool {
+	return a == b || g.root(a) == g.root(b)
+}
@@ -0,0 +1,271 @@
+package rewards
+
+import (
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pitchone/sportsbook/internal/graphql"
+)
+
+var (
+	traderA = common.HexToAddress("0x1111111111111111111111111111111111111111")
+	traderB = common.HexToAddress("0x2222222222222222222222222222222222222222")
+	traderC = common.HexToAddress("0x3333333333333333333333333333333333333333")
+	marketX = common.HexToAddress("0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa")
+	marketY = common.HexToAddress("0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb")
+)
+
+// usdc 整数 USDC 转为最小单位
+func usdc(amount int64) *big.Int {
+	return new(big.Int).Mul(big.NewInt(amount), big.NewInt(1000000))
+}
+
+func order(user, market common.Address, outcome int, amount int64) TradingOrder {
+	return TradingOrder{User: user, Market: market, Template: "WDL", League: "EPL", Outcome: outcome, Amount: usdc(amount)}
+}
+
+func testPolicy() *TradingPolicy {
+	return &TradingPolicy{
+		VolumeMode: VolumeModeNet,
+		Tiers: []VolumeTier{
+			{MinVolume: 1000000000, RateBps: 10}, // >= 1000 USDC: 0.1%
+			{MinVolume: 5000000000, RateBps: 20}, // >= 5000 USDC: 0.2%
+		},
+	}
+}
+
+func TestTradingPolicy_NetVolumeCancelsHedge(t *testing.T) {
+	orders := []TradingOrder{
+		order(traderA, marketX, 0, 3000),
+		order(traderA, marketX, 2, 3000),
+	}
+
+	// 毛交易量模式下对冲也能拿奖励
+	gross := testPolicy()
+	gross.VolumeMode = VolumeModeGross
+	assert.Equal(t, usdc(12), gross.Evaluate(orders)[traderA].Reward)
+
+	// 净敞口模式下两边抵消
+	explanation := testPolicy().Evaluate(orders)[traderA]
+	assert.Equal(t, usdc(6000), explanation.GrossVolume)
+	assert.Equal(t, new(big.Int), explanation.WeightedVolume)
+	assert.Nil(t, explanation.Tier)
+	assert.Equal(t, new(big.Int), explanation.Reward)
+}
+
+func TestTradingPolicy_TiersAndMultipliers(t *testing.T) {
+	policy := testPolicy()
+	policy.TemplateMultiplierBps = map[string]uint64{"wdl": 5000}
+	policy.LeagueMultiplierBps = map[string]uint64{"epl": 20000}
+
+	ou := order(traderA, marketY, 0, 4000)
+	ou.Template = "OU"
+
+	explanations := policy.Evaluate([]TradingOrder{
+		order(traderA, marketX, 0, 3000), // 3000 * 0.5 * 2 = 3000
+		ou,                               // 4000 * 1 * 2 = 8000
+		order(traderB, marketX, 1, 900),  // 低于最低档位
+	})
+
+	a := explanations[traderA]
+	require.Len(t, a.Markets, 2)
+	assert.Equal(t, uint64(10000), a.Markets[0].MultiplierBps)
+	assert.Equal(t, uint64(20000), a.Markets[1].MultiplierBps)
+	assert.Equal(t, usdc(11000), a.WeightedVolume)
+	assert.Equal(t, uint64(20), a.Tier.RateBps)
+	assert.Equal(t, usdc(22), a.Reward)
+
+	assert.Nil(t, explanations[traderB].Tier)
+	assert.Equal(t, new(big.Int), explanations[traderB].Reward)
+}
+
+func TestTradingPolicy_MinOdds(t *testing.T) {
+	policy := testPolicy()
+	policy.MinOdds = 1.2
+
+	safe := order(traderA, marketX, 0, 5000)
+	safe.Odds = 1.05
+	unknown := order(traderA, marketY, 0, 2000)
+
+	explanation := policy.Evaluate([]TradingOrder{safe, unknown})[traderA]
+	assert.Equal(t, map[string]int{ExcludedLowOdds: 1}, explanation.ExcludedOrders)
+	assert.Equal(t, usdc(2000), explanation.WeightedVolume)
+	assert.Equal(t, usdc(2), explanation.Reward)
+}
+
+func TestTradingPolicy_SelfReferral(t *testing.T) {
+	policy := testPolicy()
+	policy.ExcludeSelfReferral = true
+	policy.LinkedAddresses = [][]string{{traderA.Hex(), traderB.Hex()}}
+
+	self := order(traderA, marketX, 0, 2000)
+	self.Referrer = traderA
+	viaLinked := order(traderA, marketY, 0, 2000)
+	viaLinked.Referrer = traderB
+	referred := order(traderC, marketX, 0, 2000)
+	referred.Referrer = traderA
+
+	explanations := policy.Evaluate([]TradingOrder{self, viaLinked, referred})
+	assert.Equal(t, map[string]int{ExcludedSelfReferral: 2}, explanations[traderA].ExcludedOrders)
+	assert.Equal(t, new(big.Int), explanations[traderA].Reward)
+	assert.Equal(t, usdc(2), explanations[traderC].Reward)
+}
+
+func TestTradingPolicy_HedgedAndLinkedFlows(t *testing.T) {
+	policy := testPolicy()
+	policy.VolumeMode = VolumeModeGross
+	policy.ExcludeHedged = true
+	policy.ExcludeLinkedFlows = true
+	policy.LinkedAddresses = [][]string{{traderA.Hex(), traderB.Hex()}}
+
+	explanations := policy.Evaluate([]TradingOrder{
+		// A 与 B 关联，在 X 市场对倒
+		order(traderA, marketX, 0, 5000),
+		order(traderB, marketX, 2, 5000),
+		// A 在 Y 市场单方向下注，正常计入
+		order(traderA, marketY, 0, 1500),
+		// C 在 Y 市场自己对冲
+		order(traderC, marketY, 0, 3000),
+		order(traderC, marketY, 1, 1000),
+	})
+
+	a := explanations[traderA]
+	require.Len(t, a.Markets, 2)
+	assert.Equal(t, ExcludedLinkedFlow, a.Markets[0].Excluded)
+	assert.Equal(t, "", a.Markets[1].Excluded)
+	assert.Equal(t, usdc(1500), a.WeightedVolume)
+
+	assert.Equal(t, ExcludedLinkedFlow, explanations[traderB].Markets[0].Excluded)
+	assert.Equal(t, ExcludedHedged, explanations[traderC].Markets[0].Excluded)
+	assert.Equal(t, new(big.Int), explanations[traderC].Reward)
+}
+
+func TestTradingOrderFromGraph(t *testing.T) {
+	o := tradingOrderFromGraph(graphql.Order{
+		Market:   graphql.Market{ID: marketX.Hex(), TemplateID: "OU", MatchID: "epl_2024_MUN_vs_MCI"},
+		User:     graphql.User{ID: traderA.Hex()},
+		Outcome:  2,
+		Amount:   "12.5",
+		Price:    "0.4",
+		Referrer: traderB.Hex(),
+	})
+
+	assert.Equal(t, traderA, o.User)
+	assert.Equal(t, marketX, o.Market)
+	assert.Equal(t, "EPL", o.League)
+	assert.Equal(t, big.NewInt(12500000), o.Amount)
+	assert.InDelta(t, 2.5, o.Odds, 1e-9)
+	assert.Equal(t, traderB, o.Referrer)
+}
+
+// TestTradingPolicy_SelfReferralFromSubgraph 使用 Subgraph 实际返回的数据形态：
+// Order.referrer 恒为 null，推荐关系只记录在 User.referrer 上
+func TestTradingPolicy_SelfReferralFromSubgraph(t *testing.T) {
+	response := `{"data":{"orders":[
+		{"id":"0x01-1","market":{"id":"0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa","templateId":"WDL","matchId":"EPL_2024_MUN_vs_MCI"},
+		 "user":{"id":"0x1111111111111111111111111111111111111111","referrer":"0x1111111111111111111111111111111111111111"},
+		 "outcome":0,"amount":"2000","shares":"3800000000","fee":"0","referrer":null,"price":"0.5263","timestamp":"1730000000"},
+		{"id":"0x02-1","market":{"id":"0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa","templateId":"WDL","matchId":"EPL_2024_MUN_vs_MCI"},
+		 "user":{"id":"0x2222222222222222222222222222222222222222","referrer":"0x3333333333333333333333333333333333333333"},
+		 "outcome":0,"amount":"2000","shares":"3800000000","fee":"0","referrer":null,"price":"0.5263","timestamp":"1730000100"},
+		{"id":"0x03-1","market":{"id":"0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa","templateId":"WDL","matchId":"EPL_2024_MUN_vs_MCI"},
+		 "user":{"id":"0x3333333333333333333333333333333333333333","referrer":null},
+		 "outcome":1,"amount":"2000","shares":"6000000000","fee":"0","referrer":null,"price":"0.3333","timestamp":"1730000200"}
+	]}}`
+
+	var resp graphql.OrdersResponse
+	require.NoError(t, json.Unmarshal([]byte(response), &resp))
+
+	var orders []TradingOrder
+	for _, o := range resp.Data.Orders {
+		orders = append(orders, tradingOrderFromGraph(o))
+	}
+	assert.Equal(t, traderA, orders[0].Referrer)
+	assert.Equal(t, traderC, orders[1].Referrer)
+	assert.Equal(t, common.Address{}, orders[2].Referrer)
+
+	policy := testPolicy()
+	policy.ExcludeSelfReferral = true
+	explanations := policy.Evaluate(orders)
+
+	assert.Equal(t, 1, explanations[traderA].ExcludedOrders[ExcludedSelfReferral])
+	assert.Equal(t, new(big.Int), explanations[traderA].Reward)
+	assert.Equal(t, usdc(2), explanations[traderB].Reward)
+	assert.Equal(t, usdc(2), explanations[traderC].Reward)
+
+	// 推荐人与本人属于同一关联组时同样排除
+	policy.LinkedAddresses = [][]string{{traderB.Hex(), traderC.Hex()}}
+	explanations = policy.Evaluate(orders)
+	assert.Equal(t, 1, explanations[traderB].ExcludedOrders[ExcludedSelfReferral])
+}
+
+func TestDefaultTradingPolicy(t *testing.T) {
+	policy := DefaultTradingPolicy()
+	require.NoError(t, policy.Validate())
+	assert.Equal(t, VolumeModeNet, policy.VolumeMode)
+	assert.True(t, policy.ExcludeHedged)
+	assert.True(t, policy.ExcludeSelfReferral)
+
+	// 未配置策略文件时，两边下注同一市场的用户不获得交易奖励
+	orders := []TradingOrder{
+		{User: traderA, Market: marketX, Outcome: 0, Amount: usdc(1500)},
+		{User: traderA, Market: marketX, Outcome: 1, Amount: usdc(1500)},
+	}
+	explanation := policy.Evaluate(orders)[traderA]
+	assert.Equal(t, new(big.Int), explanation.Reward)
+}
+
+func TestLoadTradingPolicy(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "policy.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+volume_mode: gross
+min_odds: 1.3
+tiers:
+  - min_volume: 500000000
+    rate_bps: 5
+  - min_volume: 10000000000
+    rate_bps: 15
+template_multiplier_bps:
+  WDL: 12000
+linked_addresses:
+  - ["0x1111111111111111111111111111111111111111", "0x2222222222222222222222222222222222222222"]
+`), 0o600))
+
+	policy, err := LoadTradingPolicy(path)
+	require.NoError(t, err)
+	assert.Equal(t, VolumeModeGross, policy.VolumeMode)
+	assert.Equal(t, 1.3, policy.MinOdds)
+	assert.Equal(t, []VolumeTier{{MinVolume: 500000000, RateBps: 5}, {MinVolume: 10000000000, RateBps: 15}}, policy.Tiers)
+	assert.Equal(t, uint64(12000), policy.multiplierBps("WDL", "EPL"))
+	assert.Len(t, policy.LinkedAddresses, 1)
+
+	// 未配置的字段保留默认值
+	assert.True(t, policy.ExcludeSelfReferral)
+
+	require.NoError(t, os.WriteFile(path, []byte("tiers:\n  - min_volume: 1\n    rate_bps: 20000\n"), 0o600))
+	_, err = LoadTradingPolicy(path)
+	assert.ErrorContains(t, err, "rate_bps")
+}
+
+func TestTradingPolicy_Validate(t *testing.T) {
+	policy := testPolicy()
+	require.NoError(t, policy.Validate())
+
+	policy.VolumeMode = "raw"
+	assert.Error(t, policy.Validate())
+
+	policy = testPolicy()
+	policy.Tiers[1].MinVolume = policy.Tiers[0].MinVolume
+	assert.Error(t, policy.Validate())
+
+	policy = testPolicy()
+	policy.LinkedAddresses = [][]string{{"0x1234"}}
+	assert.Error(t, policy.Validate())
+}
@@ -0,0 +1,456 @@
+package rewards
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/core/vm/runtime"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// ContractArtifact 合约编译产物（Foundry out/*.json 或 Hardhat artifact）
+type ContractArtifact struct {
+	ABI      abi.ABI
+	Bytecode []byte
+}
+
+// LoadArtifact 读取合约编译产物，bytecode 支持 {"object": "0x..."} 和 "0x..." 两种格式
+func LoadArtifact(path string) (*ContractArtifact, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read artifact: %w", err)
+	}
+
+	var raw struct {
+		ABI      json.RawMessage `json:"abi"`
+		Bytecode json.RawMessage `json:"bytecode"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse artifact %s: %w", path, err)
+	}
+
+	parsed, err := abi.JSON(strings.NewReader(string(raw.ABI)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse ABI in %s: %w", path, err)
+	}
+
+	var code string
+	var object struct {
+		Object string `json:"object"`
+	}
+	if err := json.Unmarshal(raw.Bytecode, &object); err == nil && object.Object != "" {
+		code = object.Object
+	} else if err := json.Unmarshal(raw.Bytecode, &code); err != nil {
+		return nil, fmt.Errorf("no bytecode in %s", path)
+	}
+
+	bytecode := common.FromHex(code)
+	if len(bytecode) == 0 {
+		return nil, fmt.Errorf("no bytecode in %s", path)
+	}
+
+	return &ContractArtifact{ABI: parsed, Bytecode: bytecode}, nil
+}
+
+// ProofCheck 单个用户证明的链上验证结果
+type ProofCheck struct {
+	User      common.Address `json:"user"`
+	Amount    string         `json:"amount"`
+	Expected  string         `json:"expected"`  // amount * scaleBps / 10000
+	Claimable string         `json:"claimable"` // getClaimable 返回值
+	Claimed   string         `json:"claimed"`   // claim 后实际到账
+	Error     string         `json:"error,omitempty"`
+}
+
+// VerifyReport 分配数据的链上验证报告
+type VerifyReport struct {
+	Week           uint64       `json:"week"`
+	Root           string       `json:"root"`
+	Checked        int          `json:"checked"`
+	Failures       []ProofCheck `json:"failures"`
+	Mismatches     []string     `json:"mismatches"`     // 根、代币、分配合约或总额与分配数据不一致
+	TamperRejected bool         `json:"tamperRejected"` // 篡改金额的证明是否被拒绝
+}
+
+// OK 所有证明都通过验证，且合约状态与分配数据一致
+func (r *VerifyReport) OK() bool {
+	return len(r.Failures) == 0 && len(r.Mismatches) == 0 && r.TamperRejected
+}
+
+// verifyStartTime 模拟链的发布时间戳
+const verifyStartTime = 1_700_000_000
+
+// simEVM 基于内存状态的模拟 EVM（启用 Cancun，与 Foundry 编译目标一致）
+type simEVM struct {
+	state  *state.StateDB
+	config *params.ChainConfig
+	time   uint64
+	block  int64
+}
+
+func newSimEVM() (*simEVM, error) {
+	statedb, err := state.New(types.EmptyRootHash, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	config := *params.AllDevChainProtocolChanges
+	config.ShanghaiTime = new(uint64)
+	config.CancunTime = new(uint64)
+
+	return &simEVM{state: statedb, config: &config, time: verifyStartTime, block: 1}, nil
+}
+
+func (s *simEVM) runtimeConfig(from common.Address) *runtime.Config {
+	random := common.Hash{}
+	return &runtime.Config{
+		ChainConfig: s.config,
+		Origin:      from,
+		BlockNumber: big.NewInt(s.block),
+		Time:        s.time,
+		State:       s.state,
+		Random:      &random,
+	}
+}
+
+// deploy 部署合约
+func (s *simEVM) deploy(from common.Address, artifact *ContractArtifact, args ...interface{}) (common.Address, error) {
+	ctorArgs, err := artifact.ABI.Pack("", args...)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to pack constructor: %w", err)
+	}
+
+	input := append(append([]byte{}, artifact.Bytecode...), ctorArgs...)
+	_, addr, _, err := runtime.Create(input, s.runtimeConfig(from))
+	if err != nil {
+		return common.Address{}, fmt.Errorf("deployment failed: %w", err)
+	}
+	return addr, nil
+}
+
+// call 执行合约调用（状态变更会保留），返回解码后的输出
+func (s *simEVM) call(from, to common.Address, contract abi.ABI, method string, args ...interface{}) ([]interface{}, error) {
+	input, err := contract.Pack(method, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack %s: %w", method, err)
+	}
+
+	ret, _, err := runtime.Call(to, input, s.runtimeConfig(from))
+	if err != nil {
+		if errors.Is(err, vm.ErrExecutionReverted) {
+			return nil, fmt.Errorf("%s reverted: %s", method, revertReason(contract, ret))
+		}
+		return nil, fmt.Errorf("%s failed: %w", method, err)
+	}
+
+	return contract.Unpack(method, ret)
+}
+
+// revertReason 解码 revert 数据（Error(string) 或合约自定义错误）
+func revertReason(contract abi.ABI, data []byte) string {
+	if reason, err := abi.UnpackRevert(data); err == nil {
+		return reason
+	}
+	if len(data) >= 4 {
+		for name, e := range contract.Errors {
+			if string(e.ID[:4]) == string(data[:4]) {
+				return name
+			}
+		}
+	}
+	return common.Bytes2Hex(data)
+}
+
+// distributorBackend 验证过程用到的 RewardsDistributor 与奖励代币操作
+type distributorBackend interface {
+	// Address 分配合约地址
+	Address() common.Address
+	// Token 为分配合约部署的奖励代币地址
+	Token() common.Address
+	Mint(to common.Address, amount *big.Int) error
+	BalanceOf(account common.Address) (*big.Int, error)
+	PublishRoot(week *big.Int, root common.Hash, total, scaleBps *big.Int) error
+	// WeekStats 合约记录的 Merkle 根与总金额
+	WeekStats(week *big.Int) (common.Hash, *big.Int, error)
+	// RewardToken 合约实际发放的代币
+	RewardToken() (common.Address, error)
+	// SkipVesting 推进时间越过线性释放期
+	SkipVesting() error
+	GetClaimable(user common.Address, week, amount *big.Int, proof [][32]byte) (*big.Int, bool, error)
+	Claim(user common.Address, week, amount *big.Int, proof [][32]byte) error
+}
+
+// simDistributor 部署在模拟 EVM 上的 RewardsDistributor 与奖励代币
+type simDistributor struct {
+	sim         *simEVM
+	deployer    common.Address
+	distributor *ContractArtifact
+	token       *ContractArtifact
+	distAddr    common.Address
+	tokenAddr   common.Address
+}
+
+// deploySimDistributor 部署奖励代币与 RewardsDistributor
+func deploySimDistributor(distributor, token *ContractArtifact) (*simDistributor, error) {
+	sim, err := newSimEVM()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create simulated EVM: %w", err)
+	}
+
+	deployer := common.HexToAddress("0x00000000000000000000000000000000000D0001")
+
+	tokenAddr, err := sim.deploy(deployer, token, "Reward Token", "RWD", uint8(6))
+	if err != nil {
+		return nil, fmt.Errorf("failed to deploy token: %w", err)
+	}
+	distAddr, err := sim.deploy(deployer, distributor, tokenAddr, deployer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to deploy distributor: %w", err)
+	}
+
+	return &simDistributor{
+		sim:         sim,
+		deployer:    deployer,
+		distributor: distributor,
+		token:       token,
+		distAddr:    distAddr,
+		tokenAddr:   tokenAddr,
+	}, nil
+}
+
+func (d *simDistributor) Address() common.Address { return d.distAddr }
+
+func (d *simDistributor) Token() common.Address { return d.tokenAddr }
+
+func (d *simDistributor) Mint(to common.Address, amount *big.Int) error {
+	_, err := d.sim.call(d.deployer, d.tokenAddr, d.token.ABI, "mint", to, amount)
+	return err
+}
+
+func (d *simDistributor) BalanceOf(account common.Address) (*big.Int, error) {
+	out, err := d.sim.call(account, d.tokenAddr, d.token.ABI, "balanceOf", account)
+	if err != nil {
+		return nil, err
+	}
+	return out[0].(*big.Int), nil
+}
+
+func (d *simDistributor) PublishRoot(week *big.Int, root common.Hash, total, scaleBps *big.Int) error {
+	_, err := d.sim.call(d.deployer, d.distAddr, d.distributor.ABI, "publishRoot", week, root, total, scaleBps)
+	return err
+}
+
+func (d *simDistributor) WeekStats(week *big.Int) (common.Hash, *big.Int, error) {
+	out, err := d.sim.call(d.deployer, d.distAddr, d.distributor.ABI, "getWeekStats", week)
+	if err != nil {
+		return common.Hash{}, nil, err
+	}
+	return common.Hash(out[0].([32]byte)), out[1].(*big.Int), nil
+}
+
+func (d *simDistributor) RewardToken() (common.Address, error) {
+	out, err := d.sim.call(d.deployer, d.distAddr, d.distributor.ABI, "rewardToken")
+	if err != nil {
+		return common.Address{}, err
+	}
+	return out[0].(common.Address), nil
+}
+
+func (d *simDistributor) SkipVesting() error {
+	vesting, err := d.sim.call(d.deployer, d.distAddr, d.distributor.ABI, "vestingConfig")
+	if err != nil {
+		return err
+	}
+	if len(vesting) == 2 {
+		if duration, ok := vesting[1].(*big.Int); ok {
+			d.sim.time += duration.Uint64()
+			d.sim.block++
+		}
+	}
+	return nil
+}
+
+func (d *simDistributor) GetClaimable(user common.Address, week, amount *big.Int, proof [][32]byte) (*big.Int, bool, error) {
+	out, err := d.sim.call(user, d.distAddr, d.distributor.ABI, "getClaimable", user, week, amount, proof)
+	if err != nil {
+		return nil, false, err
+	}
+	return out[0].(*big.Int), out[1].(bool), nil
+}
+
+func (d *simDistributor) Claim(user common.Address, week, amount *big.Int, proof [][32]byte) error {
+	_, err := d.sim.call(user, d.distAddr, d.distributor.ABI, "claim", week, amount, proof)
+	return err
+}
+
+// VerifyDistribution 在模拟 EVM 上部署 RewardsDistributor，发布分配的 Merkle 根，
+// 并对每个条目调用 getClaimable 和 claim，确认生成的证明能被合约接受。
+// token 需提供 mint(address,uint256)（如 MockERC20）。
+func VerifyDistribution(dist *MerkleDistribution, distributor, token *ContractArtifact) (*VerifyReport, error) {
+	backend, err := deploySimDistributor(distributor, token)
+	if err != nil {
+		return nil, err
+	}
+	return verifyDistribution(dist, backend)
+}
+
+// verifyDistribution 对已部署的分配合约执行验证。除逐条证明外还核对：
+// 根与条目重算结果及合约记录一致（root）、总额与条目之和及合约记录一致（total）、
+// 合约发放的是为其注资的代币（token）、领取的资金确实从分配合约转出（distributor）。
+func verifyDistribution(dist *MerkleDistribution, backend distributorBackend) (*VerifyReport, error) {
+	report := &VerifyReport{Week: dist.Week, Root: dist.Root}
+
+	// 汇总金额
+	total := new(big.Int)
+	amounts := make([]*big.Int, len(dist.Entries))
+	for i, entry := range dist.Entries {
+		amount, ok := new(big.Int).SetString(entry.Amount, 10)
+		if !ok {
+			return nil, fmt.Errorf("invalid amount %q for %s", entry.Amount, entry.User.Hex())
+		}
+		amounts[i] = amount
+		total.Add(total, amount)
+	}
+
+	if declared, ok := new(big.Int).SetString(dist.TotalAmount, 10); !ok || declared.Cmp(total) != 0 {
+		report.Mismatches = append(report.Mismatches, fmt.Sprintf("total: distribution declares %s, entries sum to %s", dist.TotalAmount, total))
+	}
+
+	root := common.HexToHash(dist.Root)
+	if tree, err := NewMerkleTree(dist.Entries); err == nil && tree.Root != root {
+		report.Mismatches = append(report.Mismatches, fmt.Sprintf("root: distribution declares %s, entries hash to %s", dist.Root, tree.Root.Hex()))
+	}
+
+	// 为分配合约注资并发布
+	scaleBps := new(big.Int).SetUint64(dist.ScaleBps)
+	if err := backend.Mint(backend.Address(), total); err != nil {
+		return nil, fmt.Errorf("failed to fund distributor: %w", err)
+	}
+
+	week := new(big.Int).SetUint64(dist.Week)
+	if err := backend.PublishRoot(week, root, total, scaleBps); err != nil {
+		return nil, fmt.Errorf("failed to publish root: %w", err)
+	}
+
+	publishedRoot, publishedTotal, err := backend.WeekStats(week)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read week stats: %w", err)
+	}
+	if publishedRoot != root {
+		report.Mismatches = append(report.Mismatches, fmt.Sprintf("root: distributor recorded %s, published %s", publishedRoot.Hex(), dist.Root))
+	}
+	if publishedTotal.Cmp(total) != 0 {
+		report.Mismatches = append(report.Mismatches, fmt.Sprintf("total: distributor recorded %s, published %s", publishedTotal, total))
+	}
+
+	rewardToken, err := backend.RewardToken()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read reward token: %w", err)
+	}
+	if rewardToken != backend.Token() {
+		report.Mismatches = append(report.Mismatches, fmt.Sprintf("token: distributor pays %s, funded with %s", rewardToken.Hex(), backend.Token().Hex()))
+	}
+
+	// 跳过线性释放期，使 getClaimable 返回完整金额
+	if err := backend.SkipVesting(); err != nil {
+		return nil, fmt.Errorf("failed to read vesting config: %w", err)
+	}
+
+	// 篡改金额后证明必须失效。须在领取前检查：getClaimable 对已领取的用户
+	// 不校验证明直接返回 (0, true)
+	if len(dist.Entries) > 0 {
+		entry := dist.Entries[0]
+		proof, _ := proofBytes(dist.Proofs[entry.User.Hex()])
+		tampered := new(big.Int).Add(amounts[0], big.NewInt(1))
+		claimable, alreadyClaimed, err := backend.GetClaimable(entry.User, week, tampered, proof)
+		report.TamperRejected = err == nil && !alreadyClaimed && claimable.Sign() == 0
+	}
+
+	fundedBalance, err := backend.BalanceOf(backend.Address())
+	if err != nil {
+		return nil, fmt.Errorf("failed to read balance: %w", err)
+	}
+	totalClaimed := new(big.Int)
+
+	for i, entry := range dist.Entries {
+		proof, err := proofBytes(dist.Proofs[entry.User.Hex()])
+		if err != nil {
+			return nil, fmt.Errorf("invalid proof for %s: %w", entry.User.Hex(), err)
+		}
+
+		expected := new(big.Int).Mul(amounts[i], scaleBps)
+		expected.Div(expected, big.NewInt(10000))
+
+		check := ProofCheck{User: entry.User, Amount: entry.Amount, Expected: expected.String()}
+		report.Checked++
+
+		claimable, alreadyClaimed, err := backend.GetClaimable(entry.User, week, amounts[i], proof)
+		if err != nil {
+			check.Error = err.Error()
+			report.Failures = append(report.Failures, check)
+			continue
+		}
+		check.Claimable = claimable.String()
+		if claimable.Cmp(expected) != 0 || alreadyClaimed {
+			check.Error = "getClaimable mismatch"
+			report.Failures = append(report.Failures, check)
+			continue
+		}
+
+		before, err := backend.BalanceOf(entry.User)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read balance: %w", err)
+		}
+		if err := backend.Claim(entry.User, week, amounts[i], proof); err != nil {
+			check.Error = err.Error()
+			report.Failures = append(report.Failures, check)
+			continue
+		}
+		after, err := backend.BalanceOf(entry.User)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read balance: %w", err)
+		}
+
+		claimed := new(big.Int).Sub(after, before)
+		totalClaimed.Add(totalClaimed, claimed)
+		check.Claimed = claimed.String()
+		if claimed.Cmp(expected) != 0 {
+			check.Error = "claimed amount mismatch"
+			report.Failures = append(report.Failures, check)
+		}
+	}
+
+	// 用户到账的金额必须全部来自分配合约
+	remaining, err := backend.BalanceOf(backend.Address())
+	if err != nil {
+		return nil, fmt.Errorf("failed to read balance: %w", err)
+	}
+	if paid := new(big.Int).Sub(fundedBalance, remaining); paid.Cmp(totalClaimed) != 0 {
+		report.Mismatches = append(report.Mismatches, fmt.Sprintf("distributor: paid out %s, users received %s", paid, totalClaimed))
+	}
+
+	return report, nil
+}
+
+// proofBytes 将十六进制证明转换为合约参数
+func proofBytes(proof []string) ([][32]byte, error) {
+	out := make([][32]byte, len(proof))
+	for i, p := range proof {
+		b := common.FromHex(p)
+		if len(b) != 32 {
+			return nil, fmt.Errorf("proof element %d is not 32 bytes", i)
+		}
+		copy(out[i][:], b)
+	}
+	return out, nil
+}
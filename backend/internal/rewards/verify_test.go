@@ -0,0 +1,221 @@
+package rewards
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeDistributor 以 Go 实现 RewardsDistributor 的发布与领取逻辑（无线性释放）
+type fakeDistributor struct {
+	address  common.Address
+	token    common.Address
+	balances map[common.Address]*big.Int
+	roots    map[uint64]common.Hash
+	totals   map[uint64]*big.Int
+	scales   map[uint64]*big.Int
+	claimed  map[common.Address]bool
+
+	// 以下字段模拟与分配数据不一致的合约
+	paysToken   common.Address // rewardToken() 返回值，零值表示 token
+	recordRoot  *common.Hash   // 发布时记录的根
+	recordTotal *big.Int       // 发布时记录的总额
+	mintOnClaim bool           // 领取时凭空增发而不是从合约转出
+	skipProof   bool           // 不校验 Merkle 证明
+}
+
+func newFakeDistributor() *fakeDistributor {
+	return &fakeDistributor{
+		address:  common.HexToAddress("0x00000000000000000000000000000000000D1570"),
+		token:    common.HexToAddress("0x00000000000000000000000000000000000070CE"),
+		balances: make(map[common.Address]*big.Int),
+		roots:    make(map[uint64]common.Hash),
+		totals:   make(map[uint64]*big.Int),
+		scales:   make(map[uint64]*big.Int),
+		claimed:  make(map[common.Address]bool),
+	}
+}
+
+func (d *fakeDistributor) balance(account common.Address) *big.Int {
+	if b, ok := d.balances[account]; ok {
+		return b
+	}
+	return new(big.Int)
+}
+
+func (d *fakeDistributor) Address() common.Address { return d.address }
+
+func (d *fakeDistributor) Token() common.Address { return d.token }
+
+func (d *fakeDistributor) Mint(to common.Address, amount *big.Int) error {
+	d.balances[to] = new(big.Int).Add(d.balance(to), amount)
+	return nil
+}
+
+func (d *fakeDistributor) BalanceOf(account common.Address) (*big.Int, error) {
+	return new(big.Int).Set(d.balance(account)), nil
+}
+
+func (d *fakeDistributor) PublishRoot(week *big.Int, root common.Hash, total, scaleBps *big.Int) error {
+	if d.recordRoot != nil {
+		root = *d.recordRoot
+	}
+	if d.recordTotal != nil {
+		total = d.recordTotal
+	}
+	d.roots[week.Uint64()] = root
+	d.totals[week.Uint64()] = total
+	d.scales[week.Uint64()] = scaleBps
+	return nil
+}
+
+func (d *fakeDistributor) WeekStats(week *big.Int) (common.Hash, *big.Int, error) {
+	return d.roots[week.Uint64()], d.totals[week.Uint64()], nil
+}
+
+func (d *fakeDistributor) RewardToken() (common.Address, error) {
+	if d.paysToken != (common.Address{}) {
+		return d.paysToken, nil
+	}
+	return d.token, nil
+}
+
+func (d *fakeDistributor) SkipVesting() error { return nil }
+
+func (d *fakeDistributor) GetClaimable(user common.Address, week, amount *big.Int, proof [][32]byte) (*big.Int, bool, error) {
+	leaf, err := EncodeLeaf(user, week.Uint64(), amount)
+	if err != nil {
+		return nil, false, err
+	}
+	hashes := make([]common.Hash, len(proof))
+	for i, p := range proof {
+		hashes[i] = common.Hash(p)
+	}
+	// 与合约一致：先检查领取标记，已领取时不校验证明
+	if d.claimed[user] {
+		return new(big.Int), true, nil
+	}
+	if !d.skipProof && !VerifyProof(hashes, d.roots[week.Uint64()], leaf) {
+		return new(big.Int), false, nil
+	}
+	claimable := new(big.Int).Mul(amount, d.scales[week.Uint64()])
+	return claimable.Div(claimable, big.NewInt(10000)), false, nil
+}
+
+func (d *fakeDistributor) Claim(user common.Address, week, amount *big.Int, proof [][32]byte) error {
+	claimable, _, err := d.GetClaimable(user, week, amount, proof)
+	if err != nil {
+		return err
+	}
+	if claimable.Sign() == 0 {
+		return errors.New("claim reverted: InvalidProof")
+	}
+	d.claimed[user] = true
+	if !d.mintOnClaim {
+		d.balances[d.address] = new(big.Int).Sub(d.balance(d.address), claimable)
+	}
+	d.balances[user] = new(big.Int).Add(d.balance(user), claimable)
+	return nil
+}
+
+func TestVerifyDistribution(t *testing.T) {
+	entries := []RewardEntry{
+		{User: common.HexToAddress("0x1111111111111111111111111111111111111111"), Week: 300, Amount: "1000000"},
+		{User: common.HexToAddress("0x2222222222222222222222222222222222222222"), Week: 300, Amount: "2500000"},
+		{User: common.HexToAddress("0x3333333333333333333333333333333333333333"), Week: 300, Amount: "700000"},
+	}
+
+	otherRoot := common.HexToHash("0xbad")
+
+	tests := []struct {
+		name     string
+		editDist func(d *MerkleDistribution)
+		editFake func(f *fakeDistributor)
+		// 期望出现的不一致类别，空表示全部一致
+		mismatch  []string
+		failures  int
+		tamperRej bool
+	}{
+		{
+			name:      "match",
+			tamperRej: true,
+		},
+		{
+			name:      "root does not match entries",
+			editDist:  func(d *MerkleDistribution) { d.Root = otherRoot.Hex() },
+			mismatch:  []string{"root"},
+			failures:  3,
+			tamperRej: true,
+		},
+		{
+			name:      "distributor records a different root",
+			editFake:  func(f *fakeDistributor) { f.recordRoot = &otherRoot },
+			mismatch:  []string{"root"},
+			failures:  3,
+			tamperRej: true,
+		},
+		{
+			name: "distributor pays a different token",
+			editFake: func(f *fakeDistributor) {
+				f.paysToken = common.HexToAddress("0x0000000000000000000000000000000000000BAD")
+			},
+			mismatch:  []string{"token"},
+			tamperRej: true,
+		},
+		{
+			name:      "claims are not paid by the distributor",
+			editFake:  func(f *fakeDistributor) { f.mintOnClaim = true },
+			mismatch:  []string{"distributor"},
+			tamperRej: true,
+		},
+		{
+			name:      "distributor does not check proofs",
+			editFake:  func(f *fakeDistributor) { f.skipProof = true },
+			tamperRej: false,
+		},
+		{
+			name:      "declared total differs from entries",
+			editDist:  func(d *MerkleDistribution) { d.TotalAmount = "4200001" },
+			mismatch:  []string{"total"},
+			tamperRej: true,
+		},
+		{
+			name:      "distributor records a different total",
+			editFake:  func(f *fakeDistributor) { f.recordTotal = big.NewInt(1) },
+			mismatch:  []string{"total"},
+			tamperRej: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dist, err := BuildDistribution(300, entries, 10000)
+			require.NoError(t, err)
+			fake := newFakeDistributor()
+			if tt.editDist != nil {
+				tt.editDist(dist)
+			}
+			if tt.editFake != nil {
+				tt.editFake(fake)
+			}
+
+			report, err := verifyDistribution(dist, fake)
+			require.NoError(t, err)
+
+			var kinds []string
+			for _, m := range report.Mismatches {
+				kinds = append(kinds, strings.SplitN(m, ":", 2)[0])
+			}
+			assert.Equal(t, tt.mismatch, kinds)
+			assert.Len(t, report.Failures, tt.failures)
+			assert.Equal(t, 3, report.Checked)
+			assert.Equal(t, tt.tamperRej, report.TamperRejected)
+			assert.Equal(t, tt.mismatch == nil && tt.failures == 0 && tt.tamperRej, report.OK())
+		})
+	}
+}
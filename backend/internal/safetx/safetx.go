@@ -0,0 +1,116 @@
+// Package safetx 生成 Safe Transaction Builder 批量交易文件（可在 Safe{Wallet} 的 Transaction Builder 中导入），由多签执行
+package safetx
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// Transaction Builder 文件格式版本
+const (
+	Version          = "1.0"
+	TxBuilderVersion = "1.16.5"
+)
+
+// Batch 批量交易文件
+type Batch struct {
+	Version      string        `json:"version"`
+	ChainID      string        `json:"chainId"`
+	CreatedAt    int64         `json:"createdAt"` // 毫秒
+	Meta         Meta          `json:"meta"`
+	Transactions []Transaction `json:"transactions"`
+}
+
+// Meta 批量交易元数据
+type Meta struct {
+	Name                   string `json:"name"`
+	Description            string `json:"description"`
+	TxBuilderVersion       string `json:"txBuilderVersion"`
+	CreatedFromSafeAddress string `json:"createdFromSafeAddress"`
+}
+
+// Transaction 单笔未签名交易；Data 为完整调用数据，ContractMethod/ContractInputsValues 便于多签成员审阅
+type Transaction struct {
+	To                   string            `json:"to"`
+	Value                string            `json:"value"`
+	Data                 string            `json:"data"`
+	ContractMethod       *Method           `json:"contractMethod"`
+	ContractInputsValues map[string]string `json:"contractInputsValues"`
+}
+
+// Method 被调用的合约方法
+type Method struct {
+	Name    string      `json:"name"`
+	Payable bool        `json:"payable"`
+	Inputs  []MethodArg `json:"inputs"`
+}
+
+// MethodArg 方法参数
+type MethodArg struct {
+	Name         string `json:"name"`
+	Type         string `json:"type"`
+	InternalType string `json:"internalType"`
+}
+
+// NewBatch 构造批量交易；safe 为零地址时不填写来源 Safe
+func NewBatch(chainID *big.Int, safe common.Address, name, description string, txs ...Transaction) *Batch {
+	safeAddress := ""
+	if safe != (common.Address{}) {
+		safeAddress = safe.Hex()
+	}
+
+	return &Batch{
+		Version:   Version,
+		ChainID:   chainID.String(),
+		CreatedAt: time.Now().UnixMilli(),
+		Meta: Meta{
+			Name:                   name,
+			Description:            description,
+			TxBuilderVersion:       TxBuilderVersion,
+			CreatedFromSafeAddress: safeAddress,
+		},
+		Transactions: txs,
+	}
+}
+
+// NewTransaction 构造调用 method 的交易；data 为已打包的调用数据，values 为按参数名展示的参数值
+func NewTransaction(to common.Address, method abi.Method, data []byte, values map[string]string) Transaction {
+	inputs := make([]MethodArg, 0, len(method.Inputs))
+	for _, input := range method.Inputs {
+		inputs = append(inputs, MethodArg{
+			Name:         input.Name,
+			Type:         input.Type.String(),
+			InternalType: input.Type.String(),
+		})
+	}
+
+	if values == nil {
+		values = map[string]string{}
+	}
+
+	return Transaction{
+		To:                   to.Hex(),
+		Value:                "0",
+		Data:                 hexutil.Encode(data),
+		ContractMethod:       &Method{Name: method.Name, Payable: method.Payable, Inputs: inputs},
+		ContractInputsValues: values,
+	}
+}
+
+// Write 以 JSON 格式导出批量交易
+func Write(w io.Writer, batch *Batch) error {
+	data, err := json.MarshalIndent(batch, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal safe batch: %w", err)
+	}
+
+	_, err = w.Write(data)
+	return err
+}
@@ -0,0 +1,2124 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package bindings
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// IBasketParlay is an auto generated low-level Go binding around an user-defined struct.
+type IBasketParlay struct {
+	User            common.Address
+	Legs            []ICorrelationGuardParlayLeg
+	Stake           *big.Int
+	PotentialPayout *big.Int
+	CombinedOdds    *big.Int
+	PenaltyBps      *big.Int
+	Status          uint8
+	CreatedAt       *big.Int
+	SettledAt       *big.Int
+}
+
+// ICorrelationGuardParlayLeg is an auto generated low-level Go binding around an user-defined struct.
+type ICorrelationGuardParlayLeg struct {
+	Market    common.Address
+	OutcomeId *big.Int
+}
+
+// BasketMetaData contains all meta data concerning the Basket contract.
+var BasketMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[{\"name\":\"_settlementToken\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"_correlationGuard\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"_minOdds\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"_maxOdds\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"addReserveFund\",\"inputs\":[{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"batchSettle\",\"inputs\":[{\"name\":\"parlayIds\",\"type\":\"uint256[]\",\"internalType\":\"uint256[]\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"canSettle\",\"inputs\":[{\"name\":\"parlayId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"},{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"enumIBasket.ParlayStatus\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"correlationGuard\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"contractICorrelationGuard\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"createParlay\",\"inputs\":[{\"name\":\"legs\",\"type\":\"tuple[]\",\"internalType\":\"structICorrelationGuard.ParlayLeg[]\",\"components\":[{\"name\":\"market\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"outcomeId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"name\":\"stake\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"minPayout\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"parlayId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"getParlay\",\"inputs\":[{\"name\":\"parlayId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"tuple\",\"internalType\":\"structIBasket.Parlay\",\"components\":[{\"name\":\"user\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"legs\",\"type\":\"tuple[]\",\"internalType\":\"structICorrelationGuard.ParlayLeg[]\",\"components\":[{\"name\":\"market\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"outcomeId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"name\":\"stake\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"potentialPayout\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"combinedOdds\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"penaltyBps\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"status\",\"type\":\"uint8\",\"internalType\":\"enumIBasket.ParlayStatus\"},{\"name\":\"createdAt\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"settledAt\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getPoolStatus\",\"inputs\":[],\"outputs\":[{\"name\":\"poolBalance\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"lockedStake\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"reserve\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"potentialPayout\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getUserParlays\",\"inputs\":[{\"name\":\"user\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256[]\",\"internalType\":\"uint256[]\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"maxLegs\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"maxOdds\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"minOdds\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"onERC1155BatchReceived\",\"inputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"\",\"type\":\"uint256[]\",\"internalType\":\"uint256[]\"},{\"name\":\"\",\"type\":\"uint256[]\",\"internalType\":\"uint256[]\"},{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes4\",\"internalType\":\"bytes4\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"onERC1155Received\",\"inputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes4\",\"internalType\":\"bytes4\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"owner\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"parlayCounter\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"parlays\",\"inputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"user\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"stake\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"potentialPayout\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"combinedOdds\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"penaltyBps\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"status\",\"type\":\"uint8\",\"internalType\":\"enumIBasket.ParlayStatus\"},{\"name\":\"createdAt\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"settledAt\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"quote\",\"inputs\":[{\"name\":\"legs\",\"type\":\"tuple[]\",\"internalType\":\"structICorrelationGuard.ParlayLeg[]\",\"components\":[{\"name\":\"market\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"outcomeId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"name\":\"stake\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"combinedOdds\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"penaltyBps\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"potentialPayout\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"renounceOwnership\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"reserveFund\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"setCorrelationGuard\",\"inputs\":[{\"name\":\"newGuard\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setMaxLegs\",\"inputs\":[{\"name\":\"newMax\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setOddsLimits\",\"inputs\":[{\"name\":\"_minOdds\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"_maxOdds\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"settleParlay\",\"inputs\":[{\"name\":\"parlayId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"payout\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"settlementToken\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"contractIERC20\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"supportsInterface\",\"inputs\":[{\"name\":\"interfaceId\",\"type\":\"bytes4\",\"internalType\":\"bytes4\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"totalLockedStake\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"totalPotentialPayout\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"transferOwnership\",\"inputs\":[{\"name\":\"newOwner\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"withdrawReserveFund\",\"inputs\":[{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"event\",\"name\":\"CorrelationGuardUpdated\",\"inputs\":[{\"name\":\"oldGuard\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"newGuard\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"MaxLegsUpdated\",\"inputs\":[{\"name\":\"oldMax\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"newMax\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"OddsLimitsUpdated\",\"inputs\":[{\"name\":\"minOdds\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"maxOdds\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"OwnershipTransferred\",\"inputs\":[{\"name\":\"previousOwner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"newOwner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"ParlayCreated\",\"inputs\":[{\"name\":\"parlayId\",\"type\":\"uint256\",\"indexed\":true,\"internalType\":\"uint256\"},{\"name\":\"user\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"legs\",\"type\":\"tuple[]\",\"indexed\":false,\"internalType\":\"structICorrelationGuard.ParlayLeg[]\",\"components\":[{\"name\":\"market\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"outcomeId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"name\":\"stake\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"potentialPayout\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"combinedOdds\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"penaltyBps\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"ParlayQuoted\",\"inputs\":[{\"name\":\"user\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"legs\",\"type\":\"tuple[]\",\"indexed\":false,\"internalType\":\"structICorrelationGuard.ParlayLeg[]\",\"components\":[{\"name\":\"market\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"outcomeId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"name\":\"combinedOdds\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"penaltyBps\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"potentialPayout\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"ParlaySettled\",\"inputs\":[{\"name\":\"parlayId\",\"type\":\"uint256\",\"indexed\":true,\"internalType\":\"uint256\"},{\"name\":\"user\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"status\",\"type\":\"uint8\",\"indexed\":false,\"internalType\":\"enumIBasket.ParlayStatus\"},{\"name\":\"payout\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"error\",\"name\":\"AlreadySettled\",\"inputs\":[{\"name\":\"parlayId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"CannotWithdrawWhileParlaysActive\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InsufficientFunds\",\"inputs\":[{\"name\":\"required\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"available\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"InsufficientReserveFund\",\"inputs\":[{\"name\":\"requested\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"available\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"InvalidLegCount\",\"inputs\":[{\"name\":\"count\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"min\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"max\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"InvalidMarketStatus\",\"inputs\":[{\"name\":\"market\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"status\",\"type\":\"uint8\",\"internalType\":\"uint8\"}]},{\"type\":\"error\",\"name\":\"InvalidParlayId\",\"inputs\":[{\"name\":\"parlayId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"NotReadyToSettle\",\"inputs\":[{\"name\":\"parlayId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"OddsOutOfBounds\",\"inputs\":[{\"name\":\"odds\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"min\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"max\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"OwnableInvalidOwner\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"OwnableUnauthorizedAccount\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"ParlayBlocked\",\"inputs\":[{\"name\":\"reason\",\"type\":\"string\",\"internalType\":\"string\"}]},{\"type\":\"error\",\"name\":\"ReentrancyGuardReentrantCall\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"SafeERC20FailedOperation\",\"inputs\":[{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"SlippageExceeded\",\"inputs\":[{\"name\":\"actualPayout\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"minPayout\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"Unauthorized\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"ZeroAmount\",\"inputs\":[]}]",
+}
+
+// BasketABI is the input ABI used to generate the binding from.
+// Deprecated: Use BasketMetaData.ABI instead.
+var BasketABI = BasketMetaData.ABI
+
+// Basket is an auto generated Go binding around an Ethereum contract.
+type Basket struct {
+	BasketCaller     // Read-only binding to the contract
+	BasketTransactor // Write-only binding to the contract
+	BasketFilterer   // Log filterer for contract events
+}
+
+// BasketCaller is an auto generated read-only Go binding around an Ethereum contract.
+type BasketCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// BasketTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type BasketTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// BasketFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type BasketFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// BasketSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type BasketSession struct {
+	Contract     *Basket           // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// BasketCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type BasketCallerSession struct {
+	Contract *BasketCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts // Call options to use throughout this session
+}
+
+// BasketTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type BasketTransactorSession struct {
+	Contract     *BasketTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// BasketRaw is an auto generated low-level Go binding around an Ethereum contract.
+type BasketRaw struct {
+	Contract *Basket // Generic contract binding to access the raw methods on
+}
+
+// BasketCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type BasketCallerRaw struct {
+	Contract *BasketCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// BasketTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type BasketTransactorRaw struct {
+	Contract *BasketTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewBasket creates a new instance of Basket, bound to a specific deployed contract.
+func NewBasket(address common.Address, backend bind.ContractBackend) (*Basket, error) {
+	contract, err := bindBasket(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Basket{BasketCaller: BasketCaller{contract: contract}, BasketTransactor: BasketTransactor{contract: contract}, BasketFilterer: BasketFilterer{contract: contract}}, nil
+}
+
+// NewBasketCaller creates a new read-only instance of Basket, bound to a specific deployed contract.
+func NewBasketCaller(address common.Address, caller bind.ContractCaller) (*BasketCaller, error) {
+	contract, err := bindBasket(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &BasketCaller{contract: contract}, nil
+}
+
+// NewBasketTransactor creates a new write-only instance of Basket, bound to a specific deployed contract.
+func NewBasketTransactor(address common.Address, transactor bind.ContractTransactor) (*BasketTransactor, error) {
+	contract, err := bindBasket(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &BasketTransactor{contract: contract}, nil
+}
+
+// NewBasketFilterer creates a new log filterer instance of Basket, bound to a specific deployed contract.
+func NewBasketFilterer(address common.Address, filterer bind.ContractFilterer) (*BasketFilterer, error) {
+	contract, err := bindBasket(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &BasketFilterer{contract: contract}, nil
+}
+
+// bindBasket binds a generic wrapper to an already deployed contract.
+func bindBasket(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := BasketMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Basket *BasketRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Basket.Contract.BasketCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Basket *BasketRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Basket.Contract.BasketTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Basket *BasketRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Basket.Contract.BasketTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Basket *BasketCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Basket.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Basket *BasketTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Basket.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Basket *BasketTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Basket.Contract.contract.Transact(opts, method, params...)
+}
+
+// CanSettle is a free data retrieval call binding the contract method 0xdf69433b.
+//
+// Solidity: function canSettle(uint256 parlayId) view returns(bool, uint8)
+func (_Basket *BasketCaller) CanSettle(opts *bind.CallOpts, parlayId *big.Int) (bool, uint8, error) {
+	var out []interface{}
+	err := _Basket.contract.Call(opts, &out, "canSettle", parlayId)
+
+	if err != nil {
+		return *new(bool), *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+	out1 := *abi.ConvertType(out[1], new(uint8)).(*uint8)
+
+	return out0, out1, err
+
+}
+
+// CanSettle is a free data retrieval call binding the contract method 0xdf69433b.
+//
+// Solidity: function canSettle(uint256 parlayId) view returns(bool, uint8)
+func (_Basket *BasketSession) CanSettle(parlayId *big.Int) (bool, uint8, error) {
+	return _Basket.Contract.CanSettle(&_Basket.CallOpts, parlayId)
+}
+
+// CanSettle is a free data retrieval call binding the contract method 0xdf69433b.
+//
+// Solidity: function canSettle(uint256 parlayId) view returns(bool, uint8)
+func (_Basket *BasketCallerSession) CanSettle(parlayId *big.Int) (bool, uint8, error) {
+	return _Basket.Contract.CanSettle(&_Basket.CallOpts, parlayId)
+}
+
+// CorrelationGuard is a free data retrieval call binding the contract method 0x562d256b.
+//
+// Solidity: function correlationGuard() view returns(address)
+func (_Basket *BasketCaller) CorrelationGuard(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Basket.contract.Call(opts, &out, "correlationGuard")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// CorrelationGuard is a free data retrieval call binding the contract method 0x562d256b.
+//
+// Solidity: function correlationGuard() view returns(address)
+func (_Basket *BasketSession) CorrelationGuard() (common.Address, error) {
+	return _Basket.Contract.CorrelationGuard(&_Basket.CallOpts)
+}
+
+// CorrelationGuard is a free data retrieval call binding the contract method 0x562d256b.
+//
+// Solidity: function correlationGuard() view returns(address)
+func (_Basket *BasketCallerSession) CorrelationGuard() (common.Address, error) {
+	return _Basket.Contract.CorrelationGuard(&_Basket.CallOpts)
+}
+
+// GetParlay is a free data retrieval call binding the contract method 0x381738f5.
+//
+// Solidity: function getParlay(uint256 parlayId) view returns((address,(address,uint256)[],uint256,uint256,uint256,uint256,uint8,uint256,uint256))
+func (_Basket *BasketCaller) GetParlay(opts *bind.CallOpts, parlayId *big.Int) (IBasketParlay, error) {
+	var out []interface{}
+	err := _Basket.contract.Call(opts, &out, "getParlay", parlayId)
+
+	if err != nil {
+		return *new(IBasketParlay), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(IBasketParlay)).(*IBasketParlay)
+
+	return out0, err
+
+}
+
+// GetParlay is a free data retrieval call binding the contract method 0x381738f5.
+//
+// Solidity: function getParlay(uint256 parlayId) view returns((address,(address,uint256)[],uint256,uint256,uint256,uint256,uint8,uint256,uint256))
+func (_Basket *BasketSession) GetParlay(parlayId *big.Int) (IBasketParlay, error) {
+	return _Basket.Contract.GetParlay(&_Basket.CallOpts, parlayId)
+}
+
+// GetParlay is a free data retrieval call binding the contract method 0x381738f5.
+//
+// Solidity: function getParlay(uint256 parlayId) view returns((address,(address,uint256)[],uint256,uint256,uint256,uint256,uint8,uint256,uint256))
+func (_Basket *BasketCallerSession) GetParlay(parlayId *big.Int) (IBasketParlay, error) {
+	return _Basket.Contract.GetParlay(&_Basket.CallOpts, parlayId)
+}
+
+// GetPoolStatus is a free data retrieval call binding the contract method 0x7f79496c.
+//
+// Solidity: function getPoolStatus() view returns(uint256 poolBalance, uint256 lockedStake, uint256 reserve, uint256 potentialPayout)
+func (_Basket *BasketCaller) GetPoolStatus(opts *bind.CallOpts) (struct {
+	PoolBalance     *big.Int
+	LockedStake     *big.Int
+	Reserve         *big.Int
+	PotentialPayout *big.Int
+}, error) {
+	var out []interface{}
+	err := _Basket.contract.Call(opts, &out, "getPoolStatus")
+
+	outstruct := new(struct {
+		PoolBalance     *big.Int
+		LockedStake     *big.Int
+		Reserve         *big.Int
+		PotentialPayout *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.PoolBalance = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.LockedStake = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	outstruct.Reserve = *abi.ConvertType(out[2], new(*big.Int)).(**big.Int)
+	outstruct.PotentialPayout = *abi.ConvertType(out[3], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// GetPoolStatus is a free data retrieval call binding the contract method 0x7f79496c.
+//
+// Solidity: function getPoolStatus() view returns(uint256 poolBalance, uint256 lockedStake, uint256 reserve, uint256 potentialPayout)
+func (_Basket *BasketSession) GetPoolStatus() (struct {
+	PoolBalance     *big.Int
+	LockedStake     *big.Int
+	Reserve         *big.Int
+	PotentialPayout *big.Int
+}, error) {
+	return _Basket.Contract.GetPoolStatus(&_Basket.CallOpts)
+}
+
+// GetPoolStatus is a free data retrieval call binding the contract method 0x7f79496c.
+//
+// Solidity: function getPoolStatus() view returns(uint256 poolBalance, uint256 lockedStake, uint256 reserve, uint256 potentialPayout)
+func (_Basket *BasketCallerSession) GetPoolStatus() (struct {
+	PoolBalance     *big.Int
+	LockedStake     *big.Int
+	Reserve         *big.Int
+	PotentialPayout *big.Int
+}, error) {
+	return _Basket.Contract.GetPoolStatus(&_Basket.CallOpts)
+}
+
+// GetUserParlays is a free data retrieval call binding the contract method 0x467979aa.
+//
+// Solidity: function getUserParlays(address user) view returns(uint256[])
+func (_Basket *BasketCaller) GetUserParlays(opts *bind.CallOpts, user common.Address) ([]*big.Int, error) {
+	var out []interface{}
+	err := _Basket.contract.Call(opts, &out, "getUserParlays", user)
+
+	if err != nil {
+		return *new([]*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]*big.Int)).(*[]*big.Int)
+
+	return out0, err
+
+}
+
+// GetUserParlays is a free data retrieval call binding the contract method 0x467979aa.
+//
+// Solidity: function getUserParlays(address user) view returns(uint256[])
+func (_Basket *BasketSession) GetUserParlays(user common.Address) ([]*big.Int, error) {
+	return _Basket.Contract.GetUserParlays(&_Basket.CallOpts, user)
+}
+
+// GetUserParlays is a free data retrieval call binding the contract method 0x467979aa.
+//
+// Solidity: function getUserParlays(address user) view returns(uint256[])
+func (_Basket *BasketCallerSession) GetUserParlays(user common.Address) ([]*big.Int, error) {
+	return _Basket.Contract.GetUserParlays(&_Basket.CallOpts, user)
+}
+
+// MaxLegs is a free data retrieval call binding the contract method 0xb4e69d7f.
+//
+// Solidity: function maxLegs() view returns(uint256)
+func (_Basket *BasketCaller) MaxLegs(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Basket.contract.Call(opts, &out, "maxLegs")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MaxLegs is a free data retrieval call binding the contract method 0xb4e69d7f.
+//
+// Solidity: function maxLegs() view returns(uint256)
+func (_Basket *BasketSession) MaxLegs() (*big.Int, error) {
+	return _Basket.Contract.MaxLegs(&_Basket.CallOpts)
+}
+
+// MaxLegs is a free data retrieval call binding the contract method 0xb4e69d7f.
+//
+// Solidity: function maxLegs() view returns(uint256)
+func (_Basket *BasketCallerSession) MaxLegs() (*big.Int, error) {
+	return _Basket.Contract.MaxLegs(&_Basket.CallOpts)
+}
+
+// MaxOdds is a free data retrieval call binding the contract method 0x0f53c069.
+//
+// Solidity: function maxOdds() view returns(uint256)
+func (_Basket *BasketCaller) MaxOdds(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Basket.contract.Call(opts, &out, "maxOdds")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MaxOdds is a free data retrieval call binding the contract method 0x0f53c069.
+//
+// Solidity: function maxOdds() view returns(uint256)
+func (_Basket *BasketSession) MaxOdds() (*big.Int, error) {
+	return _Basket.Contract.MaxOdds(&_Basket.CallOpts)
+}
+
+// MaxOdds is a free data retrieval call binding the contract method 0x0f53c069.
+//
+// Solidity: function maxOdds() view returns(uint256)
+func (_Basket *BasketCallerSession) MaxOdds() (*big.Int, error) {
+	return _Basket.Contract.MaxOdds(&_Basket.CallOpts)
+}
+
+// MinOdds is a free data retrieval call binding the contract method 0xa968fc17.
+//
+// Solidity: function minOdds() view returns(uint256)
+func (_Basket *BasketCaller) MinOdds(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Basket.contract.Call(opts, &out, "minOdds")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MinOdds is a free data retrieval call binding the contract method 0xa968fc17.
+//
+// Solidity: function minOdds() view returns(uint256)
+func (_Basket *BasketSession) MinOdds() (*big.Int, error) {
+	return _Basket.Contract.MinOdds(&_Basket.CallOpts)
+}
+
+// MinOdds is a free data retrieval call binding the contract method 0xa968fc17.
+//
+// Solidity: function minOdds() view returns(uint256)
+func (_Basket *BasketCallerSession) MinOdds() (*big.Int, error) {
+	return _Basket.Contract.MinOdds(&_Basket.CallOpts)
+}
+
+// OnERC1155BatchReceived is a free data retrieval call binding the contract method 0xbc197c81.
+//
+// Solidity: function onERC1155BatchReceived(address , address , uint256[] , uint256[] , bytes ) pure returns(bytes4)
+func (_Basket *BasketCaller) OnERC1155BatchReceived(opts *bind.CallOpts, arg0 common.Address, arg1 common.Address, arg2 []*big.Int, arg3 []*big.Int, arg4 []byte) ([4]byte, error) {
+	var out []interface{}
+	err := _Basket.contract.Call(opts, &out, "onERC1155BatchReceived", arg0, arg1, arg2, arg3, arg4)
+
+	if err != nil {
+		return *new([4]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([4]byte)).(*[4]byte)
+
+	return out0, err
+
+}
+
+// OnERC1155BatchReceived is a free data retrieval call binding the contract method 0xbc197c81.
+//
+// Solidity: function onERC1155BatchReceived(address , address , uint256[] , uint256[] , bytes ) pure returns(bytes4)
+func (_Basket *BasketSession) OnERC1155BatchReceived(arg0 common.Address, arg1 common.Address, arg2 []*big.Int, arg3 []*big.Int, arg4 []byte) ([4]byte, error) {
+	return _Basket.Contract.OnERC1155BatchReceived(&_Basket.CallOpts, arg0, arg1, arg2, arg3, arg4)
+}
+
+// OnERC1155BatchReceived is a free data retrieval call binding the contract method 0xbc197c81.
+//
+// Solidity: function onERC1155BatchReceived(address , address , uint256[] , uint256[] , bytes ) pure returns(bytes4)
+func (_Basket *BasketCallerSession) OnERC1155BatchReceived(arg0 common.Address, arg1 common.Address, arg2 []*big.Int, arg3 []*big.Int, arg4 []byte) ([4]byte, error) {
+	return _Basket.Contract.OnERC1155BatchReceived(&_Basket.CallOpts, arg0, arg1, arg2, arg3, arg4)
+}
+
+// OnERC1155Received is a free data retrieval call binding the contract method 0xf23a6e61.
+//
+// Solidity: function onERC1155Received(address , address , uint256 , uint256 , bytes ) pure returns(bytes4)
+func (_Basket *BasketCaller) OnERC1155Received(opts *bind.CallOpts, arg0 common.Address, arg1 common.Address, arg2 *big.Int, arg3 *big.Int, arg4 []byte) ([4]byte, error) {
+	var out []interface{}
+	err := _Basket.contract.Call(opts, &out, "onERC1155Received", arg0, arg1, arg2, arg3, arg4)
+
+	if err != nil {
+		return *new([4]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([4]byte)).(*[4]byte)
+
+	return out0, err
+
+}
+
+// OnERC1155Received is a free data retrieval call binding the contract method 0xf23a6e61.
+//
+// Solidity: function onERC1155Received(address , address , uint256 , uint256 , bytes ) pure returns(bytes4)
+func (_Basket *BasketSession) OnERC1155Received(arg0 common.Address, arg1 common.Address, arg2 *big.Int, arg3 *big.Int, arg4 []byte) ([4]byte, error) {
+	return _Basket.Contract.OnERC1155Received(&_Basket.CallOpts, arg0, arg1, arg2, arg3, arg4)
+}
+
+// OnERC1155Received is a free data retrieval call binding the contract method 0xf23a6e61.
+//
+// Solidity: function onERC1155Received(address , address , uint256 , uint256 , bytes ) pure returns(bytes4)
+func (_Basket *BasketCallerSession) OnERC1155Received(arg0 common.Address, arg1 common.Address, arg2 *big.Int, arg3 *big.Int, arg4 []byte) ([4]byte, error) {
+	return _Basket.Contract.OnERC1155Received(&_Basket.CallOpts, arg0, arg1, arg2, arg3, arg4)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_Basket *BasketCaller) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Basket.contract.Call(opts, &out, "owner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_Basket *BasketSession) Owner() (common.Address, error) {
+	return _Basket.Contract.Owner(&_Basket.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_Basket *BasketCallerSession) Owner() (common.Address, error) {
+	return _Basket.Contract.Owner(&_Basket.CallOpts)
+}
+
+// ParlayCounter is a free data retrieval call binding the contract method 0x40c85a64.
+//
+// Solidity: function parlayCounter() view returns(uint256)
+func (_Basket *BasketCaller) ParlayCounter(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Basket.contract.Call(opts, &out, "parlayCounter")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ParlayCounter is a free data retrieval call binding the contract method 0x40c85a64.
+//
+// Solidity: function parlayCounter() view returns(uint256)
+func (_Basket *BasketSession) ParlayCounter() (*big.Int, error) {
+	return _Basket.Contract.ParlayCounter(&_Basket.CallOpts)
+}
+
+// ParlayCounter is a free data retrieval call binding the contract method 0x40c85a64.
+//
+// Solidity: function parlayCounter() view returns(uint256)
+func (_Basket *BasketCallerSession) ParlayCounter() (*big.Int, error) {
+	return _Basket.Contract.ParlayCounter(&_Basket.CallOpts)
+}
+
+// Parlays is a free data retrieval call binding the contract method 0x08300597.
+//
+// Solidity: function parlays(uint256 ) view returns(address user, uint256 stake, uint256 potentialPayout, uint256 combinedOdds, uint256 penaltyBps, uint8 status, uint256 createdAt, uint256 settledAt)
+func (_Basket *BasketCaller) Parlays(opts *bind.CallOpts, arg0 *big.Int) (struct {
+	User            common.Address
+	Stake           *big.Int
+	PotentialPayout *big.Int
+	CombinedOdds    *big.Int
+	PenaltyBps      *big.Int
+	Status          uint8
+	CreatedAt       *big.Int
+	SettledAt       *big.Int
+}, error) {
+	var out []interface{}
+	err := _Basket.contract.Call(opts, &out, "parlays", arg0)
+
+	outstruct := new(struct {
+		User            common.Address
+		Stake           *big.Int
+		PotentialPayout *big.Int
+		CombinedOdds    *big.Int
+		PenaltyBps      *big.Int
+		Status          uint8
+		CreatedAt       *big.Int
+		SettledAt       *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.User = *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	outstruct.Stake = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	outstruct.PotentialPayout = *abi.ConvertType(out[2], new(*big.Int)).(**big.Int)
+	outstruct.CombinedOdds = *abi.ConvertType(out[3], new(*big.Int)).(**big.Int)
+	outstruct.PenaltyBps = *abi.ConvertType(out[4], new(*big.Int)).(**big.Int)
+	outstruct.Status = *abi.ConvertType(out[5], new(uint8)).(*uint8)
+	outstruct.CreatedAt = *abi.ConvertType(out[6], new(*big.Int)).(**big.Int)
+	outstruct.SettledAt = *abi.ConvertType(out[7], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// Parlays is a free data retrieval call binding the contract method 0x08300597.
+//
+// Solidity: function parlays(uint256 ) view returns(address user, uint256 stake, uint256 potentialPayout, uint256 combinedOdds, uint256 penaltyBps, uint8 status, uint256 createdAt, uint256 settledAt)
+func (_Basket *BasketSession) Parlays(arg0 *big.Int) (struct {
+	User            common.Address
+	Stake           *big.Int
+	PotentialPayout *big.Int
+	CombinedOdds    *big.Int
+	PenaltyBps      *big.Int
+	Status          uint8
+	CreatedAt       *big.Int
+	SettledAt       *big.Int
+}, error) {
+	return _Basket.Contract.Parlays(&_Basket.CallOpts, arg0)
+}
+
+// Parlays is a free data retrieval call binding the contract method 0x08300597.
+//
+// Solidity: function parlays(uint256 ) view returns(address user, uint256 stake, uint256 potentialPayout, uint256 combinedOdds, uint256 penaltyBps, uint8 status, uint256 createdAt, uint256 settledAt)
+func (_Basket *BasketCallerSession) Parlays(arg0 *big.Int) (struct {
+	User            common.Address
+	Stake           *big.Int
+	PotentialPayout *big.Int
+	CombinedOdds    *big.Int
+	PenaltyBps      *big.Int
+	Status          uint8
+	CreatedAt       *big.Int
+	SettledAt       *big.Int
+}, error) {
+	return _Basket.Contract.Parlays(&_Basket.CallOpts, arg0)
+}
+
+// Quote is a free data retrieval call binding the contract method 0x0c35cff7.
+//
+// Solidity: function quote((address,uint256)[] legs, uint256 stake) view returns(uint256 combinedOdds, uint256 penaltyBps, uint256 potentialPayout)
+func (_Basket *BasketCaller) Quote(opts *bind.CallOpts, legs []ICorrelationGuardParlayLeg, stake *big.Int) (struct {
+	CombinedOdds    *big.Int
+	PenaltyBps      *big.Int
+	PotentialPayout *big.Int
+}, error) {
+	var out []interface{}
+	err := _Basket.contract.Call(opts, &out, "quote", legs, stake)
+
+	outstruct := new(struct {
+		CombinedOdds    *big.Int
+		PenaltyBps      *big.Int
+		PotentialPayout *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.CombinedOdds = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.PenaltyBps = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	outstruct.PotentialPayout = *abi.ConvertType(out[2], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// Quote is a free data retrieval call binding the contract method 0x0c35cff7.
+//
+// Solidity: function quote((address,uint256)[] legs, uint256 stake) view returns(uint256 combinedOdds, uint256 penaltyBps, uint256 potentialPayout)
+func (_Basket *BasketSession) Quote(legs []ICorrelationGuardParlayLeg, stake *big.Int) (struct {
+	CombinedOdds    *big.Int
+	PenaltyBps      *big.Int
+	PotentialPayout *big.Int
+}, error) {
+	return _Basket.Contract.Quote(&_Basket.CallOpts, legs, stake)
+}
+
+// Quote is a free data retrieval call binding the contract method 0x0c35cff7.
+//
+// Solidity: function quote((address,uint256)[] legs, uint256 stake) view returns(uint256 combinedOdds, uint256 penaltyBps, uint256 potentialPayout)
+func (_Basket *BasketCallerSession) Quote(legs []ICorrelationGuardParlayLeg, stake *big.Int) (struct {
+	CombinedOdds    *big.Int
+	PenaltyBps      *big.Int
+	PotentialPayout *big.Int
+}, error) {
+	return _Basket.Contract.Quote(&_Basket.CallOpts, legs, stake)
+}
+
+// ReserveFund is a free data retrieval call binding the contract method 0xb7f92b71.
+//
+// Solidity: function reserveFund() view returns(uint256)
+func (_Basket *BasketCaller) ReserveFund(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Basket.contract.Call(opts, &out, "reserveFund")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ReserveFund is a free data retrieval call binding the contract method 0xb7f92b71.
+//
+// Solidity: function reserveFund() view returns(uint256)
+func (_Basket *BasketSession) ReserveFund() (*big.Int, error) {
+	return _Basket.Contract.ReserveFund(&_Basket.CallOpts)
+}
+
+// ReserveFund is a free data retrieval call binding the contract method 0xb7f92b71.
+//
+// Solidity: function reserveFund() view returns(uint256)
+func (_Basket *BasketCallerSession) ReserveFund() (*big.Int, error) {
+	return _Basket.Contract.ReserveFund(&_Basket.CallOpts)
+}
+
+// SettlementToken is a free data retrieval call binding the contract method 0x7b9e618d.
+//
+// Solidity: function settlementToken() view returns(address)
+func (_Basket *BasketCaller) SettlementToken(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Basket.contract.Call(opts, &out, "settlementToken")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// SettlementToken is a free data retrieval call binding the contract method 0x7b9e618d.
+//
+// Solidity: function settlementToken() view returns(address)
+func (_Basket *BasketSession) SettlementToken() (common.Address, error) {
+	return _Basket.Contract.SettlementToken(&_Basket.CallOpts)
+}
+
+// SettlementToken is a free data retrieval call binding the contract method 0x7b9e618d.
+//
+// Solidity: function settlementToken() view returns(address)
+func (_Basket *BasketCallerSession) SettlementToken() (common.Address, error) {
+	return _Basket.Contract.SettlementToken(&_Basket.CallOpts)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_Basket *BasketCaller) SupportsInterface(opts *bind.CallOpts, interfaceId [4]byte) (bool, error) {
+	var out []interface{}
+	err := _Basket.contract.Call(opts, &out, "supportsInterface", interfaceId)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_Basket *BasketSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _Basket.Contract.SupportsInterface(&_Basket.CallOpts, interfaceId)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_Basket *BasketCallerSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _Basket.Contract.SupportsInterface(&_Basket.CallOpts, interfaceId)
+}
+
+// TotalLockedStake is a free data retrieval call binding the contract method 0x43a03bbc.
+//
+// Solidity: function totalLockedStake() view returns(uint256)
+func (_Basket *BasketCaller) TotalLockedStake(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Basket.contract.Call(opts, &out, "totalLockedStake")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TotalLockedStake is a free data retrieval call binding the contract method 0x43a03bbc.
+//
+// Solidity: function totalLockedStake() view returns(uint256)
+func (_Basket *BasketSession) TotalLockedStake() (*big.Int, error) {
+	return _Basket.Contract.TotalLockedStake(&_Basket.CallOpts)
+}
+
+// TotalLockedStake is a free data retrieval call binding the contract method 0x43a03bbc.
+//
+// Solidity: function totalLockedStake() view returns(uint256)
+func (_Basket *BasketCallerSession) TotalLockedStake() (*big.Int, error) {
+	return _Basket.Contract.TotalLockedStake(&_Basket.CallOpts)
+}
+
+// TotalPotentialPayout is a free data retrieval call binding the contract method 0x4cf0d920.
+//
+// Solidity: function totalPotentialPayout() view returns(uint256)
+func (_Basket *BasketCaller) TotalPotentialPayout(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Basket.contract.Call(opts, &out, "totalPotentialPayout")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TotalPotentialPayout is a free data retrieval call binding the contract method 0x4cf0d920.
+//
+// Solidity: function totalPotentialPayout() view returns(uint256)
+func (_Basket *BasketSession) TotalPotentialPayout() (*big.Int, error) {
+	return _Basket.Contract.TotalPotentialPayout(&_Basket.CallOpts)
+}
+
+// TotalPotentialPayout is a free data retrieval call binding the contract method 0x4cf0d920.
+//
+// Solidity: function totalPotentialPayout() view returns(uint256)
+func (_Basket *BasketCallerSession) TotalPotentialPayout() (*big.Int, error) {
+	return _Basket.Contract.TotalPotentialPayout(&_Basket.CallOpts)
+}
+
+// AddReserveFund is a paid mutator transaction binding the contract method 0x6be5733c.
+//
+// Solidity: function addReserveFund(uint256 amount) returns()
+func (_Basket *BasketTransactor) AddReserveFund(opts *bind.TransactOpts, amount *big.Int) (*types.Transaction, error) {
+	return _Basket.contract.Transact(opts, "addReserveFund", amount)
+}
+
+// AddReserveFund is a paid mutator transaction binding the contract method 0x6be5733c.
+//
+// Solidity: function addReserveFund(uint256 amount) returns()
+func (_Basket *BasketSession) AddReserveFund(amount *big.Int) (*types.Transaction, error) {
+	return _Basket.Contract.AddReserveFund(&_Basket.TransactOpts, amount)
+}
+
+// AddReserveFund is a paid mutator transaction binding the contract method 0x6be5733c.
+//
+// Solidity: function addReserveFund(uint256 amount) returns()
+func (_Basket *BasketTransactorSession) AddReserveFund(amount *big.Int) (*types.Transaction, error) {
+	return _Basket.Contract.AddReserveFund(&_Basket.TransactOpts, amount)
+}
+
+// BatchSettle is a paid mutator transaction binding the contract method 0x7e750398.
+//
+// Solidity: function batchSettle(uint256[] parlayIds) returns()
+func (_Basket *BasketTransactor) BatchSettle(opts *bind.TransactOpts, parlayIds []*big.Int) (*types.Transaction, error) {
+	return _Basket.contract.Transact(opts, "batchSettle", parlayIds)
+}
+
+// BatchSettle is a paid mutator transaction binding the contract method 0x7e750398.
+//
+// Solidity: function batchSettle(uint256[] parlayIds) returns()
+func (_Basket *BasketSession) BatchSettle(parlayIds []*big.Int) (*types.Transaction, error) {
+	return _Basket.Contract.BatchSettle(&_Basket.TransactOpts, parlayIds)
+}
+
+// BatchSettle is a paid mutator transaction binding the contract method 0x7e750398.
+//
+// Solidity: function batchSettle(uint256[] parlayIds) returns()
+func (_Basket *BasketTransactorSession) BatchSettle(parlayIds []*big.Int) (*types.Transaction, error) {
+	return _Basket.Contract.BatchSettle(&_Basket.TransactOpts, parlayIds)
+}
+
+// CreateParlay is a paid mutator transaction binding the contract method 0x15a1111d.
+//
+// Solidity: function createParlay((address,uint256)[] legs, uint256 stake, uint256 minPayout) returns(uint256 parlayId)
+func (_Basket *BasketTransactor) CreateParlay(opts *bind.TransactOpts, legs []ICorrelationGuardParlayLeg, stake *big.Int, minPayout *big.Int) (*types.Transaction, error) {
+	return _Basket.contract.Transact(opts, "createParlay", legs, stake, minPayout)
+}
+
+// CreateParlay is a paid mutator transaction binding the contract method 0x15a1111d.
+//
+// Solidity: function createParlay((address,uint256)[] legs, uint256 stake, uint256 minPayout) returns(uint256 parlayId)
+func (_Basket *BasketSession) CreateParlay(legs []ICorrelationGuardParlayLeg, stake *big.Int, minPayout *big.Int) (*types.Transaction, error) {
+	return _Basket.Contract.CreateParlay(&_Basket.TransactOpts, legs, stake, minPayout)
+}
+
+// CreateParlay is a paid mutator transaction binding the contract method 0x15a1111d.
+//
+// Solidity: function createParlay((address,uint256)[] legs, uint256 stake, uint256 minPayout) returns(uint256 parlayId)
+func (_Basket *BasketTransactorSession) CreateParlay(legs []ICorrelationGuardParlayLeg, stake *big.Int, minPayout *big.Int) (*types.Transaction, error) {
+	return _Basket.Contract.CreateParlay(&_Basket.TransactOpts, legs, stake, minPayout)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_Basket *BasketTransactor) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Basket.contract.Transact(opts, "renounceOwnership")
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_Basket *BasketSession) RenounceOwnership() (*types.Transaction, error) {
+	return _Basket.Contract.RenounceOwnership(&_Basket.TransactOpts)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_Basket *BasketTransactorSession) RenounceOwnership() (*types.Transaction, error) {
+	return _Basket.Contract.RenounceOwnership(&_Basket.TransactOpts)
+}
+
+// SetCorrelationGuard is a paid mutator transaction binding the contract method 0x3538ab4a.
+//
+// Solidity: function setCorrelationGuard(address newGuard) returns()
+func (_Basket *BasketTransactor) SetCorrelationGuard(opts *bind.TransactOpts, newGuard common.Address) (*types.Transaction, error) {
+	return _Basket.contract.Transact(opts, "setCorrelationGuard", newGuard)
+}
+
+// SetCorrelationGuard is a paid mutator transaction binding the contract method 0x3538ab4a.
+//
+// Solidity: function setCorrelationGuard(address newGuard) returns()
+func (_Basket *BasketSession) SetCorrelationGuard(newGuard common.Address) (*types.Transaction, error) {
+	return _Basket.Contract.SetCorrelationGuard(&_Basket.TransactOpts, newGuard)
+}
+
+// SetCorrelationGuard is a paid mutator transaction binding the contract method 0x3538ab4a.
+//
+// Solidity: function setCorrelationGuard(address newGuard) returns()
+func (_Basket *BasketTransactorSession) SetCorrelationGuard(newGuard common.Address) (*types.Transaction, error) {
+	return _Basket.Contract.SetCorrelationGuard(&_Basket.TransactOpts, newGuard)
+}
+
+// SetMaxLegs is a paid mutator transaction binding the contract method 0xd30400c4.
+//
+// Solidity: function setMaxLegs(uint256 newMax) returns()
+func (_Basket *BasketTransactor) SetMaxLegs(opts *bind.TransactOpts, newMax *big.Int) (*types.Transaction, error) {
+	return _Basket.contract.Transact(opts, "setMaxLegs", newMax)
+}
+
+// SetMaxLegs is a paid mutator transaction binding the contract method 0xd30400c4.
+//
+// Solidity: function setMaxLegs(uint256 newMax) returns()
+func (_Basket *BasketSession) SetMaxLegs(newMax *big.Int) (*types.Transaction, error) {
+	return _Basket.Contract.SetMaxLegs(&_Basket.TransactOpts, newMax)
+}
+
+// SetMaxLegs is a paid mutator transaction binding the contract method 0xd30400c4.
+//
+// Solidity: function setMaxLegs(uint256 newMax) returns()
+func (_Basket *BasketTransactorSession) SetMaxLegs(newMax *big.Int) (*types.Transaction, error) {
+	return _Basket.Contract.SetMaxLegs(&_Basket.TransactOpts, newMax)
+}
+
+// SetOddsLimits is a paid mutator transaction binding the contract method 0x161151ab.
+//
+// Solidity: function setOddsLimits(uint256 _minOdds, uint256 _maxOdds) returns()
+func (_Basket *BasketTransactor) SetOddsLimits(opts *bind.TransactOpts, _minOdds *big.Int, _maxOdds *big.Int) (*types.Transaction, error) {
+	return _Basket.contract.Transact(opts, "setOddsLimits", _minOdds, _maxOdds)
+}
+
+// SetOddsLimits is a paid mutator transaction binding the contract method 0x161151ab.
+//
+// Solidity: function setOddsLimits(uint256 _minOdds, uint256 _maxOdds) returns()
+func (_Basket *BasketSession) SetOddsLimits(_minOdds *big.Int, _maxOdds *big.Int) (*types.Transaction, error) {
+	return _Basket.Contract.SetOddsLimits(&_Basket.TransactOpts, _minOdds, _maxOdds)
+}
+
+// SetOddsLimits is a paid mutator transaction binding the contract method 0x161151ab.
+//
+// Solidity: function setOddsLimits(uint256 _minOdds, uint256 _maxOdds) returns()
+func (_Basket *BasketTransactorSession) SetOddsLimits(_minOdds *big.Int, _maxOdds *big.Int) (*types.Transaction, error) {
+	return _Basket.Contract.SetOddsLimits(&_Basket.TransactOpts, _minOdds, _maxOdds)
+}
+
+// SettleParlay is a paid mutator transaction binding the contract method 0x2a8ddf48.
+//
+// Solidity: function settleParlay(uint256 parlayId) returns(uint256 payout)
+func (_Basket *BasketTransactor) SettleParlay(opts *bind.TransactOpts, parlayId *big.Int) (*types.Transaction, error) {
+	return _Basket.contract.Transact(opts, "settleParlay", parlayId)
+}
+
+// SettleParlay is a paid mutator transaction binding the contract method 0x2a8ddf48.
+//
+// Solidity: function settleParlay(uint256 parlayId) returns(uint256 payout)
+func (_Basket *BasketSession) SettleParlay(parlayId *big.Int) (*types.Transaction, error) {
+	return _Basket.Contract.SettleParlay(&_Basket.TransactOpts, parlayId)
+}
+
+// SettleParlay is a paid mutator transaction binding the contract method 0x2a8ddf48.
+//
+// Solidity: function settleParlay(uint256 parlayId) returns(uint256 payout)
+func (_Basket *BasketTransactorSession) SettleParlay(parlayId *big.Int) (*types.Transaction, error) {
+	return _Basket.Contract.SettleParlay(&_Basket.TransactOpts, parlayId)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_Basket *BasketTransactor) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	return _Basket.contract.Transact(opts, "transferOwnership", newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_Basket *BasketSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _Basket.Contract.TransferOwnership(&_Basket.TransactOpts, newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_Basket *BasketTransactorSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _Basket.Contract.TransferOwnership(&_Basket.TransactOpts, newOwner)
+}
+
+// WithdrawReserveFund is a paid mutator transaction binding the contract method 0xb964eebb.
+//
+// Solidity: function withdrawReserveFund(uint256 amount) returns()
+func (_Basket *BasketTransactor) WithdrawReserveFund(opts *bind.TransactOpts, amount *big.Int) (*types.Transaction, error) {
+	return _Basket.contract.Transact(opts, "withdrawReserveFund", amount)
+}
+
+// WithdrawReserveFund is a paid mutator transaction binding the contract method 0xb964eebb.
+//
+// Solidity: function withdrawReserveFund(uint256 amount) returns()
+func (_Basket *BasketSession) WithdrawReserveFund(amount *big.Int) (*types.Transaction, error) {
+	return _Basket.Contract.WithdrawReserveFund(&_Basket.TransactOpts, amount)
+}
+
+// WithdrawReserveFund is a paid mutator transaction binding the contract method 0xb964eebb.
+//
+// Solidity: function withdrawReserveFund(uint256 amount) returns()
+func (_Basket *BasketTransactorSession) WithdrawReserveFund(amount *big.Int) (*types.Transaction, error) {
+	return _Basket.Contract.WithdrawReserveFund(&_Basket.TransactOpts, amount)
+}
+
+// BasketCorrelationGuardUpdatedIterator is returned from FilterCorrelationGuardUpdated and is used to iterate over the raw logs and unpacked data for CorrelationGuardUpdated events raised by the Basket contract.
+type BasketCorrelationGuardUpdatedIterator struct {
+	Event *BasketCorrelationGuardUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *BasketCorrelationGuardUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(BasketCorrelationGuardUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(BasketCorrelationGuardUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *BasketCorrelationGuardUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *BasketCorrelationGuardUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// BasketCorrelationGuardUpdated represents a CorrelationGuardUpdated event raised by the Basket contract.
+type BasketCorrelationGuardUpdated struct {
+	OldGuard common.Address
+	NewGuard common.Address
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterCorrelationGuardUpdated is a free log retrieval operation binding the contract event 0x0216b227cfb443c6a1287cf7dfdc9367ae08f2e9e5c34093e508ab1ddf7ebd83.
+//
+// Solidity: event CorrelationGuardUpdated(address indexed oldGuard, address indexed newGuard)
+func (_Basket *BasketFilterer) FilterCorrelationGuardUpdated(opts *bind.FilterOpts, oldGuard []common.Address, newGuard []common.Address) (*BasketCorrelationGuardUpdatedIterator, error) {
+
+	var oldGuardRule []interface{}
+	for _, oldGuardItem := range oldGuard {
+		oldGuardRule = append(oldGuardRule, oldGuardItem)
+	}
+	var newGuardRule []interface{}
+	for _, newGuardItem := range newGuard {
+		newGuardRule = append(newGuardRule, newGuardItem)
+	}
+
+	logs, sub, err := _Basket.contract.FilterLogs(opts, "CorrelationGuardUpdated", oldGuardRule, newGuardRule)
+	if err != nil {
+		return nil, err
+	}
+	return &BasketCorrelationGuardUpdatedIterator{contract: _Basket.contract, event: "CorrelationGuardUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchCorrelationGuardUpdated is a free log subscription operation binding the contract event 0x0216b227cfb443c6a1287cf7dfdc9367ae08f2e9e5c34093e508ab1ddf7ebd83.
+//
+// Solidity: event CorrelationGuardUpdated(address indexed oldGuard, address indexed newGuard)
+func (_Basket *BasketFilterer) WatchCorrelationGuardUpdated(opts *bind.WatchOpts, sink chan<- *BasketCorrelationGuardUpdated, oldGuard []common.Address, newGuard []common.Address) (event.Subscription, error) {
+
+	var oldGuardRule []interface{}
+	for _, oldGuardItem := range oldGuard {
+		oldGuardRule = append(oldGuardRule, oldGuardItem)
+	}
+	var newGuardRule []interface{}
+	for _, newGuardItem := range newGuard {
+		newGuardRule = append(newGuardRule, newGuardItem)
+	}
+
+	logs, sub, err := _Basket.contract.WatchLogs(opts, "CorrelationGuardUpdated", oldGuardRule, newGuardRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(BasketCorrelationGuardUpdated)
+				if err := _Basket.contract.UnpackLog(event, "CorrelationGuardUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseCorrelationGuardUpdated is a log parse operation binding the contract event 0x0216b227cfb443c6a1287cf7dfdc9367ae08f2e9e5c34093e508ab1ddf7ebd83.
+//
+// Solidity: event CorrelationGuardUpdated(address indexed oldGuard, address indexed newGuard)
+func (_Basket *BasketFilterer) ParseCorrelationGuardUpdated(log types.Log) (*BasketCorrelationGuardUpdated, error) {
+	event := new(BasketCorrelationGuardUpdated)
+	if err := _Basket.contract.UnpackLog(event, "CorrelationGuardUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// BasketMaxLegsUpdatedIterator is returned from FilterMaxLegsUpdated and is used to iterate over the raw logs and unpacked data for MaxLegsUpdated events raised by the Basket contract.
+type BasketMaxLegsUpdatedIterator struct {
+	Event *BasketMaxLegsUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *BasketMaxLegsUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(BasketMaxLegsUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(BasketMaxLegsUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *BasketMaxLegsUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *BasketMaxLegsUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// BasketMaxLegsUpdated represents a MaxLegsUpdated event raised by the Basket contract.
+type BasketMaxLegsUpdated struct {
+	OldMax *big.Int
+	NewMax *big.Int
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterMaxLegsUpdated is a free log retrieval operation binding the contract event 0x669443542dc979819b46e67f91200af5a8b2438390667fceff9860194fc63421.
+//
+// Solidity: event MaxLegsUpdated(uint256 oldMax, uint256 newMax)
+func (_Basket *BasketFilterer) FilterMaxLegsUpdated(opts *bind.FilterOpts) (*BasketMaxLegsUpdatedIterator, error) {
+
+	logs, sub, err := _Basket.contract.FilterLogs(opts, "MaxLegsUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return &BasketMaxLegsUpdatedIterator{contract: _Basket.contract, event: "MaxLegsUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchMaxLegsUpdated is a free log subscription operation binding the contract event 0x669443542dc979819b46e67f91200af5a8b2438390667fceff9860194fc63421.
+//
+// Solidity: event MaxLegsUpdated(uint256 oldMax, uint256 newMax)
+func (_Basket *BasketFilterer) WatchMaxLegsUpdated(opts *bind.WatchOpts, sink chan<- *BasketMaxLegsUpdated) (event.Subscription, error) {
+
+	logs, sub, err := _Basket.contract.WatchLogs(opts, "MaxLegsUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(BasketMaxLegsUpdated)
+				if err := _Basket.contract.UnpackLog(event, "MaxLegsUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseMaxLegsUpdated is a log parse operation binding the contract event 0x669443542dc979819b46e67f91200af5a8b2438390667fceff9860194fc63421.
+//
+// Solidity: event MaxLegsUpdated(uint256 oldMax, uint256 newMax)
+func (_Basket *BasketFilterer) ParseMaxLegsUpdated(log types.Log) (*BasketMaxLegsUpdated, error) {
+	event := new(BasketMaxLegsUpdated)
+	if err := _Basket.contract.UnpackLog(event, "MaxLegsUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// BasketOddsLimitsUpdatedIterator is returned from FilterOddsLimitsUpdated and is used to iterate over the raw logs and unpacked data for OddsLimitsUpdated events raised by the Basket contract.
+type BasketOddsLimitsUpdatedIterator struct {
+	Event *BasketOddsLimitsUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *BasketOddsLimitsUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(BasketOddsLimitsUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(BasketOddsLimitsUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *BasketOddsLimitsUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *BasketOddsLimitsUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// BasketOddsLimitsUpdated represents a OddsLimitsUpdated event raised by the Basket contract.
+type BasketOddsLimitsUpdated struct {
+	MinOdds *big.Int
+	MaxOdds *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterOddsLimitsUpdated is a free log retrieval operation binding the contract event 0x7fdfa4ddae5fa111b6894ceeb9b816fdc580acad2862d1ea6665a6e3907bfbe5.
+//
+// Solidity: event OddsLimitsUpdated(uint256 minOdds, uint256 maxOdds)
+func (_Basket *BasketFilterer) FilterOddsLimitsUpdated(opts *bind.FilterOpts) (*BasketOddsLimitsUpdatedIterator, error) {
+
+	logs, sub, err := _Basket.contract.FilterLogs(opts, "OddsLimitsUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return &BasketOddsLimitsUpdatedIterator{contract: _Basket.contract, event: "OddsLimitsUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchOddsLimitsUpdated is a free log subscription operation binding the contract event 0x7fdfa4ddae5fa111b6894ceeb9b816fdc580acad2862d1ea6665a6e3907bfbe5.
+//
+// Solidity: event OddsLimitsUpdated(uint256 minOdds, uint256 maxOdds)
+func (_Basket *BasketFilterer) WatchOddsLimitsUpdated(opts *bind.WatchOpts, sink chan<- *BasketOddsLimitsUpdated) (event.Subscription, error) {
+
+	logs, sub, err := _Basket.contract.WatchLogs(opts, "OddsLimitsUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(BasketOddsLimitsUpdated)
+				if err := _Basket.contract.UnpackLog(event, "OddsLimitsUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOddsLimitsUpdated is a log parse operation binding the contract event 0x7fdfa4ddae5fa111b6894ceeb9b816fdc580acad2862d1ea6665a6e3907bfbe5.
+//
+// Solidity: event OddsLimitsUpdated(uint256 minOdds, uint256 maxOdds)
+func (_Basket *BasketFilterer) ParseOddsLimitsUpdated(log types.Log) (*BasketOddsLimitsUpdated, error) {
+	event := new(BasketOddsLimitsUpdated)
+	if err := _Basket.contract.UnpackLog(event, "OddsLimitsUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// BasketOwnershipTransferredIterator is returned from FilterOwnershipTransferred and is used to iterate over the raw logs and unpacked data for OwnershipTransferred events raised by the Basket contract.
+type BasketOwnershipTransferredIterator struct {
+	Event *BasketOwnershipTransferred // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *BasketOwnershipTransferredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(BasketOwnershipTransferred)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(BasketOwnershipTransferred)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *BasketOwnershipTransferredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *BasketOwnershipTransferredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// BasketOwnershipTransferred represents a OwnershipTransferred event raised by the Basket contract.
+type BasketOwnershipTransferred struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterOwnershipTransferred is a free log retrieval operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_Basket *BasketFilterer) FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*BasketOwnershipTransferredIterator, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _Basket.contract.FilterLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &BasketOwnershipTransferredIterator{contract: _Basket.contract, event: "OwnershipTransferred", logs: logs, sub: sub}, nil
+}
+
+// WatchOwnershipTransferred is a free log subscription operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_Basket *BasketFilterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *BasketOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _Basket.contract.WatchLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(BasketOwnershipTransferred)
+				if err := _Basket.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOwnershipTransferred is a log parse operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_Basket *BasketFilterer) ParseOwnershipTransferred(log types.Log) (*BasketOwnershipTransferred, error) {
+	event := new(BasketOwnershipTransferred)
+	if err := _Basket.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// BasketParlayCreatedIterator is returned from FilterParlayCreated and is used to iterate over the raw logs and unpacked data for ParlayCreated events raised by the Basket contract.
+type BasketParlayCreatedIterator struct {
+	Event *BasketParlayCreated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *BasketParlayCreatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(BasketParlayCreated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(BasketParlayCreated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *BasketParlayCreatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *BasketParlayCreatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// BasketParlayCreated represents a ParlayCreated event raised by the Basket contract.
+type BasketParlayCreated struct {
+	ParlayId        *big.Int
+	User            common.Address
+	Legs            []ICorrelationGuardParlayLeg
+	Stake           *big.Int
+	PotentialPayout *big.Int
+	CombinedOdds    *big.Int
+	PenaltyBps      *big.Int
+	Raw             types.Log // Blockchain specific contextual infos
+}
+
+// FilterParlayCreated is a free log retrieval operation binding the contract event 0x6655d54665a5d46af0053724fcfe0f36c1af957f8ffebd64e1150fc16b938e14.
+//
+// Solidity: event ParlayCreated(uint256 indexed parlayId, address indexed user, (address,uint256)[] legs, uint256 stake, uint256 potentialPayout, uint256 combinedOdds, uint256 penaltyBps)
+func (_Basket *BasketFilterer) FilterParlayCreated(opts *bind.FilterOpts, parlayId []*big.Int, user []common.Address) (*BasketParlayCreatedIterator, error) {
+
+	var parlayIdRule []interface{}
+	for _, parlayIdItem := range parlayId {
+		parlayIdRule = append(parlayIdRule, parlayIdItem)
+	}
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+
+	logs, sub, err := _Basket.contract.FilterLogs(opts, "ParlayCreated", parlayIdRule, userRule)
+	if err != nil {
+		return nil, err
+	}
+	return &BasketParlayCreatedIterator{contract: _Basket.contract, event: "ParlayCreated", logs: logs, sub: sub}, nil
+}
+
+// WatchParlayCreated is a free log subscription operation binding the contract event 0x6655d54665a5d46af0053724fcfe0f36c1af957f8ffebd64e1150fc16b938e14.
+//
+// Solidity: event ParlayCreated(uint256 indexed parlayId, address indexed user, (address,uint256)[] legs, uint256 stake, uint256 potentialPayout, uint256 combinedOdds, uint256 penaltyBps)
+func (_Basket *BasketFilterer) WatchParlayCreated(opts *bind.WatchOpts, sink chan<- *BasketParlayCreated, parlayId []*big.Int, user []common.Address) (event.Subscription, error) {
+
+	var parlayIdRule []interface{}
+	for _, parlayIdItem := range parlayId {
+		parlayIdRule = append(parlayIdRule, parlayIdItem)
+	}
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+
+	logs, sub, err := _Basket.contract.WatchLogs(opts, "ParlayCreated", parlayIdRule, userRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(BasketParlayCreated)
+				if err := _Basket.contract.UnpackLog(event, "ParlayCreated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseParlayCreated is a log parse operation binding the contract event 0x6655d54665a5d46af0053724fcfe0f36c1af957f8ffebd64e1150fc16b938e14.
+//
+// Solidity: event ParlayCreated(uint256 indexed parlayId, address indexed user, (address,uint256)[] legs, uint256 stake, uint256 potentialPayout, uint256 combinedOdds, uint256 penaltyBps)
+func (_Basket *BasketFilterer) ParseParlayCreated(log types.Log) (*BasketParlayCreated, error) {
+	event := new(BasketParlayCreated)
+	if err := _Basket.contract.UnpackLog(event, "ParlayCreated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// BasketParlayQuotedIterator is returned from FilterParlayQuoted and is used to iterate over the raw logs and unpacked data for ParlayQuoted events raised by the Basket contract.
+type BasketParlayQuotedIterator struct {
+	Event *BasketParlayQuoted // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *BasketParlayQuotedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(BasketParlayQuoted)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(BasketParlayQuoted)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *BasketParlayQuotedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *BasketParlayQuotedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// BasketParlayQuoted represents a ParlayQuoted event raised by the Basket contract.
+type BasketParlayQuoted struct {
+	User            common.Address
+	Legs            []ICorrelationGuardParlayLeg
+	CombinedOdds    *big.Int
+	PenaltyBps      *big.Int
+	PotentialPayout *big.Int
+	Raw             types.Log // Blockchain specific contextual infos
+}
+
+// FilterParlayQuoted is a free log retrieval operation binding the contract event 0x19dcc4720af9bb2180e4ebcca5ead929e2ade1e8fb79bd3a964c28cbc7dd2ca8.
+//
+// Solidity: event ParlayQuoted(address indexed user, (address,uint256)[] legs, uint256 combinedOdds, uint256 penaltyBps, uint256 potentialPayout)
+func (_Basket *BasketFilterer) FilterParlayQuoted(opts *bind.FilterOpts, user []common.Address) (*BasketParlayQuotedIterator, error) {
+
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+
+	logs, sub, err := _Basket.contract.FilterLogs(opts, "ParlayQuoted", userRule)
+	if err != nil {
+		return nil, err
+	}
+	return &BasketParlayQuotedIterator{contract: _Basket.contract, event: "ParlayQuoted", logs: logs, sub: sub}, nil
+}
+
+// WatchParlayQuoted is a free log subscription operation binding the contract event 0x19dcc4720af9bb2180e4ebcca5ead929e2ade1e8fb79bd3a964c28cbc7dd2ca8.
+//
+// Solidity: event ParlayQuoted(address indexed user, (address,uint256)[] legs, uint256 combinedOdds, uint256 penaltyBps, uint256 potentialPayout)
+func (_Basket *BasketFilterer) WatchParlayQuoted(opts *bind.WatchOpts, sink chan<- *BasketParlayQuoted, user []common.Address) (event.Subscription, error) {
+
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+
+	logs, sub, err := _Basket.contract.WatchLogs(opts, "ParlayQuoted", userRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(BasketParlayQuoted)
+				if err := _Basket.contract.UnpackLog(event, "ParlayQuoted", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseParlayQuoted is a log parse operation binding the contract event 0x19dcc4720af9bb2180e4ebcca5ead929e2ade1e8fb79bd3a964c28cbc7dd2ca8.
+//
+// Solidity: event ParlayQuoted(address indexed user, (address,uint256)[] legs, uint256 combinedOdds, uint256 penaltyBps, uint256 potentialPayout)
+func (_Basket *BasketFilterer) ParseParlayQuoted(log types.Log) (*BasketParlayQuoted, error) {
+	event := new(BasketParlayQuoted)
+	if err := _Basket.contract.UnpackLog(event, "ParlayQuoted", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// BasketParlaySettledIterator is returned from FilterParlaySettled and is used to iterate over the raw logs and unpacked data for ParlaySettled events raised by the Basket contract.
+type BasketParlaySettledIterator struct {
+	Event *BasketParlaySettled // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *BasketParlaySettledIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(BasketParlaySettled)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(BasketParlaySettled)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *BasketParlaySettledIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *BasketParlaySettledIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// BasketParlaySettled represents a ParlaySettled event raised by the Basket contract.
+type BasketParlaySettled struct {
+	ParlayId *big.Int
+	User     common.Address
+	Status   uint8
+	Payout   *big.Int
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterParlaySettled is a free log retrieval operation binding the contract event 0xafd922159c5a1e4895f8ef419596703517b47fa2a5243133083847a82fbd563b.
+//
+// Solidity: event ParlaySettled(uint256 indexed parlayId, address indexed user, uint8 status, uint256 payout)
+func (_Basket *BasketFilterer) FilterParlaySettled(opts *bind.FilterOpts, parlayId []*big.Int, user []common.Address) (*BasketParlaySettledIterator, error) {
+
+	var parlayIdRule []interface{}
+	for _, parlayIdItem := range parlayId {
+		parlayIdRule = append(parlayIdRule, parlayIdItem)
+	}
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+
+	logs, sub, err := _Basket.contract.FilterLogs(opts, "ParlaySettled", parlayIdRule, userRule)
+	if err != nil {
+		return nil, err
+	}
+	return &BasketParlaySettledIterator{contract: _Basket.contract, event: "ParlaySettled", logs: logs, sub: sub}, nil
+}
+
+// WatchParlaySettled is a free log subscription operation binding the contract event 0xafd922159c5a1e4895f8ef419596703517b47fa2a5243133083847a82fbd563b.
+//
+// Solidity: event ParlaySettled(uint256 indexed parlayId, address indexed user, uint8 status, uint256 payout)
+func (_Basket *BasketFilterer) WatchParlaySettled(opts *bind.WatchOpts, sink chan<- *BasketParlaySettled, parlayId []*big.Int, user []common.Address) (event.Subscription, error) {
+
+	var parlayIdRule []interface{}
+	for _, parlayIdItem := range parlayId {
+		parlayIdRule = append(parlayIdRule, parlayIdItem)
+	}
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+
+	logs, sub, err := _Basket.contract.WatchLogs(opts, "ParlaySettled", parlayIdRule, userRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(BasketParlaySettled)
+				if err := _Basket.contract.UnpackLog(event, "ParlaySettled", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseParlaySettled is a log parse operation binding the contract event 0xafd922159c5a1e4895f8ef419596703517b47fa2a5243133083847a82fbd563b.
+//
+// Solidity: event ParlaySettled(uint256 indexed parlayId, address indexed user, uint8 status, uint256 payout)
+func (_Basket *BasketFilterer) ParseParlaySettled(log types.Log) (*BasketParlaySettled, error) {
+	event := new(BasketParlaySettled)
+	if err := _Basket.contract.UnpackLog(event, "ParlaySettled", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,3056 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package bindings
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// BettingRouterV3RedeemParams is an auto generated low-level Go binding around an user-defined struct.
+type BettingRouterV3RedeemParams struct {
+	Market    common.Address
+	OutcomeId *big.Int
+	Shares    *big.Int
+}
+
+// IBettingRouterV3BetParams is an auto generated low-level Go binding around an user-defined struct.
+type IBettingRouterV3BetParams struct {
+	Market    common.Address
+	OutcomeId *big.Int
+	Amount    *big.Int
+	MinShares *big.Int
+}
+
+// IBettingRouterV3BetResult is an auto generated low-level Go binding around an user-defined struct.
+type IBettingRouterV3BetResult struct {
+	Market    common.Address
+	OutcomeId *big.Int
+	Amount    *big.Int
+	Shares    *big.Int
+	Fee       *big.Int
+	Token     common.Address
+}
+
+// IBettingRouterV3FeeResult is an auto generated low-level Go binding around an user-defined struct.
+type IBettingRouterV3FeeResult struct {
+	GrossAmount *big.Int
+	FeeAmount   *big.Int
+	NetAmount   *big.Int
+	DiscountBps *big.Int
+	Referrer    common.Address
+}
+
+// IBettingRouterV3TokenInfo is an auto generated low-level Go binding around an user-defined struct.
+type IBettingRouterV3TokenInfo struct {
+	Supported    bool
+	FeeRateBps   *big.Int
+	FeeRecipient common.Address
+	MinBetAmount *big.Int
+	MaxBetAmount *big.Int
+}
+
+// BettingRouterV3MetaData contains all meta data concerning the BettingRouterV3 contract.
+var BettingRouterV3MetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[{\"name\":\"_factory\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"_defaultFeeRateBps\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"_defaultFeeRecipient\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"addToken\",\"inputs\":[{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"feeRateBps\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"feeRecipient\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"minBetAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"maxBetAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"calculateFee\",\"inputs\":[{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"user\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"result\",\"type\":\"tuple\",\"internalType\":\"structIBettingRouter_V3.FeeResult\",\"components\":[{\"name\":\"grossAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"feeAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"netAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"discountBps\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"referrer\",\"type\":\"address\",\"internalType\":\"address\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"calculateFeeForMarket\",\"inputs\":[{\"name\":\"market\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"user\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"result\",\"type\":\"tuple\",\"internalType\":\"structIBettingRouter_V3.FeeResult\",\"components\":[{\"name\":\"grossAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"feeAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"netAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"discountBps\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"referrer\",\"type\":\"address\",\"internalType\":\"address\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"defaultFeeRateBps\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"defaultFeeRecipient\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"emergencyWithdraw\",\"inputs\":[{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"factory\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getMarketToken\",\"inputs\":[{\"name\":\"market\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getSupportedTokens\",\"inputs\":[],\"outputs\":[{\"name\":\"tokens\",\"type\":\"address[]\",\"internalType\":\"address[]\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getTokenInfo\",\"inputs\":[{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"tuple\",\"internalType\":\"structIBettingRouter_V3.TokenInfo\",\"components\":[{\"name\":\"supported\",\"type\":\"bool\",\"internalType\":\"bool\"},{\"name\":\"feeRateBps\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"feeRecipient\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"minBetAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"maxBetAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"isTokenSupported\",\"inputs\":[{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"owner\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"paramController\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"contractIParamController\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"paused\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"placeBet\",\"inputs\":[{\"name\":\"market\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"outcomeId\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"minShares\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"shares\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"placeBetBatch\",\"inputs\":[{\"name\":\"bets\",\"type\":\"tuple[]\",\"internalType\":\"structIBettingRouter_V3.BetParams[]\",\"components\":[{\"name\":\"market\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"outcomeId\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"minShares\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]}],\"outputs\":[{\"name\":\"results\",\"type\":\"tuple[]\",\"internalType\":\"structIBettingRouter_V3.BetResult[]\",\"components\":[{\"name\":\"market\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"outcomeId\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"shares\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"fee\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"}]}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"placeBetMultiOutcome\",\"inputs\":[{\"name\":\"market\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"outcomeIds\",\"type\":\"uint256[]\",\"internalType\":\"uint256[]\"},{\"name\":\"amounts\",\"type\":\"uint256[]\",\"internalType\":\"uint256[]\"},{\"name\":\"minSharesList\",\"type\":\"uint256[]\",\"internalType\":\"uint256[]\"}],\"outputs\":[{\"name\":\"sharesList\",\"type\":\"uint256[]\",\"internalType\":\"uint256[]\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"previewBet\",\"inputs\":[{\"name\":\"market\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"outcomeId\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"netAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"shares\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"fee\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"removeToken\",\"inputs\":[{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"renounceOwnership\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setDefaultFeeRate\",\"inputs\":[{\"name\":\"_feeRateBps\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setDefaultFeeRecipient\",\"inputs\":[{\"name\":\"_recipient\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setFactory\",\"inputs\":[{\"name\":\"_factory\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setParamController\",\"inputs\":[{\"name\":\"_paramController\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setPaused\",\"inputs\":[{\"name\":\"_paused\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"transferOwnership\",\"inputs\":[{\"name\":\"newOwner\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"updateTokenConfig\",\"inputs\":[{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"feeRateBps\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"feeRecipient\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"minBetAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"maxBetAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"validateMarket\",\"inputs\":[{\"name\":\"market\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"valid\",\"type\":\"bool\",\"internalType\":\"bool\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"event\",\"name\":\"BatchBetPlaced\",\"inputs\":[{\"name\":\"user\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"betCount\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"totalFee\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"BetPlaced\",\"inputs\":[{\"name\":\"user\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"market\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"token\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"outcomeId\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"shares\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"fee\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"EmergencyWithdraw\",\"inputs\":[{\"name\":\"token\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"to\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"FeeRouted\",\"inputs\":[{\"name\":\"token\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"recipient\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"OwnershipTransferred\",\"inputs\":[{\"name\":\"previousOwner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"newOwner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"ParamControllerUpdated\",\"inputs\":[{\"name\":\"paramController\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"TokenAdded\",\"inputs\":[{\"name\":\"token\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"feeRateBps\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"feeRecipient\",\"type\":\"address\",\"indexed\":false,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"TokenConfigUpdated\",\"inputs\":[{\"name\":\"token\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"feeRateBps\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"feeRecipient\",\"type\":\"address\",\"indexed\":false,\"internalType\":\"address\"},{\"name\":\"minBetAmount\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"maxBetAmount\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"TokenRemoved\",\"inputs\":[{\"name\":\"token\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"error\",\"name\":\"BetAmountTooHigh\",\"inputs\":[{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"maximum\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"BetAmountTooLow\",\"inputs\":[{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"minimum\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"InvalidMarket\",\"inputs\":[{\"name\":\"market\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"InvalidParams\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"MarketNotOpen\",\"inputs\":[{\"name\":\"market\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"OwnableInvalidOwner\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"OwnableUnauthorizedAccount\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"ReentrancyGuardReentrantCall\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"RouterPaused\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"SafeERC20FailedOperation\",\"inputs\":[{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"SlippageExceeded\",\"inputs\":[{\"name\":\"expected\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"actual\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"TokenMismatch\",\"inputs\":[{\"name\":\"expected\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"actual\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"UnsupportedToken\",\"inputs\":[{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"ZeroAddress\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"ZeroAmount\",\"inputs\":[]},{\"type\":\"function\",\"name\":\"batchRedeem\",\"inputs\":[{\"name\":\"redeems\",\"type\":\"tuple[]\",\"internalType\":\"structBettingRouter_V3.RedeemParams[]\",\"components\":[{\"name\":\"market\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"outcomeId\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"shares\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]}],\"outputs\":[{\"name\":\"totalPayout\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"batchRefund\",\"inputs\":[{\"name\":\"refunds\",\"type\":\"tuple[]\",\"internalType\":\"structBettingRouter_V3.RedeemParams[]\",\"components\":[{\"name\":\"market\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"outcomeId\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"shares\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]}],\"outputs\":[{\"name\":\"totalRefund\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"getRedeemablePositions\",\"inputs\":[{\"name\":\"user\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"markets\",\"type\":\"address[]\",\"internalType\":\"address[]\"},{\"name\":\"outcomeIds\",\"type\":\"uint256[]\",\"internalType\":\"uint256[]\"}],\"outputs\":[{\"name\":\"balances\",\"type\":\"uint256[]\",\"internalType\":\"uint256[]\"},{\"name\":\"statuses\",\"type\":\"uint8[]\",\"internalType\":\"enumIMarket_V3.MarketStatus[]\"}],\"stateMutability\":\"view\"},{\"type\":\"event\",\"name\":\"PayoutRedeemed\",\"inputs\":[{\"name\":\"user\",\"type\":\"address\",\"internalType\":\"address\",\"indexed\":true},{\"name\":\"market\",\"type\":\"address\",\"internalType\":\"address\",\"indexed\":true},{\"name\":\"outcomeId\",\"type\":\"uint256\",\"internalType\":\"uint256\",\"indexed\":true},{\"name\":\"shares\",\"type\":\"uint256\",\"internalType\":\"uint256\",\"indexed\":false},{\"name\":\"payout\",\"type\":\"uint256\",\"internalType\":\"uint256\",\"indexed\":false}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"BatchRedeemed\",\"inputs\":[{\"name\":\"user\",\"type\":\"address\",\"internalType\":\"address\",\"indexed\":true},{\"name\":\"marketCount\",\"type\":\"uint256\",\"internalType\":\"uint256\",\"indexed\":false},{\"name\":\"totalPayout\",\"type\":\"uint256\",\"internalType\":\"uint256\",\"indexed\":false}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"RefundRedeemed\",\"inputs\":[{\"name\":\"user\",\"type\":\"address\",\"internalType\":\"address\",\"indexed\":true},{\"name\":\"market\",\"type\":\"address\",\"internalType\":\"address\",\"indexed\":true},{\"name\":\"outcomeId\",\"type\":\"uint256\",\"internalType\":\"uint256\",\"indexed\":true},{\"name\":\"shares\",\"type\":\"uint256\",\"internalType\":\"uint256\",\"indexed\":false},{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\",\"indexed\":false}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"BatchRefunded\",\"inputs\":[{\"name\":\"user\",\"type\":\"address\",\"internalType\":\"address\",\"indexed\":true},{\"name\":\"marketCount\",\"type\":\"uint256\",\"internalType\":\"uint256\",\"indexed\":false},{\"name\":\"totalRefund\",\"type\":\"uint256\",\"internalType\":\"uint256\",\"indexed\":false}],\"anonymous\":false}]",
+}
+
+// BettingRouterV3ABI is the input ABI used to generate the binding from.
+// Deprecated: Use BettingRouterV3MetaData.ABI instead.
+var BettingRouterV3ABI = BettingRouterV3MetaData.ABI
+
+// BettingRouterV3 is an auto generated Go binding around an Ethereum contract.
+type BettingRouterV3 struct {
+	BettingRouterV3Caller     // Read-only binding to the contract
+	BettingRouterV3Transactor // Write-only binding to the contract
+	BettingRouterV3Filterer   // Log filterer for contract events
+}
+
+// BettingRouterV3Caller is an auto generated read-only Go binding around an Ethereum contract.
+type BettingRouterV3Caller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// BettingRouterV3Transactor is an auto generated write-only Go binding around an Ethereum contract.
+type BettingRouterV3Transactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// BettingRouterV3Filterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type BettingRouterV3Filterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// BettingRouterV3Session is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type BettingRouterV3Session struct {
+	Contract     *BettingRouterV3  // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// BettingRouterV3CallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type BettingRouterV3CallerSession struct {
+	Contract *BettingRouterV3Caller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts          // Call options to use throughout this session
+}
+
+// BettingRouterV3TransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type BettingRouterV3TransactorSession struct {
+	Contract     *BettingRouterV3Transactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts          // Transaction auth options to use throughout this session
+}
+
+// BettingRouterV3Raw is an auto generated low-level Go binding around an Ethereum contract.
+type BettingRouterV3Raw struct {
+	Contract *BettingRouterV3 // Generic contract binding to access the raw methods on
+}
+
+// BettingRouterV3CallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type BettingRouterV3CallerRaw struct {
+	Contract *BettingRouterV3Caller // Generic read-only contract binding to access the raw methods on
+}
+
+// BettingRouterV3TransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type BettingRouterV3TransactorRaw struct {
+	Contract *BettingRouterV3Transactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewBettingRouterV3 creates a new instance of BettingRouterV3, bound to a specific deployed contract.
+func NewBettingRouterV3(address common.Address, backend bind.ContractBackend) (*BettingRouterV3, error) {
+	contract, err := bindBettingRouterV3(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &BettingRouterV3{BettingRouterV3Caller: BettingRouterV3Caller{contract: contract}, BettingRouterV3Transactor: BettingRouterV3Transactor{contract: contract}, BettingRouterV3Filterer: BettingRouterV3Filterer{contract: contract}}, nil
+}
+
+// NewBettingRouterV3Caller creates a new read-only instance of BettingRouterV3, bound to a specific deployed contract.
+func NewBettingRouterV3Caller(address common.Address, caller bind.ContractCaller) (*BettingRouterV3Caller, error) {
+	contract, err := bindBettingRouterV3(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &BettingRouterV3Caller{contract: contract}, nil
+}
+
+// NewBettingRouterV3Transactor creates a new write-only instance of BettingRouterV3, bound to a specific deployed contract.
+func NewBettingRouterV3Transactor(address common.Address, transactor bind.ContractTransactor) (*BettingRouterV3Transactor, error) {
+	contract, err := bindBettingRouterV3(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &BettingRouterV3Transactor{contract: contract}, nil
+}
+
+// NewBettingRouterV3Filterer creates a new log filterer instance of BettingRouterV3, bound to a specific deployed contract.
+func NewBettingRouterV3Filterer(address common.Address, filterer bind.ContractFilterer) (*BettingRouterV3Filterer, error) {
+	contract, err := bindBettingRouterV3(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &BettingRouterV3Filterer{contract: contract}, nil
+}
+
+// bindBettingRouterV3 binds a generic wrapper to an already deployed contract.
+func bindBettingRouterV3(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := BettingRouterV3MetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_BettingRouterV3 *BettingRouterV3Raw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _BettingRouterV3.Contract.BettingRouterV3Caller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_BettingRouterV3 *BettingRouterV3Raw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _BettingRouterV3.Contract.BettingRouterV3Transactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_BettingRouterV3 *BettingRouterV3Raw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _BettingRouterV3.Contract.BettingRouterV3Transactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_BettingRouterV3 *BettingRouterV3CallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _BettingRouterV3.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_BettingRouterV3 *BettingRouterV3TransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _BettingRouterV3.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_BettingRouterV3 *BettingRouterV3TransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _BettingRouterV3.Contract.contract.Transact(opts, method, params...)
+}
+
+// CalculateFee is a free data retrieval call binding the contract method 0x7ca87cb6.
+//
+// Solidity: function calculateFee(address token, address user, uint256 amount) view returns((uint256,uint256,uint256,uint256,address) result)
+func (_BettingRouterV3 *BettingRouterV3Caller) CalculateFee(opts *bind.CallOpts, token common.Address, user common.Address, amount *big.Int) (IBettingRouterV3FeeResult, error) {
+	var out []interface{}
+	err := _BettingRouterV3.contract.Call(opts, &out, "calculateFee", token, user, amount)
+
+	if err != nil {
+		return *new(IBettingRouterV3FeeResult), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(IBettingRouterV3FeeResult)).(*IBettingRouterV3FeeResult)
+
+	return out0, err
+
+}
+
+// CalculateFee is a free data retrieval call binding the contract method 0x7ca87cb6.
+//
+// Solidity: function calculateFee(address token, address user, uint256 amount) view returns((uint256,uint256,uint256,uint256,address) result)
+func (_BettingRouterV3 *BettingRouterV3Session) CalculateFee(token common.Address, user common.Address, amount *big.Int) (IBettingRouterV3FeeResult, error) {
+	return _BettingRouterV3.Contract.CalculateFee(&_BettingRouterV3.CallOpts, token, user, amount)
+}
+
+// CalculateFee is a free data retrieval call binding the contract method 0x7ca87cb6.
+//
+// Solidity: function calculateFee(address token, address user, uint256 amount) view returns((uint256,uint256,uint256,uint256,address) result)
+func (_BettingRouterV3 *BettingRouterV3CallerSession) CalculateFee(token common.Address, user common.Address, amount *big.Int) (IBettingRouterV3FeeResult, error) {
+	return _BettingRouterV3.Contract.CalculateFee(&_BettingRouterV3.CallOpts, token, user, amount)
+}
+
+// CalculateFeeForMarket is a free data retrieval call binding the contract method 0x3b131362.
+//
+// Solidity: function calculateFeeForMarket(address market, address user, uint256 amount) view returns((uint256,uint256,uint256,uint256,address) result)
+func (_BettingRouterV3 *BettingRouterV3Caller) CalculateFeeForMarket(opts *bind.CallOpts, market common.Address, user common.Address, amount *big.Int) (IBettingRouterV3FeeResult, error) {
+	var out []interface{}
+	err := _BettingRouterV3.contract.Call(opts, &out, "calculateFeeForMarket", market, user, amount)
+
+	if err != nil {
+		return *new(IBettingRouterV3FeeResult), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(IBettingRouterV3FeeResult)).(*IBettingRouterV3FeeResult)
+
+	return out0, err
+
+}
+
+// CalculateFeeForMarket is a free data retrieval call binding the contract method 0x3b131362.
+//
+// Solidity: function calculateFeeForMarket(address market, address user, uint256 amount) view returns((uint256,uint256,uint256,uint256,address) result)
+func (_BettingRouterV3 *BettingRouterV3Session) CalculateFeeForMarket(market common.Address, user common.Address, amount *big.Int) (IBettingRouterV3FeeResult, error) {
+	return _BettingRouterV3.Contract.CalculateFeeForMarket(&_BettingRouterV3.CallOpts, market, user, amount)
+}
+
+// CalculateFeeForMarket is a free data retrieval call binding the contract method 0x3b131362.
+//
+// Solidity: function calculateFeeForMarket(address market, address user, uint256 amount) view returns((uint256,uint256,uint256,uint256,address) result)
+func (_BettingRouterV3 *BettingRouterV3CallerSession) CalculateFeeForMarket(market common.Address, user common.Address, amount *big.Int) (IBettingRouterV3FeeResult, error) {
+	return _BettingRouterV3.Contract.CalculateFeeForMarket(&_BettingRouterV3.CallOpts, market, user, amount)
+}
+
+// DefaultFeeRateBps is a free data retrieval call binding the contract method 0x5d6eafc7.
+//
+// Solidity: function defaultFeeRateBps() view returns(uint256)
+func (_BettingRouterV3 *BettingRouterV3Caller) DefaultFeeRateBps(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _BettingRouterV3.contract.Call(opts, &out, "defaultFeeRateBps")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// DefaultFeeRateBps is a free data retrieval call binding the contract method 0x5d6eafc7.
+//
+// Solidity: function defaultFeeRateBps() view returns(uint256)
+func (_BettingRouterV3 *BettingRouterV3Session) DefaultFeeRateBps() (*big.Int, error) {
+	return _BettingRouterV3.Contract.DefaultFeeRateBps(&_BettingRouterV3.CallOpts)
+}
+
+// DefaultFeeRateBps is a free data retrieval call binding the contract method 0x5d6eafc7.
+//
+// Solidity: function defaultFeeRateBps() view returns(uint256)
+func (_BettingRouterV3 *BettingRouterV3CallerSession) DefaultFeeRateBps() (*big.Int, error) {
+	return _BettingRouterV3.Contract.DefaultFeeRateBps(&_BettingRouterV3.CallOpts)
+}
+
+// DefaultFeeRecipient is a free data retrieval call binding the contract method 0x4e3fe278.
+//
+// Solidity: function defaultFeeRecipient() view returns(address)
+func (_BettingRouterV3 *BettingRouterV3Caller) DefaultFeeRecipient(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _BettingRouterV3.contract.Call(opts, &out, "defaultFeeRecipient")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// DefaultFeeRecipient is a free data retrieval call binding the contract method 0x4e3fe278.
+//
+// Solidity: function defaultFeeRecipient() view returns(address)
+func (_BettingRouterV3 *BettingRouterV3Session) DefaultFeeRecipient() (common.Address, error) {
+	return _BettingRouterV3.Contract.DefaultFeeRecipient(&_BettingRouterV3.CallOpts)
+}
+
+// DefaultFeeRecipient is a free data retrieval call binding the contract method 0x4e3fe278.
+//
+// Solidity: function defaultFeeRecipient() view returns(address)
+func (_BettingRouterV3 *BettingRouterV3CallerSession) DefaultFeeRecipient() (common.Address, error) {
+	return _BettingRouterV3.Contract.DefaultFeeRecipient(&_BettingRouterV3.CallOpts)
+}
+
+// Factory is a free data retrieval call binding the contract method 0xc45a0155.
+//
+// Solidity: function factory() view returns(address)
+func (_BettingRouterV3 *BettingRouterV3Caller) Factory(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _BettingRouterV3.contract.Call(opts, &out, "factory")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Factory is a free data retrieval call binding the contract method 0xc45a0155.
+//
+// Solidity: function factory() view returns(address)
+func (_BettingRouterV3 *BettingRouterV3Session) Factory() (common.Address, error) {
+	return _BettingRouterV3.Contract.Factory(&_BettingRouterV3.CallOpts)
+}
+
+// Factory is a free data retrieval call binding the contract method 0xc45a0155.
+//
+// Solidity: function factory() view returns(address)
+func (_BettingRouterV3 *BettingRouterV3CallerSession) Factory() (common.Address, error) {
+	return _BettingRouterV3.Contract.Factory(&_BettingRouterV3.CallOpts)
+}
+
+// GetMarketToken is a free data retrieval call binding the contract method 0x10e11043.
+//
+// Solidity: function getMarketToken(address market) view returns(address)
+func (_BettingRouterV3 *BettingRouterV3Caller) GetMarketToken(opts *bind.CallOpts, market common.Address) (common.Address, error) {
+	var out []interface{}
+	err := _BettingRouterV3.contract.Call(opts, &out, "getMarketToken", market)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetMarketToken is a free data retrieval call binding the contract method 0x10e11043.
+//
+// Solidity: function getMarketToken(address market) view returns(address)
+func (_BettingRouterV3 *BettingRouterV3Session) GetMarketToken(market common.Address) (common.Address, error) {
+	return _BettingRouterV3.Contract.GetMarketToken(&_BettingRouterV3.CallOpts, market)
+}
+
+// GetMarketToken is a free data retrieval call binding the contract method 0x10e11043.
+//
+// Solidity: function getMarketToken(address market) view returns(address)
+func (_BettingRouterV3 *BettingRouterV3CallerSession) GetMarketToken(market common.Address) (common.Address, error) {
+	return _BettingRouterV3.Contract.GetMarketToken(&_BettingRouterV3.CallOpts, market)
+}
+
+// GetRedeemablePositions is a free data retrieval call binding the contract method 0x3926b05a.
+//
+// Solidity: function getRedeemablePositions(address user, address[] markets, uint256[] outcomeIds) view returns(uint256[] balances, uint8[] statuses)
+func (_BettingRouterV3 *BettingRouterV3Caller) GetRedeemablePositions(opts *bind.CallOpts, user common.Address, markets []common.Address, outcomeIds []*big.Int) (struct {
+	Balances []*big.Int
+	Statuses []uint8
+}, error) {
+	var out []interface{}
+	err := _BettingRouterV3.contract.Call(opts, &out, "getRedeemablePositions", user, markets, outcomeIds)
+
+	outstruct := new(struct {
+		Balances []*big.Int
+		Statuses []uint8
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Balances = *abi.ConvertType(out[0], new([]*big.Int)).(*[]*big.Int)
+	outstruct.Statuses = *abi.ConvertType(out[1], new([]uint8)).(*[]uint8)
+
+	return *outstruct, err
+
+}
+
+// GetRedeemablePositions is a free data retrieval call binding the contract method 0x3926b05a.
+//
+// Solidity: function getRedeemablePositions(address user, address[] markets, uint256[] outcomeIds) view returns(uint256[] balances, uint8[] statuses)
+func (_BettingRouterV3 *BettingRouterV3Session) GetRedeemablePositions(user common.Address, markets []common.Address, outcomeIds []*big.Int) (struct {
+	Balances []*big.Int
+	Statuses []uint8
+}, error) {
+	return _BettingRouterV3.Contract.GetRedeemablePositions(&_BettingRouterV3.CallOpts, user, markets, outcomeIds)
+}
+
+// GetRedeemablePositions is a free data retrieval call binding the contract method 0x3926b05a.
+//
+// Solidity: function getRedeemablePositions(address user, address[] markets, uint256[] outcomeIds) view returns(uint256[] balances, uint8[] statuses)
+func (_BettingRouterV3 *BettingRouterV3CallerSession) GetRedeemablePositions(user common.Address, markets []common.Address, outcomeIds []*big.Int) (struct {
+	Balances []*big.Int
+	Statuses []uint8
+}, error) {
+	return _BettingRouterV3.Contract.GetRedeemablePositions(&_BettingRouterV3.CallOpts, user, markets, outcomeIds)
+}
+
+// GetSupportedTokens is a free data retrieval call binding the contract method 0xd3c7c2c7.
+//
+// Solidity: function getSupportedTokens() view returns(address[] tokens)
+func (_BettingRouterV3 *BettingRouterV3Caller) GetSupportedTokens(opts *bind.CallOpts) ([]common.Address, error) {
+	var out []interface{}
+	err := _BettingRouterV3.contract.Call(opts, &out, "getSupportedTokens")
+
+	if err != nil {
+		return *new([]common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]common.Address)).(*[]common.Address)
+
+	return out0, err
+
+}
+
+// GetSupportedTokens is a free data retrieval call binding the contract method 0xd3c7c2c7.
+//
+// Solidity: function getSupportedTokens() view returns(address[] tokens)
+func (_BettingRouterV3 *BettingRouterV3Session) GetSupportedTokens() ([]common.Address, error) {
+	return _BettingRouterV3.Contract.GetSupportedTokens(&_BettingRouterV3.CallOpts)
+}
+
+// GetSupportedTokens is a free data retrieval call binding the contract method 0xd3c7c2c7.
+//
+// Solidity: function getSupportedTokens() view returns(address[] tokens)
+func (_BettingRouterV3 *BettingRouterV3CallerSession) GetSupportedTokens() ([]common.Address, error) {
+	return _BettingRouterV3.Contract.GetSupportedTokens(&_BettingRouterV3.CallOpts)
+}
+
+// GetTokenInfo is a free data retrieval call binding the contract method 0x1f69565f.
+//
+// Solidity: function getTokenInfo(address token) view returns((bool,uint256,address,uint256,uint256))
+func (_BettingRouterV3 *BettingRouterV3Caller) GetTokenInfo(opts *bind.CallOpts, token common.Address) (IBettingRouterV3TokenInfo, error) {
+	var out []interface{}
+	err := _BettingRouterV3.contract.Call(opts, &out, "getTokenInfo", token)
+
+	if err != nil {
+		return *new(IBettingRouterV3TokenInfo), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(IBettingRouterV3TokenInfo)).(*IBettingRouterV3TokenInfo)
+
+	return out0, err
+
+}
+
+// GetTokenInfo is a free data retrieval call binding the contract method 0x1f69565f.
+//
+// Solidity: function getTokenInfo(address token) view returns((bool,uint256,address,uint256,uint256))
+func (_BettingRouterV3 *BettingRouterV3Session) GetTokenInfo(token common.Address) (IBettingRouterV3TokenInfo, error) {
+	return _BettingRouterV3.Contract.GetTokenInfo(&_BettingRouterV3.CallOpts, token)
+}
+
+// GetTokenInfo is a free data retrieval call binding the contract method 0x1f69565f.
+//
+// Solidity: function getTokenInfo(address token) view returns((bool,uint256,address,uint256,uint256))
+func (_BettingRouterV3 *BettingRouterV3CallerSession) GetTokenInfo(token common.Address) (IBettingRouterV3TokenInfo, error) {
+	return _BettingRouterV3.Contract.GetTokenInfo(&_BettingRouterV3.CallOpts, token)
+}
+
+// IsTokenSupported is a free data retrieval call binding the contract method 0x75151b63.
+//
+// Solidity: function isTokenSupported(address token) view returns(bool)
+func (_BettingRouterV3 *BettingRouterV3Caller) IsTokenSupported(opts *bind.CallOpts, token common.Address) (bool, error) {
+	var out []interface{}
+	err := _BettingRouterV3.contract.Call(opts, &out, "isTokenSupported", token)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsTokenSupported is a free data retrieval call binding the contract method 0x75151b63.
+//
+// Solidity: function isTokenSupported(address token) view returns(bool)
+func (_BettingRouterV3 *BettingRouterV3Session) IsTokenSupported(token common.Address) (bool, error) {
+	return _BettingRouterV3.Contract.IsTokenSupported(&_BettingRouterV3.CallOpts, token)
+}
+
+// IsTokenSupported is a free data retrieval call binding the contract method 0x75151b63.
+//
+// Solidity: function isTokenSupported(address token) view returns(bool)
+func (_BettingRouterV3 *BettingRouterV3CallerSession) IsTokenSupported(token common.Address) (bool, error) {
+	return _BettingRouterV3.Contract.IsTokenSupported(&_BettingRouterV3.CallOpts, token)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_BettingRouterV3 *BettingRouterV3Caller) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _BettingRouterV3.contract.Call(opts, &out, "owner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_BettingRouterV3 *BettingRouterV3Session) Owner() (common.Address, error) {
+	return _BettingRouterV3.Contract.Owner(&_BettingRouterV3.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_BettingRouterV3 *BettingRouterV3CallerSession) Owner() (common.Address, error) {
+	return _BettingRouterV3.Contract.Owner(&_BettingRouterV3.CallOpts)
+}
+
+// ParamController is a free data retrieval call binding the contract method 0xd0d1854d.
+//
+// Solidity: function paramController() view returns(address)
+func (_BettingRouterV3 *BettingRouterV3Caller) ParamController(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _BettingRouterV3.contract.Call(opts, &out, "paramController")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// ParamController is a free data retrieval call binding the contract method 0xd0d1854d.
+//
+// Solidity: function paramController() view returns(address)
+func (_BettingRouterV3 *BettingRouterV3Session) ParamController() (common.Address, error) {
+	return _BettingRouterV3.Contract.ParamController(&_BettingRouterV3.CallOpts)
+}
+
+// ParamController is a free data retrieval call binding the contract method 0xd0d1854d.
+//
+// Solidity: function paramController() view returns(address)
+func (_BettingRouterV3 *BettingRouterV3CallerSession) ParamController() (common.Address, error) {
+	return _BettingRouterV3.Contract.ParamController(&_BettingRouterV3.CallOpts)
+}
+
+// Paused is a free data retrieval call binding the contract method 0x5c975abb.
+//
+// Solidity: function paused() view returns(bool)
+func (_BettingRouterV3 *BettingRouterV3Caller) Paused(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _BettingRouterV3.contract.Call(opts, &out, "paused")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// Paused is a free data retrieval call binding the contract method 0x5c975abb.
+//
+// Solidity: function paused() view returns(bool)
+func (_BettingRouterV3 *BettingRouterV3Session) Paused() (bool, error) {
+	return _BettingRouterV3.Contract.Paused(&_BettingRouterV3.CallOpts)
+}
+
+// Paused is a free data retrieval call binding the contract method 0x5c975abb.
+//
+// Solidity: function paused() view returns(bool)
+func (_BettingRouterV3 *BettingRouterV3CallerSession) Paused() (bool, error) {
+	return _BettingRouterV3.Contract.Paused(&_BettingRouterV3.CallOpts)
+}
+
+// PreviewBet is a free data retrieval call binding the contract method 0xf219d5f6.
+//
+// Solidity: function previewBet(address market, uint256 outcomeId, uint256 amount) view returns(address token, uint256 netAmount, uint256 shares, uint256 fee)
+func (_BettingRouterV3 *BettingRouterV3Caller) PreviewBet(opts *bind.CallOpts, market common.Address, outcomeId *big.Int, amount *big.Int) (struct {
+	Token     common.Address
+	NetAmount *big.Int
+	Shares    *big.Int
+	Fee       *big.Int
+}, error) {
+	var out []interface{}
+	err := _BettingRouterV3.contract.Call(opts, &out, "previewBet", market, outcomeId, amount)
+
+	outstruct := new(struct {
+		Token     common.Address
+		NetAmount *big.Int
+		Shares    *big.Int
+		Fee       *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Token = *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	outstruct.NetAmount = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	outstruct.Shares = *abi.ConvertType(out[2], new(*big.Int)).(**big.Int)
+	outstruct.Fee = *abi.ConvertType(out[3], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// PreviewBet is a free data retrieval call binding the contract method 0xf219d5f6.
+//
+// Solidity: function previewBet(address market, uint256 outcomeId, uint256 amount) view returns(address token, uint256 netAmount, uint256 shares, uint256 fee)
+func (_BettingRouterV3 *BettingRouterV3Session) PreviewBet(market common.Address, outcomeId *big.Int, amount *big.Int) (struct {
+	Token     common.Address
+	NetAmount *big.Int
+	Shares    *big.Int
+	Fee       *big.Int
+}, error) {
+	return _BettingRouterV3.Contract.PreviewBet(&_BettingRouterV3.CallOpts, market, outcomeId, amount)
+}
+
+// PreviewBet is a free data retrieval call binding the contract method 0xf219d5f6.
+//
+// Solidity: function previewBet(address market, uint256 outcomeId, uint256 amount) view returns(address token, uint256 netAmount, uint256 shares, uint256 fee)
+func (_BettingRouterV3 *BettingRouterV3CallerSession) PreviewBet(market common.Address, outcomeId *big.Int, amount *big.Int) (struct {
+	Token     common.Address
+	NetAmount *big.Int
+	Shares    *big.Int
+	Fee       *big.Int
+}, error) {
+	return _BettingRouterV3.Contract.PreviewBet(&_BettingRouterV3.CallOpts, market, outcomeId, amount)
+}
+
+// ValidateMarket is a free data retrieval call binding the contract method 0xe6c04048.
+//
+// Solidity: function validateMarket(address market) view returns(bool valid, address token)
+func (_BettingRouterV3 *BettingRouterV3Caller) ValidateMarket(opts *bind.CallOpts, market common.Address) (struct {
+	Valid bool
+	Token common.Address
+}, error) {
+	var out []interface{}
+	err := _BettingRouterV3.contract.Call(opts, &out, "validateMarket", market)
+
+	outstruct := new(struct {
+		Valid bool
+		Token common.Address
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Valid = *abi.ConvertType(out[0], new(bool)).(*bool)
+	outstruct.Token = *abi.ConvertType(out[1], new(common.Address)).(*common.Address)
+
+	return *outstruct, err
+
+}
+
+// ValidateMarket is a free data retrieval call binding the contract method 0xe6c04048.
+//
+// Solidity: function validateMarket(address market) view returns(bool valid, address token)
+func (_BettingRouterV3 *BettingRouterV3Session) ValidateMarket(market common.Address) (struct {
+	Valid bool
+	Token common.Address
+}, error) {
+	return _BettingRouterV3.Contract.ValidateMarket(&_BettingRouterV3.CallOpts, market)
+}
+
+// ValidateMarket is a free data retrieval call binding the contract method 0xe6c04048.
+//
+// Solidity: function validateMarket(address market) view returns(bool valid, address token)
+func (_BettingRouterV3 *BettingRouterV3CallerSession) ValidateMarket(market common.Address) (struct {
+	Valid bool
+	Token common.Address
+}, error) {
+	return _BettingRouterV3.Contract.ValidateMarket(&_BettingRouterV3.CallOpts, market)
+}
+
+// AddToken is a paid mutator transaction binding the contract method 0xf64507c6.
+//
+// Solidity: function addToken(address token, uint256 feeRateBps, address feeRecipient, uint256 minBetAmount, uint256 maxBetAmount) returns()
+func (_BettingRouterV3 *BettingRouterV3Transactor) AddToken(opts *bind.TransactOpts, token common.Address, feeRateBps *big.Int, feeRecipient common.Address, minBetAmount *big.Int, maxBetAmount *big.Int) (*types.Transaction, error) {
+	return _BettingRouterV3.contract.Transact(opts, "addToken", token, feeRateBps, feeRecipient, minBetAmount, maxBetAmount)
+}
+
+// AddToken is a paid mutator transaction binding the contract method 0xf64507c6.
+//
+// Solidity: function addToken(address token, uint256 feeRateBps, address feeRecipient, uint256 minBetAmount, uint256 maxBetAmount) returns()
+func (_BettingRouterV3 *BettingRouterV3Session) AddToken(token common.Address, feeRateBps *big.Int, feeRecipient common.Address, minBetAmount *big.Int, maxBetAmount *big.Int) (*types.Transaction, error) {
+	return _BettingRouterV3.Contract.AddToken(&_BettingRouterV3.TransactOpts, token, feeRateBps, feeRecipient, minBetAmount, maxBetAmount)
+}
+
+// AddToken is a paid mutator transaction binding the contract method 0xf64507c6.
+//
+// Solidity: function addToken(address token, uint256 feeRateBps, address feeRecipient, uint256 minBetAmount, uint256 maxBetAmount) returns()
+func (_BettingRouterV3 *BettingRouterV3TransactorSession) AddToken(token common.Address, feeRateBps *big.Int, feeRecipient common.Address, minBetAmount *big.Int, maxBetAmount *big.Int) (*types.Transaction, error) {
+	return _BettingRouterV3.Contract.AddToken(&_BettingRouterV3.TransactOpts, token, feeRateBps, feeRecipient, minBetAmount, maxBetAmount)
+}
+
+// BatchRedeem is a paid mutator transaction binding the contract method 0xfba21f78.
+//
+// Solidity: function batchRedeem((address,uint256,uint256)[] redeems) returns(uint256 totalPayout)
+func (_BettingRouterV3 *BettingRouterV3Transactor) BatchRedeem(opts *bind.TransactOpts, redeems []BettingRouterV3RedeemParams) (*types.Transaction, error) {
+	return _BettingRouterV3.contract.Transact(opts, "batchRedeem", redeems)
+}
+
+// BatchRedeem is a paid mutator transaction binding the contract method 0xfba21f78.
+//
+// Solidity: function batchRedeem((address,uint256,uint256)[] redeems) returns(uint256 totalPayout)
+func (_BettingRouterV3 *BettingRouterV3Session) BatchRedeem(redeems []BettingRouterV3RedeemParams) (*types.Transaction, error) {
+	return _BettingRouterV3.Contract.BatchRedeem(&_BettingRouterV3.TransactOpts, redeems)
+}
+
+// BatchRedeem is a paid mutator transaction binding the contract method 0xfba21f78.
+//
+// Solidity: function batchRedeem((address,uint256,uint256)[] redeems) returns(uint256 totalPayout)
+func (_BettingRouterV3 *BettingRouterV3TransactorSession) BatchRedeem(redeems []BettingRouterV3RedeemParams) (*types.Transaction, error) {
+	return _BettingRouterV3.Contract.BatchRedeem(&_BettingRouterV3.TransactOpts, redeems)
+}
+
+// BatchRefund is a paid mutator transaction binding the contract method 0xc102f7ba.
+//
+// Solidity: function batchRefund((address,uint256,uint256)[] refunds) returns(uint256 totalRefund)
+func (_BettingRouterV3 *BettingRouterV3Transactor) BatchRefund(opts *bind.TransactOpts, refunds []BettingRouterV3RedeemParams) (*types.Transaction, error) {
+	return _BettingRouterV3.contract.Transact(opts, "batchRefund", refunds)
+}
+
+// BatchRefund is a paid mutator transaction binding the contract method 0xc102f7ba.
+//
+// Solidity: function batchRefund((address,uint256,uint256)[] refunds) returns(uint256 totalRefund)
+func (_BettingRouterV3 *BettingRouterV3Session) BatchRefund(refunds []BettingRouterV3RedeemParams) (*types.Transaction, error) {
+	return _BettingRouterV3.Contract.BatchRefund(&_BettingRouterV3.TransactOpts, refunds)
+}
+
+// BatchRefund is a paid mutator transaction binding the contract method 0xc102f7ba.
+//
+// Solidity: function batchRefund((address,uint256,uint256)[] refunds) returns(uint256 totalRefund)
+func (_BettingRouterV3 *BettingRouterV3TransactorSession) BatchRefund(refunds []BettingRouterV3RedeemParams) (*types.Transaction, error) {
+	return _BettingRouterV3.Contract.BatchRefund(&_BettingRouterV3.TransactOpts, refunds)
+}
+
+// EmergencyWithdraw is a paid mutator transaction binding the contract method 0x95ccea67.
+//
+// Solidity: function emergencyWithdraw(address token, uint256 amount) returns()
+func (_BettingRouterV3 *BettingRouterV3Transactor) EmergencyWithdraw(opts *bind.TransactOpts, token common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _BettingRouterV3.contract.Transact(opts, "emergencyWithdraw", token, amount)
+}
+
+// EmergencyWithdraw is a paid mutator transaction binding the contract method 0x95ccea67.
+//
+// Solidity: function emergencyWithdraw(address token, uint256 amount) returns()
+func (_BettingRouterV3 *BettingRouterV3Session) EmergencyWithdraw(token common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _BettingRouterV3.Contract.EmergencyWithdraw(&_BettingRouterV3.TransactOpts, token, amount)
+}
+
+// EmergencyWithdraw is a paid mutator transaction binding the contract method 0x95ccea67.
+//
+// Solidity: function emergencyWithdraw(address token, uint256 amount) returns()
+func (_BettingRouterV3 *BettingRouterV3TransactorSession) EmergencyWithdraw(token common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _BettingRouterV3.Contract.EmergencyWithdraw(&_BettingRouterV3.TransactOpts, token, amount)
+}
+
+// PlaceBet is a paid mutator transaction binding the contract method 0xeda99336.
+//
+// Solidity: function placeBet(address market, uint256 outcomeId, uint256 amount, uint256 minShares) returns(uint256 shares)
+func (_BettingRouterV3 *BettingRouterV3Transactor) PlaceBet(opts *bind.TransactOpts, market common.Address, outcomeId *big.Int, amount *big.Int, minShares *big.Int) (*types.Transaction, error) {
+	return _BettingRouterV3.contract.Transact(opts, "placeBet", market, outcomeId, amount, minShares)
+}
+
+// PlaceBet is a paid mutator transaction binding the contract method 0xeda99336.
+//
+// Solidity: function placeBet(address market, uint256 outcomeId, uint256 amount, uint256 minShares) returns(uint256 shares)
+func (_BettingRouterV3 *BettingRouterV3Session) PlaceBet(market common.Address, outcomeId *big.Int, amount *big.Int, minShares *big.Int) (*types.Transaction, error) {
+	return _BettingRouterV3.Contract.PlaceBet(&_BettingRouterV3.TransactOpts, market, outcomeId, amount, minShares)
+}
+
+// PlaceBet is a paid mutator transaction binding the contract method 0xeda99336.
+//
+// Solidity: function placeBet(address market, uint256 outcomeId, uint256 amount, uint256 minShares) returns(uint256 shares)
+func (_BettingRouterV3 *BettingRouterV3TransactorSession) PlaceBet(market common.Address, outcomeId *big.Int, amount *big.Int, minShares *big.Int) (*types.Transaction, error) {
+	return _BettingRouterV3.Contract.PlaceBet(&_BettingRouterV3.TransactOpts, market, outcomeId, amount, minShares)
+}
+
+// PlaceBetBatch is a paid mutator transaction binding the contract method 0x82089c7d.
+//
+// Solidity: function placeBetBatch((address,uint256,uint256,uint256)[] bets) returns((address,uint256,uint256,uint256,uint256,address)[] results)
+func (_BettingRouterV3 *BettingRouterV3Transactor) PlaceBetBatch(opts *bind.TransactOpts, bets []IBettingRouterV3BetParams) (*types.Transaction, error) {
+	return _BettingRouterV3.contract.Transact(opts, "placeBetBatch", bets)
+}
+
+// PlaceBetBatch is a paid mutator transaction binding the contract method 0x82089c7d.
+//
+// Solidity: function placeBetBatch((address,uint256,uint256,uint256)[] bets) returns((address,uint256,uint256,uint256,uint256,address)[] results)
+func (_BettingRouterV3 *BettingRouterV3Session) PlaceBetBatch(bets []IBettingRouterV3BetParams) (*types.Transaction, error) {
+	return _BettingRouterV3.Contract.PlaceBetBatch(&_BettingRouterV3.TransactOpts, bets)
+}
+
+// PlaceBetBatch is a paid mutator transaction binding the contract method 0x82089c7d.
+//
+// Solidity: function placeBetBatch((address,uint256,uint256,uint256)[] bets) returns((address,uint256,uint256,uint256,uint256,address)[] results)
+func (_BettingRouterV3 *BettingRouterV3TransactorSession) PlaceBetBatch(bets []IBettingRouterV3BetParams) (*types.Transaction, error) {
+	return _BettingRouterV3.Contract.PlaceBetBatch(&_BettingRouterV3.TransactOpts, bets)
+}
+
+// PlaceBetMultiOutcome is a paid mutator transaction binding the contract method 0xebb654db.
+//
+// Solidity: function placeBetMultiOutcome(address market, uint256[] outcomeIds, uint256[] amounts, uint256[] minSharesList) returns(uint256[] sharesList)
+func (_BettingRouterV3 *BettingRouterV3Transactor) PlaceBetMultiOutcome(opts *bind.TransactOpts, market common.Address, outcomeIds []*big.Int, amounts []*big.Int, minSharesList []*big.Int) (*types.Transaction, error) {
+	return _BettingRouterV3.contract.Transact(opts, "placeBetMultiOutcome", market, outcomeIds, amounts, minSharesList)
+}
+
+// PlaceBetMultiOutcome is a paid mutator transaction binding the contract method 0xebb654db.
+//
+// Solidity: function placeBetMultiOutcome(address market, uint256[] outcomeIds, uint256[] amounts, uint256[] minSharesList) returns(uint256[] sharesList)
+func (_BettingRouterV3 *BettingRouterV3Session) PlaceBetMultiOutcome(market common.Address, outcomeIds []*big.Int, amounts []*big.Int, minSharesList []*big.Int) (*types.Transaction, error) {
+	return _BettingRouterV3.Contract.PlaceBetMultiOutcome(&_BettingRouterV3.TransactOpts, market, outcomeIds, amounts, minSharesList)
+}
+
+// PlaceBetMultiOutcome is a paid mutator transaction binding the contract method 0xebb654db.
+//
+// Solidity: function placeBetMultiOutcome(address market, uint256[] outcomeIds, uint256[] amounts, uint256[] minSharesList) returns(uint256[] sharesList)
+func (_BettingRouterV3 *BettingRouterV3TransactorSession) PlaceBetMultiOutcome(market common.Address, outcomeIds []*big.Int, amounts []*big.Int, minSharesList []*big.Int) (*types.Transaction, error) {
+	return _BettingRouterV3.Contract.PlaceBetMultiOutcome(&_BettingRouterV3.TransactOpts, market, outcomeIds, amounts, minSharesList)
+}
+
+// RemoveToken is a paid mutator transaction binding the contract method 0x5fa7b584.
+//
+// Solidity: function removeToken(address token) returns()
+func (_BettingRouterV3 *BettingRouterV3Transactor) RemoveToken(opts *bind.TransactOpts, token common.Address) (*types.Transaction, error) {
+	return _BettingRouterV3.contract.Transact(opts, "removeToken", token)
+}
+
+// RemoveToken is a paid mutator transaction binding the contract method 0x5fa7b584.
+//
+// Solidity: function removeToken(address token) returns()
+func (_BettingRouterV3 *BettingRouterV3Session) RemoveToken(token common.Address) (*types.Transaction, error) {
+	return _BettingRouterV3.Contract.RemoveToken(&_BettingRouterV3.TransactOpts, token)
+}
+
+// RemoveToken is a paid mutator transaction binding the contract method 0x5fa7b584.
+//
+// Solidity: function removeToken(address token) returns()
+func (_BettingRouterV3 *BettingRouterV3TransactorSession) RemoveToken(token common.Address) (*types.Transaction, error) {
+	return _BettingRouterV3.Contract.RemoveToken(&_BettingRouterV3.TransactOpts, token)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_BettingRouterV3 *BettingRouterV3Transactor) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _BettingRouterV3.contract.Transact(opts, "renounceOwnership")
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_BettingRouterV3 *BettingRouterV3Session) RenounceOwnership() (*types.Transaction, error) {
+	return _BettingRouterV3.Contract.RenounceOwnership(&_BettingRouterV3.TransactOpts)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_BettingRouterV3 *BettingRouterV3TransactorSession) RenounceOwnership() (*types.Transaction, error) {
+	return _BettingRouterV3.Contract.RenounceOwnership(&_BettingRouterV3.TransactOpts)
+}
+
+// SetDefaultFeeRate is a paid mutator transaction binding the contract method 0x5f70fdb5.
+//
+// Solidity: function setDefaultFeeRate(uint256 _feeRateBps) returns()
+func (_BettingRouterV3 *BettingRouterV3Transactor) SetDefaultFeeRate(opts *bind.TransactOpts, _feeRateBps *big.Int) (*types.Transaction, error) {
+	return _BettingRouterV3.contract.Transact(opts, "setDefaultFeeRate", _feeRateBps)
+}
+
+// SetDefaultFeeRate is a paid mutator transaction binding the contract method 0x5f70fdb5.
+//
+// Solidity: function setDefaultFeeRate(uint256 _feeRateBps) returns()
+func (_BettingRouterV3 *BettingRouterV3Session) SetDefaultFeeRate(_feeRateBps *big.Int) (*types.Transaction, error) {
+	return _BettingRouterV3.Contract.SetDefaultFeeRate(&_BettingRouterV3.TransactOpts, _feeRateBps)
+}
+
+// SetDefaultFeeRate is a paid mutator transaction binding the contract method 0x5f70fdb5.
+//
+// Solidity: function setDefaultFeeRate(uint256 _feeRateBps) returns()
+func (_BettingRouterV3 *BettingRouterV3TransactorSession) SetDefaultFeeRate(_feeRateBps *big.Int) (*types.Transaction, error) {
+	return _BettingRouterV3.Contract.SetDefaultFeeRate(&_BettingRouterV3.TransactOpts, _feeRateBps)
+}
+
+// SetDefaultFeeRecipient is a paid mutator transaction binding the contract method 0x513a4bed.
+//
+// Solidity: function setDefaultFeeRecipient(address _recipient) returns()
+func (_BettingRouterV3 *BettingRouterV3Transactor) SetDefaultFeeRecipient(opts *bind.TransactOpts, _recipient common.Address) (*types.Transaction, error) {
+	return _BettingRouterV3.contract.Transact(opts, "setDefaultFeeRecipient", _recipient)
+}
+
+// SetDefaultFeeRecipient is a paid mutator transaction binding the contract method 0x513a4bed.
+//
+// Solidity: function setDefaultFeeRecipient(address _recipient) returns()
+func (_BettingRouterV3 *BettingRouterV3Session) SetDefaultFeeRecipient(_recipient common.Address) (*types.Transaction, error) {
+	return _BettingRouterV3.Contract.SetDefaultFeeRecipient(&_BettingRouterV3.TransactOpts, _recipient)
+}
+
+// SetDefaultFeeRecipient is a paid mutator transaction binding the contract method 0x513a4bed.
+//
+// Solidity: function setDefaultFeeRecipient(address _recipient) returns()
+func (_BettingRouterV3 *BettingRouterV3TransactorSession) SetDefaultFeeRecipient(_recipient common.Address) (*types.Transaction, error) {
+	return _BettingRouterV3.Contract.SetDefaultFeeRecipient(&_BettingRouterV3.TransactOpts, _recipient)
+}
+
+// SetFactory is a paid mutator transaction binding the contract method 0x5bb47808.
+//
+// Solidity: function setFactory(address _factory) returns()
+func (_BettingRouterV3 *BettingRouterV3Transactor) SetFactory(opts *bind.TransactOpts, _factory common.Address) (*types.Transaction, error) {
+	return _BettingRouterV3.contract.Transact(opts, "setFactory", _factory)
+}
+
+// SetFactory is a paid mutator transaction binding the contract method 0x5bb47808.
+//
+// Solidity: function setFactory(address _factory) returns()
+func (_BettingRouterV3 *BettingRouterV3Session) SetFactory(_factory common.Address) (*types.Transaction, error) {
+	return _BettingRouterV3.Contract.SetFactory(&_BettingRouterV3.TransactOpts, _factory)
+}
+
+// SetFactory is a paid mutator transaction binding the contract method 0x5bb47808.
+//
+// Solidity: function setFactory(address _factory) returns()
+func (_BettingRouterV3 *BettingRouterV3TransactorSession) SetFactory(_factory common.Address) (*types.Transaction, error) {
+	return _BettingRouterV3.Contract.SetFactory(&_BettingRouterV3.TransactOpts, _factory)
+}
+
+// SetParamController is a paid mutator transaction binding the contract method 0xb807a2e6.
+//
+// Solidity: function setParamController(address _paramController) returns()
+func (_BettingRouterV3 *BettingRouterV3Transactor) SetParamController(opts *bind.TransactOpts, _paramController common.Address) (*types.Transaction, error) {
+	return _BettingRouterV3.contract.Transact(opts, "setParamController", _paramController)
+}
+
+// SetParamController is a paid mutator transaction binding the contract method 0xb807a2e6.
+//
+// Solidity: function setParamController(address _paramController) returns()
+func (_BettingRouterV3 *BettingRouterV3Session) SetParamController(_paramController common.Address) (*types.Transaction, error) {
+	return _BettingRouterV3.Contract.SetParamController(&_BettingRouterV3.TransactOpts, _paramController)
+}
+
+// SetParamController is a paid mutator transaction binding the contract method 0xb807a2e6.
+//
+// Solidity: function setParamController(address _paramController) returns()
+func (_BettingRouterV3 *BettingRouterV3TransactorSession) SetParamController(_paramController common.Address) (*types.Transaction, error) {
+	return _BettingRouterV3.Contract.SetParamController(&_BettingRouterV3.TransactOpts, _paramController)
+}
+
+// SetPaused is a paid mutator transaction binding the contract method 0x16c38b3c.
+//
+// Solidity: function setPaused(bool _paused) returns()
+func (_BettingRouterV3 *BettingRouterV3Transactor) SetPaused(opts *bind.TransactOpts, _paused bool) (*types.Transaction, error) {
+	return _BettingRouterV3.contract.Transact(opts, "setPaused", _paused)
+}
+
+// SetPaused is a paid mutator transaction binding the contract method 0x16c38b3c.
+//
+// Solidity: function setPaused(bool _paused) returns()
+func (_BettingRouterV3 *BettingRouterV3Session) SetPaused(_paused bool) (*types.Transaction, error) {
+	return _BettingRouterV3.Contract.SetPaused(&_BettingRouterV3.TransactOpts, _paused)
+}
+
+// SetPaused is a paid mutator transaction binding the contract method 0x16c38b3c.
+//
+// Solidity: function setPaused(bool _paused) returns()
+func (_BettingRouterV3 *BettingRouterV3TransactorSession) SetPaused(_paused bool) (*types.Transaction, error) {
+	return _BettingRouterV3.Contract.SetPaused(&_BettingRouterV3.TransactOpts, _paused)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_BettingRouterV3 *BettingRouterV3Transactor) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	return _BettingRouterV3.contract.Transact(opts, "transferOwnership", newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_BettingRouterV3 *BettingRouterV3Session) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _BettingRouterV3.Contract.TransferOwnership(&_BettingRouterV3.TransactOpts, newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_BettingRouterV3 *BettingRouterV3TransactorSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _BettingRouterV3.Contract.TransferOwnership(&_BettingRouterV3.TransactOpts, newOwner)
+}
+
+// UpdateTokenConfig is a paid mutator transaction binding the contract method 0x928f9f09.
+//
+// Solidity: function updateTokenConfig(address token, uint256 feeRateBps, address feeRecipient, uint256 minBetAmount, uint256 maxBetAmount) returns()
+func (_BettingRouterV3 *BettingRouterV3Transactor) UpdateTokenConfig(opts *bind.TransactOpts, token common.Address, feeRateBps *big.Int, feeRecipient common.Address, minBetAmount *big.Int, maxBetAmount *big.Int) (*types.Transaction, error) {
+	return _BettingRouterV3.contract.Transact(opts, "updateTokenConfig", token, feeRateBps, feeRecipient, minBetAmount, maxBetAmount)
+}
+
+// UpdateTokenConfig is a paid mutator transaction binding the contract method 0x928f9f09.
+//
+// Solidity: function updateTokenConfig(address token, uint256 feeRateBps, address feeRecipient, uint256 minBetAmount, uint256 maxBetAmount) returns()
+func (_BettingRouterV3 *BettingRouterV3Session) UpdateTokenConfig(token common.Address, feeRateBps *big.Int, feeRecipient common.Address, minBetAmount *big.Int, maxBetAmount *big.Int) (*types.Transaction, error) {
+	return _BettingRouterV3.Contract.UpdateTokenConfig(&_BettingRouterV3.TransactOpts, token, feeRateBps, feeRecipient, minBetAmount, maxBetAmount)
+}
+
+// UpdateTokenConfig is a paid mutator transaction binding the contract method 0x928f9f09.
+//
+// Solidity: function updateTokenConfig(address token, uint256 feeRateBps, address feeRecipient, uint256 minBetAmount, uint256 maxBetAmount) returns()
+func (_BettingRouterV3 *BettingRouterV3TransactorSession) UpdateTokenConfig(token common.Address, feeRateBps *big.Int, feeRecipient common.Address, minBetAmount *big.Int, maxBetAmount *big.Int) (*types.Transaction, error) {
+	return _BettingRouterV3.Contract.UpdateTokenConfig(&_BettingRouterV3.TransactOpts, token, feeRateBps, feeRecipient, minBetAmount, maxBetAmount)
+}
+
+// BettingRouterV3BatchBetPlacedIterator is returned from FilterBatchBetPlaced and is used to iterate over the raw logs and unpacked data for BatchBetPlaced events raised by the BettingRouterV3 contract.
+type BettingRouterV3BatchBetPlacedIterator struct {
+	Event *BettingRouterV3BatchBetPlaced // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *BettingRouterV3BatchBetPlacedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(BettingRouterV3BatchBetPlaced)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(BettingRouterV3BatchBetPlaced)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *BettingRouterV3BatchBetPlacedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *BettingRouterV3BatchBetPlacedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// BettingRouterV3BatchBetPlaced represents a BatchBetPlaced event raised by the BettingRouterV3 contract.
+type BettingRouterV3BatchBetPlaced struct {
+	User     common.Address
+	BetCount *big.Int
+	TotalFee *big.Int
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterBatchBetPlaced is a free log retrieval operation binding the contract event 0xfaa3f3b5e4bd3185bc059a2498b70867fd2b41976ecd9d924e19972a00a007ee.
+//
+// Solidity: event BatchBetPlaced(address indexed user, uint256 betCount, uint256 totalFee)
+func (_BettingRouterV3 *BettingRouterV3Filterer) FilterBatchBetPlaced(opts *bind.FilterOpts, user []common.Address) (*BettingRouterV3BatchBetPlacedIterator, error) {
+
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+
+	logs, sub, err := _BettingRouterV3.contract.FilterLogs(opts, "BatchBetPlaced", userRule)
+	if err != nil {
+		return nil, err
+	}
+	return &BettingRouterV3BatchBetPlacedIterator{contract: _BettingRouterV3.contract, event: "BatchBetPlaced", logs: logs, sub: sub}, nil
+}
+
+// WatchBatchBetPlaced is a free log subscription operation binding the contract event 0xfaa3f3b5e4bd3185bc059a2498b70867fd2b41976ecd9d924e19972a00a007ee.
+//
+// Solidity: event BatchBetPlaced(address indexed user, uint256 betCount, uint256 totalFee)
+func (_BettingRouterV3 *BettingRouterV3Filterer) WatchBatchBetPlaced(opts *bind.WatchOpts, sink chan<- *BettingRouterV3BatchBetPlaced, user []common.Address) (event.Subscription, error) {
+
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+
+	logs, sub, err := _BettingRouterV3.contract.WatchLogs(opts, "BatchBetPlaced", userRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(BettingRouterV3BatchBetPlaced)
+				if err := _BettingRouterV3.contract.UnpackLog(event, "BatchBetPlaced", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseBatchBetPlaced is a log parse operation binding the contract event 0xfaa3f3b5e4bd3185bc059a2498b70867fd2b41976ecd9d924e19972a00a007ee.
+//
+// Solidity: event BatchBetPlaced(address indexed user, uint256 betCount, uint256 totalFee)
+func (_BettingRouterV3 *BettingRouterV3Filterer) ParseBatchBetPlaced(log types.Log) (*BettingRouterV3BatchBetPlaced, error) {
+	event := new(BettingRouterV3BatchBetPlaced)
+	if err := _BettingRouterV3.contract.UnpackLog(event, "BatchBetPlaced", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// BettingRouterV3BatchRedeemedIterator is returned from FilterBatchRedeemed and is used to iterate over the raw logs and unpacked data for BatchRedeemed events raised by the BettingRouterV3 contract.
+type BettingRouterV3BatchRedeemedIterator struct {
+	Event *BettingRouterV3BatchRedeemed // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *BettingRouterV3BatchRedeemedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(BettingRouterV3BatchRedeemed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(BettingRouterV3BatchRedeemed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *BettingRouterV3BatchRedeemedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *BettingRouterV3BatchRedeemedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// BettingRouterV3BatchRedeemed represents a BatchRedeemed event raised by the BettingRouterV3 contract.
+type BettingRouterV3BatchRedeemed struct {
+	User        common.Address
+	MarketCount *big.Int
+	TotalPayout *big.Int
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterBatchRedeemed is a free log retrieval operation binding the contract event 0x454648b2db14c69a0d943bca7e29d7e0dacf475efe959d68d16b4e02cf661aab.
+//
+// Solidity: event BatchRedeemed(address indexed user, uint256 marketCount, uint256 totalPayout)
+func (_BettingRouterV3 *BettingRouterV3Filterer) FilterBatchRedeemed(opts *bind.FilterOpts, user []common.Address) (*BettingRouterV3BatchRedeemedIterator, error) {
+
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+
+	logs, sub, err := _BettingRouterV3.contract.FilterLogs(opts, "BatchRedeemed", userRule)
+	if err != nil {
+		return nil, err
+	}
+	return &BettingRouterV3BatchRedeemedIterator{contract: _BettingRouterV3.contract, event: "BatchRedeemed", logs: logs, sub: sub}, nil
+}
+
+// WatchBatchRedeemed is a free log subscription operation binding the contract event 0x454648b2db14c69a0d943bca7e29d7e0dacf475efe959d68d16b4e02cf661aab.
+//
+// Solidity: event BatchRedeemed(address indexed user, uint256 marketCount, uint256 totalPayout)
+func (_BettingRouterV3 *BettingRouterV3Filterer) WatchBatchRedeemed(opts *bind.WatchOpts, sink chan<- *BettingRouterV3BatchRedeemed, user []common.Address) (event.Subscription, error) {
+
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+
+	logs, sub, err := _BettingRouterV3.contract.WatchLogs(opts, "BatchRedeemed", userRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(BettingRouterV3BatchRedeemed)
+				if err := _BettingRouterV3.contract.UnpackLog(event, "BatchRedeemed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseBatchRedeemed is a log parse operation binding the contract event 0x454648b2db14c69a0d943bca7e29d7e0dacf475efe959d68d16b4e02cf661aab.
+//
+// Solidity: event BatchRedeemed(address indexed user, uint256 marketCount, uint256 totalPayout)
+func (_BettingRouterV3 *BettingRouterV3Filterer) ParseBatchRedeemed(log types.Log) (*BettingRouterV3BatchRedeemed, error) {
+	event := new(BettingRouterV3BatchRedeemed)
+	if err := _BettingRouterV3.contract.UnpackLog(event, "BatchRedeemed", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// BettingRouterV3BatchRefundedIterator is returned from FilterBatchRefunded and is used to iterate over the raw logs and unpacked data for BatchRefunded events raised by the BettingRouterV3 contract.
+type BettingRouterV3BatchRefundedIterator struct {
+	Event *BettingRouterV3BatchRefunded // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *BettingRouterV3BatchRefundedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(BettingRouterV3BatchRefunded)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(BettingRouterV3BatchRefunded)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *BettingRouterV3BatchRefundedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *BettingRouterV3BatchRefundedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// BettingRouterV3BatchRefunded represents a BatchRefunded event raised by the BettingRouterV3 contract.
+type BettingRouterV3BatchRefunded struct {
+	User        common.Address
+	MarketCount *big.Int
+	TotalRefund *big.Int
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterBatchRefunded is a free log retrieval operation binding the contract event 0x478f48e042924118598a4ce07e79a00def499622e26320d7f9c222d75e36361e.
+//
+// Solidity: event BatchRefunded(address indexed user, uint256 marketCount, uint256 totalRefund)
+func (_BettingRouterV3 *BettingRouterV3Filterer) FilterBatchRefunded(opts *bind.FilterOpts, user []common.Address) (*BettingRouterV3BatchRefundedIterator, error) {
+
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+
+	logs, sub, err := _BettingRouterV3.contract.FilterLogs(opts, "BatchRefunded", userRule)
+	if err != nil {
+		return nil, err
+	}
+	return &BettingRouterV3BatchRefundedIterator{contract: _BettingRouterV3.contract, event: "BatchRefunded", logs: logs, sub: sub}, nil
+}
+
+// WatchBatchRefunded is a free log subscription operation binding the contract event 0x478f48e042924118598a4ce07e79a00def499622e26320d7f9c222d75e36361e.
+//
+// Solidity: event BatchRefunded(address indexed user, uint256 marketCount, uint256 totalRefund)
+func (_BettingRouterV3 *BettingRouterV3Filterer) WatchBatchRefunded(opts *bind.WatchOpts, sink chan<- *BettingRouterV3BatchRefunded, user []common.Address) (event.Subscription, error) {
+
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+
+	logs, sub, err := _BettingRouterV3.contract.WatchLogs(opts, "BatchRefunded", userRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(BettingRouterV3BatchRefunded)
+				if err := _BettingRouterV3.contract.UnpackLog(event, "BatchRefunded", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseBatchRefunded is a log parse operation binding the contract event 0x478f48e042924118598a4ce07e79a00def499622e26320d7f9c222d75e36361e.
+//
+// Solidity: event BatchRefunded(address indexed user, uint256 marketCount, uint256 totalRefund)
+func (_BettingRouterV3 *BettingRouterV3Filterer) ParseBatchRefunded(log types.Log) (*BettingRouterV3BatchRefunded, error) {
+	event := new(BettingRouterV3BatchRefunded)
+	if err := _BettingRouterV3.contract.UnpackLog(event, "BatchRefunded", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// BettingRouterV3BetPlacedIterator is returned from FilterBetPlaced and is used to iterate over the raw logs and unpacked data for BetPlaced events raised by the BettingRouterV3 contract.
+type BettingRouterV3BetPlacedIterator struct {
+	Event *BettingRouterV3BetPlaced // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *BettingRouterV3BetPlacedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(BettingRouterV3BetPlaced)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(BettingRouterV3BetPlaced)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *BettingRouterV3BetPlacedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *BettingRouterV3BetPlacedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// BettingRouterV3BetPlaced represents a BetPlaced event raised by the BettingRouterV3 contract.
+type BettingRouterV3BetPlaced struct {
+	User      common.Address
+	Market    common.Address
+	Token     common.Address
+	OutcomeId *big.Int
+	Amount    *big.Int
+	Shares    *big.Int
+	Fee       *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterBetPlaced is a free log retrieval operation binding the contract event 0xf8d861b17828b2cd1bd1bcb1215cebd5bddd27de5ed5444def08d0a2ccd00146.
+//
+// Solidity: event BetPlaced(address indexed user, address indexed market, address indexed token, uint256 outcomeId, uint256 amount, uint256 shares, uint256 fee)
+func (_BettingRouterV3 *BettingRouterV3Filterer) FilterBetPlaced(opts *bind.FilterOpts, user []common.Address, market []common.Address, token []common.Address) (*BettingRouterV3BetPlacedIterator, error) {
+
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+	var marketRule []interface{}
+	for _, marketItem := range market {
+		marketRule = append(marketRule, marketItem)
+	}
+	var tokenRule []interface{}
+	for _, tokenItem := range token {
+		tokenRule = append(tokenRule, tokenItem)
+	}
+
+	logs, sub, err := _BettingRouterV3.contract.FilterLogs(opts, "BetPlaced", userRule, marketRule, tokenRule)
+	if err != nil {
+		return nil, err
+	}
+	return &BettingRouterV3BetPlacedIterator{contract: _BettingRouterV3.contract, event: "BetPlaced", logs: logs, sub: sub}, nil
+}
+
+// WatchBetPlaced is a free log subscription operation binding the contract event 0xf8d861b17828b2cd1bd1bcb1215cebd5bddd27de5ed5444def08d0a2ccd00146.
+//
+// Solidity: event BetPlaced(address indexed user, address indexed market, address indexed token, uint256 outcomeId, uint256 amount, uint256 shares, uint256 fee)
+func (_BettingRouterV3 *BettingRouterV3Filterer) WatchBetPlaced(opts *bind.WatchOpts, sink chan<- *BettingRouterV3BetPlaced, user []common.Address, market []common.Address, token []common.Address) (event.Subscription, error) {
+
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+	var marketRule []interface{}
+	for _, marketItem := range market {
+		marketRule = append(marketRule, marketItem)
+	}
+	var tokenRule []interface{}
+	for _, tokenItem := range token {
+		tokenRule = append(tokenRule, tokenItem)
+	}
+
+	logs, sub, err := _BettingRouterV3.contract.WatchLogs(opts, "BetPlaced", userRule, marketRule, tokenRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(BettingRouterV3BetPlaced)
+				if err := _BettingRouterV3.contract.UnpackLog(event, "BetPlaced", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseBetPlaced is a log parse operation binding the contract event 0xf8d861b17828b2cd1bd1bcb1215cebd5bddd27de5ed5444def08d0a2ccd00146.
+//
+// Solidity: event BetPlaced(address indexed user, address indexed market, address indexed token, uint256 outcomeId, uint256 amount, uint256 shares, uint256 fee)
+func (_BettingRouterV3 *BettingRouterV3Filterer) ParseBetPlaced(log types.Log) (*BettingRouterV3BetPlaced, error) {
+	event := new(BettingRouterV3BetPlaced)
+	if err := _BettingRouterV3.contract.UnpackLog(event, "BetPlaced", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// BettingRouterV3EmergencyWithdrawIterator is returned from FilterEmergencyWithdraw and is used to iterate over the raw logs and unpacked data for EmergencyWithdraw events raised by the BettingRouterV3 contract.
+type BettingRouterV3EmergencyWithdrawIterator struct {
+	Event *BettingRouterV3EmergencyWithdraw // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *BettingRouterV3EmergencyWithdrawIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(BettingRouterV3EmergencyWithdraw)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(BettingRouterV3EmergencyWithdraw)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *BettingRouterV3EmergencyWithdrawIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *BettingRouterV3EmergencyWithdrawIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// BettingRouterV3EmergencyWithdraw represents a EmergencyWithdraw event raised by the BettingRouterV3 contract.
+type BettingRouterV3EmergencyWithdraw struct {
+	Token  common.Address
+	Amount *big.Int
+	To     common.Address
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterEmergencyWithdraw is a free log retrieval operation binding the contract event 0xaaeda929aa102e867049528ec7cd2499e3a2f8846e736ae7935f234dfbf500d9.
+//
+// Solidity: event EmergencyWithdraw(address indexed token, uint256 amount, address indexed to)
+func (_BettingRouterV3 *BettingRouterV3Filterer) FilterEmergencyWithdraw(opts *bind.FilterOpts, token []common.Address, to []common.Address) (*BettingRouterV3EmergencyWithdrawIterator, error) {
+
+	var tokenRule []interface{}
+	for _, tokenItem := range token {
+		tokenRule = append(tokenRule, tokenItem)
+	}
+
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _BettingRouterV3.contract.FilterLogs(opts, "EmergencyWithdraw", tokenRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return &BettingRouterV3EmergencyWithdrawIterator{contract: _BettingRouterV3.contract, event: "EmergencyWithdraw", logs: logs, sub: sub}, nil
+}
+
+// WatchEmergencyWithdraw is a free log subscription operation binding the contract event 0xaaeda929aa102e867049528ec7cd2499e3a2f8846e736ae7935f234dfbf500d9.
+//
+// Solidity: event EmergencyWithdraw(address indexed token, uint256 amount, address indexed to)
+func (_BettingRouterV3 *BettingRouterV3Filterer) WatchEmergencyWithdraw(opts *bind.WatchOpts, sink chan<- *BettingRouterV3EmergencyWithdraw, token []common.Address, to []common.Address) (event.Subscription, error) {
+
+	var tokenRule []interface{}
+	for _, tokenItem := range token {
+		tokenRule = append(tokenRule, tokenItem)
+	}
+
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _BettingRouterV3.contract.WatchLogs(opts, "EmergencyWithdraw", tokenRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(BettingRouterV3EmergencyWithdraw)
+				if err := _BettingRouterV3.contract.UnpackLog(event, "EmergencyWithdraw", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseEmergencyWithdraw is a log parse operation binding the contract event 0xaaeda929aa102e867049528ec7cd2499e3a2f8846e736ae7935f234dfbf500d9.
+//
+// Solidity: event EmergencyWithdraw(address indexed token, uint256 amount, address indexed to)
+func (_BettingRouterV3 *BettingRouterV3Filterer) ParseEmergencyWithdraw(log types.Log) (*BettingRouterV3EmergencyWithdraw, error) {
+	event := new(BettingRouterV3EmergencyWithdraw)
+	if err := _BettingRouterV3.contract.UnpackLog(event, "EmergencyWithdraw", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// BettingRouterV3FeeRoutedIterator is returned from FilterFeeRouted and is used to iterate over the raw logs and unpacked data for FeeRouted events raised by the BettingRouterV3 contract.
+type BettingRouterV3FeeRoutedIterator struct {
+	Event *BettingRouterV3FeeRouted // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *BettingRouterV3FeeRoutedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(BettingRouterV3FeeRouted)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(BettingRouterV3FeeRouted)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *BettingRouterV3FeeRoutedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *BettingRouterV3FeeRoutedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// BettingRouterV3FeeRouted represents a FeeRouted event raised by the BettingRouterV3 contract.
+type BettingRouterV3FeeRouted struct {
+	Token     common.Address
+	Amount    *big.Int
+	Recipient common.Address
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterFeeRouted is a free log retrieval operation binding the contract event 0x34020f492373c71a5291489ef5d16be0f6fbc1bc2328ad9173a231c32a375acb.
+//
+// Solidity: event FeeRouted(address indexed token, uint256 amount, address indexed recipient)
+func (_BettingRouterV3 *BettingRouterV3Filterer) FilterFeeRouted(opts *bind.FilterOpts, token []common.Address, recipient []common.Address) (*BettingRouterV3FeeRoutedIterator, error) {
+
+	var tokenRule []interface{}
+	for _, tokenItem := range token {
+		tokenRule = append(tokenRule, tokenItem)
+	}
+
+	var recipientRule []interface{}
+	for _, recipientItem := range recipient {
+		recipientRule = append(recipientRule, recipientItem)
+	}
+
+	logs, sub, err := _BettingRouterV3.contract.FilterLogs(opts, "FeeRouted", tokenRule, recipientRule)
+	if err != nil {
+		return nil, err
+	}
+	return &BettingRouterV3FeeRoutedIterator{contract: _BettingRouterV3.contract, event: "FeeRouted", logs: logs, sub: sub}, nil
+}
+
+// WatchFeeRouted is a free log subscription operation binding the contract event 0x34020f492373c71a5291489ef5d16be0f6fbc1bc2328ad9173a231c32a375acb.
+//
+// Solidity: event FeeRouted(address indexed token, uint256 amount, address indexed recipient)
+func (_BettingRouterV3 *BettingRouterV3Filterer) WatchFeeRouted(opts *bind.WatchOpts, sink chan<- *BettingRouterV3FeeRouted, token []common.Address, recipient []common.Address) (event.Subscription, error) {
+
+	var tokenRule []interface{}
+	for _, tokenItem := range token {
+		tokenRule = append(tokenRule, tokenItem)
+	}
+
+	var recipientRule []interface{}
+	for _, recipientItem := range recipient {
+		recipientRule = append(recipientRule, recipientItem)
+	}
+
+	logs, sub, err := _BettingRouterV3.contract.WatchLogs(opts, "FeeRouted", tokenRule, recipientRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(BettingRouterV3FeeRouted)
+				if err := _BettingRouterV3.contract.UnpackLog(event, "FeeRouted", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseFeeRouted is a log parse operation binding the contract event 0x34020f492373c71a5291489ef5d16be0f6fbc1bc2328ad9173a231c32a375acb.
+//
+// Solidity: event FeeRouted(address indexed token, uint256 amount, address indexed recipient)
+func (_BettingRouterV3 *BettingRouterV3Filterer) ParseFeeRouted(log types.Log) (*BettingRouterV3FeeRouted, error) {
+	event := new(BettingRouterV3FeeRouted)
+	if err := _BettingRouterV3.contract.UnpackLog(event, "FeeRouted", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// BettingRouterV3OwnershipTransferredIterator is returned from FilterOwnershipTransferred and is used to iterate over the raw logs and unpacked data for OwnershipTransferred events raised by the BettingRouterV3 contract.
+type BettingRouterV3OwnershipTransferredIterator struct {
+	Event *BettingRouterV3OwnershipTransferred // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *BettingRouterV3OwnershipTransferredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(BettingRouterV3OwnershipTransferred)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(BettingRouterV3OwnershipTransferred)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *BettingRouterV3OwnershipTransferredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *BettingRouterV3OwnershipTransferredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// BettingRouterV3OwnershipTransferred represents a OwnershipTransferred event raised by the BettingRouterV3 contract.
+type BettingRouterV3OwnershipTransferred struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterOwnershipTransferred is a free log retrieval operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_BettingRouterV3 *BettingRouterV3Filterer) FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*BettingRouterV3OwnershipTransferredIterator, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _BettingRouterV3.contract.FilterLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &BettingRouterV3OwnershipTransferredIterator{contract: _BettingRouterV3.contract, event: "OwnershipTransferred", logs: logs, sub: sub}, nil
+}
+
+// WatchOwnershipTransferred is a free log subscription operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_BettingRouterV3 *BettingRouterV3Filterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *BettingRouterV3OwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _BettingRouterV3.contract.WatchLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(BettingRouterV3OwnershipTransferred)
+				if err := _BettingRouterV3.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOwnershipTransferred is a log parse operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_BettingRouterV3 *BettingRouterV3Filterer) ParseOwnershipTransferred(log types.Log) (*BettingRouterV3OwnershipTransferred, error) {
+	event := new(BettingRouterV3OwnershipTransferred)
+	if err := _BettingRouterV3.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// BettingRouterV3ParamControllerUpdatedIterator is returned from FilterParamControllerUpdated and is used to iterate over the raw logs and unpacked data for ParamControllerUpdated events raised by the BettingRouterV3 contract.
+type BettingRouterV3ParamControllerUpdatedIterator struct {
+	Event *BettingRouterV3ParamControllerUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *BettingRouterV3ParamControllerUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(BettingRouterV3ParamControllerUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(BettingRouterV3ParamControllerUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *BettingRouterV3ParamControllerUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *BettingRouterV3ParamControllerUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// BettingRouterV3ParamControllerUpdated represents a ParamControllerUpdated event raised by the BettingRouterV3 contract.
+type BettingRouterV3ParamControllerUpdated struct {
+	ParamController common.Address
+	Raw             types.Log // Blockchain specific contextual infos
+}
+
+// FilterParamControllerUpdated is a free log retrieval operation binding the contract event 0xaac53e7623c5ddd5ebd98d65e1ebb7a2afc78305c7de3aa2c9a2261c1e3a6b94.
+//
+// Solidity: event ParamControllerUpdated(address indexed paramController)
+func (_BettingRouterV3 *BettingRouterV3Filterer) FilterParamControllerUpdated(opts *bind.FilterOpts, paramController []common.Address) (*BettingRouterV3ParamControllerUpdatedIterator, error) {
+
+	var paramControllerRule []interface{}
+	for _, paramControllerItem := range paramController {
+		paramControllerRule = append(paramControllerRule, paramControllerItem)
+	}
+
+	logs, sub, err := _BettingRouterV3.contract.FilterLogs(opts, "ParamControllerUpdated", paramControllerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &BettingRouterV3ParamControllerUpdatedIterator{contract: _BettingRouterV3.contract, event: "ParamControllerUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchParamControllerUpdated is a free log subscription operation binding the contract event 0xaac53e7623c5ddd5ebd98d65e1ebb7a2afc78305c7de3aa2c9a2261c1e3a6b94.
+//
+// Solidity: event ParamControllerUpdated(address indexed paramController)
+func (_BettingRouterV3 *BettingRouterV3Filterer) WatchParamControllerUpdated(opts *bind.WatchOpts, sink chan<- *BettingRouterV3ParamControllerUpdated, paramController []common.Address) (event.Subscription, error) {
+
+	var paramControllerRule []interface{}
+	for _, paramControllerItem := range paramController {
+		paramControllerRule = append(paramControllerRule, paramControllerItem)
+	}
+
+	logs, sub, err := _BettingRouterV3.contract.WatchLogs(opts, "ParamControllerUpdated", paramControllerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(BettingRouterV3ParamControllerUpdated)
+				if err := _BettingRouterV3.contract.UnpackLog(event, "ParamControllerUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseParamControllerUpdated is a log parse operation binding the contract event 0xaac53e7623c5ddd5ebd98d65e1ebb7a2afc78305c7de3aa2c9a2261c1e3a6b94.
+//
+// Solidity: event ParamControllerUpdated(address indexed paramController)
+func (_BettingRouterV3 *BettingRouterV3Filterer) ParseParamControllerUpdated(log types.Log) (*BettingRouterV3ParamControllerUpdated, error) {
+	event := new(BettingRouterV3ParamControllerUpdated)
+	if err := _BettingRouterV3.contract.UnpackLog(event, "ParamControllerUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// BettingRouterV3PayoutRedeemedIterator is returned from FilterPayoutRedeemed and is used to iterate over the raw logs and unpacked data for PayoutRedeemed events raised by the BettingRouterV3 contract.
+type BettingRouterV3PayoutRedeemedIterator struct {
+	Event *BettingRouterV3PayoutRedeemed // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *BettingRouterV3PayoutRedeemedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(BettingRouterV3PayoutRedeemed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(BettingRouterV3PayoutRedeemed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *BettingRouterV3PayoutRedeemedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *BettingRouterV3PayoutRedeemedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// BettingRouterV3PayoutRedeemed represents a PayoutRedeemed event raised by the BettingRouterV3 contract.
+type BettingRouterV3PayoutRedeemed struct {
+	User      common.Address
+	Market    common.Address
+	OutcomeId *big.Int
+	Shares    *big.Int
+	Payout    *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterPayoutRedeemed is a free log retrieval operation binding the contract event 0x9b2fcb97ba7dccc647238d5f129f6300965c947fd4a106192d5aabc971332f54.
+//
+// Solidity: event PayoutRedeemed(address indexed user, address indexed market, uint256 indexed outcomeId, uint256 shares, uint256 payout)
+func (_BettingRouterV3 *BettingRouterV3Filterer) FilterPayoutRedeemed(opts *bind.FilterOpts, user []common.Address, market []common.Address, outcomeId []*big.Int) (*BettingRouterV3PayoutRedeemedIterator, error) {
+
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+	var marketRule []interface{}
+	for _, marketItem := range market {
+		marketRule = append(marketRule, marketItem)
+	}
+	var outcomeIdRule []interface{}
+	for _, outcomeIdItem := range outcomeId {
+		outcomeIdRule = append(outcomeIdRule, outcomeIdItem)
+	}
+
+	logs, sub, err := _BettingRouterV3.contract.FilterLogs(opts, "PayoutRedeemed", userRule, marketRule, outcomeIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &BettingRouterV3PayoutRedeemedIterator{contract: _BettingRouterV3.contract, event: "PayoutRedeemed", logs: logs, sub: sub}, nil
+}
+
+// WatchPayoutRedeemed is a free log subscription operation binding the contract event 0x9b2fcb97ba7dccc647238d5f129f6300965c947fd4a106192d5aabc971332f54.
+//
+// Solidity: event PayoutRedeemed(address indexed user, address indexed market, uint256 indexed outcomeId, uint256 shares, uint256 payout)
+func (_BettingRouterV3 *BettingRouterV3Filterer) WatchPayoutRedeemed(opts *bind.WatchOpts, sink chan<- *BettingRouterV3PayoutRedeemed, user []common.Address, market []common.Address, outcomeId []*big.Int) (event.Subscription, error) {
+
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+	var marketRule []interface{}
+	for _, marketItem := range market {
+		marketRule = append(marketRule, marketItem)
+	}
+	var outcomeIdRule []interface{}
+	for _, outcomeIdItem := range outcomeId {
+		outcomeIdRule = append(outcomeIdRule, outcomeIdItem)
+	}
+
+	logs, sub, err := _BettingRouterV3.contract.WatchLogs(opts, "PayoutRedeemed", userRule, marketRule, outcomeIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(BettingRouterV3PayoutRedeemed)
+				if err := _BettingRouterV3.contract.UnpackLog(event, "PayoutRedeemed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParsePayoutRedeemed is a log parse operation binding the contract event 0x9b2fcb97ba7dccc647238d5f129f6300965c947fd4a106192d5aabc971332f54.
+//
+// Solidity: event PayoutRedeemed(address indexed user, address indexed market, uint256 indexed outcomeId, uint256 shares, uint256 payout)
+func (_BettingRouterV3 *BettingRouterV3Filterer) ParsePayoutRedeemed(log types.Log) (*BettingRouterV3PayoutRedeemed, error) {
+	event := new(BettingRouterV3PayoutRedeemed)
+	if err := _BettingRouterV3.contract.UnpackLog(event, "PayoutRedeemed", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// BettingRouterV3RefundRedeemedIterator is returned from FilterRefundRedeemed and is used to iterate over the raw logs and unpacked data for RefundRedeemed events raised by the BettingRouterV3 contract.
+type BettingRouterV3RefundRedeemedIterator struct {
+	Event *BettingRouterV3RefundRedeemed // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *BettingRouterV3RefundRedeemedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(BettingRouterV3RefundRedeemed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(BettingRouterV3RefundRedeemed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *BettingRouterV3RefundRedeemedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *BettingRouterV3RefundRedeemedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// BettingRouterV3RefundRedeemed represents a RefundRedeemed event raised by the BettingRouterV3 contract.
+type BettingRouterV3RefundRedeemed struct {
+	User      common.Address
+	Market    common.Address
+	OutcomeId *big.Int
+	Shares    *big.Int
+	Amount    *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterRefundRedeemed is a free log retrieval operation binding the contract event 0xf143233047891b31502258d465a6f9227808ebed812f776bb28e81ad9931b534.
+//
+// Solidity: event RefundRedeemed(address indexed user, address indexed market, uint256 indexed outcomeId, uint256 shares, uint256 amount)
+func (_BettingRouterV3 *BettingRouterV3Filterer) FilterRefundRedeemed(opts *bind.FilterOpts, user []common.Address, market []common.Address, outcomeId []*big.Int) (*BettingRouterV3RefundRedeemedIterator, error) {
+
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+	var marketRule []interface{}
+	for _, marketItem := range market {
+		marketRule = append(marketRule, marketItem)
+	}
+	var outcomeIdRule []interface{}
+	for _, outcomeIdItem := range outcomeId {
+		outcomeIdRule = append(outcomeIdRule, outcomeIdItem)
+	}
+
+	logs, sub, err := _BettingRouterV3.contract.FilterLogs(opts, "RefundRedeemed", userRule, marketRule, outcomeIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &BettingRouterV3RefundRedeemedIterator{contract: _BettingRouterV3.contract, event: "RefundRedeemed", logs: logs, sub: sub}, nil
+}
+
+// WatchRefundRedeemed is a free log subscription operation binding the contract event 0xf143233047891b31502258d465a6f9227808ebed812f776bb28e81ad9931b534.
+//
+// Solidity: event RefundRedeemed(address indexed user, address indexed market, uint256 indexed outcomeId, uint256 shares, uint256 amount)
+func (_BettingRouterV3 *BettingRouterV3Filterer) WatchRefundRedeemed(opts *bind.WatchOpts, sink chan<- *BettingRouterV3RefundRedeemed, user []common.Address, market []common.Address, outcomeId []*big.Int) (event.Subscription, error) {
+
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+	var marketRule []interface{}
+	for _, marketItem := range market {
+		marketRule = append(marketRule, marketItem)
+	}
+	var outcomeIdRule []interface{}
+	for _, outcomeIdItem := range outcomeId {
+		outcomeIdRule = append(outcomeIdRule, outcomeIdItem)
+	}
+
+	logs, sub, err := _BettingRouterV3.contract.WatchLogs(opts, "RefundRedeemed", userRule, marketRule, outcomeIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(BettingRouterV3RefundRedeemed)
+				if err := _BettingRouterV3.contract.UnpackLog(event, "RefundRedeemed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRefundRedeemed is a log parse operation binding the contract event 0xf143233047891b31502258d465a6f9227808ebed812f776bb28e81ad9931b534.
+//
+// Solidity: event RefundRedeemed(address indexed user, address indexed market, uint256 indexed outcomeId, uint256 shares, uint256 amount)
+func (_BettingRouterV3 *BettingRouterV3Filterer) ParseRefundRedeemed(log types.Log) (*BettingRouterV3RefundRedeemed, error) {
+	event := new(BettingRouterV3RefundRedeemed)
+	if err := _BettingRouterV3.contract.UnpackLog(event, "RefundRedeemed", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// BettingRouterV3TokenAddedIterator is returned from FilterTokenAdded and is used to iterate over the raw logs and unpacked data for TokenAdded events raised by the BettingRouterV3 contract.
+type BettingRouterV3TokenAddedIterator struct {
+	Event *BettingRouterV3TokenAdded // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *BettingRouterV3TokenAddedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(BettingRouterV3TokenAdded)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(BettingRouterV3TokenAdded)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *BettingRouterV3TokenAddedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *BettingRouterV3TokenAddedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// BettingRouterV3TokenAdded represents a TokenAdded event raised by the BettingRouterV3 contract.
+type BettingRouterV3TokenAdded struct {
+	Token        common.Address
+	FeeRateBps   *big.Int
+	FeeRecipient common.Address
+	Raw          types.Log // Blockchain specific contextual infos
+}
+
+// FilterTokenAdded is a free log retrieval operation binding the contract event 0xbdd2f82f10747dc14fda36f0645d229cde9aa60c5834f3e3d328efafc3e4e35f.
+//
+// Solidity: event TokenAdded(address indexed token, uint256 feeRateBps, address feeRecipient)
+func (_BettingRouterV3 *BettingRouterV3Filterer) FilterTokenAdded(opts *bind.FilterOpts, token []common.Address) (*BettingRouterV3TokenAddedIterator, error) {
+
+	var tokenRule []interface{}
+	for _, tokenItem := range token {
+		tokenRule = append(tokenRule, tokenItem)
+	}
+
+	logs, sub, err := _BettingRouterV3.contract.FilterLogs(opts, "TokenAdded", tokenRule)
+	if err != nil {
+		return nil, err
+	}
+	return &BettingRouterV3TokenAddedIterator{contract: _BettingRouterV3.contract, event: "TokenAdded", logs: logs, sub: sub}, nil
+}
+
+// WatchTokenAdded is a free log subscription operation binding the contract event 0xbdd2f82f10747dc14fda36f0645d229cde9aa60c5834f3e3d328efafc3e4e35f.
+//
+// Solidity: event TokenAdded(address indexed token, uint256 feeRateBps, address feeRecipient)
+func (_BettingRouterV3 *BettingRouterV3Filterer) WatchTokenAdded(opts *bind.WatchOpts, sink chan<- *BettingRouterV3TokenAdded, token []common.Address) (event.Subscription, error) {
+
+	var tokenRule []interface{}
+	for _, tokenItem := range token {
+		tokenRule = append(tokenRule, tokenItem)
+	}
+
+	logs, sub, err := _BettingRouterV3.contract.WatchLogs(opts, "TokenAdded", tokenRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(BettingRouterV3TokenAdded)
+				if err := _BettingRouterV3.contract.UnpackLog(event, "TokenAdded", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTokenAdded is a log parse operation binding the contract event 0xbdd2f82f10747dc14fda36f0645d229cde9aa60c5834f3e3d328efafc3e4e35f.
+//
+// Solidity: event TokenAdded(address indexed token, uint256 feeRateBps, address feeRecipient)
+func (_BettingRouterV3 *BettingRouterV3Filterer) ParseTokenAdded(log types.Log) (*BettingRouterV3TokenAdded, error) {
+	event := new(BettingRouterV3TokenAdded)
+	if err := _BettingRouterV3.contract.UnpackLog(event, "TokenAdded", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// BettingRouterV3TokenConfigUpdatedIterator is returned from FilterTokenConfigUpdated and is used to iterate over the raw logs and unpacked data for TokenConfigUpdated events raised by the BettingRouterV3 contract.
+type BettingRouterV3TokenConfigUpdatedIterator struct {
+	Event *BettingRouterV3TokenConfigUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *BettingRouterV3TokenConfigUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(BettingRouterV3TokenConfigUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(BettingRouterV3TokenConfigUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *BettingRouterV3TokenConfigUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *BettingRouterV3TokenConfigUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// BettingRouterV3TokenConfigUpdated represents a TokenConfigUpdated event raised by the BettingRouterV3 contract.
+type BettingRouterV3TokenConfigUpdated struct {
+	Token        common.Address
+	FeeRateBps   *big.Int
+	FeeRecipient common.Address
+	MinBetAmount *big.Int
+	MaxBetAmount *big.Int
+	Raw          types.Log // Blockchain specific contextual infos
+}
+
+// FilterTokenConfigUpdated is a free log retrieval operation binding the contract event 0xfaaf96e517161520b0f63642ce406e033fb636706e78a7416d49245de56e14fa.
+//
+// Solidity: event TokenConfigUpdated(address indexed token, uint256 feeRateBps, address feeRecipient, uint256 minBetAmount, uint256 maxBetAmount)
+func (_BettingRouterV3 *BettingRouterV3Filterer) FilterTokenConfigUpdated(opts *bind.FilterOpts, token []common.Address) (*BettingRouterV3TokenConfigUpdatedIterator, error) {
+
+	var tokenRule []interface{}
+	for _, tokenItem := range token {
+		tokenRule = append(tokenRule, tokenItem)
+	}
+
+	logs, sub, err := _BettingRouterV3.contract.FilterLogs(opts, "TokenConfigUpdated", tokenRule)
+	if err != nil {
+		return nil, err
+	}
+	return &BettingRouterV3TokenConfigUpdatedIterator{contract: _BettingRouterV3.contract, event: "TokenConfigUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchTokenConfigUpdated is a free log subscription operation binding the contract event 0xfaaf96e517161520b0f63642ce406e033fb636706e78a7416d49245de56e14fa.
+//
+// Solidity: event TokenConfigUpdated(address indexed token, uint256 feeRateBps, address feeRecipient, uint256 minBetAmount, uint256 maxBetAmount)
+func (_BettingRouterV3 *BettingRouterV3Filterer) WatchTokenConfigUpdated(opts *bind.WatchOpts, sink chan<- *BettingRouterV3TokenConfigUpdated, token []common.Address) (event.Subscription, error) {
+
+	var tokenRule []interface{}
+	for _, tokenItem := range token {
+		tokenRule = append(tokenRule, tokenItem)
+	}
+
+	logs, sub, err := _BettingRouterV3.contract.WatchLogs(opts, "TokenConfigUpdated", tokenRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(BettingRouterV3TokenConfigUpdated)
+				if err := _BettingRouterV3.contract.UnpackLog(event, "TokenConfigUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTokenConfigUpdated is a log parse operation binding the contract event 0xfaaf96e517161520b0f63642ce406e033fb636706e78a7416d49245de56e14fa.
+//
+// Solidity: event TokenConfigUpdated(address indexed token, uint256 feeRateBps, address feeRecipient, uint256 minBetAmount, uint256 maxBetAmount)
+func (_BettingRouterV3 *BettingRouterV3Filterer) ParseTokenConfigUpdated(log types.Log) (*BettingRouterV3TokenConfigUpdated, error) {
+	event := new(BettingRouterV3TokenConfigUpdated)
+	if err := _BettingRouterV3.contract.UnpackLog(event, "TokenConfigUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// BettingRouterV3TokenRemovedIterator is returned from FilterTokenRemoved and is used to iterate over the raw logs and unpacked data for TokenRemoved events raised by the BettingRouterV3 contract.
+type BettingRouterV3TokenRemovedIterator struct {
+	Event *BettingRouterV3TokenRemoved // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *BettingRouterV3TokenRemovedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(BettingRouterV3TokenRemoved)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(BettingRouterV3TokenRemoved)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *BettingRouterV3TokenRemovedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *BettingRouterV3TokenRemovedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// BettingRouterV3TokenRemoved represents a TokenRemoved event raised by the BettingRouterV3 contract.
+type BettingRouterV3TokenRemoved struct {
+	Token common.Address
+	Raw   types.Log // Blockchain specific contextual infos
+}
+
+// FilterTokenRemoved is a free log retrieval operation binding the contract event 0x4c910b69fe65a61f7531b9c5042b2329ca7179c77290aa7e2eb3afa3c8511fd3.
+//
+// Solidity: event TokenRemoved(address indexed token)
+func (_BettingRouterV3 *BettingRouterV3Filterer) FilterTokenRemoved(opts *bind.FilterOpts, token []common.Address) (*BettingRouterV3TokenRemovedIterator, error) {
+
+	var tokenRule []interface{}
+	for _, tokenItem := range token {
+		tokenRule = append(tokenRule, tokenItem)
+	}
+
+	logs, sub, err := _BettingRouterV3.contract.FilterLogs(opts, "TokenRemoved", tokenRule)
+	if err != nil {
+		return nil, err
+	}
+	return &BettingRouterV3TokenRemovedIterator{contract: _BettingRouterV3.contract, event: "TokenRemoved", logs: logs, sub: sub}, nil
+}
+
+// WatchTokenRemoved is a free log subscription operation binding the contract event 0x4c910b69fe65a61f7531b9c5042b2329ca7179c77290aa7e2eb3afa3c8511fd3.
+//
+// Solidity: event TokenRemoved(address indexed token)
+func (_BettingRouterV3 *BettingRouterV3Filterer) WatchTokenRemoved(opts *bind.WatchOpts, sink chan<- *BettingRouterV3TokenRemoved, token []common.Address) (event.Subscription, error) {
+
+	var tokenRule []interface{}
+	for _, tokenItem := range token {
+		tokenRule = append(tokenRule, tokenItem)
+	}
+
+	logs, sub, err := _BettingRouterV3.contract.WatchLogs(opts, "TokenRemoved", tokenRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(BettingRouterV3TokenRemoved)
+				if err := _BettingRouterV3.contract.UnpackLog(event, "TokenRemoved", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTokenRemoved is a log parse operation binding the contract event 0x4c910b69fe65a61f7531b9c5042b2329ca7179c77290aa7e2eb3afa3c8511fd3.
+//
+// Solidity: event TokenRemoved(address indexed token)
+func (_BettingRouterV3 *BettingRouterV3Filterer) ParseTokenRemoved(log types.Log) (*BettingRouterV3TokenRemoved, error) {
+	event := new(BettingRouterV3TokenRemoved)
+	if err := _BettingRouterV3.contract.UnpackLog(event, "TokenRemoved", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,2003 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package bindings
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// ICampaignCampaignInfo is an auto generated low-level Go binding around an user-defined struct.
+type ICampaignCampaignInfo struct {
+	CampaignId       [32]byte
+	Name             string
+	RuleHash         [32]byte
+	BudgetCap        *big.Int
+	SpentAmount      *big.Int
+	StartTime        *big.Int
+	EndTime          *big.Int
+	Status           uint8
+	ParticipantCount *big.Int
+}
+
+// CampaignMetaData contains all meta data concerning the Campaign contract.
+var CampaignMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[{\"name\":\"admin\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"ADMIN_ROLE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"DEFAULT_ADMIN_ROLE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"OPERATOR_ROLE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"createCampaign\",\"inputs\":[{\"name\":\"campaignId\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"name\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"ruleHash\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"budgetCap\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"startTime\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"endTime\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"endCampaign\",\"inputs\":[{\"name\":\"campaignId\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"getAllCampaignIds\",\"inputs\":[],\"outputs\":[{\"name\":\"campaignIds\",\"type\":\"bytes32[]\",\"internalType\":\"bytes32[]\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getCampaignCount\",\"inputs\":[],\"outputs\":[{\"name\":\"count\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getCampaignInfo\",\"inputs\":[{\"name\":\"campaignId\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"outputs\":[{\"name\":\"info\",\"type\":\"tuple\",\"internalType\":\"structICampaign.CampaignInfo\",\"components\":[{\"name\":\"campaignId\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"name\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"ruleHash\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"budgetCap\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"spentAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"startTime\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"endTime\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"status\",\"type\":\"uint8\",\"internalType\":\"enumICampaign.CampaignStatus\"},{\"name\":\"participantCount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getRemainingBudget\",\"inputs\":[{\"name\":\"campaignId\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"outputs\":[{\"name\":\"remaining\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getRoleAdmin\",\"inputs\":[{\"name\":\"role\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"grantRole\",\"inputs\":[{\"name\":\"role\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"hasParticipated\",\"inputs\":[{\"name\":\"campaignId\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"user\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"participated\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"hasRole\",\"inputs\":[{\"name\":\"role\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"increaseBudget\",\"inputs\":[{\"name\":\"campaignId\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"additionalBudget\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"isActive\",\"inputs\":[{\"name\":\"campaignId\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"outputs\":[{\"name\":\"active\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"participate\",\"inputs\":[{\"name\":\"campaignId\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"pauseCampaign\",\"inputs\":[{\"name\":\"campaignId\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"recordSpending\",\"inputs\":[{\"name\":\"campaignId\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"renounceRole\",\"inputs\":[{\"name\":\"role\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"callerConfirmation\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"resumeCampaign\",\"inputs\":[{\"name\":\"campaignId\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"revokeRole\",\"inputs\":[{\"name\":\"role\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"supportsInterface\",\"inputs\":[{\"name\":\"interfaceId\",\"type\":\"bytes4\",\"internalType\":\"bytes4\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"event\",\"name\":\"CampaignBudgetIncreased\",\"inputs\":[{\"name\":\"campaignId\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"oldCap\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"newCap\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"CampaignBudgetSpent\",\"inputs\":[{\"name\":\"campaignId\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"totalSpent\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"CampaignCreated\",\"inputs\":[{\"name\":\"campaignId\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"name\",\"type\":\"string\",\"indexed\":false,\"internalType\":\"string\"},{\"name\":\"ruleHash\",\"type\":\"bytes32\",\"indexed\":false,\"internalType\":\"bytes32\"},{\"name\":\"budgetCap\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"startTime\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"endTime\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"CampaignParticipated\",\"inputs\":[{\"name\":\"campaignId\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"user\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"timestamp\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"CampaignStatusChanged\",\"inputs\":[{\"name\":\"campaignId\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"oldStatus\",\"type\":\"uint8\",\"indexed\":false,\"internalType\":\"enumICampaign.CampaignStatus\"},{\"name\":\"newStatus\",\"type\":\"uint8\",\"indexed\":false,\"internalType\":\"enumICampaign.CampaignStatus\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"RoleAdminChanged\",\"inputs\":[{\"name\":\"role\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"previousAdminRole\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"newAdminRole\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"RoleGranted\",\"inputs\":[{\"name\":\"role\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"account\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"sender\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"RoleRevoked\",\"inputs\":[{\"name\":\"role\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"account\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"sender\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"error\",\"name\":\"AccessControlBadConfirmation\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"AccessControlUnauthorizedAccount\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"neededRole\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}]},{\"type\":\"error\",\"name\":\"AlreadyParticipated\",\"inputs\":[{\"name\":\"campaignId\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"user\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"CampaignAlreadyEnded\",\"inputs\":[{\"name\":\"campaignId\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}]},{\"type\":\"error\",\"name\":\"CampaignAlreadyExists\",\"inputs\":[{\"name\":\"campaignId\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}]},{\"type\":\"error\",\"name\":\"CampaignBudgetExceeded\",\"inputs\":[{\"name\":\"campaignId\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"required\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"available\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"CampaignNotActive\",\"inputs\":[{\"name\":\"campaignId\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}]},{\"type\":\"error\",\"name\":\"CampaignNotFound\",\"inputs\":[{\"name\":\"campaignId\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}]},{\"type\":\"error\",\"name\":\"CampaignNotStarted\",\"inputs\":[{\"name\":\"campaignId\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}]},{\"type\":\"error\",\"name\":\"InvalidBudget\",\"inputs\":[{\"name\":\"budget\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"InvalidTimeRange\",\"inputs\":[{\"name\":\"startTime\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"endTime\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"ReentrancyGuardReentrantCall\",\"inputs\":[]}]",
+}
+
+// CampaignABI is the input ABI used to generate the binding from.
+// Deprecated: Use CampaignMetaData.ABI instead.
+var CampaignABI = CampaignMetaData.ABI
+
+// Campaign is an auto generated Go binding around an Ethereum contract.
+type Campaign struct {
+	CampaignCaller     // Read-only binding to the contract
+	CampaignTransactor // Write-only binding to the contract
+	CampaignFilterer   // Log filterer for contract events
+}
+
+// CampaignCaller is an auto generated read-only Go binding around an Ethereum contract.
+type CampaignCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// CampaignTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type CampaignTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// CampaignFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type CampaignFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// CampaignSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type CampaignSession struct {
+	Contract     *Campaign         // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// CampaignCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type CampaignCallerSession struct {
+	Contract *CampaignCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts   // Call options to use throughout this session
+}
+
+// CampaignTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type CampaignTransactorSession struct {
+	Contract     *CampaignTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts   // Transaction auth options to use throughout this session
+}
+
+// CampaignRaw is an auto generated low-level Go binding around an Ethereum contract.
+type CampaignRaw struct {
+	Contract *Campaign // Generic contract binding to access the raw methods on
+}
+
+// CampaignCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type CampaignCallerRaw struct {
+	Contract *CampaignCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// CampaignTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type CampaignTransactorRaw struct {
+	Contract *CampaignTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewCampaign creates a new instance of Campaign, bound to a specific deployed contract.
+func NewCampaign(address common.Address, backend bind.ContractBackend) (*Campaign, error) {
+	contract, err := bindCampaign(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Campaign{CampaignCaller: CampaignCaller{contract: contract}, CampaignTransactor: CampaignTransactor{contract: contract}, CampaignFilterer: CampaignFilterer{contract: contract}}, nil
+}
+
+// NewCampaignCaller creates a new read-only instance of Campaign, bound to a specific deployed contract.
+func NewCampaignCaller(address common.Address, caller bind.ContractCaller) (*CampaignCaller, error) {
+	contract, err := bindCampaign(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &CampaignCaller{contract: contract}, nil
+}
+
+// NewCampaignTransactor creates a new write-only instance of Campaign, bound to a specific deployed contract.
+func NewCampaignTransactor(address common.Address, transactor bind.ContractTransactor) (*CampaignTransactor, error) {
+	contract, err := bindCampaign(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &CampaignTransactor{contract: contract}, nil
+}
+
+// NewCampaignFilterer creates a new log filterer instance of Campaign, bound to a specific deployed contract.
+func NewCampaignFilterer(address common.Address, filterer bind.ContractFilterer) (*CampaignFilterer, error) {
+	contract, err := bindCampaign(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &CampaignFilterer{contract: contract}, nil
+}
+
+// bindCampaign binds a generic wrapper to an already deployed contract.
+func bindCampaign(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := CampaignMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Campaign *CampaignRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Campaign.Contract.CampaignCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Campaign *CampaignRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Campaign.Contract.CampaignTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Campaign *CampaignRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Campaign.Contract.CampaignTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Campaign *CampaignCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Campaign.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Campaign *CampaignTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Campaign.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Campaign *CampaignTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Campaign.Contract.contract.Transact(opts, method, params...)
+}
+
+// ADMINROLE is a free data retrieval call binding the contract method 0x75b238fc.
+//
+// Solidity: function ADMIN_ROLE() view returns(bytes32)
+func (_Campaign *CampaignCaller) ADMINROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _Campaign.contract.Call(opts, &out, "ADMIN_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// ADMINROLE is a free data retrieval call binding the contract method 0x75b238fc.
+//
+// Solidity: function ADMIN_ROLE() view returns(bytes32)
+func (_Campaign *CampaignSession) ADMINROLE() ([32]byte, error) {
+	return _Campaign.Contract.ADMINROLE(&_Campaign.CallOpts)
+}
+
+// ADMINROLE is a free data retrieval call binding the contract method 0x75b238fc.
+//
+// Solidity: function ADMIN_ROLE() view returns(bytes32)
+func (_Campaign *CampaignCallerSession) ADMINROLE() ([32]byte, error) {
+	return _Campaign.Contract.ADMINROLE(&_Campaign.CallOpts)
+}
+
+// DEFAULTADMINROLE is a free data retrieval call binding the contract method 0xa217fddf.
+//
+// Solidity: function DEFAULT_ADMIN_ROLE() view returns(bytes32)
+func (_Campaign *CampaignCaller) DEFAULTADMINROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _Campaign.contract.Call(opts, &out, "DEFAULT_ADMIN_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// DEFAULTADMINROLE is a free data retrieval call binding the contract method 0xa217fddf.
+//
+// Solidity: function DEFAULT_ADMIN_ROLE() view returns(bytes32)
+func (_Campaign *CampaignSession) DEFAULTADMINROLE() ([32]byte, error) {
+	return _Campaign.Contract.DEFAULTADMINROLE(&_Campaign.CallOpts)
+}
+
+// DEFAULTADMINROLE is a free data retrieval call binding the contract method 0xa217fddf.
+//
+// Solidity: function DEFAULT_ADMIN_ROLE() view returns(bytes32)
+func (_Campaign *CampaignCallerSession) DEFAULTADMINROLE() ([32]byte, error) {
+	return _Campaign.Contract.DEFAULTADMINROLE(&_Campaign.CallOpts)
+}
+
+// OPERATORROLE is a free data retrieval call binding the contract method 0xf5b541a6.
+//
+// Solidity: function OPERATOR_ROLE() view returns(bytes32)
+func (_Campaign *CampaignCaller) OPERATORROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _Campaign.contract.Call(opts, &out, "OPERATOR_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// OPERATORROLE is a free data retrieval call binding the contract method 0xf5b541a6.
+//
+// Solidity: function OPERATOR_ROLE() view returns(bytes32)
+func (_Campaign *CampaignSession) OPERATORROLE() ([32]byte, error) {
+	return _Campaign.Contract.OPERATORROLE(&_Campaign.CallOpts)
+}
+
+// OPERATORROLE is a free data retrieval call binding the contract method 0xf5b541a6.
+//
+// Solidity: function OPERATOR_ROLE() view returns(bytes32)
+func (_Campaign *CampaignCallerSession) OPERATORROLE() ([32]byte, error) {
+	return _Campaign.Contract.OPERATORROLE(&_Campaign.CallOpts)
+}
+
+// GetAllCampaignIds is a free data retrieval call binding the contract method 0x04182496.
+//
+// Solidity: function getAllCampaignIds() view returns(bytes32[] campaignIds)
+func (_Campaign *CampaignCaller) GetAllCampaignIds(opts *bind.CallOpts) ([][32]byte, error) {
+	var out []interface{}
+	err := _Campaign.contract.Call(opts, &out, "getAllCampaignIds")
+
+	if err != nil {
+		return *new([][32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([][32]byte)).(*[][32]byte)
+
+	return out0, err
+
+}
+
+// GetAllCampaignIds is a free data retrieval call binding the contract method 0x04182496.
+//
+// Solidity: function getAllCampaignIds() view returns(bytes32[] campaignIds)
+func (_Campaign *CampaignSession) GetAllCampaignIds() ([][32]byte, error) {
+	return _Campaign.Contract.GetAllCampaignIds(&_Campaign.CallOpts)
+}
+
+// GetAllCampaignIds is a free data retrieval call binding the contract method 0x04182496.
+//
+// Solidity: function getAllCampaignIds() view returns(bytes32[] campaignIds)
+func (_Campaign *CampaignCallerSession) GetAllCampaignIds() ([][32]byte, error) {
+	return _Campaign.Contract.GetAllCampaignIds(&_Campaign.CallOpts)
+}
+
+// GetCampaignCount is a free data retrieval call binding the contract method 0x6caa9218.
+//
+// Solidity: function getCampaignCount() view returns(uint256 count)
+func (_Campaign *CampaignCaller) GetCampaignCount(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Campaign.contract.Call(opts, &out, "getCampaignCount")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetCampaignCount is a free data retrieval call binding the contract method 0x6caa9218.
+//
+// Solidity: function getCampaignCount() view returns(uint256 count)
+func (_Campaign *CampaignSession) GetCampaignCount() (*big.Int, error) {
+	return _Campaign.Contract.GetCampaignCount(&_Campaign.CallOpts)
+}
+
+// GetCampaignCount is a free data retrieval call binding the contract method 0x6caa9218.
+//
+// Solidity: function getCampaignCount() view returns(uint256 count)
+func (_Campaign *CampaignCallerSession) GetCampaignCount() (*big.Int, error) {
+	return _Campaign.Contract.GetCampaignCount(&_Campaign.CallOpts)
+}
+
+// GetCampaignInfo is a free data retrieval call binding the contract method 0xb999be1b.
+//
+// Solidity: function getCampaignInfo(bytes32 campaignId) view returns((bytes32,string,bytes32,uint256,uint256,uint256,uint256,uint8,uint256) info)
+func (_Campaign *CampaignCaller) GetCampaignInfo(opts *bind.CallOpts, campaignId [32]byte) (ICampaignCampaignInfo, error) {
+	var out []interface{}
+	err := _Campaign.contract.Call(opts, &out, "getCampaignInfo", campaignId)
+
+	if err != nil {
+		return *new(ICampaignCampaignInfo), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(ICampaignCampaignInfo)).(*ICampaignCampaignInfo)
+
+	return out0, err
+
+}
+
+// GetCampaignInfo is a free data retrieval call binding the contract method 0xb999be1b.
+//
+// Solidity: function getCampaignInfo(bytes32 campaignId) view returns((bytes32,string,bytes32,uint256,uint256,uint256,uint256,uint8,uint256) info)
+func (_Campaign *CampaignSession) GetCampaignInfo(campaignId [32]byte) (ICampaignCampaignInfo, error) {
+	return _Campaign.Contract.GetCampaignInfo(&_Campaign.CallOpts, campaignId)
+}
+
+// GetCampaignInfo is a free data retrieval call binding the contract method 0xb999be1b.
+//
+// Solidity: function getCampaignInfo(bytes32 campaignId) view returns((bytes32,string,bytes32,uint256,uint256,uint256,uint256,uint8,uint256) info)
+func (_Campaign *CampaignCallerSession) GetCampaignInfo(campaignId [32]byte) (ICampaignCampaignInfo, error) {
+	return _Campaign.Contract.GetCampaignInfo(&_Campaign.CallOpts, campaignId)
+}
+
+// GetRemainingBudget is a free data retrieval call binding the contract method 0xdecb8763.
+//
+// Solidity: function getRemainingBudget(bytes32 campaignId) view returns(uint256 remaining)
+func (_Campaign *CampaignCaller) GetRemainingBudget(opts *bind.CallOpts, campaignId [32]byte) (*big.Int, error) {
+	var out []interface{}
+	err := _Campaign.contract.Call(opts, &out, "getRemainingBudget", campaignId)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetRemainingBudget is a free data retrieval call binding the contract method 0xdecb8763.
+//
+// Solidity: function getRemainingBudget(bytes32 campaignId) view returns(uint256 remaining)
+func (_Campaign *CampaignSession) GetRemainingBudget(campaignId [32]byte) (*big.Int, error) {
+	return _Campaign.Contract.GetRemainingBudget(&_Campaign.CallOpts, campaignId)
+}
+
+// GetRemainingBudget is a free data retrieval call binding the contract method 0xdecb8763.
+//
+// Solidity: function getRemainingBudget(bytes32 campaignId) view returns(uint256 remaining)
+func (_Campaign *CampaignCallerSession) GetRemainingBudget(campaignId [32]byte) (*big.Int, error) {
+	return _Campaign.Contract.GetRemainingBudget(&_Campaign.CallOpts, campaignId)
+}
+
+// GetRoleAdmin is a free data retrieval call binding the contract method 0x248a9ca3.
+//
+// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
+func (_Campaign *CampaignCaller) GetRoleAdmin(opts *bind.CallOpts, role [32]byte) ([32]byte, error) {
+	var out []interface{}
+	err := _Campaign.contract.Call(opts, &out, "getRoleAdmin", role)
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// GetRoleAdmin is a free data retrieval call binding the contract method 0x248a9ca3.
+//
+// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
+func (_Campaign *CampaignSession) GetRoleAdmin(role [32]byte) ([32]byte, error) {
+	return _Campaign.Contract.GetRoleAdmin(&_Campaign.CallOpts, role)
+}
+
+// GetRoleAdmin is a free data retrieval call binding the contract method 0x248a9ca3.
+//
+// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
+func (_Campaign *CampaignCallerSession) GetRoleAdmin(role [32]byte) ([32]byte, error) {
+	return _Campaign.Contract.GetRoleAdmin(&_Campaign.CallOpts, role)
+}
+
+// HasParticipated is a free data retrieval call binding the contract method 0xcc8d3810.
+//
+// Solidity: function hasParticipated(bytes32 campaignId, address user) view returns(bool participated)
+func (_Campaign *CampaignCaller) HasParticipated(opts *bind.CallOpts, campaignId [32]byte, user common.Address) (bool, error) {
+	var out []interface{}
+	err := _Campaign.contract.Call(opts, &out, "hasParticipated", campaignId, user)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// HasParticipated is a free data retrieval call binding the contract method 0xcc8d3810.
+//
+// Solidity: function hasParticipated(bytes32 campaignId, address user) view returns(bool participated)
+func (_Campaign *CampaignSession) HasParticipated(campaignId [32]byte, user common.Address) (bool, error) {
+	return _Campaign.Contract.HasParticipated(&_Campaign.CallOpts, campaignId, user)
+}
+
+// HasParticipated is a free data retrieval call binding the contract method 0xcc8d3810.
+//
+// Solidity: function hasParticipated(bytes32 campaignId, address user) view returns(bool participated)
+func (_Campaign *CampaignCallerSession) HasParticipated(campaignId [32]byte, user common.Address) (bool, error) {
+	return _Campaign.Contract.HasParticipated(&_Campaign.CallOpts, campaignId, user)
+}
+
+// HasRole is a free data retrieval call binding the contract method 0x91d14854.
+//
+// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
+func (_Campaign *CampaignCaller) HasRole(opts *bind.CallOpts, role [32]byte, account common.Address) (bool, error) {
+	var out []interface{}
+	err := _Campaign.contract.Call(opts, &out, "hasRole", role, account)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// HasRole is a free data retrieval call binding the contract method 0x91d14854.
+//
+// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
+func (_Campaign *CampaignSession) HasRole(role [32]byte, account common.Address) (bool, error) {
+	return _Campaign.Contract.HasRole(&_Campaign.CallOpts, role, account)
+}
+
+// HasRole is a free data retrieval call binding the contract method 0x91d14854.
+//
+// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
+func (_Campaign *CampaignCallerSession) HasRole(role [32]byte, account common.Address) (bool, error) {
+	return _Campaign.Contract.HasRole(&_Campaign.CallOpts, role, account)
+}
+
+// IsActive is a free data retrieval call binding the contract method 0x5c36901c.
+//
+// Solidity: function isActive(bytes32 campaignId) view returns(bool active)
+func (_Campaign *CampaignCaller) IsActive(opts *bind.CallOpts, campaignId [32]byte) (bool, error) {
+	var out []interface{}
+	err := _Campaign.contract.Call(opts, &out, "isActive", campaignId)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsActive is a free data retrieval call binding the contract method 0x5c36901c.
+//
+// Solidity: function isActive(bytes32 campaignId) view returns(bool active)
+func (_Campaign *CampaignSession) IsActive(campaignId [32]byte) (bool, error) {
+	return _Campaign.Contract.IsActive(&_Campaign.CallOpts, campaignId)
+}
+
+// IsActive is a free data retrieval call binding the contract method 0x5c36901c.
+//
+// Solidity: function isActive(bytes32 campaignId) view returns(bool active)
+func (_Campaign *CampaignCallerSession) IsActive(campaignId [32]byte) (bool, error) {
+	return _Campaign.Contract.IsActive(&_Campaign.CallOpts, campaignId)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_Campaign *CampaignCaller) SupportsInterface(opts *bind.CallOpts, interfaceId [4]byte) (bool, error) {
+	var out []interface{}
+	err := _Campaign.contract.Call(opts, &out, "supportsInterface", interfaceId)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_Campaign *CampaignSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _Campaign.Contract.SupportsInterface(&_Campaign.CallOpts, interfaceId)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_Campaign *CampaignCallerSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _Campaign.Contract.SupportsInterface(&_Campaign.CallOpts, interfaceId)
+}
+
+// CreateCampaign is a paid mutator transaction binding the contract method 0xbe184d80.
+//
+// Solidity: function createCampaign(bytes32 campaignId, string name, bytes32 ruleHash, uint256 budgetCap, uint256 startTime, uint256 endTime) returns()
+func (_Campaign *CampaignTransactor) CreateCampaign(opts *bind.TransactOpts, campaignId [32]byte, name string, ruleHash [32]byte, budgetCap *big.Int, startTime *big.Int, endTime *big.Int) (*types.Transaction, error) {
+	return _Campaign.contract.Transact(opts, "createCampaign", campaignId, name, ruleHash, budgetCap, startTime, endTime)
+}
+
+// CreateCampaign is a paid mutator transaction binding the contract method 0xbe184d80.
+//
+// Solidity: function createCampaign(bytes32 campaignId, string name, bytes32 ruleHash, uint256 budgetCap, uint256 startTime, uint256 endTime) returns()
+func (_Campaign *CampaignSession) CreateCampaign(campaignId [32]byte, name string, ruleHash [32]byte, budgetCap *big.Int, startTime *big.Int, endTime *big.Int) (*types.Transaction, error) {
+	return _Campaign.Contract.CreateCampaign(&_Campaign.TransactOpts, campaignId, name, ruleHash, budgetCap, startTime, endTime)
+}
+
+// CreateCampaign is a paid mutator transaction binding the contract method 0xbe184d80.
+//
+// Solidity: function createCampaign(bytes32 campaignId, string name, bytes32 ruleHash, uint256 budgetCap, uint256 startTime, uint256 endTime) returns()
+func (_Campaign *CampaignTransactorSession) CreateCampaign(campaignId [32]byte, name string, ruleHash [32]byte, budgetCap *big.Int, startTime *big.Int, endTime *big.Int) (*types.Transaction, error) {
+	return _Campaign.Contract.CreateCampaign(&_Campaign.TransactOpts, campaignId, name, ruleHash, budgetCap, startTime, endTime)
+}
+
+// EndCampaign is a paid mutator transaction binding the contract method 0x29777bc6.
+//
+// Solidity: function endCampaign(bytes32 campaignId) returns()
+func (_Campaign *CampaignTransactor) EndCampaign(opts *bind.TransactOpts, campaignId [32]byte) (*types.Transaction, error) {
+	return _Campaign.contract.Transact(opts, "endCampaign", campaignId)
+}
+
+// EndCampaign is a paid mutator transaction binding the contract method 0x29777bc6.
+//
+// Solidity: function endCampaign(bytes32 campaignId) returns()
+func (_Campaign *CampaignSession) EndCampaign(campaignId [32]byte) (*types.Transaction, error) {
+	return _Campaign.Contract.EndCampaign(&_Campaign.TransactOpts, campaignId)
+}
+
+// EndCampaign is a paid mutator transaction binding the contract method 0x29777bc6.
+//
+// Solidity: function endCampaign(bytes32 campaignId) returns()
+func (_Campaign *CampaignTransactorSession) EndCampaign(campaignId [32]byte) (*types.Transaction, error) {
+	return _Campaign.Contract.EndCampaign(&_Campaign.TransactOpts, campaignId)
+}
+
+// GrantRole is a paid mutator transaction binding the contract method 0x2f2ff15d.
+//
+// Solidity: function grantRole(bytes32 role, address account) returns()
+func (_Campaign *CampaignTransactor) GrantRole(opts *bind.TransactOpts, role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _Campaign.contract.Transact(opts, "grantRole", role, account)
+}
+
+// GrantRole is a paid mutator transaction binding the contract method 0x2f2ff15d.
+//
+// Solidity: function grantRole(bytes32 role, address account) returns()
+func (_Campaign *CampaignSession) GrantRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _Campaign.Contract.GrantRole(&_Campaign.TransactOpts, role, account)
+}
+
+// GrantRole is a paid mutator transaction binding the contract method 0x2f2ff15d.
+//
+// Solidity: function grantRole(bytes32 role, address account) returns()
+func (_Campaign *CampaignTransactorSession) GrantRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _Campaign.Contract.GrantRole(&_Campaign.TransactOpts, role, account)
+}
+
+// IncreaseBudget is a paid mutator transaction binding the contract method 0x9d2b12e5.
+//
+// Solidity: function increaseBudget(bytes32 campaignId, uint256 additionalBudget) returns()
+func (_Campaign *CampaignTransactor) IncreaseBudget(opts *bind.TransactOpts, campaignId [32]byte, additionalBudget *big.Int) (*types.Transaction, error) {
+	return _Campaign.contract.Transact(opts, "increaseBudget", campaignId, additionalBudget)
+}
+
+// IncreaseBudget is a paid mutator transaction binding the contract method 0x9d2b12e5.
+//
+// Solidity: function increaseBudget(bytes32 campaignId, uint256 additionalBudget) returns()
+func (_Campaign *CampaignSession) IncreaseBudget(campaignId [32]byte, additionalBudget *big.Int) (*types.Transaction, error) {
+	return _Campaign.Contract.IncreaseBudget(&_Campaign.TransactOpts, campaignId, additionalBudget)
+}
+
+// IncreaseBudget is a paid mutator transaction binding the contract method 0x9d2b12e5.
+//
+// Solidity: function increaseBudget(bytes32 campaignId, uint256 additionalBudget) returns()
+func (_Campaign *CampaignTransactorSession) IncreaseBudget(campaignId [32]byte, additionalBudget *big.Int) (*types.Transaction, error) {
+	return _Campaign.Contract.IncreaseBudget(&_Campaign.TransactOpts, campaignId, additionalBudget)
+}
+
+// Participate is a paid mutator transaction binding the contract method 0x0a14504c.
+//
+// Solidity: function participate(bytes32 campaignId) returns()
+func (_Campaign *CampaignTransactor) Participate(opts *bind.TransactOpts, campaignId [32]byte) (*types.Transaction, error) {
+	return _Campaign.contract.Transact(opts, "participate", campaignId)
+}
+
+// Participate is a paid mutator transaction binding the contract method 0x0a14504c.
+//
+// Solidity: function participate(bytes32 campaignId) returns()
+func (_Campaign *CampaignSession) Participate(campaignId [32]byte) (*types.Transaction, error) {
+	return _Campaign.Contract.Participate(&_Campaign.TransactOpts, campaignId)
+}
+
+// Participate is a paid mutator transaction binding the contract method 0x0a14504c.
+//
+// Solidity: function participate(bytes32 campaignId) returns()
+func (_Campaign *CampaignTransactorSession) Participate(campaignId [32]byte) (*types.Transaction, error) {
+	return _Campaign.Contract.Participate(&_Campaign.TransactOpts, campaignId)
+}
+
+// PauseCampaign is a paid mutator transaction binding the contract method 0x52d98083.
+//
+// Solidity: function pauseCampaign(bytes32 campaignId) returns()
+func (_Campaign *CampaignTransactor) PauseCampaign(opts *bind.TransactOpts, campaignId [32]byte) (*types.Transaction, error) {
+	return _Campaign.contract.Transact(opts, "pauseCampaign", campaignId)
+}
+
+// PauseCampaign is a paid mutator transaction binding the contract method 0x52d98083.
+//
+// Solidity: function pauseCampaign(bytes32 campaignId) returns()
+func (_Campaign *CampaignSession) PauseCampaign(campaignId [32]byte) (*types.Transaction, error) {
+	return _Campaign.Contract.PauseCampaign(&_Campaign.TransactOpts, campaignId)
+}
+
+// PauseCampaign is a paid mutator transaction binding the contract method 0x52d98083.
+//
+// Solidity: function pauseCampaign(bytes32 campaignId) returns()
+func (_Campaign *CampaignTransactorSession) PauseCampaign(campaignId [32]byte) (*types.Transaction, error) {
+	return _Campaign.Contract.PauseCampaign(&_Campaign.TransactOpts, campaignId)
+}
+
+// RecordSpending is a paid mutator transaction binding the contract method 0x3bc62a5a.
+//
+// Solidity: function recordSpending(bytes32 campaignId, uint256 amount) returns()
+func (_Campaign *CampaignTransactor) RecordSpending(opts *bind.TransactOpts, campaignId [32]byte, amount *big.Int) (*types.Transaction, error) {
+	return _Campaign.contract.Transact(opts, "recordSpending", campaignId, amount)
+}
+
+// RecordSpending is a paid mutator transaction binding the contract method 0x3bc62a5a.
+//
+// Solidity: function recordSpending(bytes32 campaignId, uint256 amount) returns()
+func (_Campaign *CampaignSession) RecordSpending(campaignId [32]byte, amount *big.Int) (*types.Transaction, error) {
+	return _Campaign.Contract.RecordSpending(&_Campaign.TransactOpts, campaignId, amount)
+}
+
+// RecordSpending is a paid mutator transaction binding the contract method 0x3bc62a5a.
+//
+// Solidity: function recordSpending(bytes32 campaignId, uint256 amount) returns()
+func (_Campaign *CampaignTransactorSession) RecordSpending(campaignId [32]byte, amount *big.Int) (*types.Transaction, error) {
+	return _Campaign.Contract.RecordSpending(&_Campaign.TransactOpts, campaignId, amount)
+}
+
+// RenounceRole is a paid mutator transaction binding the contract method 0x36568abe.
+//
+// Solidity: function renounceRole(bytes32 role, address callerConfirmation) returns()
+func (_Campaign *CampaignTransactor) RenounceRole(opts *bind.TransactOpts, role [32]byte, callerConfirmation common.Address) (*types.Transaction, error) {
+	return _Campaign.contract.Transact(opts, "renounceRole", role, callerConfirmation)
+}
+
+// RenounceRole is a paid mutator transaction binding the contract method 0x36568abe.
+//
+// Solidity: function renounceRole(bytes32 role, address callerConfirmation) returns()
+func (_Campaign *CampaignSession) RenounceRole(role [32]byte, callerConfirmation common.Address) (*types.Transaction, error) {
+	return _Campaign.Contract.RenounceRole(&_Campaign.TransactOpts, role, callerConfirmation)
+}
+
+// RenounceRole is a paid mutator transaction binding the contract method 0x36568abe.
+//
+// Solidity: function renounceRole(bytes32 role, address callerConfirmation) returns()
+func (_Campaign *CampaignTransactorSession) RenounceRole(role [32]byte, callerConfirmation common.Address) (*types.Transaction, error) {
+	return _Campaign.Contract.RenounceRole(&_Campaign.TransactOpts, role, callerConfirmation)
+}
+
+// ResumeCampaign is a paid mutator transaction binding the contract method 0xe17aef25.
+//
+// Solidity: function resumeCampaign(bytes32 campaignId) returns()
+func (_Campaign *CampaignTransactor) ResumeCampaign(opts *bind.TransactOpts, campaignId [32]byte) (*types.Transaction, error) {
+	return _Campaign.contract.Transact(opts, "resumeCampaign", campaignId)
+}
+
+// ResumeCampaign is a paid mutator transaction binding the contract method 0xe17aef25.
+//
+// Solidity: function resumeCampaign(bytes32 campaignId) returns()
+func (_Campaign *CampaignSession) ResumeCampaign(campaignId [32]byte) (*types.Transaction, error) {
+	return _Campaign.Contract.ResumeCampaign(&_Campaign.TransactOpts, campaignId)
+}
+
+// ResumeCampaign is a paid mutator transaction binding the contract method 0xe17aef25.
+//
+// Solidity: function resumeCampaign(bytes32 campaignId) returns()
+func (_Campaign *CampaignTransactorSession) ResumeCampaign(campaignId [32]byte) (*types.Transaction, error) {
+	return _Campaign.Contract.ResumeCampaign(&_Campaign.TransactOpts, campaignId)
+}
+
+// RevokeRole is a paid mutator transaction binding the contract method 0xd547741f.
+//
+// Solidity: function revokeRole(bytes32 role, address account) returns()
+func (_Campaign *CampaignTransactor) RevokeRole(opts *bind.TransactOpts, role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _Campaign.contract.Transact(opts, "revokeRole", role, account)
+}
+
+// RevokeRole is a paid mutator transaction binding the contract method 0xd547741f.
+//
+// Solidity: function revokeRole(bytes32 role, address account) returns()
+func (_Campaign *CampaignSession) RevokeRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _Campaign.Contract.RevokeRole(&_Campaign.TransactOpts, role, account)
+}
+
+// RevokeRole is a paid mutator transaction binding the contract method 0xd547741f.
+//
+// Solidity: function revokeRole(bytes32 role, address account) returns()
+func (_Campaign *CampaignTransactorSession) RevokeRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _Campaign.Contract.RevokeRole(&_Campaign.TransactOpts, role, account)
+}
+
+// CampaignCampaignBudgetIncreasedIterator is returned from FilterCampaignBudgetIncreased and is used to iterate over the raw logs and unpacked data for CampaignBudgetIncreased events raised by the Campaign contract.
+type CampaignCampaignBudgetIncreasedIterator struct {
+	Event *CampaignCampaignBudgetIncreased // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *CampaignCampaignBudgetIncreasedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(CampaignCampaignBudgetIncreased)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(CampaignCampaignBudgetIncreased)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *CampaignCampaignBudgetIncreasedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *CampaignCampaignBudgetIncreasedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// CampaignCampaignBudgetIncreased represents a CampaignBudgetIncreased event raised by the Campaign contract.
+type CampaignCampaignBudgetIncreased struct {
+	CampaignId [32]byte
+	OldCap     *big.Int
+	NewCap     *big.Int
+	Raw        types.Log // Blockchain specific contextual infos
+}
+
+// FilterCampaignBudgetIncreased is a free log retrieval operation binding the contract event 0xb1e7d236cf290de7e7395cab5ca21f0eaceb36e73d18fce39ec42a821187b9ac.
+//
+// Solidity: event CampaignBudgetIncreased(bytes32 indexed campaignId, uint256 oldCap, uint256 newCap)
+func (_Campaign *CampaignFilterer) FilterCampaignBudgetIncreased(opts *bind.FilterOpts, campaignId [][32]byte) (*CampaignCampaignBudgetIncreasedIterator, error) {
+
+	var campaignIdRule []interface{}
+	for _, campaignIdItem := range campaignId {
+		campaignIdRule = append(campaignIdRule, campaignIdItem)
+	}
+
+	logs, sub, err := _Campaign.contract.FilterLogs(opts, "CampaignBudgetIncreased", campaignIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &CampaignCampaignBudgetIncreasedIterator{contract: _Campaign.contract, event: "CampaignBudgetIncreased", logs: logs, sub: sub}, nil
+}
+
+// WatchCampaignBudgetIncreased is a free log subscription operation binding the contract event 0xb1e7d236cf290de7e7395cab5ca21f0eaceb36e73d18fce39ec42a821187b9ac.
+//
+// Solidity: event CampaignBudgetIncreased(bytes32 indexed campaignId, uint256 oldCap, uint256 newCap)
+func (_Campaign *CampaignFilterer) WatchCampaignBudgetIncreased(opts *bind.WatchOpts, sink chan<- *CampaignCampaignBudgetIncreased, campaignId [][32]byte) (event.Subscription, error) {
+
+	var campaignIdRule []interface{}
+	for _, campaignIdItem := range campaignId {
+		campaignIdRule = append(campaignIdRule, campaignIdItem)
+	}
+
+	logs, sub, err := _Campaign.contract.WatchLogs(opts, "CampaignBudgetIncreased", campaignIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(CampaignCampaignBudgetIncreased)
+				if err := _Campaign.contract.UnpackLog(event, "CampaignBudgetIncreased", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseCampaignBudgetIncreased is a log parse operation binding the contract event 0xb1e7d236cf290de7e7395cab5ca21f0eaceb36e73d18fce39ec42a821187b9ac.
+//
+// Solidity: event CampaignBudgetIncreased(bytes32 indexed campaignId, uint256 oldCap, uint256 newCap)
+func (_Campaign *CampaignFilterer) ParseCampaignBudgetIncreased(log types.Log) (*CampaignCampaignBudgetIncreased, error) {
+	event := new(CampaignCampaignBudgetIncreased)
+	if err := _Campaign.contract.UnpackLog(event, "CampaignBudgetIncreased", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// CampaignCampaignBudgetSpentIterator is returned from FilterCampaignBudgetSpent and is used to iterate over the raw logs and unpacked data for CampaignBudgetSpent events raised by the Campaign contract.
+type CampaignCampaignBudgetSpentIterator struct {
+	Event *CampaignCampaignBudgetSpent // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *CampaignCampaignBudgetSpentIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(CampaignCampaignBudgetSpent)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(CampaignCampaignBudgetSpent)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *CampaignCampaignBudgetSpentIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *CampaignCampaignBudgetSpentIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// CampaignCampaignBudgetSpent represents a CampaignBudgetSpent event raised by the Campaign contract.
+type CampaignCampaignBudgetSpent struct {
+	CampaignId [32]byte
+	Amount     *big.Int
+	TotalSpent *big.Int
+	Raw        types.Log // Blockchain specific contextual infos
+}
+
+// FilterCampaignBudgetSpent is a free log retrieval operation binding the contract event 0x1a894d3f7a7e6ae22863b264db591ee9eac71e36fbe804c0aaf147802d3406b7.
+//
+// Solidity: event CampaignBudgetSpent(bytes32 indexed campaignId, uint256 amount, uint256 totalSpent)
+func (_Campaign *CampaignFilterer) FilterCampaignBudgetSpent(opts *bind.FilterOpts, campaignId [][32]byte) (*CampaignCampaignBudgetSpentIterator, error) {
+
+	var campaignIdRule []interface{}
+	for _, campaignIdItem := range campaignId {
+		campaignIdRule = append(campaignIdRule, campaignIdItem)
+	}
+
+	logs, sub, err := _Campaign.contract.FilterLogs(opts, "CampaignBudgetSpent", campaignIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &CampaignCampaignBudgetSpentIterator{contract: _Campaign.contract, event: "CampaignBudgetSpent", logs: logs, sub: sub}, nil
+}
+
+// WatchCampaignBudgetSpent is a free log subscription operation binding the contract event 0x1a894d3f7a7e6ae22863b264db591ee9eac71e36fbe804c0aaf147802d3406b7.
+//
+// Solidity: event CampaignBudgetSpent(bytes32 indexed campaignId, uint256 amount, uint256 totalSpent)
+func (_Campaign *CampaignFilterer) WatchCampaignBudgetSpent(opts *bind.WatchOpts, sink chan<- *CampaignCampaignBudgetSpent, campaignId [][32]byte) (event.Subscription, error) {
+
+	var campaignIdRule []interface{}
+	for _, campaignIdItem := range campaignId {
+		campaignIdRule = append(campaignIdRule, campaignIdItem)
+	}
+
+	logs, sub, err := _Campaign.contract.WatchLogs(opts, "CampaignBudgetSpent", campaignIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(CampaignCampaignBudgetSpent)
+				if err := _Campaign.contract.UnpackLog(event, "CampaignBudgetSpent", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseCampaignBudgetSpent is a log parse operation binding the contract event 0x1a894d3f7a7e6ae22863b264db591ee9eac71e36fbe804c0aaf147802d3406b7.
+//
+// Solidity: event CampaignBudgetSpent(bytes32 indexed campaignId, uint256 amount, uint256 totalSpent)
+func (_Campaign *CampaignFilterer) ParseCampaignBudgetSpent(log types.Log) (*CampaignCampaignBudgetSpent, error) {
+	event := new(CampaignCampaignBudgetSpent)
+	if err := _Campaign.contract.UnpackLog(event, "CampaignBudgetSpent", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// CampaignCampaignCreatedIterator is returned from FilterCampaignCreated and is used to iterate over the raw logs and unpacked data for CampaignCreated events raised by the Campaign contract.
+type CampaignCampaignCreatedIterator struct {
+	Event *CampaignCampaignCreated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *CampaignCampaignCreatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(CampaignCampaignCreated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(CampaignCampaignCreated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *CampaignCampaignCreatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *CampaignCampaignCreatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// CampaignCampaignCreated represents a CampaignCreated event raised by the Campaign contract.
+type CampaignCampaignCreated struct {
+	CampaignId [32]byte
+	Name       string
+	RuleHash   [32]byte
+	BudgetCap  *big.Int
+	StartTime  *big.Int
+	EndTime    *big.Int
+	Raw        types.Log // Blockchain specific contextual infos
+}
+
+// FilterCampaignCreated is a free log retrieval operation binding the contract event 0x72508513ecebfcd20bf81763960fed3b057f808b31bff71b3d388cf6336203a2.
+//
+// Solidity: event CampaignCreated(bytes32 indexed campaignId, string name, bytes32 ruleHash, uint256 budgetCap, uint256 startTime, uint256 endTime)
+func (_Campaign *CampaignFilterer) FilterCampaignCreated(opts *bind.FilterOpts, campaignId [][32]byte) (*CampaignCampaignCreatedIterator, error) {
+
+	var campaignIdRule []interface{}
+	for _, campaignIdItem := range campaignId {
+		campaignIdRule = append(campaignIdRule, campaignIdItem)
+	}
+
+	logs, sub, err := _Campaign.contract.FilterLogs(opts, "CampaignCreated", campaignIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &CampaignCampaignCreatedIterator{contract: _Campaign.contract, event: "CampaignCreated", logs: logs, sub: sub}, nil
+}
+
+// WatchCampaignCreated is a free log subscription operation binding the contract event 0x72508513ecebfcd20bf81763960fed3b057f808b31bff71b3d388cf6336203a2.
+//
+// Solidity: event CampaignCreated(bytes32 indexed campaignId, string name, bytes32 ruleHash, uint256 budgetCap, uint256 startTime, uint256 endTime)
+func (_Campaign *CampaignFilterer) WatchCampaignCreated(opts *bind.WatchOpts, sink chan<- *CampaignCampaignCreated, campaignId [][32]byte) (event.Subscription, error) {
+
+	var campaignIdRule []interface{}
+	for _, campaignIdItem := range campaignId {
+		campaignIdRule = append(campaignIdRule, campaignIdItem)
+	}
+
+	logs, sub, err := _Campaign.contract.WatchLogs(opts, "CampaignCreated", campaignIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(CampaignCampaignCreated)
+				if err := _Campaign.contract.UnpackLog(event, "CampaignCreated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseCampaignCreated is a log parse operation binding the contract event 0x72508513ecebfcd20bf81763960fed3b057f808b31bff71b3d388cf6336203a2.
+//
+// Solidity: event CampaignCreated(bytes32 indexed campaignId, string name, bytes32 ruleHash, uint256 budgetCap, uint256 startTime, uint256 endTime)
+func (_Campaign *CampaignFilterer) ParseCampaignCreated(log types.Log) (*CampaignCampaignCreated, error) {
+	event := new(CampaignCampaignCreated)
+	if err := _Campaign.contract.UnpackLog(event, "CampaignCreated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// CampaignCampaignParticipatedIterator is returned from FilterCampaignParticipated and is used to iterate over the raw logs and unpacked data for CampaignParticipated events raised by the Campaign contract.
+type CampaignCampaignParticipatedIterator struct {
+	Event *CampaignCampaignParticipated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *CampaignCampaignParticipatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(CampaignCampaignParticipated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(CampaignCampaignParticipated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *CampaignCampaignParticipatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *CampaignCampaignParticipatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// CampaignCampaignParticipated represents a CampaignParticipated event raised by the Campaign contract.
+type CampaignCampaignParticipated struct {
+	CampaignId [32]byte
+	User       common.Address
+	Timestamp  *big.Int
+	Raw        types.Log // Blockchain specific contextual infos
+}
+
+// FilterCampaignParticipated is a free log retrieval operation binding the contract event 0x1e48d19fcc6bddc4f14f738922074a8492d364c0570ed37d3e6e9bf69b8f94dd.
+//
+// Solidity: event CampaignParticipated(bytes32 indexed campaignId, address indexed user, uint256 timestamp)
+func (_Campaign *CampaignFilterer) FilterCampaignParticipated(opts *bind.FilterOpts, campaignId [][32]byte, user []common.Address) (*CampaignCampaignParticipatedIterator, error) {
+
+	var campaignIdRule []interface{}
+	for _, campaignIdItem := range campaignId {
+		campaignIdRule = append(campaignIdRule, campaignIdItem)
+	}
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+
+	logs, sub, err := _Campaign.contract.FilterLogs(opts, "CampaignParticipated", campaignIdRule, userRule)
+	if err != nil {
+		return nil, err
+	}
+	return &CampaignCampaignParticipatedIterator{contract: _Campaign.contract, event: "CampaignParticipated", logs: logs, sub: sub}, nil
+}
+
+// WatchCampaignParticipated is a free log subscription operation binding the contract event 0x1e48d19fcc6bddc4f14f738922074a8492d364c0570ed37d3e6e9bf69b8f94dd.
+//
+// Solidity: event CampaignParticipated(bytes32 indexed campaignId, address indexed user, uint256 timestamp)
+func (_Campaign *CampaignFilterer) WatchCampaignParticipated(opts *bind.WatchOpts, sink chan<- *CampaignCampaignParticipated, campaignId [][32]byte, user []common.Address) (event.Subscription, error) {
+
+	var campaignIdRule []interface{}
+	for _, campaignIdItem := range campaignId {
+		campaignIdRule = append(campaignIdRule, campaignIdItem)
+	}
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+
+	logs, sub, err := _Campaign.contract.WatchLogs(opts, "CampaignParticipated", campaignIdRule, userRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(CampaignCampaignParticipated)
+				if err := _Campaign.contract.UnpackLog(event, "CampaignParticipated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseCampaignParticipated is a log parse operation binding the contract event 0x1e48d19fcc6bddc4f14f738922074a8492d364c0570ed37d3e6e9bf69b8f94dd.
+//
+// Solidity: event CampaignParticipated(bytes32 indexed campaignId, address indexed user, uint256 timestamp)
+func (_Campaign *CampaignFilterer) ParseCampaignParticipated(log types.Log) (*CampaignCampaignParticipated, error) {
+	event := new(CampaignCampaignParticipated)
+	if err := _Campaign.contract.UnpackLog(event, "CampaignParticipated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// CampaignCampaignStatusChangedIterator is returned from FilterCampaignStatusChanged and is used to iterate over the raw logs and unpacked data for CampaignStatusChanged events raised by the Campaign contract.
+type CampaignCampaignStatusChangedIterator struct {
+	Event *CampaignCampaignStatusChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *CampaignCampaignStatusChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(CampaignCampaignStatusChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(CampaignCampaignStatusChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *CampaignCampaignStatusChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *CampaignCampaignStatusChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// CampaignCampaignStatusChanged represents a CampaignStatusChanged event raised by the Campaign contract.
+type CampaignCampaignStatusChanged struct {
+	CampaignId [32]byte
+	OldStatus  uint8
+	NewStatus  uint8
+	Raw        types.Log // Blockchain specific contextual infos
+}
+
+// FilterCampaignStatusChanged is a free log retrieval operation binding the contract event 0x62e2a1953e821510c0e790500822de97e88565d771724ba089fcfe6f375f86ad.
+//
+// Solidity: event CampaignStatusChanged(bytes32 indexed campaignId, uint8 oldStatus, uint8 newStatus)
+func (_Campaign *CampaignFilterer) FilterCampaignStatusChanged(opts *bind.FilterOpts, campaignId [][32]byte) (*CampaignCampaignStatusChangedIterator, error) {
+
+	var campaignIdRule []interface{}
+	for _, campaignIdItem := range campaignId {
+		campaignIdRule = append(campaignIdRule, campaignIdItem)
+	}
+
+	logs, sub, err := _Campaign.contract.FilterLogs(opts, "CampaignStatusChanged", campaignIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &CampaignCampaignStatusChangedIterator{contract: _Campaign.contract, event: "CampaignStatusChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchCampaignStatusChanged is a free log subscription operation binding the contract event 0x62e2a1953e821510c0e790500822de97e88565d771724ba089fcfe6f375f86ad.
+//
+// Solidity: event CampaignStatusChanged(bytes32 indexed campaignId, uint8 oldStatus, uint8 newStatus)
+func (_Campaign *CampaignFilterer) WatchCampaignStatusChanged(opts *bind.WatchOpts, sink chan<- *CampaignCampaignStatusChanged, campaignId [][32]byte) (event.Subscription, error) {
+
+	var campaignIdRule []interface{}
+	for _, campaignIdItem := range campaignId {
+		campaignIdRule = append(campaignIdRule, campaignIdItem)
+	}
+
+	logs, sub, err := _Campaign.contract.WatchLogs(opts, "CampaignStatusChanged", campaignIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(CampaignCampaignStatusChanged)
+				if err := _Campaign.contract.UnpackLog(event, "CampaignStatusChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseCampaignStatusChanged is a log parse operation binding the contract event 0x62e2a1953e821510c0e790500822de97e88565d771724ba089fcfe6f375f86ad.
+//
+// Solidity: event CampaignStatusChanged(bytes32 indexed campaignId, uint8 oldStatus, uint8 newStatus)
+func (_Campaign *CampaignFilterer) ParseCampaignStatusChanged(log types.Log) (*CampaignCampaignStatusChanged, error) {
+	event := new(CampaignCampaignStatusChanged)
+	if err := _Campaign.contract.UnpackLog(event, "CampaignStatusChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// CampaignRoleAdminChangedIterator is returned from FilterRoleAdminChanged and is used to iterate over the raw logs and unpacked data for RoleAdminChanged events raised by the Campaign contract.
+type CampaignRoleAdminChangedIterator struct {
+	Event *CampaignRoleAdminChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *CampaignRoleAdminChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(CampaignRoleAdminChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(CampaignRoleAdminChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *CampaignRoleAdminChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *CampaignRoleAdminChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// CampaignRoleAdminChanged represents a RoleAdminChanged event raised by the Campaign contract.
+type CampaignRoleAdminChanged struct {
+	Role              [32]byte
+	PreviousAdminRole [32]byte
+	NewAdminRole      [32]byte
+	Raw               types.Log // Blockchain specific contextual infos
+}
+
+// FilterRoleAdminChanged is a free log retrieval operation binding the contract event 0xbd79b86ffe0ab8e8776151514217cd7cacd52c909f66475c3af44e129f0b00ff.
+//
+// Solidity: event RoleAdminChanged(bytes32 indexed role, bytes32 indexed previousAdminRole, bytes32 indexed newAdminRole)
+func (_Campaign *CampaignFilterer) FilterRoleAdminChanged(opts *bind.FilterOpts, role [][32]byte, previousAdminRole [][32]byte, newAdminRole [][32]byte) (*CampaignRoleAdminChangedIterator, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var previousAdminRoleRule []interface{}
+	for _, previousAdminRoleItem := range previousAdminRole {
+		previousAdminRoleRule = append(previousAdminRoleRule, previousAdminRoleItem)
+	}
+	var newAdminRoleRule []interface{}
+	for _, newAdminRoleItem := range newAdminRole {
+		newAdminRoleRule = append(newAdminRoleRule, newAdminRoleItem)
+	}
+
+	logs, sub, err := _Campaign.contract.FilterLogs(opts, "RoleAdminChanged", roleRule, previousAdminRoleRule, newAdminRoleRule)
+	if err != nil {
+		return nil, err
+	}
+	return &CampaignRoleAdminChangedIterator{contract: _Campaign.contract, event: "RoleAdminChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchRoleAdminChanged is a free log subscription operation binding the contract event 0xbd79b86ffe0ab8e8776151514217cd7cacd52c909f66475c3af44e129f0b00ff.
+//
+// Solidity: event RoleAdminChanged(bytes32 indexed role, bytes32 indexed previousAdminRole, bytes32 indexed newAdminRole)
+func (_Campaign *CampaignFilterer) WatchRoleAdminChanged(opts *bind.WatchOpts, sink chan<- *CampaignRoleAdminChanged, role [][32]byte, previousAdminRole [][32]byte, newAdminRole [][32]byte) (event.Subscription, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var previousAdminRoleRule []interface{}
+	for _, previousAdminRoleItem := range previousAdminRole {
+		previousAdminRoleRule = append(previousAdminRoleRule, previousAdminRoleItem)
+	}
+	var newAdminRoleRule []interface{}
+	for _, newAdminRoleItem := range newAdminRole {
+		newAdminRoleRule = append(newAdminRoleRule, newAdminRoleItem)
+	}
+
+	logs, sub, err := _Campaign.contract.WatchLogs(opts, "RoleAdminChanged", roleRule, previousAdminRoleRule, newAdminRoleRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(CampaignRoleAdminChanged)
+				if err := _Campaign.contract.UnpackLog(event, "RoleAdminChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRoleAdminChanged is a log parse operation binding the contract event 0xbd79b86ffe0ab8e8776151514217cd7cacd52c909f66475c3af44e129f0b00ff.
+//
+// Solidity: event RoleAdminChanged(bytes32 indexed role, bytes32 indexed previousAdminRole, bytes32 indexed newAdminRole)
+func (_Campaign *CampaignFilterer) ParseRoleAdminChanged(log types.Log) (*CampaignRoleAdminChanged, error) {
+	event := new(CampaignRoleAdminChanged)
+	if err := _Campaign.contract.UnpackLog(event, "RoleAdminChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// CampaignRoleGrantedIterator is returned from FilterRoleGranted and is used to iterate over the raw logs and unpacked data for RoleGranted events raised by the Campaign contract.
+type CampaignRoleGrantedIterator struct {
+	Event *CampaignRoleGranted // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *CampaignRoleGrantedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(CampaignRoleGranted)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(CampaignRoleGranted)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *CampaignRoleGrantedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *CampaignRoleGrantedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// CampaignRoleGranted represents a RoleGranted event raised by the Campaign contract.
+type CampaignRoleGranted struct {
+	Role    [32]byte
+	Account common.Address
+	Sender  common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterRoleGranted is a free log retrieval operation binding the contract event 0x2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d.
+//
+// Solidity: event RoleGranted(bytes32 indexed role, address indexed account, address indexed sender)
+func (_Campaign *CampaignFilterer) FilterRoleGranted(opts *bind.FilterOpts, role [][32]byte, account []common.Address, sender []common.Address) (*CampaignRoleGrantedIterator, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _Campaign.contract.FilterLogs(opts, "RoleGranted", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &CampaignRoleGrantedIterator{contract: _Campaign.contract, event: "RoleGranted", logs: logs, sub: sub}, nil
+}
+
+// WatchRoleGranted is a free log subscription operation binding the contract event 0x2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d.
+//
+// Solidity: event RoleGranted(bytes32 indexed role, address indexed account, address indexed sender)
+func (_Campaign *CampaignFilterer) WatchRoleGranted(opts *bind.WatchOpts, sink chan<- *CampaignRoleGranted, role [][32]byte, account []common.Address, sender []common.Address) (event.Subscription, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _Campaign.contract.WatchLogs(opts, "RoleGranted", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(CampaignRoleGranted)
+				if err := _Campaign.contract.UnpackLog(event, "RoleGranted", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRoleGranted is a log parse operation binding the contract event 0x2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d.
+//
+// Solidity: event RoleGranted(bytes32 indexed role, address indexed account, address indexed sender)
+func (_Campaign *CampaignFilterer) ParseRoleGranted(log types.Log) (*CampaignRoleGranted, error) {
+	event := new(CampaignRoleGranted)
+	if err := _Campaign.contract.UnpackLog(event, "RoleGranted", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// CampaignRoleRevokedIterator is returned from FilterRoleRevoked and is used to iterate over the raw logs and unpacked data for RoleRevoked events raised by the Campaign contract.
+type CampaignRoleRevokedIterator struct {
+	Event *CampaignRoleRevoked // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *CampaignRoleRevokedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(CampaignRoleRevoked)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(CampaignRoleRevoked)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *CampaignRoleRevokedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *CampaignRoleRevokedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// CampaignRoleRevoked represents a RoleRevoked event raised by the Campaign contract.
+type CampaignRoleRevoked struct {
+	Role    [32]byte
+	Account common.Address
+	Sender  common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterRoleRevoked is a free log retrieval operation binding the contract event 0xf6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b.
+//
+// Solidity: event RoleRevoked(bytes32 indexed role, address indexed account, address indexed sender)
+func (_Campaign *CampaignFilterer) FilterRoleRevoked(opts *bind.FilterOpts, role [][32]byte, account []common.Address, sender []common.Address) (*CampaignRoleRevokedIterator, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _Campaign.contract.FilterLogs(opts, "RoleRevoked", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &CampaignRoleRevokedIterator{contract: _Campaign.contract, event: "RoleRevoked", logs: logs, sub: sub}, nil
+}
+
+// WatchRoleRevoked is a free log subscription operation binding the contract event 0xf6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b.
+//
+// Solidity: event RoleRevoked(bytes32 indexed role, address indexed account, address indexed sender)
+func (_Campaign *CampaignFilterer) WatchRoleRevoked(opts *bind.WatchOpts, sink chan<- *CampaignRoleRevoked, role [][32]byte, account []common.Address, sender []common.Address) (event.Subscription, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _Campaign.contract.WatchLogs(opts, "RoleRevoked", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(CampaignRoleRevoked)
+				if err := _Campaign.contract.UnpackLog(event, "RoleRevoked", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRoleRevoked is a log parse operation binding the contract event 0xf6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b.
+//
+// Solidity: event RoleRevoked(bytes32 indexed role, address indexed account, address indexed sender)
+func (_Campaign *CampaignFilterer) ParseRoleRevoked(log types.Log) (*CampaignRoleRevoked, error) {
+	event := new(CampaignRoleRevoked)
+	if err := _Campaign.contract.UnpackLog(event, "RoleRevoked", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
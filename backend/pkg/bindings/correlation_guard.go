@@ -0,0 +1,2153 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package bindings
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// ICorrelationGuardCorrelationRule is an auto generated low-level Go binding around an user-defined struct.
+type ICorrelationGuardCorrelationRule struct {
+	MatchId1   [32]byte
+	MatchId2   [32]byte
+	PenaltyBps *big.Int
+	IsBlocked  bool
+}
+
+// CorrelationGuardMetaData contains all meta data concerning the CorrelationGuard contract.
+var CorrelationGuardMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[{\"name\":\"initialPolicy\",\"type\":\"uint8\",\"internalType\":\"enumICorrelationGuard.CorrelationPolicy\"},{\"name\":\"_defaultSameMatchPenalty\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"DEFAULT_ADMIN_ROLE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"MAX_PENALTY_BPS\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"RULE_MANAGER_ROLE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"_extractMatchId\",\"inputs\":[{\"name\":\"market\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"batchRegisterMarkets\",\"inputs\":[{\"name\":\"markets\",\"type\":\"address[]\",\"internalType\":\"address[]\"},{\"name\":\"matchIds\",\"type\":\"bytes32[]\",\"internalType\":\"bytes32[]\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"batchSetRules\",\"inputs\":[{\"name\":\"rules\",\"type\":\"tuple[]\",\"internalType\":\"structICorrelationGuard.CorrelationRule[]\",\"components\":[{\"name\":\"matchId1\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"matchId2\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"penaltyBps\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"isBlocked\",\"type\":\"bool\",\"internalType\":\"bool\"}]}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"calculatePenalty\",\"inputs\":[{\"name\":\"legs\",\"type\":\"tuple[]\",\"internalType\":\"structICorrelationGuard.ParlayLeg[]\",\"components\":[{\"name\":\"market\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"outcomeId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]}],\"outputs\":[{\"name\":\"totalPenaltyBps\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"details\",\"type\":\"uint256[]\",\"internalType\":\"uint256[]\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"checkBlocked\",\"inputs\":[{\"name\":\"legs\",\"type\":\"tuple[]\",\"internalType\":\"structICorrelationGuard.ParlayLeg[]\",\"components\":[{\"name\":\"market\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"outcomeId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]}],\"outputs\":[{\"name\":\"isBlocked\",\"type\":\"bool\",\"internalType\":\"bool\"},{\"name\":\"reason\",\"type\":\"string\",\"internalType\":\"string\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"defaultSameMatchPenalty\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getCorrelationRule\",\"inputs\":[{\"name\":\"matchId1\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"matchId2\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"outputs\":[{\"name\":\"penaltyBps\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"isBlocked\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getMatchId\",\"inputs\":[{\"name\":\"market\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getPolicy\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"enumICorrelationGuard.CorrelationPolicy\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getRoleAdmin\",\"inputs\":[{\"name\":\"role\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"grantRole\",\"inputs\":[{\"name\":\"role\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"hasRole\",\"inputs\":[{\"name\":\"role\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"owner\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"policy\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"enumICorrelationGuard.CorrelationPolicy\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"registerMarket\",\"inputs\":[{\"name\":\"market\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"matchId\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"renounceOwnership\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"renounceRole\",\"inputs\":[{\"name\":\"role\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"callerConfirmation\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"revokeRole\",\"inputs\":[{\"name\":\"role\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setCorrelationRule\",\"inputs\":[{\"name\":\"matchId1\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"matchId2\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"penaltyBps\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"isBlocked\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setDefaultSameMatchPenalty\",\"inputs\":[{\"name\":\"penaltyBps\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setPolicy\",\"inputs\":[{\"name\":\"newPolicy\",\"type\":\"uint8\",\"internalType\":\"enumICorrelationGuard.CorrelationPolicy\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"supportsInterface\",\"inputs\":[{\"name\":\"interfaceId\",\"type\":\"bytes4\",\"internalType\":\"bytes4\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"transferOwnership\",\"inputs\":[{\"name\":\"newOwner\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"event\",\"name\":\"CorrelationRuleSet\",\"inputs\":[{\"name\":\"matchId1\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"matchId2\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"penaltyBps\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"isBlocked\",\"type\":\"bool\",\"indexed\":false,\"internalType\":\"bool\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"DefaultPenaltyUpdated\",\"inputs\":[{\"name\":\"sameMatchPenalty\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"OwnershipTransferred\",\"inputs\":[{\"name\":\"previousOwner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"newOwner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"ParlayBlocked\",\"inputs\":[{\"name\":\"user\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"reason\",\"type\":\"string\",\"indexed\":false,\"internalType\":\"string\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"PolicyUpdated\",\"inputs\":[{\"name\":\"oldPolicy\",\"type\":\"uint8\",\"indexed\":true,\"internalType\":\"enumICorrelationGuard.CorrelationPolicy\"},{\"name\":\"newPolicy\",\"type\":\"uint8\",\"indexed\":true,\"internalType\":\"enumICorrelationGuard.CorrelationPolicy\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"RoleAdminChanged\",\"inputs\":[{\"name\":\"role\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"previousAdminRole\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"newAdminRole\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"RoleGranted\",\"inputs\":[{\"name\":\"role\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"account\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"sender\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"RoleRevoked\",\"inputs\":[{\"name\":\"role\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"account\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"sender\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"error\",\"name\":\"AccessControlBadConfirmation\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"AccessControlUnauthorizedAccount\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"neededRole\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}]},{\"type\":\"error\",\"name\":\"InvalidLegCount\",\"inputs\":[{\"name\":\"count\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"InvalidPenalty\",\"inputs\":[{\"name\":\"penaltyBps\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"InvalidPolicy\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"OwnableInvalidOwner\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"OwnableUnauthorizedAccount\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"ParlayBlockedByCorrelation\",\"inputs\":[{\"name\":\"reason\",\"type\":\"string\",\"internalType\":\"string\"}]},{\"type\":\"error\",\"name\":\"Unauthorized\",\"inputs\":[]}]",
+}
+
+// CorrelationGuardABI is the input ABI used to generate the binding from.
+// Deprecated: Use CorrelationGuardMetaData.ABI instead.
+var CorrelationGuardABI = CorrelationGuardMetaData.ABI
+
+// CorrelationGuard is an auto generated Go binding around an Ethereum contract.
+type CorrelationGuard struct {
+	CorrelationGuardCaller     // Read-only binding to the contract
+	CorrelationGuardTransactor // Write-only binding to the contract
+	CorrelationGuardFilterer   // Log filterer for contract events
+}
+
+// CorrelationGuardCaller is an auto generated read-only Go binding around an Ethereum contract.
+type CorrelationGuardCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// CorrelationGuardTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type CorrelationGuardTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// CorrelationGuardFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type CorrelationGuardFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// CorrelationGuardSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type CorrelationGuardSession struct {
+	Contract     *CorrelationGuard // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// CorrelationGuardCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type CorrelationGuardCallerSession struct {
+	Contract *CorrelationGuardCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts           // Call options to use throughout this session
+}
+
+// CorrelationGuardTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type CorrelationGuardTransactorSession struct {
+	Contract     *CorrelationGuardTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts           // Transaction auth options to use throughout this session
+}
+
+// CorrelationGuardRaw is an auto generated low-level Go binding around an Ethereum contract.
+type CorrelationGuardRaw struct {
+	Contract *CorrelationGuard // Generic contract binding to access the raw methods on
+}
+
+// CorrelationGuardCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type CorrelationGuardCallerRaw struct {
+	Contract *CorrelationGuardCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// CorrelationGuardTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type CorrelationGuardTransactorRaw struct {
+	Contract *CorrelationGuardTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewCorrelationGuard creates a new instance of CorrelationGuard, bound to a specific deployed contract.
+func NewCorrelationGuard(address common.Address, backend bind.ContractBackend) (*CorrelationGuard, error) {
+	contract, err := bindCorrelationGuard(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &CorrelationGuard{CorrelationGuardCaller: CorrelationGuardCaller{contract: contract}, CorrelationGuardTransactor: CorrelationGuardTransactor{contract: contract}, CorrelationGuardFilterer: CorrelationGuardFilterer{contract: contract}}, nil
+}
+
+// NewCorrelationGuardCaller creates a new read-only instance of CorrelationGuard, bound to a specific deployed contract.
+func NewCorrelationGuardCaller(address common.Address, caller bind.ContractCaller) (*CorrelationGuardCaller, error) {
+	contract, err := bindCorrelationGuard(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &CorrelationGuardCaller{contract: contract}, nil
+}
+
+// NewCorrelationGuardTransactor creates a new write-only instance of CorrelationGuard, bound to a specific deployed contract.
+func NewCorrelationGuardTransactor(address common.Address, transactor bind.ContractTransactor) (*CorrelationGuardTransactor, error) {
+	contract, err := bindCorrelationGuard(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &CorrelationGuardTransactor{contract: contract}, nil
+}
+
+// NewCorrelationGuardFilterer creates a new log filterer instance of CorrelationGuard, bound to a specific deployed contract.
+func NewCorrelationGuardFilterer(address common.Address, filterer bind.ContractFilterer) (*CorrelationGuardFilterer, error) {
+	contract, err := bindCorrelationGuard(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &CorrelationGuardFilterer{contract: contract}, nil
+}
+
+// bindCorrelationGuard binds a generic wrapper to an already deployed contract.
+func bindCorrelationGuard(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := CorrelationGuardMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_CorrelationGuard *CorrelationGuardRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _CorrelationGuard.Contract.CorrelationGuardCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_CorrelationGuard *CorrelationGuardRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _CorrelationGuard.Contract.CorrelationGuardTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_CorrelationGuard *CorrelationGuardRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _CorrelationGuard.Contract.CorrelationGuardTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_CorrelationGuard *CorrelationGuardCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _CorrelationGuard.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_CorrelationGuard *CorrelationGuardTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _CorrelationGuard.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_CorrelationGuard *CorrelationGuardTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _CorrelationGuard.Contract.contract.Transact(opts, method, params...)
+}
+
+// DEFAULTADMINROLE is a free data retrieval call binding the contract method 0xa217fddf.
+//
+// Solidity: function DEFAULT_ADMIN_ROLE() view returns(bytes32)
+func (_CorrelationGuard *CorrelationGuardCaller) DEFAULTADMINROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _CorrelationGuard.contract.Call(opts, &out, "DEFAULT_ADMIN_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// DEFAULTADMINROLE is a free data retrieval call binding the contract method 0xa217fddf.
+//
+// Solidity: function DEFAULT_ADMIN_ROLE() view returns(bytes32)
+func (_CorrelationGuard *CorrelationGuardSession) DEFAULTADMINROLE() ([32]byte, error) {
+	return _CorrelationGuard.Contract.DEFAULTADMINROLE(&_CorrelationGuard.CallOpts)
+}
+
+// DEFAULTADMINROLE is a free data retrieval call binding the contract method 0xa217fddf.
+//
+// Solidity: function DEFAULT_ADMIN_ROLE() view returns(bytes32)
+func (_CorrelationGuard *CorrelationGuardCallerSession) DEFAULTADMINROLE() ([32]byte, error) {
+	return _CorrelationGuard.Contract.DEFAULTADMINROLE(&_CorrelationGuard.CallOpts)
+}
+
+// MAXPENALTYBPS is a free data retrieval call binding the contract method 0x0225d3cc.
+//
+// Solidity: function MAX_PENALTY_BPS() view returns(uint256)
+func (_CorrelationGuard *CorrelationGuardCaller) MAXPENALTYBPS(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _CorrelationGuard.contract.Call(opts, &out, "MAX_PENALTY_BPS")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MAXPENALTYBPS is a free data retrieval call binding the contract method 0x0225d3cc.
+//
+// Solidity: function MAX_PENALTY_BPS() view returns(uint256)
+func (_CorrelationGuard *CorrelationGuardSession) MAXPENALTYBPS() (*big.Int, error) {
+	return _CorrelationGuard.Contract.MAXPENALTYBPS(&_CorrelationGuard.CallOpts)
+}
+
+// MAXPENALTYBPS is a free data retrieval call binding the contract method 0x0225d3cc.
+//
+// Solidity: function MAX_PENALTY_BPS() view returns(uint256)
+func (_CorrelationGuard *CorrelationGuardCallerSession) MAXPENALTYBPS() (*big.Int, error) {
+	return _CorrelationGuard.Contract.MAXPENALTYBPS(&_CorrelationGuard.CallOpts)
+}
+
+// RULEMANAGERROLE is a free data retrieval call binding the contract method 0xb1d6794f.
+//
+// Solidity: function RULE_MANAGER_ROLE() view returns(bytes32)
+func (_CorrelationGuard *CorrelationGuardCaller) RULEMANAGERROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _CorrelationGuard.contract.Call(opts, &out, "RULE_MANAGER_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// RULEMANAGERROLE is a free data retrieval call binding the contract method 0xb1d6794f.
+//
+// Solidity: function RULE_MANAGER_ROLE() view returns(bytes32)
+func (_CorrelationGuard *CorrelationGuardSession) RULEMANAGERROLE() ([32]byte, error) {
+	return _CorrelationGuard.Contract.RULEMANAGERROLE(&_CorrelationGuard.CallOpts)
+}
+
+// RULEMANAGERROLE is a free data retrieval call binding the contract method 0xb1d6794f.
+//
+// Solidity: function RULE_MANAGER_ROLE() view returns(bytes32)
+func (_CorrelationGuard *CorrelationGuardCallerSession) RULEMANAGERROLE() ([32]byte, error) {
+	return _CorrelationGuard.Contract.RULEMANAGERROLE(&_CorrelationGuard.CallOpts)
+}
+
+// ExtractMatchId is a free data retrieval call binding the contract method 0xed5530c9.
+//
+// Solidity: function _extractMatchId(address market) view returns(bytes32)
+func (_CorrelationGuard *CorrelationGuardCaller) ExtractMatchId(opts *bind.CallOpts, market common.Address) ([32]byte, error) {
+	var out []interface{}
+	err := _CorrelationGuard.contract.Call(opts, &out, "_extractMatchId", market)
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// ExtractMatchId is a free data retrieval call binding the contract method 0xed5530c9.
+//
+// Solidity: function _extractMatchId(address market) view returns(bytes32)
+func (_CorrelationGuard *CorrelationGuardSession) ExtractMatchId(market common.Address) ([32]byte, error) {
+	return _CorrelationGuard.Contract.ExtractMatchId(&_CorrelationGuard.CallOpts, market)
+}
+
+// ExtractMatchId is a free data retrieval call binding the contract method 0xed5530c9.
+//
+// Solidity: function _extractMatchId(address market) view returns(bytes32)
+func (_CorrelationGuard *CorrelationGuardCallerSession) ExtractMatchId(market common.Address) ([32]byte, error) {
+	return _CorrelationGuard.Contract.ExtractMatchId(&_CorrelationGuard.CallOpts, market)
+}
+
+// CalculatePenalty is a free data retrieval call binding the contract method 0x3d2e57c9.
+//
+// Solidity: function calculatePenalty((address,uint256)[] legs) view returns(uint256 totalPenaltyBps, uint256[] details)
+func (_CorrelationGuard *CorrelationGuardCaller) CalculatePenalty(opts *bind.CallOpts, legs []ICorrelationGuardParlayLeg) (struct {
+	TotalPenaltyBps *big.Int
+	Details         []*big.Int
+}, error) {
+	var out []interface{}
+	err := _CorrelationGuard.contract.Call(opts, &out, "calculatePenalty", legs)
+
+	outstruct := new(struct {
+		TotalPenaltyBps *big.Int
+		Details         []*big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.TotalPenaltyBps = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.Details = *abi.ConvertType(out[1], new([]*big.Int)).(*[]*big.Int)
+
+	return *outstruct, err
+
+}
+
+// CalculatePenalty is a free data retrieval call binding the contract method 0x3d2e57c9.
+//
+// Solidity: function calculatePenalty((address,uint256)[] legs) view returns(uint256 totalPenaltyBps, uint256[] details)
+func (_CorrelationGuard *CorrelationGuardSession) CalculatePenalty(legs []ICorrelationGuardParlayLeg) (struct {
+	TotalPenaltyBps *big.Int
+	Details         []*big.Int
+}, error) {
+	return _CorrelationGuard.Contract.CalculatePenalty(&_CorrelationGuard.CallOpts, legs)
+}
+
+// CalculatePenalty is a free data retrieval call binding the contract method 0x3d2e57c9.
+//
+// Solidity: function calculatePenalty((address,uint256)[] legs) view returns(uint256 totalPenaltyBps, uint256[] details)
+func (_CorrelationGuard *CorrelationGuardCallerSession) CalculatePenalty(legs []ICorrelationGuardParlayLeg) (struct {
+	TotalPenaltyBps *big.Int
+	Details         []*big.Int
+}, error) {
+	return _CorrelationGuard.Contract.CalculatePenalty(&_CorrelationGuard.CallOpts, legs)
+}
+
+// CheckBlocked is a free data retrieval call binding the contract method 0xfd072f7a.
+//
+// Solidity: function checkBlocked((address,uint256)[] legs) view returns(bool isBlocked, string reason)
+func (_CorrelationGuard *CorrelationGuardCaller) CheckBlocked(opts *bind.CallOpts, legs []ICorrelationGuardParlayLeg) (struct {
+	IsBlocked bool
+	Reason    string
+}, error) {
+	var out []interface{}
+	err := _CorrelationGuard.contract.Call(opts, &out, "checkBlocked", legs)
+
+	outstruct := new(struct {
+		IsBlocked bool
+		Reason    string
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.IsBlocked = *abi.ConvertType(out[0], new(bool)).(*bool)
+	outstruct.Reason = *abi.ConvertType(out[1], new(string)).(*string)
+
+	return *outstruct, err
+
+}
+
+// CheckBlocked is a free data retrieval call binding the contract method 0xfd072f7a.
+//
+// Solidity: function checkBlocked((address,uint256)[] legs) view returns(bool isBlocked, string reason)
+func (_CorrelationGuard *CorrelationGuardSession) CheckBlocked(legs []ICorrelationGuardParlayLeg) (struct {
+	IsBlocked bool
+	Reason    string
+}, error) {
+	return _CorrelationGuard.Contract.CheckBlocked(&_CorrelationGuard.CallOpts, legs)
+}
+
+// CheckBlocked is a free data retrieval call binding the contract method 0xfd072f7a.
+//
+// Solidity: function checkBlocked((address,uint256)[] legs) view returns(bool isBlocked, string reason)
+func (_CorrelationGuard *CorrelationGuardCallerSession) CheckBlocked(legs []ICorrelationGuardParlayLeg) (struct {
+	IsBlocked bool
+	Reason    string
+}, error) {
+	return _CorrelationGuard.Contract.CheckBlocked(&_CorrelationGuard.CallOpts, legs)
+}
+
+// DefaultSameMatchPenalty is a free data retrieval call binding the contract method 0x8f503e19.
+//
+// Solidity: function defaultSameMatchPenalty() view returns(uint256)
+func (_CorrelationGuard *CorrelationGuardCaller) DefaultSameMatchPenalty(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _CorrelationGuard.contract.Call(opts, &out, "defaultSameMatchPenalty")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// DefaultSameMatchPenalty is a free data retrieval call binding the contract method 0x8f503e19.
+//
+// Solidity: function defaultSameMatchPenalty() view returns(uint256)
+func (_CorrelationGuard *CorrelationGuardSession) DefaultSameMatchPenalty() (*big.Int, error) {
+	return _CorrelationGuard.Contract.DefaultSameMatchPenalty(&_CorrelationGuard.CallOpts)
+}
+
+// DefaultSameMatchPenalty is a free data retrieval call binding the contract method 0x8f503e19.
+//
+// Solidity: function defaultSameMatchPenalty() view returns(uint256)
+func (_CorrelationGuard *CorrelationGuardCallerSession) DefaultSameMatchPenalty() (*big.Int, error) {
+	return _CorrelationGuard.Contract.DefaultSameMatchPenalty(&_CorrelationGuard.CallOpts)
+}
+
+// GetCorrelationRule is a free data retrieval call binding the contract method 0xb45970c1.
+//
+// Solidity: function getCorrelationRule(bytes32 matchId1, bytes32 matchId2) view returns(uint256 penaltyBps, bool isBlocked)
+func (_CorrelationGuard *CorrelationGuardCaller) GetCorrelationRule(opts *bind.CallOpts, matchId1 [32]byte, matchId2 [32]byte) (struct {
+	PenaltyBps *big.Int
+	IsBlocked  bool
+}, error) {
+	var out []interface{}
+	err := _CorrelationGuard.contract.Call(opts, &out, "getCorrelationRule", matchId1, matchId2)
+
+	outstruct := new(struct {
+		PenaltyBps *big.Int
+		IsBlocked  bool
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.PenaltyBps = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.IsBlocked = *abi.ConvertType(out[1], new(bool)).(*bool)
+
+	return *outstruct, err
+
+}
+
+// GetCorrelationRule is a free data retrieval call binding the contract method 0xb45970c1.
+//
+// Solidity: function getCorrelationRule(bytes32 matchId1, bytes32 matchId2) view returns(uint256 penaltyBps, bool isBlocked)
+func (_CorrelationGuard *CorrelationGuardSession) GetCorrelationRule(matchId1 [32]byte, matchId2 [32]byte) (struct {
+	PenaltyBps *big.Int
+	IsBlocked  bool
+}, error) {
+	return _CorrelationGuard.Contract.GetCorrelationRule(&_CorrelationGuard.CallOpts, matchId1, matchId2)
+}
+
+// GetCorrelationRule is a free data retrieval call binding the contract method 0xb45970c1.
+//
+// Solidity: function getCorrelationRule(bytes32 matchId1, bytes32 matchId2) view returns(uint256 penaltyBps, bool isBlocked)
+func (_CorrelationGuard *CorrelationGuardCallerSession) GetCorrelationRule(matchId1 [32]byte, matchId2 [32]byte) (struct {
+	PenaltyBps *big.Int
+	IsBlocked  bool
+}, error) {
+	return _CorrelationGuard.Contract.GetCorrelationRule(&_CorrelationGuard.CallOpts, matchId1, matchId2)
+}
+
+// GetMatchId is a free data retrieval call binding the contract method 0xf33fcf0b.
+//
+// Solidity: function getMatchId(address market) view returns(bytes32)
+func (_CorrelationGuard *CorrelationGuardCaller) GetMatchId(opts *bind.CallOpts, market common.Address) ([32]byte, error) {
+	var out []interface{}
+	err := _CorrelationGuard.contract.Call(opts, &out, "getMatchId", market)
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// GetMatchId is a free data retrieval call binding the contract method 0xf33fcf0b.
+//
+// Solidity: function getMatchId(address market) view returns(bytes32)
+func (_CorrelationGuard *CorrelationGuardSession) GetMatchId(market common.Address) ([32]byte, error) {
+	return _CorrelationGuard.Contract.GetMatchId(&_CorrelationGuard.CallOpts, market)
+}
+
+// GetMatchId is a free data retrieval call binding the contract method 0xf33fcf0b.
+//
+// Solidity: function getMatchId(address market) view returns(bytes32)
+func (_CorrelationGuard *CorrelationGuardCallerSession) GetMatchId(market common.Address) ([32]byte, error) {
+	return _CorrelationGuard.Contract.GetMatchId(&_CorrelationGuard.CallOpts, market)
+}
+
+// GetPolicy is a free data retrieval call binding the contract method 0xce1e4626.
+//
+// Solidity: function getPolicy() view returns(uint8)
+func (_CorrelationGuard *CorrelationGuardCaller) GetPolicy(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _CorrelationGuard.contract.Call(opts, &out, "getPolicy")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// GetPolicy is a free data retrieval call binding the contract method 0xce1e4626.
+//
+// Solidity: function getPolicy() view returns(uint8)
+func (_CorrelationGuard *CorrelationGuardSession) GetPolicy() (uint8, error) {
+	return _CorrelationGuard.Contract.GetPolicy(&_CorrelationGuard.CallOpts)
+}
+
+// GetPolicy is a free data retrieval call binding the contract method 0xce1e4626.
+//
+// Solidity: function getPolicy() view returns(uint8)
+func (_CorrelationGuard *CorrelationGuardCallerSession) GetPolicy() (uint8, error) {
+	return _CorrelationGuard.Contract.GetPolicy(&_CorrelationGuard.CallOpts)
+}
+
+// GetRoleAdmin is a free data retrieval call binding the contract method 0x248a9ca3.
+//
+// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
+func (_CorrelationGuard *CorrelationGuardCaller) GetRoleAdmin(opts *bind.CallOpts, role [32]byte) ([32]byte, error) {
+	var out []interface{}
+	err := _CorrelationGuard.contract.Call(opts, &out, "getRoleAdmin", role)
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// GetRoleAdmin is a free data retrieval call binding the contract method 0x248a9ca3.
+//
+// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
+func (_CorrelationGuard *CorrelationGuardSession) GetRoleAdmin(role [32]byte) ([32]byte, error) {
+	return _CorrelationGuard.Contract.GetRoleAdmin(&_CorrelationGuard.CallOpts, role)
+}
+
+// GetRoleAdmin is a free data retrieval call binding the contract method 0x248a9ca3.
+//
+// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
+func (_CorrelationGuard *CorrelationGuardCallerSession) GetRoleAdmin(role [32]byte) ([32]byte, error) {
+	return _CorrelationGuard.Contract.GetRoleAdmin(&_CorrelationGuard.CallOpts, role)
+}
+
+// HasRole is a free data retrieval call binding the contract method 0x91d14854.
+//
+// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
+func (_CorrelationGuard *CorrelationGuardCaller) HasRole(opts *bind.CallOpts, role [32]byte, account common.Address) (bool, error) {
+	var out []interface{}
+	err := _CorrelationGuard.contract.Call(opts, &out, "hasRole", role, account)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// HasRole is a free data retrieval call binding the contract method 0x91d14854.
+//
+// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
+func (_CorrelationGuard *CorrelationGuardSession) HasRole(role [32]byte, account common.Address) (bool, error) {
+	return _CorrelationGuard.Contract.HasRole(&_CorrelationGuard.CallOpts, role, account)
+}
+
+// HasRole is a free data retrieval call binding the contract method 0x91d14854.
+//
+// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
+func (_CorrelationGuard *CorrelationGuardCallerSession) HasRole(role [32]byte, account common.Address) (bool, error) {
+	return _CorrelationGuard.Contract.HasRole(&_CorrelationGuard.CallOpts, role, account)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_CorrelationGuard *CorrelationGuardCaller) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _CorrelationGuard.contract.Call(opts, &out, "owner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_CorrelationGuard *CorrelationGuardSession) Owner() (common.Address, error) {
+	return _CorrelationGuard.Contract.Owner(&_CorrelationGuard.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_CorrelationGuard *CorrelationGuardCallerSession) Owner() (common.Address, error) {
+	return _CorrelationGuard.Contract.Owner(&_CorrelationGuard.CallOpts)
+}
+
+// Policy is a free data retrieval call binding the contract method 0x0505c8c9.
+//
+// Solidity: function policy() view returns(uint8)
+func (_CorrelationGuard *CorrelationGuardCaller) Policy(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _CorrelationGuard.contract.Call(opts, &out, "policy")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// Policy is a free data retrieval call binding the contract method 0x0505c8c9.
+//
+// Solidity: function policy() view returns(uint8)
+func (_CorrelationGuard *CorrelationGuardSession) Policy() (uint8, error) {
+	return _CorrelationGuard.Contract.Policy(&_CorrelationGuard.CallOpts)
+}
+
+// Policy is a free data retrieval call binding the contract method 0x0505c8c9.
+//
+// Solidity: function policy() view returns(uint8)
+func (_CorrelationGuard *CorrelationGuardCallerSession) Policy() (uint8, error) {
+	return _CorrelationGuard.Contract.Policy(&_CorrelationGuard.CallOpts)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_CorrelationGuard *CorrelationGuardCaller) SupportsInterface(opts *bind.CallOpts, interfaceId [4]byte) (bool, error) {
+	var out []interface{}
+	err := _CorrelationGuard.contract.Call(opts, &out, "supportsInterface", interfaceId)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_CorrelationGuard *CorrelationGuardSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _CorrelationGuard.Contract.SupportsInterface(&_CorrelationGuard.CallOpts, interfaceId)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_CorrelationGuard *CorrelationGuardCallerSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _CorrelationGuard.Contract.SupportsInterface(&_CorrelationGuard.CallOpts, interfaceId)
+}
+
+// BatchRegisterMarkets is a paid mutator transaction binding the contract method 0xdc425502.
+//
+// Solidity: function batchRegisterMarkets(address[] markets, bytes32[] matchIds) returns()
+func (_CorrelationGuard *CorrelationGuardTransactor) BatchRegisterMarkets(opts *bind.TransactOpts, markets []common.Address, matchIds [][32]byte) (*types.Transaction, error) {
+	return _CorrelationGuard.contract.Transact(opts, "batchRegisterMarkets", markets, matchIds)
+}
+
+// BatchRegisterMarkets is a paid mutator transaction binding the contract method 0xdc425502.
+//
+// Solidity: function batchRegisterMarkets(address[] markets, bytes32[] matchIds) returns()
+func (_CorrelationGuard *CorrelationGuardSession) BatchRegisterMarkets(markets []common.Address, matchIds [][32]byte) (*types.Transaction, error) {
+	return _CorrelationGuard.Contract.BatchRegisterMarkets(&_CorrelationGuard.TransactOpts, markets, matchIds)
+}
+
+// BatchRegisterMarkets is a paid mutator transaction binding the contract method 0xdc425502.
+//
+// Solidity: function batchRegisterMarkets(address[] markets, bytes32[] matchIds) returns()
+func (_CorrelationGuard *CorrelationGuardTransactorSession) BatchRegisterMarkets(markets []common.Address, matchIds [][32]byte) (*types.Transaction, error) {
+	return _CorrelationGuard.Contract.BatchRegisterMarkets(&_CorrelationGuard.TransactOpts, markets, matchIds)
+}
+
+// BatchSetRules is a paid mutator transaction binding the contract method 0xa43ec9b9.
+//
+// Solidity: function batchSetRules((bytes32,bytes32,uint256,bool)[] rules) returns()
+func (_CorrelationGuard *CorrelationGuardTransactor) BatchSetRules(opts *bind.TransactOpts, rules []ICorrelationGuardCorrelationRule) (*types.Transaction, error) {
+	return _CorrelationGuard.contract.Transact(opts, "batchSetRules", rules)
+}
+
+// BatchSetRules is a paid mutator transaction binding the contract method 0xa43ec9b9.
+//
+// Solidity: function batchSetRules((bytes32,bytes32,uint256,bool)[] rules) returns()
+func (_CorrelationGuard *CorrelationGuardSession) BatchSetRules(rules []ICorrelationGuardCorrelationRule) (*types.Transaction, error) {
+	return _CorrelationGuard.Contract.BatchSetRules(&_CorrelationGuard.TransactOpts, rules)
+}
+
+// BatchSetRules is a paid mutator transaction binding the contract method 0xa43ec9b9.
+//
+// Solidity: function batchSetRules((bytes32,bytes32,uint256,bool)[] rules) returns()
+func (_CorrelationGuard *CorrelationGuardTransactorSession) BatchSetRules(rules []ICorrelationGuardCorrelationRule) (*types.Transaction, error) {
+	return _CorrelationGuard.Contract.BatchSetRules(&_CorrelationGuard.TransactOpts, rules)
+}
+
+// GrantRole is a paid mutator transaction binding the contract method 0x2f2ff15d.
+//
+// Solidity: function grantRole(bytes32 role, address account) returns()
+func (_CorrelationGuard *CorrelationGuardTransactor) GrantRole(opts *bind.TransactOpts, role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _CorrelationGuard.contract.Transact(opts, "grantRole", role, account)
+}
+
+// GrantRole is a paid mutator transaction binding the contract method 0x2f2ff15d.
+//
+// Solidity: function grantRole(bytes32 role, address account) returns()
+func (_CorrelationGuard *CorrelationGuardSession) GrantRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _CorrelationGuard.Contract.GrantRole(&_CorrelationGuard.TransactOpts, role, account)
+}
+
+// GrantRole is a paid mutator transaction binding the contract method 0x2f2ff15d.
+//
+// Solidity: function grantRole(bytes32 role, address account) returns()
+func (_CorrelationGuard *CorrelationGuardTransactorSession) GrantRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _CorrelationGuard.Contract.GrantRole(&_CorrelationGuard.TransactOpts, role, account)
+}
+
+// RegisterMarket is a paid mutator transaction binding the contract method 0xca816ee2.
+//
+// Solidity: function registerMarket(address market, bytes32 matchId) returns()
+func (_CorrelationGuard *CorrelationGuardTransactor) RegisterMarket(opts *bind.TransactOpts, market common.Address, matchId [32]byte) (*types.Transaction, error) {
+	return _CorrelationGuard.contract.Transact(opts, "registerMarket", market, matchId)
+}
+
+// RegisterMarket is a paid mutator transaction binding the contract method 0xca816ee2.
+//
+// Solidity: function registerMarket(address market, bytes32 matchId) returns()
+func (_CorrelationGuard *CorrelationGuardSession) RegisterMarket(market common.Address, matchId [32]byte) (*types.Transaction, error) {
+	return _CorrelationGuard.Contract.RegisterMarket(&_CorrelationGuard.TransactOpts, market, matchId)
+}
+
+// RegisterMarket is a paid mutator transaction binding the contract method 0xca816ee2.
+//
+// Solidity: function registerMarket(address market, bytes32 matchId) returns()
+func (_CorrelationGuard *CorrelationGuardTransactorSession) RegisterMarket(market common.Address, matchId [32]byte) (*types.Transaction, error) {
+	return _CorrelationGuard.Contract.RegisterMarket(&_CorrelationGuard.TransactOpts, market, matchId)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_CorrelationGuard *CorrelationGuardTransactor) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _CorrelationGuard.contract.Transact(opts, "renounceOwnership")
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_CorrelationGuard *CorrelationGuardSession) RenounceOwnership() (*types.Transaction, error) {
+	return _CorrelationGuard.Contract.RenounceOwnership(&_CorrelationGuard.TransactOpts)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_CorrelationGuard *CorrelationGuardTransactorSession) RenounceOwnership() (*types.Transaction, error) {
+	return _CorrelationGuard.Contract.RenounceOwnership(&_CorrelationGuard.TransactOpts)
+}
+
+// RenounceRole is a paid mutator transaction binding the contract method 0x36568abe.
+//
+// Solidity: function renounceRole(bytes32 role, address callerConfirmation) returns()
+func (_CorrelationGuard *CorrelationGuardTransactor) RenounceRole(opts *bind.TransactOpts, role [32]byte, callerConfirmation common.Address) (*types.Transaction, error) {
+	return _CorrelationGuard.contract.Transact(opts, "renounceRole", role, callerConfirmation)
+}
+
+// RenounceRole is a paid mutator transaction binding the contract method 0x36568abe.
+//
+// Solidity: function renounceRole(bytes32 role, address callerConfirmation) returns()
+func (_CorrelationGuard *CorrelationGuardSession) RenounceRole(role [32]byte, callerConfirmation common.Address) (*types.Transaction, error) {
+	return _CorrelationGuard.Contract.RenounceRole(&_CorrelationGuard.TransactOpts, role, callerConfirmation)
+}
+
+// RenounceRole is a paid mutator transaction binding the contract method 0x36568abe.
+//
+// Solidity: function renounceRole(bytes32 role, address callerConfirmation) returns()
+func (_CorrelationGuard *CorrelationGuardTransactorSession) RenounceRole(role [32]byte, callerConfirmation common.Address) (*types.Transaction, error) {
+	return _CorrelationGuard.Contract.RenounceRole(&_CorrelationGuard.TransactOpts, role, callerConfirmation)
+}
+
+// RevokeRole is a paid mutator transaction binding the contract method 0xd547741f.
+//
+// Solidity: function revokeRole(bytes32 role, address account) returns()
+func (_CorrelationGuard *CorrelationGuardTransactor) RevokeRole(opts *bind.TransactOpts, role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _CorrelationGuard.contract.Transact(opts, "revokeRole", role, account)
+}
+
+// RevokeRole is a paid mutator transaction binding the contract method 0xd547741f.
+//
+// Solidity: function revokeRole(bytes32 role, address account) returns()
+func (_CorrelationGuard *CorrelationGuardSession) RevokeRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _CorrelationGuard.Contract.RevokeRole(&_CorrelationGuard.TransactOpts, role, account)
+}
+
+// RevokeRole is a paid mutator transaction binding the contract method 0xd547741f.
+//
+// Solidity: function revokeRole(bytes32 role, address account) returns()
+func (_CorrelationGuard *CorrelationGuardTransactorSession) RevokeRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _CorrelationGuard.Contract.RevokeRole(&_CorrelationGuard.TransactOpts, role, account)
+}
+
+// SetCorrelationRule is a paid mutator transaction binding the contract method 0xdbcab718.
+//
+// Solidity: function setCorrelationRule(bytes32 matchId1, bytes32 matchId2, uint256 penaltyBps, bool isBlocked) returns()
+func (_CorrelationGuard *CorrelationGuardTransactor) SetCorrelationRule(opts *bind.TransactOpts, matchId1 [32]byte, matchId2 [32]byte, penaltyBps *big.Int, isBlocked bool) (*types.Transaction, error) {
+	return _CorrelationGuard.contract.Transact(opts, "setCorrelationRule", matchId1, matchId2, penaltyBps, isBlocked)
+}
+
+// SetCorrelationRule is a paid mutator transaction binding the contract method 0xdbcab718.
+//
+// Solidity: function setCorrelationRule(bytes32 matchId1, bytes32 matchId2, uint256 penaltyBps, bool isBlocked) returns()
+func (_CorrelationGuard *CorrelationGuardSession) SetCorrelationRule(matchId1 [32]byte, matchId2 [32]byte, penaltyBps *big.Int, isBlocked bool) (*types.Transaction, error) {
+	return _CorrelationGuard.Contract.SetCorrelationRule(&_CorrelationGuard.TransactOpts, matchId1, matchId2, penaltyBps, isBlocked)
+}
+
+// SetCorrelationRule is a paid mutator transaction binding the contract method 0xdbcab718.
+//
+// Solidity: function setCorrelationRule(bytes32 matchId1, bytes32 matchId2, uint256 penaltyBps, bool isBlocked) returns()
+func (_CorrelationGuard *CorrelationGuardTransactorSession) SetCorrelationRule(matchId1 [32]byte, matchId2 [32]byte, penaltyBps *big.Int, isBlocked bool) (*types.Transaction, error) {
+	return _CorrelationGuard.Contract.SetCorrelationRule(&_CorrelationGuard.TransactOpts, matchId1, matchId2, penaltyBps, isBlocked)
+}
+
+// SetDefaultSameMatchPenalty is a paid mutator transaction binding the contract method 0xa99bec31.
+//
+// Solidity: function setDefaultSameMatchPenalty(uint256 penaltyBps) returns()
+func (_CorrelationGuard *CorrelationGuardTransactor) SetDefaultSameMatchPenalty(opts *bind.TransactOpts, penaltyBps *big.Int) (*types.Transaction, error) {
+	return _CorrelationGuard.contract.Transact(opts, "setDefaultSameMatchPenalty", penaltyBps)
+}
+
+// SetDefaultSameMatchPenalty is a paid mutator transaction binding the contract method 0xa99bec31.
+//
+// Solidity: function setDefaultSameMatchPenalty(uint256 penaltyBps) returns()
+func (_CorrelationGuard *CorrelationGuardSession) SetDefaultSameMatchPenalty(penaltyBps *big.Int) (*types.Transaction, error) {
+	return _CorrelationGuard.Contract.SetDefaultSameMatchPenalty(&_CorrelationGuard.TransactOpts, penaltyBps)
+}
+
+// SetDefaultSameMatchPenalty is a paid mutator transaction binding the contract method 0xa99bec31.
+//
+// Solidity: function setDefaultSameMatchPenalty(uint256 penaltyBps) returns()
+func (_CorrelationGuard *CorrelationGuardTransactorSession) SetDefaultSameMatchPenalty(penaltyBps *big.Int) (*types.Transaction, error) {
+	return _CorrelationGuard.Contract.SetDefaultSameMatchPenalty(&_CorrelationGuard.TransactOpts, penaltyBps)
+}
+
+// SetPolicy is a paid mutator transaction binding the contract method 0x74bce0bc.
+//
+// Solidity: function setPolicy(uint8 newPolicy) returns()
+func (_CorrelationGuard *CorrelationGuardTransactor) SetPolicy(opts *bind.TransactOpts, newPolicy uint8) (*types.Transaction, error) {
+	return _CorrelationGuard.contract.Transact(opts, "setPolicy", newPolicy)
+}
+
+// SetPolicy is a paid mutator transaction binding the contract method 0x74bce0bc.
+//
+// Solidity: function setPolicy(uint8 newPolicy) returns()
+func (_CorrelationGuard *CorrelationGuardSession) SetPolicy(newPolicy uint8) (*types.Transaction, error) {
+	return _CorrelationGuard.Contract.SetPolicy(&_CorrelationGuard.TransactOpts, newPolicy)
+}
+
+// SetPolicy is a paid mutator transaction binding the contract method 0x74bce0bc.
+//
+// Solidity: function setPolicy(uint8 newPolicy) returns()
+func (_CorrelationGuard *CorrelationGuardTransactorSession) SetPolicy(newPolicy uint8) (*types.Transaction, error) {
+	return _CorrelationGuard.Contract.SetPolicy(&_CorrelationGuard.TransactOpts, newPolicy)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_CorrelationGuard *CorrelationGuardTransactor) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	return _CorrelationGuard.contract.Transact(opts, "transferOwnership", newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_CorrelationGuard *CorrelationGuardSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _CorrelationGuard.Contract.TransferOwnership(&_CorrelationGuard.TransactOpts, newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_CorrelationGuard *CorrelationGuardTransactorSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _CorrelationGuard.Contract.TransferOwnership(&_CorrelationGuard.TransactOpts, newOwner)
+}
+
+// CorrelationGuardCorrelationRuleSetIterator is returned from FilterCorrelationRuleSet and is used to iterate over the raw logs and unpacked data for CorrelationRuleSet events raised by the CorrelationGuard contract.
+type CorrelationGuardCorrelationRuleSetIterator struct {
+	Event *CorrelationGuardCorrelationRuleSet // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *CorrelationGuardCorrelationRuleSetIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(CorrelationGuardCorrelationRuleSet)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(CorrelationGuardCorrelationRuleSet)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *CorrelationGuardCorrelationRuleSetIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *CorrelationGuardCorrelationRuleSetIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// CorrelationGuardCorrelationRuleSet represents a CorrelationRuleSet event raised by the CorrelationGuard contract.
+type CorrelationGuardCorrelationRuleSet struct {
+	MatchId1   [32]byte
+	MatchId2   [32]byte
+	PenaltyBps *big.Int
+	IsBlocked  bool
+	Raw        types.Log // Blockchain specific contextual infos
+}
+
+// FilterCorrelationRuleSet is a free log retrieval operation binding the contract event 0x7cb3172f049a693289d5a4b371142e1c02baae0525955e1571f9e2a283dee915.
+//
+// Solidity: event CorrelationRuleSet(bytes32 indexed matchId1, bytes32 indexed matchId2, uint256 penaltyBps, bool isBlocked)
+func (_CorrelationGuard *CorrelationGuardFilterer) FilterCorrelationRuleSet(opts *bind.FilterOpts, matchId1 [][32]byte, matchId2 [][32]byte) (*CorrelationGuardCorrelationRuleSetIterator, error) {
+
+	var matchId1Rule []interface{}
+	for _, matchId1Item := range matchId1 {
+		matchId1Rule = append(matchId1Rule, matchId1Item)
+	}
+	var matchId2Rule []interface{}
+	for _, matchId2Item := range matchId2 {
+		matchId2Rule = append(matchId2Rule, matchId2Item)
+	}
+
+	logs, sub, err := _CorrelationGuard.contract.FilterLogs(opts, "CorrelationRuleSet", matchId1Rule, matchId2Rule)
+	if err != nil {
+		return nil, err
+	}
+	return &CorrelationGuardCorrelationRuleSetIterator{contract: _CorrelationGuard.contract, event: "CorrelationRuleSet", logs: logs, sub: sub}, nil
+}
+
+// WatchCorrelationRuleSet is a free log subscription operation binding the contract event 0x7cb3172f049a693289d5a4b371142e1c02baae0525955e1571f9e2a283dee915.
+//
+// Solidity: event CorrelationRuleSet(bytes32 indexed matchId1, bytes32 indexed matchId2, uint256 penaltyBps, bool isBlocked)
+func (_CorrelationGuard *CorrelationGuardFilterer) WatchCorrelationRuleSet(opts *bind.WatchOpts, sink chan<- *CorrelationGuardCorrelationRuleSet, matchId1 [][32]byte, matchId2 [][32]byte) (event.Subscription, error) {
+
+	var matchId1Rule []interface{}
+	for _, matchId1Item := range matchId1 {
+		matchId1Rule = append(matchId1Rule, matchId1Item)
+	}
+	var matchId2Rule []interface{}
+	for _, matchId2Item := range matchId2 {
+		matchId2Rule = append(matchId2Rule, matchId2Item)
+	}
+
+	logs, sub, err := _CorrelationGuard.contract.WatchLogs(opts, "CorrelationRuleSet", matchId1Rule, matchId2Rule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(CorrelationGuardCorrelationRuleSet)
+				if err := _CorrelationGuard.contract.UnpackLog(event, "CorrelationRuleSet", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseCorrelationRuleSet is a log parse operation binding the contract event 0x7cb3172f049a693289d5a4b371142e1c02baae0525955e1571f9e2a283dee915.
+//
+// Solidity: event CorrelationRuleSet(bytes32 indexed matchId1, bytes32 indexed matchId2, uint256 penaltyBps, bool isBlocked)
+func (_CorrelationGuard *CorrelationGuardFilterer) ParseCorrelationRuleSet(log types.Log) (*CorrelationGuardCorrelationRuleSet, error) {
+	event := new(CorrelationGuardCorrelationRuleSet)
+	if err := _CorrelationGuard.contract.UnpackLog(event, "CorrelationRuleSet", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// CorrelationGuardDefaultPenaltyUpdatedIterator is returned from FilterDefaultPenaltyUpdated and is used to iterate over the raw logs and unpacked data for DefaultPenaltyUpdated events raised by the CorrelationGuard contract.
+type CorrelationGuardDefaultPenaltyUpdatedIterator struct {
+	Event *CorrelationGuardDefaultPenaltyUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *CorrelationGuardDefaultPenaltyUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(CorrelationGuardDefaultPenaltyUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(CorrelationGuardDefaultPenaltyUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *CorrelationGuardDefaultPenaltyUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *CorrelationGuardDefaultPenaltyUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// CorrelationGuardDefaultPenaltyUpdated represents a DefaultPenaltyUpdated event raised by the CorrelationGuard contract.
+type CorrelationGuardDefaultPenaltyUpdated struct {
+	SameMatchPenalty *big.Int
+	Raw              types.Log // Blockchain specific contextual infos
+}
+
+// FilterDefaultPenaltyUpdated is a free log retrieval operation binding the contract event 0x0a421f1cc6702a4c4b8b0b196a36d0a38c4532737e720e08ad38e5a204817cd7.
+//
+// Solidity: event DefaultPenaltyUpdated(uint256 sameMatchPenalty)
+func (_CorrelationGuard *CorrelationGuardFilterer) FilterDefaultPenaltyUpdated(opts *bind.FilterOpts) (*CorrelationGuardDefaultPenaltyUpdatedIterator, error) {
+
+	logs, sub, err := _CorrelationGuard.contract.FilterLogs(opts, "DefaultPenaltyUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return &CorrelationGuardDefaultPenaltyUpdatedIterator{contract: _CorrelationGuard.contract, event: "DefaultPenaltyUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchDefaultPenaltyUpdated is a free log subscription operation binding the contract event 0x0a421f1cc6702a4c4b8b0b196a36d0a38c4532737e720e08ad38e5a204817cd7.
+//
+// Solidity: event DefaultPenaltyUpdated(uint256 sameMatchPenalty)
+func (_CorrelationGuard *CorrelationGuardFilterer) WatchDefaultPenaltyUpdated(opts *bind.WatchOpts, sink chan<- *CorrelationGuardDefaultPenaltyUpdated) (event.Subscription, error) {
+
+	logs, sub, err := _CorrelationGuard.contract.WatchLogs(opts, "DefaultPenaltyUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(CorrelationGuardDefaultPenaltyUpdated)
+				if err := _CorrelationGuard.contract.UnpackLog(event, "DefaultPenaltyUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseDefaultPenaltyUpdated is a log parse operation binding the contract event 0x0a421f1cc6702a4c4b8b0b196a36d0a38c4532737e720e08ad38e5a204817cd7.
+//
+// Solidity: event DefaultPenaltyUpdated(uint256 sameMatchPenalty)
+func (_CorrelationGuard *CorrelationGuardFilterer) ParseDefaultPenaltyUpdated(log types.Log) (*CorrelationGuardDefaultPenaltyUpdated, error) {
+	event := new(CorrelationGuardDefaultPenaltyUpdated)
+	if err := _CorrelationGuard.contract.UnpackLog(event, "DefaultPenaltyUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// CorrelationGuardOwnershipTransferredIterator is returned from FilterOwnershipTransferred and is used to iterate over the raw logs and unpacked data for OwnershipTransferred events raised by the CorrelationGuard contract.
+type CorrelationGuardOwnershipTransferredIterator struct {
+	Event *CorrelationGuardOwnershipTransferred // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *CorrelationGuardOwnershipTransferredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(CorrelationGuardOwnershipTransferred)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(CorrelationGuardOwnershipTransferred)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *CorrelationGuardOwnershipTransferredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *CorrelationGuardOwnershipTransferredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// CorrelationGuardOwnershipTransferred represents a OwnershipTransferred event raised by the CorrelationGuard contract.
+type CorrelationGuardOwnershipTransferred struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterOwnershipTransferred is a free log retrieval operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_CorrelationGuard *CorrelationGuardFilterer) FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*CorrelationGuardOwnershipTransferredIterator, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _CorrelationGuard.contract.FilterLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &CorrelationGuardOwnershipTransferredIterator{contract: _CorrelationGuard.contract, event: "OwnershipTransferred", logs: logs, sub: sub}, nil
+}
+
+// WatchOwnershipTransferred is a free log subscription operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_CorrelationGuard *CorrelationGuardFilterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *CorrelationGuardOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _CorrelationGuard.contract.WatchLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(CorrelationGuardOwnershipTransferred)
+				if err := _CorrelationGuard.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOwnershipTransferred is a log parse operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_CorrelationGuard *CorrelationGuardFilterer) ParseOwnershipTransferred(log types.Log) (*CorrelationGuardOwnershipTransferred, error) {
+	event := new(CorrelationGuardOwnershipTransferred)
+	if err := _CorrelationGuard.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// CorrelationGuardParlayBlockedIterator is returned from FilterParlayBlocked and is used to iterate over the raw logs and unpacked data for ParlayBlocked events raised by the CorrelationGuard contract.
+type CorrelationGuardParlayBlockedIterator struct {
+	Event *CorrelationGuardParlayBlocked // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *CorrelationGuardParlayBlockedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(CorrelationGuardParlayBlocked)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(CorrelationGuardParlayBlocked)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *CorrelationGuardParlayBlockedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *CorrelationGuardParlayBlockedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// CorrelationGuardParlayBlocked represents a ParlayBlocked event raised by the CorrelationGuard contract.
+type CorrelationGuardParlayBlocked struct {
+	User   common.Address
+	Reason string
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterParlayBlocked is a free log retrieval operation binding the contract event 0x7bf9e24e771d449ef04412ba16bac306bf61e91b458c7db9671e6d32b65a0656.
+//
+// Solidity: event ParlayBlocked(address indexed user, string reason)
+func (_CorrelationGuard *CorrelationGuardFilterer) FilterParlayBlocked(opts *bind.FilterOpts, user []common.Address) (*CorrelationGuardParlayBlockedIterator, error) {
+
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+
+	logs, sub, err := _CorrelationGuard.contract.FilterLogs(opts, "ParlayBlocked", userRule)
+	if err != nil {
+		return nil, err
+	}
+	return &CorrelationGuardParlayBlockedIterator{contract: _CorrelationGuard.contract, event: "ParlayBlocked", logs: logs, sub: sub}, nil
+}
+
+// WatchParlayBlocked is a free log subscription operation binding the contract event 0x7bf9e24e771d449ef04412ba16bac306bf61e91b458c7db9671e6d32b65a0656.
+//
+// Solidity: event ParlayBlocked(address indexed user, string reason)
+func (_CorrelationGuard *CorrelationGuardFilterer) WatchParlayBlocked(opts *bind.WatchOpts, sink chan<- *CorrelationGuardParlayBlocked, user []common.Address) (event.Subscription, error) {
+
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+
+	logs, sub, err := _CorrelationGuard.contract.WatchLogs(opts, "ParlayBlocked", userRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(CorrelationGuardParlayBlocked)
+				if err := _CorrelationGuard.contract.UnpackLog(event, "ParlayBlocked", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseParlayBlocked is a log parse operation binding the contract event 0x7bf9e24e771d449ef04412ba16bac306bf61e91b458c7db9671e6d32b65a0656.
+//
+// Solidity: event ParlayBlocked(address indexed user, string reason)
+func (_CorrelationGuard *CorrelationGuardFilterer) ParseParlayBlocked(log types.Log) (*CorrelationGuardParlayBlocked, error) {
+	event := new(CorrelationGuardParlayBlocked)
+	if err := _CorrelationGuard.contract.UnpackLog(event, "ParlayBlocked", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// CorrelationGuardPolicyUpdatedIterator is returned from FilterPolicyUpdated and is used to iterate over the raw logs and unpacked data for PolicyUpdated events raised by the CorrelationGuard contract.
+type CorrelationGuardPolicyUpdatedIterator struct {
+	Event *CorrelationGuardPolicyUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *CorrelationGuardPolicyUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(CorrelationGuardPolicyUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(CorrelationGuardPolicyUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *CorrelationGuardPolicyUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *CorrelationGuardPolicyUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// CorrelationGuardPolicyUpdated represents a PolicyUpdated event raised by the CorrelationGuard contract.
+type CorrelationGuardPolicyUpdated struct {
+	OldPolicy uint8
+	NewPolicy uint8
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterPolicyUpdated is a free log retrieval operation binding the contract event 0xc03d2c623dd2ffc9dcf7405b7a6c317d058eb450ad8a9e7e7411bf25b00e472a.
+//
+// Solidity: event PolicyUpdated(uint8 indexed oldPolicy, uint8 indexed newPolicy)
+func (_CorrelationGuard *CorrelationGuardFilterer) FilterPolicyUpdated(opts *bind.FilterOpts, oldPolicy []uint8, newPolicy []uint8) (*CorrelationGuardPolicyUpdatedIterator, error) {
+
+	var oldPolicyRule []interface{}
+	for _, oldPolicyItem := range oldPolicy {
+		oldPolicyRule = append(oldPolicyRule, oldPolicyItem)
+	}
+	var newPolicyRule []interface{}
+	for _, newPolicyItem := range newPolicy {
+		newPolicyRule = append(newPolicyRule, newPolicyItem)
+	}
+
+	logs, sub, err := _CorrelationGuard.contract.FilterLogs(opts, "PolicyUpdated", oldPolicyRule, newPolicyRule)
+	if err != nil {
+		return nil, err
+	}
+	return &CorrelationGuardPolicyUpdatedIterator{contract: _CorrelationGuard.contract, event: "PolicyUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchPolicyUpdated is a free log subscription operation binding the contract event 0xc03d2c623dd2ffc9dcf7405b7a6c317d058eb450ad8a9e7e7411bf25b00e472a.
+//
+// Solidity: event PolicyUpdated(uint8 indexed oldPolicy, uint8 indexed newPolicy)
+func (_CorrelationGuard *CorrelationGuardFilterer) WatchPolicyUpdated(opts *bind.WatchOpts, sink chan<- *CorrelationGuardPolicyUpdated, oldPolicy []uint8, newPolicy []uint8) (event.Subscription, error) {
+
+	var oldPolicyRule []interface{}
+	for _, oldPolicyItem := range oldPolicy {
+		oldPolicyRule = append(oldPolicyRule, oldPolicyItem)
+	}
+	var newPolicyRule []interface{}
+	for _, newPolicyItem := range newPolicy {
+		newPolicyRule = append(newPolicyRule, newPolicyItem)
+	}
+
+	logs, sub, err := _CorrelationGuard.contract.WatchLogs(opts, "PolicyUpdated", oldPolicyRule, newPolicyRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(CorrelationGuardPolicyUpdated)
+				if err := _CorrelationGuard.contract.UnpackLog(event, "PolicyUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParsePolicyUpdated is a log parse operation binding the contract event 0xc03d2c623dd2ffc9dcf7405b7a6c317d058eb450ad8a9e7e7411bf25b00e472a.
+//
+// Solidity: event PolicyUpdated(uint8 indexed oldPolicy, uint8 indexed newPolicy)
+func (_CorrelationGuard *CorrelationGuardFilterer) ParsePolicyUpdated(log types.Log) (*CorrelationGuardPolicyUpdated, error) {
+	event := new(CorrelationGuardPolicyUpdated)
+	if err := _CorrelationGuard.contract.UnpackLog(event, "PolicyUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// CorrelationGuardRoleAdminChangedIterator is returned from FilterRoleAdminChanged and is used to iterate over the raw logs and unpacked data for RoleAdminChanged events raised by the CorrelationGuard contract.
+type CorrelationGuardRoleAdminChangedIterator struct {
+	Event *CorrelationGuardRoleAdminChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *CorrelationGuardRoleAdminChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(CorrelationGuardRoleAdminChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(CorrelationGuardRoleAdminChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *CorrelationGuardRoleAdminChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *CorrelationGuardRoleAdminChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// CorrelationGuardRoleAdminChanged represents a RoleAdminChanged event raised by the CorrelationGuard contract.
+type CorrelationGuardRoleAdminChanged struct {
+	Role              [32]byte
+	PreviousAdminRole [32]byte
+	NewAdminRole      [32]byte
+	Raw               types.Log // Blockchain specific contextual infos
+}
+
+// FilterRoleAdminChanged is a free log retrieval operation binding the contract event 0xbd79b86ffe0ab8e8776151514217cd7cacd52c909f66475c3af44e129f0b00ff.
+//
+// Solidity: event RoleAdminChanged(bytes32 indexed role, bytes32 indexed previousAdminRole, bytes32 indexed newAdminRole)
+func (_CorrelationGuard *CorrelationGuardFilterer) FilterRoleAdminChanged(opts *bind.FilterOpts, role [][32]byte, previousAdminRole [][32]byte, newAdminRole [][32]byte) (*CorrelationGuardRoleAdminChangedIterator, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var previousAdminRoleRule []interface{}
+	for _, previousAdminRoleItem := range previousAdminRole {
+		previousAdminRoleRule = append(previousAdminRoleRule, previousAdminRoleItem)
+	}
+	var newAdminRoleRule []interface{}
+	for _, newAdminRoleItem := range newAdminRole {
+		newAdminRoleRule = append(newAdminRoleRule, newAdminRoleItem)
+	}
+
+	logs, sub, err := _CorrelationGuard.contract.FilterLogs(opts, "RoleAdminChanged", roleRule, previousAdminRoleRule, newAdminRoleRule)
+	if err != nil {
+		return nil, err
+	}
+	return &CorrelationGuardRoleAdminChangedIterator{contract: _CorrelationGuard.contract, event: "RoleAdminChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchRoleAdminChanged is a free log subscription operation binding the contract event 0xbd79b86ffe0ab8e8776151514217cd7cacd52c909f66475c3af44e129f0b00ff.
+//
+// Solidity: event RoleAdminChanged(bytes32 indexed role, bytes32 indexed previousAdminRole, bytes32 indexed newAdminRole)
+func (_CorrelationGuard *CorrelationGuardFilterer) WatchRoleAdminChanged(opts *bind.WatchOpts, sink chan<- *CorrelationGuardRoleAdminChanged, role [][32]byte, previousAdminRole [][32]byte, newAdminRole [][32]byte) (event.Subscription, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var previousAdminRoleRule []interface{}
+	for _, previousAdminRoleItem := range previousAdminRole {
+		previousAdminRoleRule = append(previousAdminRoleRule, previousAdminRoleItem)
+	}
+	var newAdminRoleRule []interface{}
+	for _, newAdminRoleItem := range newAdminRole {
+		newAdminRoleRule = append(newAdminRoleRule, newAdminRoleItem)
+	}
+
+	logs, sub, err := _CorrelationGuard.contract.WatchLogs(opts, "RoleAdminChanged", roleRule, previousAdminRoleRule, newAdminRoleRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(CorrelationGuardRoleAdminChanged)
+				if err := _CorrelationGuard.contract.UnpackLog(event, "RoleAdminChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRoleAdminChanged is a log parse operation binding the contract event 0xbd79b86ffe0ab8e8776151514217cd7cacd52c909f66475c3af44e129f0b00ff.
+//
+// Solidity: event RoleAdminChanged(bytes32 indexed role, bytes32 indexed previousAdminRole, bytes32 indexed newAdminRole)
+func (_CorrelationGuard *CorrelationGuardFilterer) ParseRoleAdminChanged(log types.Log) (*CorrelationGuardRoleAdminChanged, error) {
+	event := new(CorrelationGuardRoleAdminChanged)
+	if err := _CorrelationGuard.contract.UnpackLog(event, "RoleAdminChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// CorrelationGuardRoleGrantedIterator is returned from FilterRoleGranted and is used to iterate over the raw logs and unpacked data for RoleGranted events raised by the CorrelationGuard contract.
+type CorrelationGuardRoleGrantedIterator struct {
+	Event *CorrelationGuardRoleGranted // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *CorrelationGuardRoleGrantedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(CorrelationGuardRoleGranted)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(CorrelationGuardRoleGranted)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *CorrelationGuardRoleGrantedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *CorrelationGuardRoleGrantedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// CorrelationGuardRoleGranted represents a RoleGranted event raised by the CorrelationGuard contract.
+type CorrelationGuardRoleGranted struct {
+	Role    [32]byte
+	Account common.Address
+	Sender  common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterRoleGranted is a free log retrieval operation binding the contract event 0x2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d.
+//
+// Solidity: event RoleGranted(bytes32 indexed role, address indexed account, address indexed sender)
+func (_CorrelationGuard *CorrelationGuardFilterer) FilterRoleGranted(opts *bind.FilterOpts, role [][32]byte, account []common.Address, sender []common.Address) (*CorrelationGuardRoleGrantedIterator, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _CorrelationGuard.contract.FilterLogs(opts, "RoleGranted", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &CorrelationGuardRoleGrantedIterator{contract: _CorrelationGuard.contract, event: "RoleGranted", logs: logs, sub: sub}, nil
+}
+
+// WatchRoleGranted is a free log subscription operation binding the contract event 0x2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d.
+//
+// Solidity: event RoleGranted(bytes32 indexed role, address indexed account, address indexed sender)
+func (_CorrelationGuard *CorrelationGuardFilterer) WatchRoleGranted(opts *bind.WatchOpts, sink chan<- *CorrelationGuardRoleGranted, role [][32]byte, account []common.Address, sender []common.Address) (event.Subscription, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _CorrelationGuard.contract.WatchLogs(opts, "RoleGranted", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(CorrelationGuardRoleGranted)
+				if err := _CorrelationGuard.contract.UnpackLog(event, "RoleGranted", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRoleGranted is a log parse operation binding the contract event 0x2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d.
+//
+// Solidity: event RoleGranted(bytes32 indexed role, address indexed account, address indexed sender)
+func (_CorrelationGuard *CorrelationGuardFilterer) ParseRoleGranted(log types.Log) (*CorrelationGuardRoleGranted, error) {
+	event := new(CorrelationGuardRoleGranted)
+	if err := _CorrelationGuard.contract.UnpackLog(event, "RoleGranted", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// CorrelationGuardRoleRevokedIterator is returned from FilterRoleRevoked and is used to iterate over the raw logs and unpacked data for RoleRevoked events raised by the CorrelationGuard contract.
+type CorrelationGuardRoleRevokedIterator struct {
+	Event *CorrelationGuardRoleRevoked // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *CorrelationGuardRoleRevokedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(CorrelationGuardRoleRevoked)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(CorrelationGuardRoleRevoked)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *CorrelationGuardRoleRevokedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *CorrelationGuardRoleRevokedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// CorrelationGuardRoleRevoked represents a RoleRevoked event raised by the CorrelationGuard contract.
+type CorrelationGuardRoleRevoked struct {
+	Role    [32]byte
+	Account common.Address
+	Sender  common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterRoleRevoked is a free log retrieval operation binding the contract event 0xf6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b.
+//
+// Solidity: event RoleRevoked(bytes32 indexed role, address indexed account, address indexed sender)
+func (_CorrelationGuard *CorrelationGuardFilterer) FilterRoleRevoked(opts *bind.FilterOpts, role [][32]byte, account []common.Address, sender []common.Address) (*CorrelationGuardRoleRevokedIterator, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _CorrelationGuard.contract.FilterLogs(opts, "RoleRevoked", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &CorrelationGuardRoleRevokedIterator{contract: _CorrelationGuard.contract, event: "RoleRevoked", logs: logs, sub: sub}, nil
+}
+
+// WatchRoleRevoked is a free log subscription operation binding the contract event 0xf6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b.
+//
+// Solidity: event RoleRevoked(bytes32 indexed role, address indexed account, address indexed sender)
+func (_CorrelationGuard *CorrelationGuardFilterer) WatchRoleRevoked(opts *bind.WatchOpts, sink chan<- *CorrelationGuardRoleRevoked, role [][32]byte, account []common.Address, sender []common.Address) (event.Subscription, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _CorrelationGuard.contract.WatchLogs(opts, "RoleRevoked", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(CorrelationGuardRoleRevoked)
+				if err := _CorrelationGuard.contract.UnpackLog(event, "RoleRevoked", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRoleRevoked is a log parse operation binding the contract event 0xf6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b.
+//
+// Solidity: event RoleRevoked(bytes32 indexed role, address indexed account, address indexed sender)
+func (_CorrelationGuard *CorrelationGuardFilterer) ParseRoleRevoked(log types.Log) (*CorrelationGuardRoleRevoked, error) {
+	event := new(CorrelationGuardRoleRevoked)
+	if err := _CorrelationGuard.contract.UnpackLog(event, "RoleRevoked", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,2822 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package bindings
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// PayoutScalerMetaData contains all meta data concerning the PayoutScaler contract.
+var PayoutScalerMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[{\"name\":\"_rewardToken\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"_treasury\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"BPS_DENOMINATOR\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"BUDGET_WARNING_THRESHOLD_BPS\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"DEFAULT_ADMIN_ROLE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"MAX_SCALE_BPS\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"MIN_SCALE_BPS\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"OPERATOR_ROLE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"PAUSER_ROLE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"autoScaleEnabled\",\"inputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"enumPayoutScaler.BudgetPool\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"budgetStatus\",\"inputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"enumPayoutScaler.BudgetPool\"}],\"outputs\":[{\"name\":\"totalBudget\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"usedBudget\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"pendingPayout\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"lastRefillAt\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"calculateScale\",\"inputs\":[{\"name\":\"pool\",\"type\":\"uint8\",\"internalType\":\"enumPayoutScaler.BudgetPool\"},{\"name\":\"period\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"requestedAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"scaleBps\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"scaledAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"emergencyWithdraw\",\"inputs\":[{\"name\":\"to\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"getBudgetStatus\",\"inputs\":[{\"name\":\"pool\",\"type\":\"uint8\",\"internalType\":\"enumPayoutScaler.BudgetPool\"}],\"outputs\":[{\"name\":\"total\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"used\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"pending\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"available\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getPeriodScale\",\"inputs\":[{\"name\":\"pool\",\"type\":\"uint8\",\"internalType\":\"enumPayoutScaler.BudgetPool\"},{\"name\":\"period\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"scaleBps\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getRoleAdmin\",\"inputs\":[{\"name\":\"role\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getScalingHistoryCount\",\"inputs\":[],\"outputs\":[{\"name\":\"count\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"grantRole\",\"inputs\":[{\"name\":\"role\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"hasRole\",\"inputs\":[{\"name\":\"role\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"markBudgetUsed\",\"inputs\":[{\"name\":\"pool\",\"type\":\"uint8\",\"internalType\":\"enumPayoutScaler.BudgetPool\"},{\"name\":\"period\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"pause\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"paused\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"periodScaleBps\",\"inputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"enumPayoutScaler.BudgetPool\"},{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"previewScale\",\"inputs\":[{\"name\":\"pool\",\"type\":\"uint8\",\"internalType\":\"enumPayoutScaler.BudgetPool\"},{\"name\":\"requestedAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"scaleBps\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"scaledAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"refillBudget\",\"inputs\":[{\"name\":\"pool\",\"type\":\"uint8\",\"internalType\":\"enumPayoutScaler.BudgetPool\"},{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"renounceRole\",\"inputs\":[{\"name\":\"role\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"callerConfirmation\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"revokeRole\",\"inputs\":[{\"name\":\"role\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"rewardToken\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"contractIERC20\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"scalingHistory\",\"inputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"pool\",\"type\":\"uint8\",\"internalType\":\"enumPayoutScaler.BudgetPool\"},{\"name\":\"period\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"requestedAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"availableBudget\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"scaleBps\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"scaledAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"timestamp\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"setAutoScale\",\"inputs\":[{\"name\":\"pool\",\"type\":\"uint8\",\"internalType\":\"enumPayoutScaler.BudgetPool\"},{\"name\":\"enabled\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setTreasury\",\"inputs\":[{\"name\":\"newTreasury\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"supportsInterface\",\"inputs\":[{\"name\":\"interfaceId\",\"type\":\"bytes4\",\"internalType\":\"bytes4\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"treasury\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"unpause\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"event\",\"name\":\"AutoScaleUpdated\",\"inputs\":[{\"name\":\"pool\",\"type\":\"uint8\",\"indexed\":true,\"internalType\":\"enumPayoutScaler.BudgetPool\"},{\"name\":\"enabled\",\"type\":\"bool\",\"indexed\":false,\"internalType\":\"bool\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"BudgetRefilled\",\"inputs\":[{\"name\":\"pool\",\"type\":\"uint8\",\"indexed\":true,\"internalType\":\"enumPayoutScaler.BudgetPool\"},{\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"newTotal\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"BudgetUsed\",\"inputs\":[{\"name\":\"pool\",\"type\":\"uint8\",\"indexed\":true,\"internalType\":\"enumPayoutScaler.BudgetPool\"},{\"name\":\"period\",\"type\":\"uint256\",\"indexed\":true,\"internalType\":\"uint256\"},{\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"remainingBudget\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"BudgetWarning\",\"inputs\":[{\"name\":\"pool\",\"type\":\"uint8\",\"indexed\":true,\"internalType\":\"enumPayoutScaler.BudgetPool\"},{\"name\":\"availableBudget\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"requestedAmount\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"utilizationBps\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"Paused\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"indexed\":false,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"RoleAdminChanged\",\"inputs\":[{\"name\":\"role\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"previousAdminRole\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"newAdminRole\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"RoleGranted\",\"inputs\":[{\"name\":\"role\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"account\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"sender\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"RoleRevoked\",\"inputs\":[{\"name\":\"role\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"account\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"sender\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"ScalingCalculated\",\"inputs\":[{\"name\":\"pool\",\"type\":\"uint8\",\"indexed\":true,\"internalType\":\"enumPayoutScaler.BudgetPool\"},{\"name\":\"period\",\"type\":\"uint256\",\"indexed\":true,\"internalType\":\"uint256\"},{\"name\":\"requestedAmount\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"availableBudget\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"scaleBps\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"scaledAmount\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"recordId\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"TreasuryUpdated\",\"inputs\":[{\"name\":\"oldTreasury\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"newTreasury\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"Unpaused\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"indexed\":false,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"error\",\"name\":\"AccessControlBadConfirmation\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"AccessControlUnauthorizedAccount\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"neededRole\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}]},{\"type\":\"error\",\"name\":\"BudgetExhausted\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"EnforcedPause\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"ExpectedPause\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InsufficientBudget\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InvalidPool\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InvalidScaleBps\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"ZeroAddress\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"ZeroAmount\",\"inputs\":[]}]",
+}
+
+// PayoutScalerABI is the input ABI used to generate the binding from.
+// Deprecated: Use PayoutScalerMetaData.ABI instead.
+var PayoutScalerABI = PayoutScalerMetaData.ABI
+
+// PayoutScaler is an auto generated Go binding around an Ethereum contract.
+type PayoutScaler struct {
+	PayoutScalerCaller     // Read-only binding to the contract
+	PayoutScalerTransactor // Write-only binding to the contract
+	PayoutScalerFilterer   // Log filterer for contract events
+}
+
+// PayoutScalerCaller is an auto generated read-only Go binding around an Ethereum contract.
+type PayoutScalerCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// PayoutScalerTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type PayoutScalerTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// PayoutScalerFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type PayoutScalerFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// PayoutScalerSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type PayoutScalerSession struct {
+	Contract     *PayoutScaler     // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// PayoutScalerCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type PayoutScalerCallerSession struct {
+	Contract *PayoutScalerCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts       // Call options to use throughout this session
+}
+
+// PayoutScalerTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type PayoutScalerTransactorSession struct {
+	Contract     *PayoutScalerTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts       // Transaction auth options to use throughout this session
+}
+
+// PayoutScalerRaw is an auto generated low-level Go binding around an Ethereum contract.
+type PayoutScalerRaw struct {
+	Contract *PayoutScaler // Generic contract binding to access the raw methods on
+}
+
+// PayoutScalerCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type PayoutScalerCallerRaw struct {
+	Contract *PayoutScalerCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// PayoutScalerTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type PayoutScalerTransactorRaw struct {
+	Contract *PayoutScalerTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewPayoutScaler creates a new instance of PayoutScaler, bound to a specific deployed contract.
+func NewPayoutScaler(address common.Address, backend bind.ContractBackend) (*PayoutScaler, error) {
+	contract, err := bindPayoutScaler(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &PayoutScaler{PayoutScalerCaller: PayoutScalerCaller{contract: contract}, PayoutScalerTransactor: PayoutScalerTransactor{contract: contract}, PayoutScalerFilterer: PayoutScalerFilterer{contract: contract}}, nil
+}
+
+// NewPayoutScalerCaller creates a new read-only instance of PayoutScaler, bound to a specific deployed contract.
+func NewPayoutScalerCaller(address common.Address, caller bind.ContractCaller) (*PayoutScalerCaller, error) {
+	contract, err := bindPayoutScaler(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &PayoutScalerCaller{contract: contract}, nil
+}
+
+// NewPayoutScalerTransactor creates a new write-only instance of PayoutScaler, bound to a specific deployed contract.
+func NewPayoutScalerTransactor(address common.Address, transactor bind.ContractTransactor) (*PayoutScalerTransactor, error) {
+	contract, err := bindPayoutScaler(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &PayoutScalerTransactor{contract: contract}, nil
+}
+
+// NewPayoutScalerFilterer creates a new log filterer instance of PayoutScaler, bound to a specific deployed contract.
+func NewPayoutScalerFilterer(address common.Address, filterer bind.ContractFilterer) (*PayoutScalerFilterer, error) {
+	contract, err := bindPayoutScaler(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &PayoutScalerFilterer{contract: contract}, nil
+}
+
+// bindPayoutScaler binds a generic wrapper to an already deployed contract.
+func bindPayoutScaler(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := PayoutScalerMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_PayoutScaler *PayoutScalerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _PayoutScaler.Contract.PayoutScalerCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_PayoutScaler *PayoutScalerRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _PayoutScaler.Contract.PayoutScalerTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_PayoutScaler *PayoutScalerRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _PayoutScaler.Contract.PayoutScalerTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_PayoutScaler *PayoutScalerCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _PayoutScaler.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_PayoutScaler *PayoutScalerTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _PayoutScaler.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_PayoutScaler *PayoutScalerTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _PayoutScaler.Contract.contract.Transact(opts, method, params...)
+}
+
+// BPSDENOMINATOR is a free data retrieval call binding the contract method 0xe1a45218.
+//
+// Solidity: function BPS_DENOMINATOR() view returns(uint256)
+func (_PayoutScaler *PayoutScalerCaller) BPSDENOMINATOR(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _PayoutScaler.contract.Call(opts, &out, "BPS_DENOMINATOR")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BPSDENOMINATOR is a free data retrieval call binding the contract method 0xe1a45218.
+//
+// Solidity: function BPS_DENOMINATOR() view returns(uint256)
+func (_PayoutScaler *PayoutScalerSession) BPSDENOMINATOR() (*big.Int, error) {
+	return _PayoutScaler.Contract.BPSDENOMINATOR(&_PayoutScaler.CallOpts)
+}
+
+// BPSDENOMINATOR is a free data retrieval call binding the contract method 0xe1a45218.
+//
+// Solidity: function BPS_DENOMINATOR() view returns(uint256)
+func (_PayoutScaler *PayoutScalerCallerSession) BPSDENOMINATOR() (*big.Int, error) {
+	return _PayoutScaler.Contract.BPSDENOMINATOR(&_PayoutScaler.CallOpts)
+}
+
+// BUDGETWARNINGTHRESHOLDBPS is a free data retrieval call binding the contract method 0xaf6727ef.
+//
+// Solidity: function BUDGET_WARNING_THRESHOLD_BPS() view returns(uint256)
+func (_PayoutScaler *PayoutScalerCaller) BUDGETWARNINGTHRESHOLDBPS(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _PayoutScaler.contract.Call(opts, &out, "BUDGET_WARNING_THRESHOLD_BPS")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BUDGETWARNINGTHRESHOLDBPS is a free data retrieval call binding the contract method 0xaf6727ef.
+//
+// Solidity: function BUDGET_WARNING_THRESHOLD_BPS() view returns(uint256)
+func (_PayoutScaler *PayoutScalerSession) BUDGETWARNINGTHRESHOLDBPS() (*big.Int, error) {
+	return _PayoutScaler.Contract.BUDGETWARNINGTHRESHOLDBPS(&_PayoutScaler.CallOpts)
+}
+
+// BUDGETWARNINGTHRESHOLDBPS is a free data retrieval call binding the contract method 0xaf6727ef.
+//
+// Solidity: function BUDGET_WARNING_THRESHOLD_BPS() view returns(uint256)
+func (_PayoutScaler *PayoutScalerCallerSession) BUDGETWARNINGTHRESHOLDBPS() (*big.Int, error) {
+	return _PayoutScaler.Contract.BUDGETWARNINGTHRESHOLDBPS(&_PayoutScaler.CallOpts)
+}
+
+// DEFAULTADMINROLE is a free data retrieval call binding the contract method 0xa217fddf.
+//
+// Solidity: function DEFAULT_ADMIN_ROLE() view returns(bytes32)
+func (_PayoutScaler *PayoutScalerCaller) DEFAULTADMINROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _PayoutScaler.contract.Call(opts, &out, "DEFAULT_ADMIN_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// DEFAULTADMINROLE is a free data retrieval call binding the contract method 0xa217fddf.
+//
+// Solidity: function DEFAULT_ADMIN_ROLE() view returns(bytes32)
+func (_PayoutScaler *PayoutScalerSession) DEFAULTADMINROLE() ([32]byte, error) {
+	return _PayoutScaler.Contract.DEFAULTADMINROLE(&_PayoutScaler.CallOpts)
+}
+
+// DEFAULTADMINROLE is a free data retrieval call binding the contract method 0xa217fddf.
+//
+// Solidity: function DEFAULT_ADMIN_ROLE() view returns(bytes32)
+func (_PayoutScaler *PayoutScalerCallerSession) DEFAULTADMINROLE() ([32]byte, error) {
+	return _PayoutScaler.Contract.DEFAULTADMINROLE(&_PayoutScaler.CallOpts)
+}
+
+// MAXSCALEBPS is a free data retrieval call binding the contract method 0x20ba9e19.
+//
+// Solidity: function MAX_SCALE_BPS() view returns(uint256)
+func (_PayoutScaler *PayoutScalerCaller) MAXSCALEBPS(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _PayoutScaler.contract.Call(opts, &out, "MAX_SCALE_BPS")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MAXSCALEBPS is a free data retrieval call binding the contract method 0x20ba9e19.
+//
+// Solidity: function MAX_SCALE_BPS() view returns(uint256)
+func (_PayoutScaler *PayoutScalerSession) MAXSCALEBPS() (*big.Int, error) {
+	return _PayoutScaler.Contract.MAXSCALEBPS(&_PayoutScaler.CallOpts)
+}
+
+// MAXSCALEBPS is a free data retrieval call binding the contract method 0x20ba9e19.
+//
+// Solidity: function MAX_SCALE_BPS() view returns(uint256)
+func (_PayoutScaler *PayoutScalerCallerSession) MAXSCALEBPS() (*big.Int, error) {
+	return _PayoutScaler.Contract.MAXSCALEBPS(&_PayoutScaler.CallOpts)
+}
+
+// MINSCALEBPS is a free data retrieval call binding the contract method 0x18fafd65.
+//
+// Solidity: function MIN_SCALE_BPS() view returns(uint256)
+func (_PayoutScaler *PayoutScalerCaller) MINSCALEBPS(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _PayoutScaler.contract.Call(opts, &out, "MIN_SCALE_BPS")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MINSCALEBPS is a free data retrieval call binding the contract method 0x18fafd65.
+//
+// Solidity: function MIN_SCALE_BPS() view returns(uint256)
+func (_PayoutScaler *PayoutScalerSession) MINSCALEBPS() (*big.Int, error) {
+	return _PayoutScaler.Contract.MINSCALEBPS(&_PayoutScaler.CallOpts)
+}
+
+// MINSCALEBPS is a free data retrieval call binding the contract method 0x18fafd65.
+//
+// Solidity: function MIN_SCALE_BPS() view returns(uint256)
+func (_PayoutScaler *PayoutScalerCallerSession) MINSCALEBPS() (*big.Int, error) {
+	return _PayoutScaler.Contract.MINSCALEBPS(&_PayoutScaler.CallOpts)
+}
+
+// OPERATORROLE is a free data retrieval call binding the contract method 0xf5b541a6.
+//
+// Solidity: function OPERATOR_ROLE() view returns(bytes32)
+func (_PayoutScaler *PayoutScalerCaller) OPERATORROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _PayoutScaler.contract.Call(opts, &out, "OPERATOR_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// OPERATORROLE is a free data retrieval call binding the contract method 0xf5b541a6.
+//
+// Solidity: function OPERATOR_ROLE() view returns(bytes32)
+func (_PayoutScaler *PayoutScalerSession) OPERATORROLE() ([32]byte, error) {
+	return _PayoutScaler.Contract.OPERATORROLE(&_PayoutScaler.CallOpts)
+}
+
+// OPERATORROLE is a free data retrieval call binding the contract method 0xf5b541a6.
+//
+// Solidity: function OPERATOR_ROLE() view returns(bytes32)
+func (_PayoutScaler *PayoutScalerCallerSession) OPERATORROLE() ([32]byte, error) {
+	return _PayoutScaler.Contract.OPERATORROLE(&_PayoutScaler.CallOpts)
+}
+
+// PAUSERROLE is a free data retrieval call binding the contract method 0xe63ab1e9.
+//
+// Solidity: function PAUSER_ROLE() view returns(bytes32)
+func (_PayoutScaler *PayoutScalerCaller) PAUSERROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _PayoutScaler.contract.Call(opts, &out, "PAUSER_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// PAUSERROLE is a free data retrieval call binding the contract method 0xe63ab1e9.
+//
+// Solidity: function PAUSER_ROLE() view returns(bytes32)
+func (_PayoutScaler *PayoutScalerSession) PAUSERROLE() ([32]byte, error) {
+	return _PayoutScaler.Contract.PAUSERROLE(&_PayoutScaler.CallOpts)
+}
+
+// PAUSERROLE is a free data retrieval call binding the contract method 0xe63ab1e9.
+//
+// Solidity: function PAUSER_ROLE() view returns(bytes32)
+func (_PayoutScaler *PayoutScalerCallerSession) PAUSERROLE() ([32]byte, error) {
+	return _PayoutScaler.Contract.PAUSERROLE(&_PayoutScaler.CallOpts)
+}
+
+// AutoScaleEnabled is a free data retrieval call binding the contract method 0xc8e0e627.
+//
+// Solidity: function autoScaleEnabled(uint8 ) view returns(bool)
+func (_PayoutScaler *PayoutScalerCaller) AutoScaleEnabled(opts *bind.CallOpts, arg0 uint8) (bool, error) {
+	var out []interface{}
+	err := _PayoutScaler.contract.Call(opts, &out, "autoScaleEnabled", arg0)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// AutoScaleEnabled is a free data retrieval call binding the contract method 0xc8e0e627.
+//
+// Solidity: function autoScaleEnabled(uint8 ) view returns(bool)
+func (_PayoutScaler *PayoutScalerSession) AutoScaleEnabled(arg0 uint8) (bool, error) {
+	return _PayoutScaler.Contract.AutoScaleEnabled(&_PayoutScaler.CallOpts, arg0)
+}
+
+// AutoScaleEnabled is a free data retrieval call binding the contract method 0xc8e0e627.
+//
+// Solidity: function autoScaleEnabled(uint8 ) view returns(bool)
+func (_PayoutScaler *PayoutScalerCallerSession) AutoScaleEnabled(arg0 uint8) (bool, error) {
+	return _PayoutScaler.Contract.AutoScaleEnabled(&_PayoutScaler.CallOpts, arg0)
+}
+
+// BudgetStatus is a free data retrieval call binding the contract method 0x80997cba.
+//
+// Solidity: function budgetStatus(uint8 ) view returns(uint256 totalBudget, uint256 usedBudget, uint256 pendingPayout, uint256 lastRefillAt)
+func (_PayoutScaler *PayoutScalerCaller) BudgetStatus(opts *bind.CallOpts, arg0 uint8) (struct {
+	TotalBudget   *big.Int
+	UsedBudget    *big.Int
+	PendingPayout *big.Int
+	LastRefillAt  *big.Int
+}, error) {
+	var out []interface{}
+	err := _PayoutScaler.contract.Call(opts, &out, "budgetStatus", arg0)
+
+	outstruct := new(struct {
+		TotalBudget   *big.Int
+		UsedBudget    *big.Int
+		PendingPayout *big.Int
+		LastRefillAt  *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.TotalBudget = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.UsedBudget = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	outstruct.PendingPayout = *abi.ConvertType(out[2], new(*big.Int)).(**big.Int)
+	outstruct.LastRefillAt = *abi.ConvertType(out[3], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// BudgetStatus is a free data retrieval call binding the contract method 0x80997cba.
+//
+// Solidity: function budgetStatus(uint8 ) view returns(uint256 totalBudget, uint256 usedBudget, uint256 pendingPayout, uint256 lastRefillAt)
+func (_PayoutScaler *PayoutScalerSession) BudgetStatus(arg0 uint8) (struct {
+	TotalBudget   *big.Int
+	UsedBudget    *big.Int
+	PendingPayout *big.Int
+	LastRefillAt  *big.Int
+}, error) {
+	return _PayoutScaler.Contract.BudgetStatus(&_PayoutScaler.CallOpts, arg0)
+}
+
+// BudgetStatus is a free data retrieval call binding the contract method 0x80997cba.
+//
+// Solidity: function budgetStatus(uint8 ) view returns(uint256 totalBudget, uint256 usedBudget, uint256 pendingPayout, uint256 lastRefillAt)
+func (_PayoutScaler *PayoutScalerCallerSession) BudgetStatus(arg0 uint8) (struct {
+	TotalBudget   *big.Int
+	UsedBudget    *big.Int
+	PendingPayout *big.Int
+	LastRefillAt  *big.Int
+}, error) {
+	return _PayoutScaler.Contract.BudgetStatus(&_PayoutScaler.CallOpts, arg0)
+}
+
+// GetBudgetStatus is a free data retrieval call binding the contract method 0x9c8865bd.
+//
+// Solidity: function getBudgetStatus(uint8 pool) view returns(uint256 total, uint256 used, uint256 pending, uint256 available)
+func (_PayoutScaler *PayoutScalerCaller) GetBudgetStatus(opts *bind.CallOpts, pool uint8) (struct {
+	Total     *big.Int
+	Used      *big.Int
+	Pending   *big.Int
+	Available *big.Int
+}, error) {
+	var out []interface{}
+	err := _PayoutScaler.contract.Call(opts, &out, "getBudgetStatus", pool)
+
+	outstruct := new(struct {
+		Total     *big.Int
+		Used      *big.Int
+		Pending   *big.Int
+		Available *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Total = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.Used = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	outstruct.Pending = *abi.ConvertType(out[2], new(*big.Int)).(**big.Int)
+	outstruct.Available = *abi.ConvertType(out[3], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// GetBudgetStatus is a free data retrieval call binding the contract method 0x9c8865bd.
+//
+// Solidity: function getBudgetStatus(uint8 pool) view returns(uint256 total, uint256 used, uint256 pending, uint256 available)
+func (_PayoutScaler *PayoutScalerSession) GetBudgetStatus(pool uint8) (struct {
+	Total     *big.Int
+	Used      *big.Int
+	Pending   *big.Int
+	Available *big.Int
+}, error) {
+	return _PayoutScaler.Contract.GetBudgetStatus(&_PayoutScaler.CallOpts, pool)
+}
+
+// GetBudgetStatus is a free data retrieval call binding the contract method 0x9c8865bd.
+//
+// Solidity: function getBudgetStatus(uint8 pool) view returns(uint256 total, uint256 used, uint256 pending, uint256 available)
+func (_PayoutScaler *PayoutScalerCallerSession) GetBudgetStatus(pool uint8) (struct {
+	Total     *big.Int
+	Used      *big.Int
+	Pending   *big.Int
+	Available *big.Int
+}, error) {
+	return _PayoutScaler.Contract.GetBudgetStatus(&_PayoutScaler.CallOpts, pool)
+}
+
+// GetPeriodScale is a free data retrieval call binding the contract method 0x089269b1.
+//
+// Solidity: function getPeriodScale(uint8 pool, uint256 period) view returns(uint256 scaleBps)
+func (_PayoutScaler *PayoutScalerCaller) GetPeriodScale(opts *bind.CallOpts, pool uint8, period *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _PayoutScaler.contract.Call(opts, &out, "getPeriodScale", pool, period)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetPeriodScale is a free data retrieval call binding the contract method 0x089269b1.
+//
+// Solidity: function getPeriodScale(uint8 pool, uint256 period) view returns(uint256 scaleBps)
+func (_PayoutScaler *PayoutScalerSession) GetPeriodScale(pool uint8, period *big.Int) (*big.Int, error) {
+	return _PayoutScaler.Contract.GetPeriodScale(&_PayoutScaler.CallOpts, pool, period)
+}
+
+// GetPeriodScale is a free data retrieval call binding the contract method 0x089269b1.
+//
+// Solidity: function getPeriodScale(uint8 pool, uint256 period) view returns(uint256 scaleBps)
+func (_PayoutScaler *PayoutScalerCallerSession) GetPeriodScale(pool uint8, period *big.Int) (*big.Int, error) {
+	return _PayoutScaler.Contract.GetPeriodScale(&_PayoutScaler.CallOpts, pool, period)
+}
+
+// GetRoleAdmin is a free data retrieval call binding the contract method 0x248a9ca3.
+//
+// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
+func (_PayoutScaler *PayoutScalerCaller) GetRoleAdmin(opts *bind.CallOpts, role [32]byte) ([32]byte, error) {
+	var out []interface{}
+	err := _PayoutScaler.contract.Call(opts, &out, "getRoleAdmin", role)
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// GetRoleAdmin is a free data retrieval call binding the contract method 0x248a9ca3.
+//
+// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
+func (_PayoutScaler *PayoutScalerSession) GetRoleAdmin(role [32]byte) ([32]byte, error) {
+	return _PayoutScaler.Contract.GetRoleAdmin(&_PayoutScaler.CallOpts, role)
+}
+
+// GetRoleAdmin is a free data retrieval call binding the contract method 0x248a9ca3.
+//
+// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
+func (_PayoutScaler *PayoutScalerCallerSession) GetRoleAdmin(role [32]byte) ([32]byte, error) {
+	return _PayoutScaler.Contract.GetRoleAdmin(&_PayoutScaler.CallOpts, role)
+}
+
+// GetScalingHistoryCount is a free data retrieval call binding the contract method 0x5648b78a.
+//
+// Solidity: function getScalingHistoryCount() view returns(uint256 count)
+func (_PayoutScaler *PayoutScalerCaller) GetScalingHistoryCount(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _PayoutScaler.contract.Call(opts, &out, "getScalingHistoryCount")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetScalingHistoryCount is a free data retrieval call binding the contract method 0x5648b78a.
+//
+// Solidity: function getScalingHistoryCount() view returns(uint256 count)
+func (_PayoutScaler *PayoutScalerSession) GetScalingHistoryCount() (*big.Int, error) {
+	return _PayoutScaler.Contract.GetScalingHistoryCount(&_PayoutScaler.CallOpts)
+}
+
+// GetScalingHistoryCount is a free data retrieval call binding the contract method 0x5648b78a.
+//
+// Solidity: function getScalingHistoryCount() view returns(uint256 count)
+func (_PayoutScaler *PayoutScalerCallerSession) GetScalingHistoryCount() (*big.Int, error) {
+	return _PayoutScaler.Contract.GetScalingHistoryCount(&_PayoutScaler.CallOpts)
+}
+
+// HasRole is a free data retrieval call binding the contract method 0x91d14854.
+//
+// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
+func (_PayoutScaler *PayoutScalerCaller) HasRole(opts *bind.CallOpts, role [32]byte, account common.Address) (bool, error) {
+	var out []interface{}
+	err := _PayoutScaler.contract.Call(opts, &out, "hasRole", role, account)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// HasRole is a free data retrieval call binding the contract method 0x91d14854.
+//
+// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
+func (_PayoutScaler *PayoutScalerSession) HasRole(role [32]byte, account common.Address) (bool, error) {
+	return _PayoutScaler.Contract.HasRole(&_PayoutScaler.CallOpts, role, account)
+}
+
+// HasRole is a free data retrieval call binding the contract method 0x91d14854.
+//
+// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
+func (_PayoutScaler *PayoutScalerCallerSession) HasRole(role [32]byte, account common.Address) (bool, error) {
+	return _PayoutScaler.Contract.HasRole(&_PayoutScaler.CallOpts, role, account)
+}
+
+// Paused is a free data retrieval call binding the contract method 0x5c975abb.
+//
+// Solidity: function paused() view returns(bool)
+func (_PayoutScaler *PayoutScalerCaller) Paused(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _PayoutScaler.contract.Call(opts, &out, "paused")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// Paused is a free data retrieval call binding the contract method 0x5c975abb.
+//
+// Solidity: function paused() view returns(bool)
+func (_PayoutScaler *PayoutScalerSession) Paused() (bool, error) {
+	return _PayoutScaler.Contract.Paused(&_PayoutScaler.CallOpts)
+}
+
+// Paused is a free data retrieval call binding the contract method 0x5c975abb.
+//
+// Solidity: function paused() view returns(bool)
+func (_PayoutScaler *PayoutScalerCallerSession) Paused() (bool, error) {
+	return _PayoutScaler.Contract.Paused(&_PayoutScaler.CallOpts)
+}
+
+// PeriodScaleBps is a free data retrieval call binding the contract method 0x799a30d7.
+//
+// Solidity: function periodScaleBps(uint8 , uint256 ) view returns(uint256)
+func (_PayoutScaler *PayoutScalerCaller) PeriodScaleBps(opts *bind.CallOpts, arg0 uint8, arg1 *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _PayoutScaler.contract.Call(opts, &out, "periodScaleBps", arg0, arg1)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// PeriodScaleBps is a free data retrieval call binding the contract method 0x799a30d7.
+//
+// Solidity: function periodScaleBps(uint8 , uint256 ) view returns(uint256)
+func (_PayoutScaler *PayoutScalerSession) PeriodScaleBps(arg0 uint8, arg1 *big.Int) (*big.Int, error) {
+	return _PayoutScaler.Contract.PeriodScaleBps(&_PayoutScaler.CallOpts, arg0, arg1)
+}
+
+// PeriodScaleBps is a free data retrieval call binding the contract method 0x799a30d7.
+//
+// Solidity: function periodScaleBps(uint8 , uint256 ) view returns(uint256)
+func (_PayoutScaler *PayoutScalerCallerSession) PeriodScaleBps(arg0 uint8, arg1 *big.Int) (*big.Int, error) {
+	return _PayoutScaler.Contract.PeriodScaleBps(&_PayoutScaler.CallOpts, arg0, arg1)
+}
+
+// PreviewScale is a free data retrieval call binding the contract method 0xebe8cf2b.
+//
+// Solidity: function previewScale(uint8 pool, uint256 requestedAmount) view returns(uint256 scaleBps, uint256 scaledAmount)
+func (_PayoutScaler *PayoutScalerCaller) PreviewScale(opts *bind.CallOpts, pool uint8, requestedAmount *big.Int) (struct {
+	ScaleBps     *big.Int
+	ScaledAmount *big.Int
+}, error) {
+	var out []interface{}
+	err := _PayoutScaler.contract.Call(opts, &out, "previewScale", pool, requestedAmount)
+
+	outstruct := new(struct {
+		ScaleBps     *big.Int
+		ScaledAmount *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.ScaleBps = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.ScaledAmount = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// PreviewScale is a free data retrieval call binding the contract method 0xebe8cf2b.
+//
+// Solidity: function previewScale(uint8 pool, uint256 requestedAmount) view returns(uint256 scaleBps, uint256 scaledAmount)
+func (_PayoutScaler *PayoutScalerSession) PreviewScale(pool uint8, requestedAmount *big.Int) (struct {
+	ScaleBps     *big.Int
+	ScaledAmount *big.Int
+}, error) {
+	return _PayoutScaler.Contract.PreviewScale(&_PayoutScaler.CallOpts, pool, requestedAmount)
+}
+
+// PreviewScale is a free data retrieval call binding the contract method 0xebe8cf2b.
+//
+// Solidity: function previewScale(uint8 pool, uint256 requestedAmount) view returns(uint256 scaleBps, uint256 scaledAmount)
+func (_PayoutScaler *PayoutScalerCallerSession) PreviewScale(pool uint8, requestedAmount *big.Int) (struct {
+	ScaleBps     *big.Int
+	ScaledAmount *big.Int
+}, error) {
+	return _PayoutScaler.Contract.PreviewScale(&_PayoutScaler.CallOpts, pool, requestedAmount)
+}
+
+// RewardToken is a free data retrieval call binding the contract method 0xf7c618c1.
+//
+// Solidity: function rewardToken() view returns(address)
+func (_PayoutScaler *PayoutScalerCaller) RewardToken(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _PayoutScaler.contract.Call(opts, &out, "rewardToken")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// RewardToken is a free data retrieval call binding the contract method 0xf7c618c1.
+//
+// Solidity: function rewardToken() view returns(address)
+func (_PayoutScaler *PayoutScalerSession) RewardToken() (common.Address, error) {
+	return _PayoutScaler.Contract.RewardToken(&_PayoutScaler.CallOpts)
+}
+
+// RewardToken is a free data retrieval call binding the contract method 0xf7c618c1.
+//
+// Solidity: function rewardToken() view returns(address)
+func (_PayoutScaler *PayoutScalerCallerSession) RewardToken() (common.Address, error) {
+	return _PayoutScaler.Contract.RewardToken(&_PayoutScaler.CallOpts)
+}
+
+// ScalingHistory is a free data retrieval call binding the contract method 0xefa74655.
+//
+// Solidity: function scalingHistory(uint256 ) view returns(uint8 pool, uint256 period, uint256 requestedAmount, uint256 availableBudget, uint256 scaleBps, uint256 scaledAmount, uint256 timestamp)
+func (_PayoutScaler *PayoutScalerCaller) ScalingHistory(opts *bind.CallOpts, arg0 *big.Int) (struct {
+	Pool            uint8
+	Period          *big.Int
+	RequestedAmount *big.Int
+	AvailableBudget *big.Int
+	ScaleBps        *big.Int
+	ScaledAmount    *big.Int
+	Timestamp       *big.Int
+}, error) {
+	var out []interface{}
+	err := _PayoutScaler.contract.Call(opts, &out, "scalingHistory", arg0)
+
+	outstruct := new(struct {
+		Pool            uint8
+		Period          *big.Int
+		RequestedAmount *big.Int
+		AvailableBudget *big.Int
+		ScaleBps        *big.Int
+		ScaledAmount    *big.Int
+		Timestamp       *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Pool = *abi.ConvertType(out[0], new(uint8)).(*uint8)
+	outstruct.Period = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	outstruct.RequestedAmount = *abi.ConvertType(out[2], new(*big.Int)).(**big.Int)
+	outstruct.AvailableBudget = *abi.ConvertType(out[3], new(*big.Int)).(**big.Int)
+	outstruct.ScaleBps = *abi.ConvertType(out[4], new(*big.Int)).(**big.Int)
+	outstruct.ScaledAmount = *abi.ConvertType(out[5], new(*big.Int)).(**big.Int)
+	outstruct.Timestamp = *abi.ConvertType(out[6], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// ScalingHistory is a free data retrieval call binding the contract method 0xefa74655.
+//
+// Solidity: function scalingHistory(uint256 ) view returns(uint8 pool, uint256 period, uint256 requestedAmount, uint256 availableBudget, uint256 scaleBps, uint256 scaledAmount, uint256 timestamp)
+func (_PayoutScaler *PayoutScalerSession) ScalingHistory(arg0 *big.Int) (struct {
+	Pool            uint8
+	Period          *big.Int
+	RequestedAmount *big.Int
+	AvailableBudget *big.Int
+	ScaleBps        *big.Int
+	ScaledAmount    *big.Int
+	Timestamp       *big.Int
+}, error) {
+	return _PayoutScaler.Contract.ScalingHistory(&_PayoutScaler.CallOpts, arg0)
+}
+
+// ScalingHistory is a free data retrieval call binding the contract method 0xefa74655.
+//
+// Solidity: function scalingHistory(uint256 ) view returns(uint8 pool, uint256 period, uint256 requestedAmount, uint256 availableBudget, uint256 scaleBps, uint256 scaledAmount, uint256 timestamp)
+func (_PayoutScaler *PayoutScalerCallerSession) ScalingHistory(arg0 *big.Int) (struct {
+	Pool            uint8
+	Period          *big.Int
+	RequestedAmount *big.Int
+	AvailableBudget *big.Int
+	ScaleBps        *big.Int
+	ScaledAmount    *big.Int
+	Timestamp       *big.Int
+}, error) {
+	return _PayoutScaler.Contract.ScalingHistory(&_PayoutScaler.CallOpts, arg0)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_PayoutScaler *PayoutScalerCaller) SupportsInterface(opts *bind.CallOpts, interfaceId [4]byte) (bool, error) {
+	var out []interface{}
+	err := _PayoutScaler.contract.Call(opts, &out, "supportsInterface", interfaceId)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_PayoutScaler *PayoutScalerSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _PayoutScaler.Contract.SupportsInterface(&_PayoutScaler.CallOpts, interfaceId)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_PayoutScaler *PayoutScalerCallerSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _PayoutScaler.Contract.SupportsInterface(&_PayoutScaler.CallOpts, interfaceId)
+}
+
+// Treasury is a free data retrieval call binding the contract method 0x61d027b3.
+//
+// Solidity: function treasury() view returns(address)
+func (_PayoutScaler *PayoutScalerCaller) Treasury(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _PayoutScaler.contract.Call(opts, &out, "treasury")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Treasury is a free data retrieval call binding the contract method 0x61d027b3.
+//
+// Solidity: function treasury() view returns(address)
+func (_PayoutScaler *PayoutScalerSession) Treasury() (common.Address, error) {
+	return _PayoutScaler.Contract.Treasury(&_PayoutScaler.CallOpts)
+}
+
+// Treasury is a free data retrieval call binding the contract method 0x61d027b3.
+//
+// Solidity: function treasury() view returns(address)
+func (_PayoutScaler *PayoutScalerCallerSession) Treasury() (common.Address, error) {
+	return _PayoutScaler.Contract.Treasury(&_PayoutScaler.CallOpts)
+}
+
+// CalculateScale is a paid mutator transaction binding the contract method 0x19cca0c8.
+//
+// Solidity: function calculateScale(uint8 pool, uint256 period, uint256 requestedAmount) returns(uint256 scaleBps, uint256 scaledAmount)
+func (_PayoutScaler *PayoutScalerTransactor) CalculateScale(opts *bind.TransactOpts, pool uint8, period *big.Int, requestedAmount *big.Int) (*types.Transaction, error) {
+	return _PayoutScaler.contract.Transact(opts, "calculateScale", pool, period, requestedAmount)
+}
+
+// CalculateScale is a paid mutator transaction binding the contract method 0x19cca0c8.
+//
+// Solidity: function calculateScale(uint8 pool, uint256 period, uint256 requestedAmount) returns(uint256 scaleBps, uint256 scaledAmount)
+func (_PayoutScaler *PayoutScalerSession) CalculateScale(pool uint8, period *big.Int, requestedAmount *big.Int) (*types.Transaction, error) {
+	return _PayoutScaler.Contract.CalculateScale(&_PayoutScaler.TransactOpts, pool, period, requestedAmount)
+}
+
+// CalculateScale is a paid mutator transaction binding the contract method 0x19cca0c8.
+//
+// Solidity: function calculateScale(uint8 pool, uint256 period, uint256 requestedAmount) returns(uint256 scaleBps, uint256 scaledAmount)
+func (_PayoutScaler *PayoutScalerTransactorSession) CalculateScale(pool uint8, period *big.Int, requestedAmount *big.Int) (*types.Transaction, error) {
+	return _PayoutScaler.Contract.CalculateScale(&_PayoutScaler.TransactOpts, pool, period, requestedAmount)
+}
+
+// EmergencyWithdraw is a paid mutator transaction binding the contract method 0x95ccea67.
+//
+// Solidity: function emergencyWithdraw(address to, uint256 amount) returns()
+func (_PayoutScaler *PayoutScalerTransactor) EmergencyWithdraw(opts *bind.TransactOpts, to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _PayoutScaler.contract.Transact(opts, "emergencyWithdraw", to, amount)
+}
+
+// EmergencyWithdraw is a paid mutator transaction binding the contract method 0x95ccea67.
+//
+// Solidity: function emergencyWithdraw(address to, uint256 amount) returns()
+func (_PayoutScaler *PayoutScalerSession) EmergencyWithdraw(to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _PayoutScaler.Contract.EmergencyWithdraw(&_PayoutScaler.TransactOpts, to, amount)
+}
+
+// EmergencyWithdraw is a paid mutator transaction binding the contract method 0x95ccea67.
+//
+// Solidity: function emergencyWithdraw(address to, uint256 amount) returns()
+func (_PayoutScaler *PayoutScalerTransactorSession) EmergencyWithdraw(to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _PayoutScaler.Contract.EmergencyWithdraw(&_PayoutScaler.TransactOpts, to, amount)
+}
+
+// GrantRole is a paid mutator transaction binding the contract method 0x2f2ff15d.
+//
+// Solidity: function grantRole(bytes32 role, address account) returns()
+func (_PayoutScaler *PayoutScalerTransactor) GrantRole(opts *bind.TransactOpts, role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _PayoutScaler.contract.Transact(opts, "grantRole", role, account)
+}
+
+// GrantRole is a paid mutator transaction binding the contract method 0x2f2ff15d.
+//
+// Solidity: function grantRole(bytes32 role, address account) returns()
+func (_PayoutScaler *PayoutScalerSession) GrantRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _PayoutScaler.Contract.GrantRole(&_PayoutScaler.TransactOpts, role, account)
+}
+
+// GrantRole is a paid mutator transaction binding the contract method 0x2f2ff15d.
+//
+// Solidity: function grantRole(bytes32 role, address account) returns()
+func (_PayoutScaler *PayoutScalerTransactorSession) GrantRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _PayoutScaler.Contract.GrantRole(&_PayoutScaler.TransactOpts, role, account)
+}
+
+// MarkBudgetUsed is a paid mutator transaction binding the contract method 0x41cca031.
+//
+// Solidity: function markBudgetUsed(uint8 pool, uint256 period, uint256 amount) returns()
+func (_PayoutScaler *PayoutScalerTransactor) MarkBudgetUsed(opts *bind.TransactOpts, pool uint8, period *big.Int, amount *big.Int) (*types.Transaction, error) {
+	return _PayoutScaler.contract.Transact(opts, "markBudgetUsed", pool, period, amount)
+}
+
+// MarkBudgetUsed is a paid mutator transaction binding the contract method 0x41cca031.
+//
+// Solidity: function markBudgetUsed(uint8 pool, uint256 period, uint256 amount) returns()
+func (_PayoutScaler *PayoutScalerSession) MarkBudgetUsed(pool uint8, period *big.Int, amount *big.Int) (*types.Transaction, error) {
+	return _PayoutScaler.Contract.MarkBudgetUsed(&_PayoutScaler.TransactOpts, pool, period, amount)
+}
+
+// MarkBudgetUsed is a paid mutator transaction binding the contract method 0x41cca031.
+//
+// Solidity: function markBudgetUsed(uint8 pool, uint256 period, uint256 amount) returns()
+func (_PayoutScaler *PayoutScalerTransactorSession) MarkBudgetUsed(pool uint8, period *big.Int, amount *big.Int) (*types.Transaction, error) {
+	return _PayoutScaler.Contract.MarkBudgetUsed(&_PayoutScaler.TransactOpts, pool, period, amount)
+}
+
+// Pause is a paid mutator transaction binding the contract method 0x8456cb59.
+//
+// Solidity: function pause() returns()
+func (_PayoutScaler *PayoutScalerTransactor) Pause(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _PayoutScaler.contract.Transact(opts, "pause")
+}
+
+// Pause is a paid mutator transaction binding the contract method 0x8456cb59.
+//
+// Solidity: function pause() returns()
+func (_PayoutScaler *PayoutScalerSession) Pause() (*types.Transaction, error) {
+	return _PayoutScaler.Contract.Pause(&_PayoutScaler.TransactOpts)
+}
+
+// Pause is a paid mutator transaction binding the contract method 0x8456cb59.
+//
+// Solidity: function pause() returns()
+func (_PayoutScaler *PayoutScalerTransactorSession) Pause() (*types.Transaction, error) {
+	return _PayoutScaler.Contract.Pause(&_PayoutScaler.TransactOpts)
+}
+
+// RefillBudget is a paid mutator transaction binding the contract method 0xbeb67175.
+//
+// Solidity: function refillBudget(uint8 pool, uint256 amount) returns()
+func (_PayoutScaler *PayoutScalerTransactor) RefillBudget(opts *bind.TransactOpts, pool uint8, amount *big.Int) (*types.Transaction, error) {
+	return _PayoutScaler.contract.Transact(opts, "refillBudget", pool, amount)
+}
+
+// RefillBudget is a paid mutator transaction binding the contract method 0xbeb67175.
+//
+// Solidity: function refillBudget(uint8 pool, uint256 amount) returns()
+func (_PayoutScaler *PayoutScalerSession) RefillBudget(pool uint8, amount *big.Int) (*types.Transaction, error) {
+	return _PayoutScaler.Contract.RefillBudget(&_PayoutScaler.TransactOpts, pool, amount)
+}
+
+// RefillBudget is a paid mutator transaction binding the contract method 0xbeb67175.
+//
+// Solidity: function refillBudget(uint8 pool, uint256 amount) returns()
+func (_PayoutScaler *PayoutScalerTransactorSession) RefillBudget(pool uint8, amount *big.Int) (*types.Transaction, error) {
+	return _PayoutScaler.Contract.RefillBudget(&_PayoutScaler.TransactOpts, pool, amount)
+}
+
+// RenounceRole is a paid mutator transaction binding the contract method 0x36568abe.
+//
+// Solidity: function renounceRole(bytes32 role, address callerConfirmation) returns()
+func (_PayoutScaler *PayoutScalerTransactor) RenounceRole(opts *bind.TransactOpts, role [32]byte, callerConfirmation common.Address) (*types.Transaction, error) {
+	return _PayoutScaler.contract.Transact(opts, "renounceRole", role, callerConfirmation)
+}
+
+// RenounceRole is a paid mutator transaction binding the contract method 0x36568abe.
+//
+// Solidity: function renounceRole(bytes32 role, address callerConfirmation) returns()
+func (_PayoutScaler *PayoutScalerSession) RenounceRole(role [32]byte, callerConfirmation common.Address) (*types.Transaction, error) {
+	return _PayoutScaler.Contract.RenounceRole(&_PayoutScaler.TransactOpts, role, callerConfirmation)
+}
+
+// RenounceRole is a paid mutator transaction binding the contract method 0x36568abe.
+//
+// Solidity: function renounceRole(bytes32 role, address callerConfirmation) returns()
+func (_PayoutScaler *PayoutScalerTransactorSession) RenounceRole(role [32]byte, callerConfirmation common.Address) (*types.Transaction, error) {
+	return _PayoutScaler.Contract.RenounceRole(&_PayoutScaler.TransactOpts, role, callerConfirmation)
+}
+
+// RevokeRole is a paid mutator transaction binding the contract method 0xd547741f.
+//
+// Solidity: function revokeRole(bytes32 role, address account) returns()
+func (_PayoutScaler *PayoutScalerTransactor) RevokeRole(opts *bind.TransactOpts, role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _PayoutScaler.contract.Transact(opts, "revokeRole", role, account)
+}
+
+// RevokeRole is a paid mutator transaction binding the contract method 0xd547741f.
+//
+// Solidity: function revokeRole(bytes32 role, address account) returns()
+func (_PayoutScaler *PayoutScalerSession) RevokeRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _PayoutScaler.Contract.RevokeRole(&_PayoutScaler.TransactOpts, role, account)
+}
+
+// RevokeRole is a paid mutator transaction binding the contract method 0xd547741f.
+//
+// Solidity: function revokeRole(bytes32 role, address account) returns()
+func (_PayoutScaler *PayoutScalerTransactorSession) RevokeRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _PayoutScaler.Contract.RevokeRole(&_PayoutScaler.TransactOpts, role, account)
+}
+
+// SetAutoScale is a paid mutator transaction binding the contract method 0xf6b42368.
+//
+// Solidity: function setAutoScale(uint8 pool, bool enabled) returns()
+func (_PayoutScaler *PayoutScalerTransactor) SetAutoScale(opts *bind.TransactOpts, pool uint8, enabled bool) (*types.Transaction, error) {
+	return _PayoutScaler.contract.Transact(opts, "setAutoScale", pool, enabled)
+}
+
+// SetAutoScale is a paid mutator transaction binding the contract method 0xf6b42368.
+//
+// Solidity: function setAutoScale(uint8 pool, bool enabled) returns()
+func (_PayoutScaler *PayoutScalerSession) SetAutoScale(pool uint8, enabled bool) (*types.Transaction, error) {
+	return _PayoutScaler.Contract.SetAutoScale(&_PayoutScaler.TransactOpts, pool, enabled)
+}
+
+// SetAutoScale is a paid mutator transaction binding the contract method 0xf6b42368.
+//
+// Solidity: function setAutoScale(uint8 pool, bool enabled) returns()
+func (_PayoutScaler *PayoutScalerTransactorSession) SetAutoScale(pool uint8, enabled bool) (*types.Transaction, error) {
+	return _PayoutScaler.Contract.SetAutoScale(&_PayoutScaler.TransactOpts, pool, enabled)
+}
+
+// SetTreasury is a paid mutator transaction binding the contract method 0xf0f44260.
+//
+// Solidity: function setTreasury(address newTreasury) returns()
+func (_PayoutScaler *PayoutScalerTransactor) SetTreasury(opts *bind.TransactOpts, newTreasury common.Address) (*types.Transaction, error) {
+	return _PayoutScaler.contract.Transact(opts, "setTreasury", newTreasury)
+}
+
+// SetTreasury is a paid mutator transaction binding the contract method 0xf0f44260.
+//
+// Solidity: function setTreasury(address newTreasury) returns()
+func (_PayoutScaler *PayoutScalerSession) SetTreasury(newTreasury common.Address) (*types.Transaction, error) {
+	return _PayoutScaler.Contract.SetTreasury(&_PayoutScaler.TransactOpts, newTreasury)
+}
+
+// SetTreasury is a paid mutator transaction binding the contract method 0xf0f44260.
+//
+// Solidity: function setTreasury(address newTreasury) returns()
+func (_PayoutScaler *PayoutScalerTransactorSession) SetTreasury(newTreasury common.Address) (*types.Transaction, error) {
+	return _PayoutScaler.Contract.SetTreasury(&_PayoutScaler.TransactOpts, newTreasury)
+}
+
+// Unpause is a paid mutator transaction binding the contract method 0x3f4ba83a.
+//
+// Solidity: function unpause() returns()
+func (_PayoutScaler *PayoutScalerTransactor) Unpause(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _PayoutScaler.contract.Transact(opts, "unpause")
+}
+
+// Unpause is a paid mutator transaction binding the contract method 0x3f4ba83a.
+//
+// Solidity: function unpause() returns()
+func (_PayoutScaler *PayoutScalerSession) Unpause() (*types.Transaction, error) {
+	return _PayoutScaler.Contract.Unpause(&_PayoutScaler.TransactOpts)
+}
+
+// Unpause is a paid mutator transaction binding the contract method 0x3f4ba83a.
+//
+// Solidity: function unpause() returns()
+func (_PayoutScaler *PayoutScalerTransactorSession) Unpause() (*types.Transaction, error) {
+	return _PayoutScaler.Contract.Unpause(&_PayoutScaler.TransactOpts)
+}
+
+// PayoutScalerAutoScaleUpdatedIterator is returned from FilterAutoScaleUpdated and is used to iterate over the raw logs and unpacked data for AutoScaleUpdated events raised by the PayoutScaler contract.
+type PayoutScalerAutoScaleUpdatedIterator struct {
+	Event *PayoutScalerAutoScaleUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *PayoutScalerAutoScaleUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(PayoutScalerAutoScaleUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(PayoutScalerAutoScaleUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *PayoutScalerAutoScaleUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *PayoutScalerAutoScaleUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// PayoutScalerAutoScaleUpdated represents a AutoScaleUpdated event raised by the PayoutScaler contract.
+type PayoutScalerAutoScaleUpdated struct {
+	Pool    uint8
+	Enabled bool
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterAutoScaleUpdated is a free log retrieval operation binding the contract event 0x384bfe566ea01a9969bdb8782bd12af41798d6f817e83fa098eda903123a61f9.
+//
+// Solidity: event AutoScaleUpdated(uint8 indexed pool, bool enabled)
+func (_PayoutScaler *PayoutScalerFilterer) FilterAutoScaleUpdated(opts *bind.FilterOpts, pool []uint8) (*PayoutScalerAutoScaleUpdatedIterator, error) {
+
+	var poolRule []interface{}
+	for _, poolItem := range pool {
+		poolRule = append(poolRule, poolItem)
+	}
+
+	logs, sub, err := _PayoutScaler.contract.FilterLogs(opts, "AutoScaleUpdated", poolRule)
+	if err != nil {
+		return nil, err
+	}
+	return &PayoutScalerAutoScaleUpdatedIterator{contract: _PayoutScaler.contract, event: "AutoScaleUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchAutoScaleUpdated is a free log subscription operation binding the contract event 0x384bfe566ea01a9969bdb8782bd12af41798d6f817e83fa098eda903123a61f9.
+//
+// Solidity: event AutoScaleUpdated(uint8 indexed pool, bool enabled)
+func (_PayoutScaler *PayoutScalerFilterer) WatchAutoScaleUpdated(opts *bind.WatchOpts, sink chan<- *PayoutScalerAutoScaleUpdated, pool []uint8) (event.Subscription, error) {
+
+	var poolRule []interface{}
+	for _, poolItem := range pool {
+		poolRule = append(poolRule, poolItem)
+	}
+
+	logs, sub, err := _PayoutScaler.contract.WatchLogs(opts, "AutoScaleUpdated", poolRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(PayoutScalerAutoScaleUpdated)
+				if err := _PayoutScaler.contract.UnpackLog(event, "AutoScaleUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseAutoScaleUpdated is a log parse operation binding the contract event 0x384bfe566ea01a9969bdb8782bd12af41798d6f817e83fa098eda903123a61f9.
+//
+// Solidity: event AutoScaleUpdated(uint8 indexed pool, bool enabled)
+func (_PayoutScaler *PayoutScalerFilterer) ParseAutoScaleUpdated(log types.Log) (*PayoutScalerAutoScaleUpdated, error) {
+	event := new(PayoutScalerAutoScaleUpdated)
+	if err := _PayoutScaler.contract.UnpackLog(event, "AutoScaleUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// PayoutScalerBudgetRefilledIterator is returned from FilterBudgetRefilled and is used to iterate over the raw logs and unpacked data for BudgetRefilled events raised by the PayoutScaler contract.
+type PayoutScalerBudgetRefilledIterator struct {
+	Event *PayoutScalerBudgetRefilled // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *PayoutScalerBudgetRefilledIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(PayoutScalerBudgetRefilled)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(PayoutScalerBudgetRefilled)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *PayoutScalerBudgetRefilledIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *PayoutScalerBudgetRefilledIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// PayoutScalerBudgetRefilled represents a BudgetRefilled event raised by the PayoutScaler contract.
+type PayoutScalerBudgetRefilled struct {
+	Pool     uint8
+	Amount   *big.Int
+	NewTotal *big.Int
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterBudgetRefilled is a free log retrieval operation binding the contract event 0x1858ffffa395241de4e33e3ba83781fb61518fcb122b48a69b7bc25b7a636400.
+//
+// Solidity: event BudgetRefilled(uint8 indexed pool, uint256 amount, uint256 newTotal)
+func (_PayoutScaler *PayoutScalerFilterer) FilterBudgetRefilled(opts *bind.FilterOpts, pool []uint8) (*PayoutScalerBudgetRefilledIterator, error) {
+
+	var poolRule []interface{}
+	for _, poolItem := range pool {
+		poolRule = append(poolRule, poolItem)
+	}
+
+	logs, sub, err := _PayoutScaler.contract.FilterLogs(opts, "BudgetRefilled", poolRule)
+	if err != nil {
+		return nil, err
+	}
+	return &PayoutScalerBudgetRefilledIterator{contract: _PayoutScaler.contract, event: "BudgetRefilled", logs: logs, sub: sub}, nil
+}
+
+// WatchBudgetRefilled is a free log subscription operation binding the contract event 0x1858ffffa395241de4e33e3ba83781fb61518fcb122b48a69b7bc25b7a636400.
+//
+// Solidity: event BudgetRefilled(uint8 indexed pool, uint256 amount, uint256 newTotal)
+func (_PayoutScaler *PayoutScalerFilterer) WatchBudgetRefilled(opts *bind.WatchOpts, sink chan<- *PayoutScalerBudgetRefilled, pool []uint8) (event.Subscription, error) {
+
+	var poolRule []interface{}
+	for _, poolItem := range pool {
+		poolRule = append(poolRule, poolItem)
+	}
+
+	logs, sub, err := _PayoutScaler.contract.WatchLogs(opts, "BudgetRefilled", poolRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(PayoutScalerBudgetRefilled)
+				if err := _PayoutScaler.contract.UnpackLog(event, "BudgetRefilled", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseBudgetRefilled is a log parse operation binding the contract event 0x1858ffffa395241de4e33e3ba83781fb61518fcb122b48a69b7bc25b7a636400.
+//
+// Solidity: event BudgetRefilled(uint8 indexed pool, uint256 amount, uint256 newTotal)
+func (_PayoutScaler *PayoutScalerFilterer) ParseBudgetRefilled(log types.Log) (*PayoutScalerBudgetRefilled, error) {
+	event := new(PayoutScalerBudgetRefilled)
+	if err := _PayoutScaler.contract.UnpackLog(event, "BudgetRefilled", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// PayoutScalerBudgetUsedIterator is returned from FilterBudgetUsed and is used to iterate over the raw logs and unpacked data for BudgetUsed events raised by the PayoutScaler contract.
+type PayoutScalerBudgetUsedIterator struct {
+	Event *PayoutScalerBudgetUsed // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *PayoutScalerBudgetUsedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(PayoutScalerBudgetUsed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(PayoutScalerBudgetUsed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *PayoutScalerBudgetUsedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *PayoutScalerBudgetUsedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// PayoutScalerBudgetUsed represents a BudgetUsed event raised by the PayoutScaler contract.
+type PayoutScalerBudgetUsed struct {
+	Pool            uint8
+	Period          *big.Int
+	Amount          *big.Int
+	RemainingBudget *big.Int
+	Raw             types.Log // Blockchain specific contextual infos
+}
+
+// FilterBudgetUsed is a free log retrieval operation binding the contract event 0x679a928d6efcfaafbfe720b7287f4473db5f51a647d581fbfb4f71aba6101a3a.
+//
+// Solidity: event BudgetUsed(uint8 indexed pool, uint256 indexed period, uint256 amount, uint256 remainingBudget)
+func (_PayoutScaler *PayoutScalerFilterer) FilterBudgetUsed(opts *bind.FilterOpts, pool []uint8, period []*big.Int) (*PayoutScalerBudgetUsedIterator, error) {
+
+	var poolRule []interface{}
+	for _, poolItem := range pool {
+		poolRule = append(poolRule, poolItem)
+	}
+	var periodRule []interface{}
+	for _, periodItem := range period {
+		periodRule = append(periodRule, periodItem)
+	}
+
+	logs, sub, err := _PayoutScaler.contract.FilterLogs(opts, "BudgetUsed", poolRule, periodRule)
+	if err != nil {
+		return nil, err
+	}
+	return &PayoutScalerBudgetUsedIterator{contract: _PayoutScaler.contract, event: "BudgetUsed", logs: logs, sub: sub}, nil
+}
+
+// WatchBudgetUsed is a free log subscription operation binding the contract event 0x679a928d6efcfaafbfe720b7287f4473db5f51a647d581fbfb4f71aba6101a3a.
+//
+// Solidity: event BudgetUsed(uint8 indexed pool, uint256 indexed period, uint256 amount, uint256 remainingBudget)
+func (_PayoutScaler *PayoutScalerFilterer) WatchBudgetUsed(opts *bind.WatchOpts, sink chan<- *PayoutScalerBudgetUsed, pool []uint8, period []*big.Int) (event.Subscription, error) {
+
+	var poolRule []interface{}
+	for _, poolItem := range pool {
+		poolRule = append(poolRule, poolItem)
+	}
+	var periodRule []interface{}
+	for _, periodItem := range period {
+		periodRule = append(periodRule, periodItem)
+	}
+
+	logs, sub, err := _PayoutScaler.contract.WatchLogs(opts, "BudgetUsed", poolRule, periodRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(PayoutScalerBudgetUsed)
+				if err := _PayoutScaler.contract.UnpackLog(event, "BudgetUsed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseBudgetUsed is a log parse operation binding the contract event 0x679a928d6efcfaafbfe720b7287f4473db5f51a647d581fbfb4f71aba6101a3a.
+//
+// Solidity: event BudgetUsed(uint8 indexed pool, uint256 indexed period, uint256 amount, uint256 remainingBudget)
+func (_PayoutScaler *PayoutScalerFilterer) ParseBudgetUsed(log types.Log) (*PayoutScalerBudgetUsed, error) {
+	event := new(PayoutScalerBudgetUsed)
+	if err := _PayoutScaler.contract.UnpackLog(event, "BudgetUsed", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// PayoutScalerBudgetWarningIterator is returned from FilterBudgetWarning and is used to iterate over the raw logs and unpacked data for BudgetWarning events raised by the PayoutScaler contract.
+type PayoutScalerBudgetWarningIterator struct {
+	Event *PayoutScalerBudgetWarning // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *PayoutScalerBudgetWarningIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(PayoutScalerBudgetWarning)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(PayoutScalerBudgetWarning)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *PayoutScalerBudgetWarningIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *PayoutScalerBudgetWarningIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// PayoutScalerBudgetWarning represents a BudgetWarning event raised by the PayoutScaler contract.
+type PayoutScalerBudgetWarning struct {
+	Pool            uint8
+	AvailableBudget *big.Int
+	RequestedAmount *big.Int
+	UtilizationBps  *big.Int
+	Raw             types.Log // Blockchain specific contextual infos
+}
+
+// FilterBudgetWarning is a free log retrieval operation binding the contract event 0x378b262045b466036a3aa4fb6929dca94fa9ed3a24a7b776aafd38e41c6ada94.
+//
+// Solidity: event BudgetWarning(uint8 indexed pool, uint256 availableBudget, uint256 requestedAmount, uint256 utilizationBps)
+func (_PayoutScaler *PayoutScalerFilterer) FilterBudgetWarning(opts *bind.FilterOpts, pool []uint8) (*PayoutScalerBudgetWarningIterator, error) {
+
+	var poolRule []interface{}
+	for _, poolItem := range pool {
+		poolRule = append(poolRule, poolItem)
+	}
+
+	logs, sub, err := _PayoutScaler.contract.FilterLogs(opts, "BudgetWarning", poolRule)
+	if err != nil {
+		return nil, err
+	}
+	return &PayoutScalerBudgetWarningIterator{contract: _PayoutScaler.contract, event: "BudgetWarning", logs: logs, sub: sub}, nil
+}
+
+// WatchBudgetWarning is a free log subscription operation binding the contract event 0x378b262045b466036a3aa4fb6929dca94fa9ed3a24a7b776aafd38e41c6ada94.
+//
+// Solidity: event BudgetWarning(uint8 indexed pool, uint256 availableBudget, uint256 requestedAmount, uint256 utilizationBps)
+func (_PayoutScaler *PayoutScalerFilterer) WatchBudgetWarning(opts *bind.WatchOpts, sink chan<- *PayoutScalerBudgetWarning, pool []uint8) (event.Subscription, error) {
+
+	var poolRule []interface{}
+	for _, poolItem := range pool {
+		poolRule = append(poolRule, poolItem)
+	}
+
+	logs, sub, err := _PayoutScaler.contract.WatchLogs(opts, "BudgetWarning", poolRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(PayoutScalerBudgetWarning)
+				if err := _PayoutScaler.contract.UnpackLog(event, "BudgetWarning", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseBudgetWarning is a log parse operation binding the contract event 0x378b262045b466036a3aa4fb6929dca94fa9ed3a24a7b776aafd38e41c6ada94.
+//
+// Solidity: event BudgetWarning(uint8 indexed pool, uint256 availableBudget, uint256 requestedAmount, uint256 utilizationBps)
+func (_PayoutScaler *PayoutScalerFilterer) ParseBudgetWarning(log types.Log) (*PayoutScalerBudgetWarning, error) {
+	event := new(PayoutScalerBudgetWarning)
+	if err := _PayoutScaler.contract.UnpackLog(event, "BudgetWarning", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// PayoutScalerPausedIterator is returned from FilterPaused and is used to iterate over the raw logs and unpacked data for Paused events raised by the PayoutScaler contract.
+type PayoutScalerPausedIterator struct {
+	Event *PayoutScalerPaused // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *PayoutScalerPausedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(PayoutScalerPaused)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(PayoutScalerPaused)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *PayoutScalerPausedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *PayoutScalerPausedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// PayoutScalerPaused represents a Paused event raised by the PayoutScaler contract.
+type PayoutScalerPaused struct {
+	Account common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterPaused is a free log retrieval operation binding the contract event 0x62e78cea01bee320cd4e420270b5ea74000d11b0c9f74754ebdbfc544b05a258.
+//
+// Solidity: event Paused(address account)
+func (_PayoutScaler *PayoutScalerFilterer) FilterPaused(opts *bind.FilterOpts) (*PayoutScalerPausedIterator, error) {
+
+	logs, sub, err := _PayoutScaler.contract.FilterLogs(opts, "Paused")
+	if err != nil {
+		return nil, err
+	}
+	return &PayoutScalerPausedIterator{contract: _PayoutScaler.contract, event: "Paused", logs: logs, sub: sub}, nil
+}
+
+// WatchPaused is a free log subscription operation binding the contract event 0x62e78cea01bee320cd4e420270b5ea74000d11b0c9f74754ebdbfc544b05a258.
+//
+// Solidity: event Paused(address account)
+func (_PayoutScaler *PayoutScalerFilterer) WatchPaused(opts *bind.WatchOpts, sink chan<- *PayoutScalerPaused) (event.Subscription, error) {
+
+	logs, sub, err := _PayoutScaler.contract.WatchLogs(opts, "Paused")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(PayoutScalerPaused)
+				if err := _PayoutScaler.contract.UnpackLog(event, "Paused", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParsePaused is a log parse operation binding the contract event 0x62e78cea01bee320cd4e420270b5ea74000d11b0c9f74754ebdbfc544b05a258.
+//
+// Solidity: event Paused(address account)
+func (_PayoutScaler *PayoutScalerFilterer) ParsePaused(log types.Log) (*PayoutScalerPaused, error) {
+	event := new(PayoutScalerPaused)
+	if err := _PayoutScaler.contract.UnpackLog(event, "Paused", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// PayoutScalerRoleAdminChangedIterator is returned from FilterRoleAdminChanged and is used to iterate over the raw logs and unpacked data for RoleAdminChanged events raised by the PayoutScaler contract.
+type PayoutScalerRoleAdminChangedIterator struct {
+	Event *PayoutScalerRoleAdminChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *PayoutScalerRoleAdminChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(PayoutScalerRoleAdminChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(PayoutScalerRoleAdminChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *PayoutScalerRoleAdminChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *PayoutScalerRoleAdminChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// PayoutScalerRoleAdminChanged represents a RoleAdminChanged event raised by the PayoutScaler contract.
+type PayoutScalerRoleAdminChanged struct {
+	Role              [32]byte
+	PreviousAdminRole [32]byte
+	NewAdminRole      [32]byte
+	Raw               types.Log // Blockchain specific contextual infos
+}
+
+// FilterRoleAdminChanged is a free log retrieval operation binding the contract event 0xbd79b86ffe0ab8e8776151514217cd7cacd52c909f66475c3af44e129f0b00ff.
+//
+// Solidity: event RoleAdminChanged(bytes32 indexed role, bytes32 indexed previousAdminRole, bytes32 indexed newAdminRole)
+func (_PayoutScaler *PayoutScalerFilterer) FilterRoleAdminChanged(opts *bind.FilterOpts, role [][32]byte, previousAdminRole [][32]byte, newAdminRole [][32]byte) (*PayoutScalerRoleAdminChangedIterator, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var previousAdminRoleRule []interface{}
+	for _, previousAdminRoleItem := range previousAdminRole {
+		previousAdminRoleRule = append(previousAdminRoleRule, previousAdminRoleItem)
+	}
+	var newAdminRoleRule []interface{}
+	for _, newAdminRoleItem := range newAdminRole {
+		newAdminRoleRule = append(newAdminRoleRule, newAdminRoleItem)
+	}
+
+	logs, sub, err := _PayoutScaler.contract.FilterLogs(opts, "RoleAdminChanged", roleRule, previousAdminRoleRule, newAdminRoleRule)
+	if err != nil {
+		return nil, err
+	}
+	return &PayoutScalerRoleAdminChangedIterator{contract: _PayoutScaler.contract, event: "RoleAdminChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchRoleAdminChanged is a free log subscription operation binding the contract event 0xbd79b86ffe0ab8e8776151514217cd7cacd52c909f66475c3af44e129f0b00ff.
+//
+// Solidity: event RoleAdminChanged(bytes32 indexed role, bytes32 indexed previousAdminRole, bytes32 indexed newAdminRole)
+func (_PayoutScaler *PayoutScalerFilterer) WatchRoleAdminChanged(opts *bind.WatchOpts, sink chan<- *PayoutScalerRoleAdminChanged, role [][32]byte, previousAdminRole [][32]byte, newAdminRole [][32]byte) (event.Subscription, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var previousAdminRoleRule []interface{}
+	for _, previousAdminRoleItem := range previousAdminRole {
+		previousAdminRoleRule = append(previousAdminRoleRule, previousAdminRoleItem)
+	}
+	var newAdminRoleRule []interface{}
+	for _, newAdminRoleItem := range newAdminRole {
+		newAdminRoleRule = append(newAdminRoleRule, newAdminRoleItem)
+	}
+
+	logs, sub, err := _PayoutScaler.contract.WatchLogs(opts, "RoleAdminChanged", roleRule, previousAdminRoleRule, newAdminRoleRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(PayoutScalerRoleAdminChanged)
+				if err := _PayoutScaler.contract.UnpackLog(event, "RoleAdminChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRoleAdminChanged is a log parse operation binding the contract event 0xbd79b86ffe0ab8e8776151514217cd7cacd52c909f66475c3af44e129f0b00ff.
+//
+// Solidity: event RoleAdminChanged(bytes32 indexed role, bytes32 indexed previousAdminRole, bytes32 indexed newAdminRole)
+func (_PayoutScaler *PayoutScalerFilterer) ParseRoleAdminChanged(log types.Log) (*PayoutScalerRoleAdminChanged, error) {
+	event := new(PayoutScalerRoleAdminChanged)
+	if err := _PayoutScaler.contract.UnpackLog(event, "RoleAdminChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// PayoutScalerRoleGrantedIterator is returned from FilterRoleGranted and is used to iterate over the raw logs and unpacked data for RoleGranted events raised by the PayoutScaler contract.
+type PayoutScalerRoleGrantedIterator struct {
+	Event *PayoutScalerRoleGranted // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *PayoutScalerRoleGrantedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(PayoutScalerRoleGranted)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(PayoutScalerRoleGranted)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *PayoutScalerRoleGrantedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *PayoutScalerRoleGrantedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// PayoutScalerRoleGranted represents a RoleGranted event raised by the PayoutScaler contract.
+type PayoutScalerRoleGranted struct {
+	Role    [32]byte
+	Account common.Address
+	Sender  common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterRoleGranted is a free log retrieval operation binding the contract event 0x2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d.
+//
+// Solidity: event RoleGranted(bytes32 indexed role, address indexed account, address indexed sender)
+func (_PayoutScaler *PayoutScalerFilterer) FilterRoleGranted(opts *bind.FilterOpts, role [][32]byte, account []common.Address, sender []common.Address) (*PayoutScalerRoleGrantedIterator, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _PayoutScaler.contract.FilterLogs(opts, "RoleGranted", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &PayoutScalerRoleGrantedIterator{contract: _PayoutScaler.contract, event: "RoleGranted", logs: logs, sub: sub}, nil
+}
+
+// WatchRoleGranted is a free log subscription operation binding the contract event 0x2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d.
+//
+// Solidity: event RoleGranted(bytes32 indexed role, address indexed account, address indexed sender)
+func (_PayoutScaler *PayoutScalerFilterer) WatchRoleGranted(opts *bind.WatchOpts, sink chan<- *PayoutScalerRoleGranted, role [][32]byte, account []common.Address, sender []common.Address) (event.Subscription, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _PayoutScaler.contract.WatchLogs(opts, "RoleGranted", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(PayoutScalerRoleGranted)
+				if err := _PayoutScaler.contract.UnpackLog(event, "RoleGranted", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRoleGranted is a log parse operation binding the contract event 0x2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d.
+//
+// Solidity: event RoleGranted(bytes32 indexed role, address indexed account, address indexed sender)
+func (_PayoutScaler *PayoutScalerFilterer) ParseRoleGranted(log types.Log) (*PayoutScalerRoleGranted, error) {
+	event := new(PayoutScalerRoleGranted)
+	if err := _PayoutScaler.contract.UnpackLog(event, "RoleGranted", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// PayoutScalerRoleRevokedIterator is returned from FilterRoleRevoked and is used to iterate over the raw logs and unpacked data for RoleRevoked events raised by the PayoutScaler contract.
+type PayoutScalerRoleRevokedIterator struct {
+	Event *PayoutScalerRoleRevoked // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *PayoutScalerRoleRevokedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(PayoutScalerRoleRevoked)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(PayoutScalerRoleRevoked)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *PayoutScalerRoleRevokedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *PayoutScalerRoleRevokedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// PayoutScalerRoleRevoked represents a RoleRevoked event raised by the PayoutScaler contract.
+type PayoutScalerRoleRevoked struct {
+	Role    [32]byte
+	Account common.Address
+	Sender  common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterRoleRevoked is a free log retrieval operation binding the contract event 0xf6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b.
+//
+// Solidity: event RoleRevoked(bytes32 indexed role, address indexed account, address indexed sender)
+func (_PayoutScaler *PayoutScalerFilterer) FilterRoleRevoked(opts *bind.FilterOpts, role [][32]byte, account []common.Address, sender []common.Address) (*PayoutScalerRoleRevokedIterator, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _PayoutScaler.contract.FilterLogs(opts, "RoleRevoked", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &PayoutScalerRoleRevokedIterator{contract: _PayoutScaler.contract, event: "RoleRevoked", logs: logs, sub: sub}, nil
+}
+
+// WatchRoleRevoked is a free log subscription operation binding the contract event 0xf6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b.
+//
+// Solidity: event RoleRevoked(bytes32 indexed role, address indexed account, address indexed sender)
+func (_PayoutScaler *PayoutScalerFilterer) WatchRoleRevoked(opts *bind.WatchOpts, sink chan<- *PayoutScalerRoleRevoked, role [][32]byte, account []common.Address, sender []common.Address) (event.Subscription, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _PayoutScaler.contract.WatchLogs(opts, "RoleRevoked", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(PayoutScalerRoleRevoked)
+				if err := _PayoutScaler.contract.UnpackLog(event, "RoleRevoked", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRoleRevoked is a log parse operation binding the contract event 0xf6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b.
+//
+// Solidity: event RoleRevoked(bytes32 indexed role, address indexed account, address indexed sender)
+func (_PayoutScaler *PayoutScalerFilterer) ParseRoleRevoked(log types.Log) (*PayoutScalerRoleRevoked, error) {
+	event := new(PayoutScalerRoleRevoked)
+	if err := _PayoutScaler.contract.UnpackLog(event, "RoleRevoked", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// PayoutScalerScalingCalculatedIterator is returned from FilterScalingCalculated and is used to iterate over the raw logs and unpacked data for ScalingCalculated events raised by the PayoutScaler contract.
+type PayoutScalerScalingCalculatedIterator struct {
+	Event *PayoutScalerScalingCalculated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *PayoutScalerScalingCalculatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(PayoutScalerScalingCalculated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(PayoutScalerScalingCalculated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *PayoutScalerScalingCalculatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *PayoutScalerScalingCalculatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// PayoutScalerScalingCalculated represents a ScalingCalculated event raised by the PayoutScaler contract.
+type PayoutScalerScalingCalculated struct {
+	Pool            uint8
+	Period          *big.Int
+	RequestedAmount *big.Int
+	AvailableBudget *big.Int
+	ScaleBps        *big.Int
+	ScaledAmount    *big.Int
+	RecordId        *big.Int
+	Raw             types.Log // Blockchain specific contextual infos
+}
+
+// FilterScalingCalculated is a free log retrieval operation binding the contract event 0xc07926cf1c1c8f8ac0fc9d3a1dbe16eb702b3402dbac2a8958c9861f6983fb75.
+//
+// Solidity: event ScalingCalculated(uint8 indexed pool, uint256 indexed period, uint256 requestedAmount, uint256 availableBudget, uint256 scaleBps, uint256 scaledAmount, uint256 recordId)
+func (_PayoutScaler *PayoutScalerFilterer) FilterScalingCalculated(opts *bind.FilterOpts, pool []uint8, period []*big.Int) (*PayoutScalerScalingCalculatedIterator, error) {
+
+	var poolRule []interface{}
+	for _, poolItem := range pool {
+		poolRule = append(poolRule, poolItem)
+	}
+	var periodRule []interface{}
+	for _, periodItem := range period {
+		periodRule = append(periodRule, periodItem)
+	}
+
+	logs, sub, err := _PayoutScaler.contract.FilterLogs(opts, "ScalingCalculated", poolRule, periodRule)
+	if err != nil {
+		return nil, err
+	}
+	return &PayoutScalerScalingCalculatedIterator{contract: _PayoutScaler.contract, event: "ScalingCalculated", logs: logs, sub: sub}, nil
+}
+
+// WatchScalingCalculated is a free log subscription operation binding the contract event 0xc07926cf1c1c8f8ac0fc9d3a1dbe16eb702b3402dbac2a8958c9861f6983fb75.
+//
+// Solidity: event ScalingCalculated(uint8 indexed pool, uint256 indexed period, uint256 requestedAmount, uint256 availableBudget, uint256 scaleBps, uint256 scaledAmount, uint256 recordId)
+func (_PayoutScaler *PayoutScalerFilterer) WatchScalingCalculated(opts *bind.WatchOpts, sink chan<- *PayoutScalerScalingCalculated, pool []uint8, period []*big.Int) (event.Subscription, error) {
+
+	var poolRule []interface{}
+	for _, poolItem := range pool {
+		poolRule = append(poolRule, poolItem)
+	}
+	var periodRule []interface{}
+	for _, periodItem := range period {
+		periodRule = append(periodRule, periodItem)
+	}
+
+	logs, sub, err := _PayoutScaler.contract.WatchLogs(opts, "ScalingCalculated", poolRule, periodRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(PayoutScalerScalingCalculated)
+				if err := _PayoutScaler.contract.UnpackLog(event, "ScalingCalculated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseScalingCalculated is a log parse operation binding the contract event 0xc07926cf1c1c8f8ac0fc9d3a1dbe16eb702b3402dbac2a8958c9861f6983fb75.
+//
+// Solidity: event ScalingCalculated(uint8 indexed pool, uint256 indexed period, uint256 requestedAmount, uint256 availableBudget, uint256 scaleBps, uint256 scaledAmount, uint256 recordId)
+func (_PayoutScaler *PayoutScalerFilterer) ParseScalingCalculated(log types.Log) (*PayoutScalerScalingCalculated, error) {
+	event := new(PayoutScalerScalingCalculated)
+	if err := _PayoutScaler.contract.UnpackLog(event, "ScalingCalculated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// PayoutScalerTreasuryUpdatedIterator is returned from FilterTreasuryUpdated and is used to iterate over the raw logs and unpacked data for TreasuryUpdated events raised by the PayoutScaler contract.
+type PayoutScalerTreasuryUpdatedIterator struct {
+	Event *PayoutScalerTreasuryUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *PayoutScalerTreasuryUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(PayoutScalerTreasuryUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(PayoutScalerTreasuryUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *PayoutScalerTreasuryUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *PayoutScalerTreasuryUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// PayoutScalerTreasuryUpdated represents a TreasuryUpdated event raised by the PayoutScaler contract.
+type PayoutScalerTreasuryUpdated struct {
+	OldTreasury common.Address
+	NewTreasury common.Address
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterTreasuryUpdated is a free log retrieval operation binding the contract event 0x4ab5be82436d353e61ca18726e984e561f5c1cc7c6d38b29d2553c790434705a.
+//
+// Solidity: event TreasuryUpdated(address indexed oldTreasury, address indexed newTreasury)
+func (_PayoutScaler *PayoutScalerFilterer) FilterTreasuryUpdated(opts *bind.FilterOpts, oldTreasury []common.Address, newTreasury []common.Address) (*PayoutScalerTreasuryUpdatedIterator, error) {
+
+	var oldTreasuryRule []interface{}
+	for _, oldTreasuryItem := range oldTreasury {
+		oldTreasuryRule = append(oldTreasuryRule, oldTreasuryItem)
+	}
+	var newTreasuryRule []interface{}
+	for _, newTreasuryItem := range newTreasury {
+		newTreasuryRule = append(newTreasuryRule, newTreasuryItem)
+	}
+
+	logs, sub, err := _PayoutScaler.contract.FilterLogs(opts, "TreasuryUpdated", oldTreasuryRule, newTreasuryRule)
+	if err != nil {
+		return nil, err
+	}
+	return &PayoutScalerTreasuryUpdatedIterator{contract: _PayoutScaler.contract, event: "TreasuryUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchTreasuryUpdated is a free log subscription operation binding the contract event 0x4ab5be82436d353e61ca18726e984e561f5c1cc7c6d38b29d2553c790434705a.
+//
+// Solidity: event TreasuryUpdated(address indexed oldTreasury, address indexed newTreasury)
+func (_PayoutScaler *PayoutScalerFilterer) WatchTreasuryUpdated(opts *bind.WatchOpts, sink chan<- *PayoutScalerTreasuryUpdated, oldTreasury []common.Address, newTreasury []common.Address) (event.Subscription, error) {
+
+	var oldTreasuryRule []interface{}
+	for _, oldTreasuryItem := range oldTreasury {
+		oldTreasuryRule = append(oldTreasuryRule, oldTreasuryItem)
+	}
+	var newTreasuryRule []interface{}
+	for _, newTreasuryItem := range newTreasury {
+		newTreasuryRule = append(newTreasuryRule, newTreasuryItem)
+	}
+
+	logs, sub, err := _PayoutScaler.contract.WatchLogs(opts, "TreasuryUpdated", oldTreasuryRule, newTreasuryRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(PayoutScalerTreasuryUpdated)
+				if err := _PayoutScaler.contract.UnpackLog(event, "TreasuryUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTreasuryUpdated is a log parse operation binding the contract event 0x4ab5be82436d353e61ca18726e984e561f5c1cc7c6d38b29d2553c790434705a.
+//
+// Solidity: event TreasuryUpdated(address indexed oldTreasury, address indexed newTreasury)
+func (_PayoutScaler *PayoutScalerFilterer) ParseTreasuryUpdated(log types.Log) (*PayoutScalerTreasuryUpdated, error) {
+	event := new(PayoutScalerTreasuryUpdated)
+	if err := _PayoutScaler.contract.UnpackLog(event, "TreasuryUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// PayoutScalerUnpausedIterator is returned from FilterUnpaused and is used to iterate over the raw logs and unpacked data for Unpaused events raised by the PayoutScaler contract.
+type PayoutScalerUnpausedIterator struct {
+	Event *PayoutScalerUnpaused // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *PayoutScalerUnpausedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(PayoutScalerUnpaused)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(PayoutScalerUnpaused)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *PayoutScalerUnpausedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *PayoutScalerUnpausedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// PayoutScalerUnpaused represents a Unpaused event raised by the PayoutScaler contract.
+type PayoutScalerUnpaused struct {
+	Account common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterUnpaused is a free log retrieval operation binding the contract event 0x5db9ee0a495bf2e6ff9c91a7834c1ba4fdd244a5e8aa4e537bd38aeae4b073aa.
+//
+// Solidity: event Unpaused(address account)
+func (_PayoutScaler *PayoutScalerFilterer) FilterUnpaused(opts *bind.FilterOpts) (*PayoutScalerUnpausedIterator, error) {
+
+	logs, sub, err := _PayoutScaler.contract.FilterLogs(opts, "Unpaused")
+	if err != nil {
+		return nil, err
+	}
+	return &PayoutScalerUnpausedIterator{contract: _PayoutScaler.contract, event: "Unpaused", logs: logs, sub: sub}, nil
+}
+
+// WatchUnpaused is a free log subscription operation binding the contract event 0x5db9ee0a495bf2e6ff9c91a7834c1ba4fdd244a5e8aa4e537bd38aeae4b073aa.
+//
+// Solidity: event Unpaused(address account)
+func (_PayoutScaler *PayoutScalerFilterer) WatchUnpaused(opts *bind.WatchOpts, sink chan<- *PayoutScalerUnpaused) (event.Subscription, error) {
+
+	logs, sub, err := _PayoutScaler.contract.WatchLogs(opts, "Unpaused")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(PayoutScalerUnpaused)
+				if err := _PayoutScaler.contract.UnpackLog(event, "Unpaused", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUnpaused is a log parse operation binding the contract event 0x5db9ee0a495bf2e6ff9c91a7834c1ba4fdd244a5e8aa4e537bd38aeae4b073aa.
+//
+// Solidity: event Unpaused(address account)
+func (_PayoutScaler *PayoutScalerFilterer) ParseUnpaused(log types.Log) (*PayoutScalerUnpaused, error) {
+	event := new(PayoutScalerUnpaused)
+	if err := _PayoutScaler.contract.UnpackLog(event, "Unpaused", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,243 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package bindings
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// IPricingStrategyMetaData contains all meta data concerning the IPricingStrategy contract.
+var IPricingStrategyMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[],\"name\":\"strategyType\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"pure\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"requiresInitialLiquidity\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"pure\",\"type\":\"function\"}]",
+}
+
+// IPricingStrategyABI is the input ABI used to generate the binding from.
+// Deprecated: Use IPricingStrategyMetaData.ABI instead.
+var IPricingStrategyABI = IPricingStrategyMetaData.ABI
+
+// IPricingStrategy is an auto generated Go binding around an Ethereum contract.
+type IPricingStrategy struct {
+	IPricingStrategyCaller     // Read-only binding to the contract
+	IPricingStrategyTransactor // Write-only binding to the contract
+	IPricingStrategyFilterer   // Log filterer for contract events
+}
+
+// IPricingStrategyCaller is an auto generated read-only Go binding around an Ethereum contract.
+type IPricingStrategyCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IPricingStrategyTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type IPricingStrategyTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IPricingStrategyFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type IPricingStrategyFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IPricingStrategySession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type IPricingStrategySession struct {
+	Contract     *IPricingStrategy // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// IPricingStrategyCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type IPricingStrategyCallerSession struct {
+	Contract *IPricingStrategyCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts           // Call options to use throughout this session
+}
+
+// IPricingStrategyTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type IPricingStrategyTransactorSession struct {
+	Contract     *IPricingStrategyTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts           // Transaction auth options to use throughout this session
+}
+
+// IPricingStrategyRaw is an auto generated low-level Go binding around an Ethereum contract.
+type IPricingStrategyRaw struct {
+	Contract *IPricingStrategy // Generic contract binding to access the raw methods on
+}
+
+// IPricingStrategyCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type IPricingStrategyCallerRaw struct {
+	Contract *IPricingStrategyCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// IPricingStrategyTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type IPricingStrategyTransactorRaw struct {
+	Contract *IPricingStrategyTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewIPricingStrategy creates a new instance of IPricingStrategy, bound to a specific deployed contract.
+func NewIPricingStrategy(address common.Address, backend bind.ContractBackend) (*IPricingStrategy, error) {
+	contract, err := bindIPricingStrategy(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &IPricingStrategy{IPricingStrategyCaller: IPricingStrategyCaller{contract: contract}, IPricingStrategyTransactor: IPricingStrategyTransactor{contract: contract}, IPricingStrategyFilterer: IPricingStrategyFilterer{contract: contract}}, nil
+}
+
+// NewIPricingStrategyCaller creates a new read-only instance of IPricingStrategy, bound to a specific deployed contract.
+func NewIPricingStrategyCaller(address common.Address, caller bind.ContractCaller) (*IPricingStrategyCaller, error) {
+	contract, err := bindIPricingStrategy(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &IPricingStrategyCaller{contract: contract}, nil
+}
+
+// NewIPricingStrategyTransactor creates a new write-only instance of IPricingStrategy, bound to a specific deployed contract.
+func NewIPricingStrategyTransactor(address common.Address, transactor bind.ContractTransactor) (*IPricingStrategyTransactor, error) {
+	contract, err := bindIPricingStrategy(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &IPricingStrategyTransactor{contract: contract}, nil
+}
+
+// NewIPricingStrategyFilterer creates a new log filterer instance of IPricingStrategy, bound to a specific deployed contract.
+func NewIPricingStrategyFilterer(address common.Address, filterer bind.ContractFilterer) (*IPricingStrategyFilterer, error) {
+	contract, err := bindIPricingStrategy(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &IPricingStrategyFilterer{contract: contract}, nil
+}
+
+// bindIPricingStrategy binds a generic wrapper to an already deployed contract.
+func bindIPricingStrategy(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := IPricingStrategyMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_IPricingStrategy *IPricingStrategyRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _IPricingStrategy.Contract.IPricingStrategyCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_IPricingStrategy *IPricingStrategyRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _IPricingStrategy.Contract.IPricingStrategyTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_IPricingStrategy *IPricingStrategyRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _IPricingStrategy.Contract.IPricingStrategyTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_IPricingStrategy *IPricingStrategyCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _IPricingStrategy.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_IPricingStrategy *IPricingStrategyTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _IPricingStrategy.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_IPricingStrategy *IPricingStrategyTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _IPricingStrategy.Contract.contract.Transact(opts, method, params...)
+}
+
+// RequiresInitialLiquidity is a free data retrieval call binding the contract method 0x718c6894.
+//
+// Solidity: function requiresInitialLiquidity() pure returns(bool)
+func (_IPricingStrategy *IPricingStrategyCaller) RequiresInitialLiquidity(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _IPricingStrategy.contract.Call(opts, &out, "requiresInitialLiquidity")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// RequiresInitialLiquidity is a free data retrieval call binding the contract method 0x718c6894.
+//
+// Solidity: function requiresInitialLiquidity() pure returns(bool)
+func (_IPricingStrategy *IPricingStrategySession) RequiresInitialLiquidity() (bool, error) {
+	return _IPricingStrategy.Contract.RequiresInitialLiquidity(&_IPricingStrategy.CallOpts)
+}
+
+// RequiresInitialLiquidity is a free data retrieval call binding the contract method 0x718c6894.
+//
+// Solidity: function requiresInitialLiquidity() pure returns(bool)
+func (_IPricingStrategy *IPricingStrategyCallerSession) RequiresInitialLiquidity() (bool, error) {
+	return _IPricingStrategy.Contract.RequiresInitialLiquidity(&_IPricingStrategy.CallOpts)
+}
+
+// StrategyType is a free data retrieval call binding the contract method 0x82ccd330.
+//
+// Solidity: function strategyType() pure returns(string)
+func (_IPricingStrategy *IPricingStrategyCaller) StrategyType(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _IPricingStrategy.contract.Call(opts, &out, "strategyType")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// StrategyType is a free data retrieval call binding the contract method 0x82ccd330.
+//
+// Solidity: function strategyType() pure returns(string)
+func (_IPricingStrategy *IPricingStrategySession) StrategyType() (string, error) {
+	return _IPricingStrategy.Contract.StrategyType(&_IPricingStrategy.CallOpts)
+}
+
+// StrategyType is a free data retrieval call binding the contract method 0x82ccd330.
+//
+// Solidity: function strategyType() pure returns(string)
+func (_IPricingStrategy *IPricingStrategyCallerSession) StrategyType() (string, error) {
+	return _IPricingStrategy.Contract.StrategyType(&_IPricingStrategy.CallOpts)
+}
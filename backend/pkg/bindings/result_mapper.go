@@ -0,0 +1,457 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package bindings
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// IResultMapperMetaData contains all meta data concerning the IResultMapper contract.
+var IResultMapperMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"function\",\"name\":\"getAllOutcomeNames\",\"inputs\":[],\"outputs\":[{\"name\":\"names\",\"type\":\"string[]\",\"internalType\":\"string[]\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getOutcomeName\",\"inputs\":[{\"name\":\"outcomeId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"name\",\"type\":\"string\",\"internalType\":\"string\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getParams\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"mapResult\",\"inputs\":[{\"name\":\"rawResult\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"outcomeIds\",\"type\":\"uint256[]\",\"internalType\":\"uint256[]\"},{\"name\":\"weights\",\"type\":\"uint256[]\",\"internalType\":\"uint256[]\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"mapperType\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"string\",\"internalType\":\"string\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"outcomeCount\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"previewResult\",\"inputs\":[{\"name\":\"homeScore\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"awayScore\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"outcomeIds\",\"type\":\"uint256[]\",\"internalType\":\"uint256[]\"},{\"name\":\"weights\",\"type\":\"uint256[]\",\"internalType\":\"uint256[]\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"version\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"string\",\"internalType\":\"string\"}],\"stateMutability\":\"pure\"}]",
+}
+
+// IResultMapperABI is the input ABI used to generate the binding from.
+// Deprecated: Use IResultMapperMetaData.ABI instead.
+var IResultMapperABI = IResultMapperMetaData.ABI
+
+// IResultMapper is an auto generated Go binding around an Ethereum contract.
+type IResultMapper struct {
+	IResultMapperCaller     // Read-only binding to the contract
+	IResultMapperTransactor // Write-only binding to the contract
+	IResultMapperFilterer   // Log filterer for contract events
+}
+
+// IResultMapperCaller is an auto generated read-only Go binding around an Ethereum contract.
+type IResultMapperCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IResultMapperTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type IResultMapperTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IResultMapperFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type IResultMapperFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IResultMapperSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type IResultMapperSession struct {
+	Contract     *IResultMapper    // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// IResultMapperCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type IResultMapperCallerSession struct {
+	Contract *IResultMapperCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts        // Call options to use throughout this session
+}
+
+// IResultMapperTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type IResultMapperTransactorSession struct {
+	Contract     *IResultMapperTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts        // Transaction auth options to use throughout this session
+}
+
+// IResultMapperRaw is an auto generated low-level Go binding around an Ethereum contract.
+type IResultMapperRaw struct {
+	Contract *IResultMapper // Generic contract binding to access the raw methods on
+}
+
+// IResultMapperCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type IResultMapperCallerRaw struct {
+	Contract *IResultMapperCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// IResultMapperTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type IResultMapperTransactorRaw struct {
+	Contract *IResultMapperTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewIResultMapper creates a new instance of IResultMapper, bound to a specific deployed contract.
+func NewIResultMapper(address common.Address, backend bind.ContractBackend) (*IResultMapper, error) {
+	contract, err := bindIResultMapper(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &IResultMapper{IResultMapperCaller: IResultMapperCaller{contract: contract}, IResultMapperTransactor: IResultMapperTransactor{contract: contract}, IResultMapperFilterer: IResultMapperFilterer{contract: contract}}, nil
+}
+
+// NewIResultMapperCaller creates a new read-only instance of IResultMapper, bound to a specific deployed contract.
+func NewIResultMapperCaller(address common.Address, caller bind.ContractCaller) (*IResultMapperCaller, error) {
+	contract, err := bindIResultMapper(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &IResultMapperCaller{contract: contract}, nil
+}
+
+// NewIResultMapperTransactor creates a new write-only instance of IResultMapper, bound to a specific deployed contract.
+func NewIResultMapperTransactor(address common.Address, transactor bind.ContractTransactor) (*IResultMapperTransactor, error) {
+	contract, err := bindIResultMapper(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &IResultMapperTransactor{contract: contract}, nil
+}
+
+// NewIResultMapperFilterer creates a new log filterer instance of IResultMapper, bound to a specific deployed contract.
+func NewIResultMapperFilterer(address common.Address, filterer bind.ContractFilterer) (*IResultMapperFilterer, error) {
+	contract, err := bindIResultMapper(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &IResultMapperFilterer{contract: contract}, nil
+}
+
+// bindIResultMapper binds a generic wrapper to an already deployed contract.
+func bindIResultMapper(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := IResultMapperMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_IResultMapper *IResultMapperRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _IResultMapper.Contract.IResultMapperCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_IResultMapper *IResultMapperRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _IResultMapper.Contract.IResultMapperTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_IResultMapper *IResultMapperRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _IResultMapper.Contract.IResultMapperTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_IResultMapper *IResultMapperCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _IResultMapper.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_IResultMapper *IResultMapperTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _IResultMapper.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_IResultMapper *IResultMapperTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _IResultMapper.Contract.contract.Transact(opts, method, params...)
+}
+
+// GetAllOutcomeNames is a free data retrieval call binding the contract method 0x69596484.
+//
+// Solidity: function getAllOutcomeNames() view returns(string[] names)
+func (_IResultMapper *IResultMapperCaller) GetAllOutcomeNames(opts *bind.CallOpts) ([]string, error) {
+	var out []interface{}
+	err := _IResultMapper.contract.Call(opts, &out, "getAllOutcomeNames")
+
+	if err != nil {
+		return *new([]string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]string)).(*[]string)
+
+	return out0, err
+
+}
+
+// GetAllOutcomeNames is a free data retrieval call binding the contract method 0x69596484.
+//
+// Solidity: function getAllOutcomeNames() view returns(string[] names)
+func (_IResultMapper *IResultMapperSession) GetAllOutcomeNames() ([]string, error) {
+	return _IResultMapper.Contract.GetAllOutcomeNames(&_IResultMapper.CallOpts)
+}
+
+// GetAllOutcomeNames is a free data retrieval call binding the contract method 0x69596484.
+//
+// Solidity: function getAllOutcomeNames() view returns(string[] names)
+func (_IResultMapper *IResultMapperCallerSession) GetAllOutcomeNames() ([]string, error) {
+	return _IResultMapper.Contract.GetAllOutcomeNames(&_IResultMapper.CallOpts)
+}
+
+// GetOutcomeName is a free data retrieval call binding the contract method 0xde5005cd.
+//
+// Solidity: function getOutcomeName(uint256 outcomeId) view returns(string name)
+func (_IResultMapper *IResultMapperCaller) GetOutcomeName(opts *bind.CallOpts, outcomeId *big.Int) (string, error) {
+	var out []interface{}
+	err := _IResultMapper.contract.Call(opts, &out, "getOutcomeName", outcomeId)
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// GetOutcomeName is a free data retrieval call binding the contract method 0xde5005cd.
+//
+// Solidity: function getOutcomeName(uint256 outcomeId) view returns(string name)
+func (_IResultMapper *IResultMapperSession) GetOutcomeName(outcomeId *big.Int) (string, error) {
+	return _IResultMapper.Contract.GetOutcomeName(&_IResultMapper.CallOpts, outcomeId)
+}
+
+// GetOutcomeName is a free data retrieval call binding the contract method 0xde5005cd.
+//
+// Solidity: function getOutcomeName(uint256 outcomeId) view returns(string name)
+func (_IResultMapper *IResultMapperCallerSession) GetOutcomeName(outcomeId *big.Int) (string, error) {
+	return _IResultMapper.Contract.GetOutcomeName(&_IResultMapper.CallOpts, outcomeId)
+}
+
+// GetParams is a free data retrieval call binding the contract method 0x5e615a6b.
+//
+// Solidity: function getParams() view returns(bytes)
+func (_IResultMapper *IResultMapperCaller) GetParams(opts *bind.CallOpts) ([]byte, error) {
+	var out []interface{}
+	err := _IResultMapper.contract.Call(opts, &out, "getParams")
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// GetParams is a free data retrieval call binding the contract method 0x5e615a6b.
+//
+// Solidity: function getParams() view returns(bytes)
+func (_IResultMapper *IResultMapperSession) GetParams() ([]byte, error) {
+	return _IResultMapper.Contract.GetParams(&_IResultMapper.CallOpts)
+}
+
+// GetParams is a free data retrieval call binding the contract method 0x5e615a6b.
+//
+// Solidity: function getParams() view returns(bytes)
+func (_IResultMapper *IResultMapperCallerSession) GetParams() ([]byte, error) {
+	return _IResultMapper.Contract.GetParams(&_IResultMapper.CallOpts)
+}
+
+// MapResult is a free data retrieval call binding the contract method 0x46e56c11.
+//
+// Solidity: function mapResult(bytes rawResult) view returns(uint256[] outcomeIds, uint256[] weights)
+func (_IResultMapper *IResultMapperCaller) MapResult(opts *bind.CallOpts, rawResult []byte) (struct {
+	OutcomeIds []*big.Int
+	Weights    []*big.Int
+}, error) {
+	var out []interface{}
+	err := _IResultMapper.contract.Call(opts, &out, "mapResult", rawResult)
+
+	outstruct := new(struct {
+		OutcomeIds []*big.Int
+		Weights    []*big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.OutcomeIds = *abi.ConvertType(out[0], new([]*big.Int)).(*[]*big.Int)
+	outstruct.Weights = *abi.ConvertType(out[1], new([]*big.Int)).(*[]*big.Int)
+
+	return *outstruct, err
+
+}
+
+// MapResult is a free data retrieval call binding the contract method 0x46e56c11.
+//
+// Solidity: function mapResult(bytes rawResult) view returns(uint256[] outcomeIds, uint256[] weights)
+func (_IResultMapper *IResultMapperSession) MapResult(rawResult []byte) (struct {
+	OutcomeIds []*big.Int
+	Weights    []*big.Int
+}, error) {
+	return _IResultMapper.Contract.MapResult(&_IResultMapper.CallOpts, rawResult)
+}
+
+// MapResult is a free data retrieval call binding the contract method 0x46e56c11.
+//
+// Solidity: function mapResult(bytes rawResult) view returns(uint256[] outcomeIds, uint256[] weights)
+func (_IResultMapper *IResultMapperCallerSession) MapResult(rawResult []byte) (struct {
+	OutcomeIds []*big.Int
+	Weights    []*big.Int
+}, error) {
+	return _IResultMapper.Contract.MapResult(&_IResultMapper.CallOpts, rawResult)
+}
+
+// MapperType is a free data retrieval call binding the contract method 0x70b4293d.
+//
+// Solidity: function mapperType() pure returns(string)
+func (_IResultMapper *IResultMapperCaller) MapperType(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _IResultMapper.contract.Call(opts, &out, "mapperType")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// MapperType is a free data retrieval call binding the contract method 0x70b4293d.
+//
+// Solidity: function mapperType() pure returns(string)
+func (_IResultMapper *IResultMapperSession) MapperType() (string, error) {
+	return _IResultMapper.Contract.MapperType(&_IResultMapper.CallOpts)
+}
+
+// MapperType is a free data retrieval call binding the contract method 0x70b4293d.
+//
+// Solidity: function mapperType() pure returns(string)
+func (_IResultMapper *IResultMapperCallerSession) MapperType() (string, error) {
+	return _IResultMapper.Contract.MapperType(&_IResultMapper.CallOpts)
+}
+
+// OutcomeCount is a free data retrieval call binding the contract method 0xd300cb31.
+//
+// Solidity: function outcomeCount() view returns(uint256)
+func (_IResultMapper *IResultMapperCaller) OutcomeCount(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _IResultMapper.contract.Call(opts, &out, "outcomeCount")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// OutcomeCount is a free data retrieval call binding the contract method 0xd300cb31.
+//
+// Solidity: function outcomeCount() view returns(uint256)
+func (_IResultMapper *IResultMapperSession) OutcomeCount() (*big.Int, error) {
+	return _IResultMapper.Contract.OutcomeCount(&_IResultMapper.CallOpts)
+}
+
+// OutcomeCount is a free data retrieval call binding the contract method 0xd300cb31.
+//
+// Solidity: function outcomeCount() view returns(uint256)
+func (_IResultMapper *IResultMapperCallerSession) OutcomeCount() (*big.Int, error) {
+	return _IResultMapper.Contract.OutcomeCount(&_IResultMapper.CallOpts)
+}
+
+// PreviewResult is a free data retrieval call binding the contract method 0xe2db4f04.
+//
+// Solidity: function previewResult(uint256 homeScore, uint256 awayScore) view returns(uint256[] outcomeIds, uint256[] weights)
+func (_IResultMapper *IResultMapperCaller) PreviewResult(opts *bind.CallOpts, homeScore *big.Int, awayScore *big.Int) (struct {
+	OutcomeIds []*big.Int
+	Weights    []*big.Int
+}, error) {
+	var out []interface{}
+	err := _IResultMapper.contract.Call(opts, &out, "previewResult", homeScore, awayScore)
+
+	outstruct := new(struct {
+		OutcomeIds []*big.Int
+		Weights    []*big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.OutcomeIds = *abi.ConvertType(out[0], new([]*big.Int)).(*[]*big.Int)
+	outstruct.Weights = *abi.ConvertType(out[1], new([]*big.Int)).(*[]*big.Int)
+
+	return *outstruct, err
+
+}
+
+// PreviewResult is a free data retrieval call binding the contract method 0xe2db4f04.
+//
+// Solidity: function previewResult(uint256 homeScore, uint256 awayScore) view returns(uint256[] outcomeIds, uint256[] weights)
+func (_IResultMapper *IResultMapperSession) PreviewResult(homeScore *big.Int, awayScore *big.Int) (struct {
+	OutcomeIds []*big.Int
+	Weights    []*big.Int
+}, error) {
+	return _IResultMapper.Contract.PreviewResult(&_IResultMapper.CallOpts, homeScore, awayScore)
+}
+
+// PreviewResult is a free data retrieval call binding the contract method 0xe2db4f04.
+//
+// Solidity: function previewResult(uint256 homeScore, uint256 awayScore) view returns(uint256[] outcomeIds, uint256[] weights)
+func (_IResultMapper *IResultMapperCallerSession) PreviewResult(homeScore *big.Int, awayScore *big.Int) (struct {
+	OutcomeIds []*big.Int
+	Weights    []*big.Int
+}, error) {
+	return _IResultMapper.Contract.PreviewResult(&_IResultMapper.CallOpts, homeScore, awayScore)
+}
+
+// Version is a free data retrieval call binding the contract method 0x54fd4d50.
+//
+// Solidity: function version() pure returns(string)
+func (_IResultMapper *IResultMapperCaller) Version(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _IResultMapper.contract.Call(opts, &out, "version")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Version is a free data retrieval call binding the contract method 0x54fd4d50.
+//
+// Solidity: function version() pure returns(string)
+func (_IResultMapper *IResultMapperSession) Version() (string, error) {
+	return _IResultMapper.Contract.Version(&_IResultMapper.CallOpts)
+}
+
+// Version is a free data retrieval call binding the contract method 0x54fd4d50.
+//
+// Solidity: function version() pure returns(string)
+func (_IResultMapper *IResultMapperCallerSession) Version() (string, error) {
+	return _IResultMapper.Contract.Version(&_IResultMapper.CallOpts)
+}
@@ -0,0 +1,2258 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package bindings
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// RewardsDistributorMetaData contains all meta data concerning the RewardsDistributor contract.
+var RewardsDistributorMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[{\"name\":\"_rewardToken\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"_emergencyWithdrawAddress\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"BPS_DENOMINATOR\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"DEFAULT_VESTING_DURATION\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"MIN_SCALE_BPS\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"batchClaim\",\"inputs\":[{\"name\":\"weekNumbers\",\"type\":\"uint256[]\",\"internalType\":\"uint256[]\"},{\"name\":\"amounts\",\"type\":\"uint256[]\",\"internalType\":\"uint256[]\"},{\"name\":\"proofs\",\"type\":\"bytes32[][]\",\"internalType\":\"bytes32[][]\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"claim\",\"inputs\":[{\"name\":\"week\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"proof\",\"type\":\"bytes32[]\",\"internalType\":\"bytes32[]\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"claimed\",\"inputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"currentWeek\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"emergencyWithdraw\",\"inputs\":[{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"emergencyWithdrawAddress\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getBatchClaimed\",\"inputs\":[{\"name\":\"user\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"weekNumbers\",\"type\":\"uint256[]\",\"internalType\":\"uint256[]\"}],\"outputs\":[{\"name\":\"amounts\",\"type\":\"uint256[]\",\"internalType\":\"uint256[]\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getClaimable\",\"inputs\":[{\"name\":\"user\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"week\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"proof\",\"type\":\"bytes32[]\",\"internalType\":\"bytes32[]\"}],\"outputs\":[{\"name\":\"claimable\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"alreadyClaimed\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getWeekStats\",\"inputs\":[{\"name\":\"week\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"merkleRoot\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"totalAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"scaleBps\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"claimedAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"claimRate\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"isPublisher\",\"inputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"owner\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"pause\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"paused\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"publishRoot\",\"inputs\":[{\"name\":\"week\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"merkleRoot\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"totalAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"scaleBps\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"renounceOwnership\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"rewardToken\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"contractIERC20\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"setEmergencyWithdrawAddress\",\"inputs\":[{\"name\":\"_address\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setPublisher\",\"inputs\":[{\"name\":\"publisher\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"enabled\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setVestingConfig\",\"inputs\":[{\"name\":\"enabled\",\"type\":\"bool\",\"internalType\":\"bool\"},{\"name\":\"duration\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"transferOwnership\",\"inputs\":[{\"name\":\"newOwner\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"unpause\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"vestingConfig\",\"inputs\":[],\"outputs\":[{\"name\":\"enabled\",\"type\":\"bool\",\"internalType\":\"bool\"},{\"name\":\"vestingDuration\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"weeklyRewards\",\"inputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"merkleRoot\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"totalAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"scaleBps\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"publishedAt\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"claimedAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"event\",\"name\":\"BatchClaimed\",\"inputs\":[{\"name\":\"user\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"weekCount\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"totalAmount\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"EmergencyWithdraw\",\"inputs\":[{\"name\":\"to\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"OwnershipTransferred\",\"inputs\":[{\"name\":\"previousOwner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"newOwner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"Paused\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"indexed\":false,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"PublisherUpdated\",\"inputs\":[{\"name\":\"publisher\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"enabled\",\"type\":\"bool\",\"indexed\":false,\"internalType\":\"bool\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"RewardClaimed\",\"inputs\":[{\"name\":\"user\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"week\",\"type\":\"uint256\",\"indexed\":true,\"internalType\":\"uint256\"},{\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"claimedAt\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"RewardsRootPublished\",\"inputs\":[{\"name\":\"week\",\"type\":\"uint256\",\"indexed\":true,\"internalType\":\"uint256\"},{\"name\":\"merkleRoot\",\"type\":\"bytes32\",\"indexed\":false,\"internalType\":\"bytes32\"},{\"name\":\"totalAmount\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"scaleBps\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"publishedAt\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"Unpaused\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"indexed\":false,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"VestingConfigUpdated\",\"inputs\":[{\"name\":\"enabled\",\"type\":\"bool\",\"indexed\":false,\"internalType\":\"bool\"},{\"name\":\"duration\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"error\",\"name\":\"AlreadyClaimed\",\"inputs\":[{\"name\":\"user\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"week\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"EmptyWeeksArray\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"EnforcedPause\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"ExpectedPause\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InsufficientBalance\",\"inputs\":[{\"name\":\"required\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"available\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"InvalidMerkleRoot\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InvalidProof\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InvalidScaleBps\",\"inputs\":[{\"name\":\"provided\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"min\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"InvalidVestingDuration\",\"inputs\":[{\"name\":\"duration\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"OwnableInvalidOwner\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"OwnableUnauthorizedAccount\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"SafeERC20FailedOperation\",\"inputs\":[{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"UnauthorizedPublisher\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"WeekAlreadyPublished\",\"inputs\":[{\"name\":\"week\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"WeekNotPublished\",\"inputs\":[{\"name\":\"week\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"ZeroAddress\",\"inputs\":[]}]",
+}
+
+// RewardsDistributorABI is the input ABI used to generate the binding from.
+// Deprecated: Use RewardsDistributorMetaData.ABI instead.
+var RewardsDistributorABI = RewardsDistributorMetaData.ABI
+
+// RewardsDistributor is an auto generated Go binding around an Ethereum contract.
+type RewardsDistributor struct {
+	RewardsDistributorCaller     // Read-only binding to the contract
+	RewardsDistributorTransactor // Write-only binding to the contract
+	RewardsDistributorFilterer   // Log filterer for contract events
+}
+
+// RewardsDistributorCaller is an auto generated read-only Go binding around an Ethereum contract.
+type RewardsDistributorCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// RewardsDistributorTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type RewardsDistributorTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// RewardsDistributorFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type RewardsDistributorFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// RewardsDistributorSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type RewardsDistributorSession struct {
+	Contract     *RewardsDistributor // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts       // Call options to use throughout this session
+	TransactOpts bind.TransactOpts   // Transaction auth options to use throughout this session
+}
+
+// RewardsDistributorCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type RewardsDistributorCallerSession struct {
+	Contract *RewardsDistributorCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts             // Call options to use throughout this session
+}
+
+// RewardsDistributorTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type RewardsDistributorTransactorSession struct {
+	Contract     *RewardsDistributorTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts             // Transaction auth options to use throughout this session
+}
+
+// RewardsDistributorRaw is an auto generated low-level Go binding around an Ethereum contract.
+type RewardsDistributorRaw struct {
+	Contract *RewardsDistributor // Generic contract binding to access the raw methods on
+}
+
+// RewardsDistributorCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type RewardsDistributorCallerRaw struct {
+	Contract *RewardsDistributorCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// RewardsDistributorTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type RewardsDistributorTransactorRaw struct {
+	Contract *RewardsDistributorTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewRewardsDistributor creates a new instance of RewardsDistributor, bound to a specific deployed contract.
+func NewRewardsDistributor(address common.Address, backend bind.ContractBackend) (*RewardsDistributor, error) {
+	contract, err := bindRewardsDistributor(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &RewardsDistributor{RewardsDistributorCaller: RewardsDistributorCaller{contract: contract}, RewardsDistributorTransactor: RewardsDistributorTransactor{contract: contract}, RewardsDistributorFilterer: RewardsDistributorFilterer{contract: contract}}, nil
+}
+
+// NewRewardsDistributorCaller creates a new read-only instance of RewardsDistributor, bound to a specific deployed contract.
+func NewRewardsDistributorCaller(address common.Address, caller bind.ContractCaller) (*RewardsDistributorCaller, error) {
+	contract, err := bindRewardsDistributor(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &RewardsDistributorCaller{contract: contract}, nil
+}
+
+// NewRewardsDistributorTransactor creates a new write-only instance of RewardsDistributor, bound to a specific deployed contract.
+func NewRewardsDistributorTransactor(address common.Address, transactor bind.ContractTransactor) (*RewardsDistributorTransactor, error) {
+	contract, err := bindRewardsDistributor(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &RewardsDistributorTransactor{contract: contract}, nil
+}
+
+// NewRewardsDistributorFilterer creates a new log filterer instance of RewardsDistributor, bound to a specific deployed contract.
+func NewRewardsDistributorFilterer(address common.Address, filterer bind.ContractFilterer) (*RewardsDistributorFilterer, error) {
+	contract, err := bindRewardsDistributor(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &RewardsDistributorFilterer{contract: contract}, nil
+}
+
+// bindRewardsDistributor binds a generic wrapper to an already deployed contract.
+func bindRewardsDistributor(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := RewardsDistributorMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_RewardsDistributor *RewardsDistributorRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _RewardsDistributor.Contract.RewardsDistributorCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_RewardsDistributor *RewardsDistributorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _RewardsDistributor.Contract.RewardsDistributorTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_RewardsDistributor *RewardsDistributorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _RewardsDistributor.Contract.RewardsDistributorTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_RewardsDistributor *RewardsDistributorCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _RewardsDistributor.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_RewardsDistributor *RewardsDistributorTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _RewardsDistributor.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_RewardsDistributor *RewardsDistributorTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _RewardsDistributor.Contract.contract.Transact(opts, method, params...)
+}
+
+// BPSDENOMINATOR is a free data retrieval call binding the contract method 0xe1a45218.
+//
+// Solidity: function BPS_DENOMINATOR() view returns(uint256)
+func (_RewardsDistributor *RewardsDistributorCaller) BPSDENOMINATOR(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _RewardsDistributor.contract.Call(opts, &out, "BPS_DENOMINATOR")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BPSDENOMINATOR is a free data retrieval call binding the contract method 0xe1a45218.
+//
+// Solidity: function BPS_DENOMINATOR() view returns(uint256)
+func (_RewardsDistributor *RewardsDistributorSession) BPSDENOMINATOR() (*big.Int, error) {
+	return _RewardsDistributor.Contract.BPSDENOMINATOR(&_RewardsDistributor.CallOpts)
+}
+
+// BPSDENOMINATOR is a free data retrieval call binding the contract method 0xe1a45218.
+//
+// Solidity: function BPS_DENOMINATOR() view returns(uint256)
+func (_RewardsDistributor *RewardsDistributorCallerSession) BPSDENOMINATOR() (*big.Int, error) {
+	return _RewardsDistributor.Contract.BPSDENOMINATOR(&_RewardsDistributor.CallOpts)
+}
+
+// DEFAULTVESTINGDURATION is a free data retrieval call binding the contract method 0x2714c51c.
+//
+// Solidity: function DEFAULT_VESTING_DURATION() view returns(uint256)
+func (_RewardsDistributor *RewardsDistributorCaller) DEFAULTVESTINGDURATION(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _RewardsDistributor.contract.Call(opts, &out, "DEFAULT_VESTING_DURATION")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// DEFAULTVESTINGDURATION is a free data retrieval call binding the contract method 0x2714c51c.
+//
+// Solidity: function DEFAULT_VESTING_DURATION() view returns(uint256)
+func (_RewardsDistributor *RewardsDistributorSession) DEFAULTVESTINGDURATION() (*big.Int, error) {
+	return _RewardsDistributor.Contract.DEFAULTVESTINGDURATION(&_RewardsDistributor.CallOpts)
+}
+
+// DEFAULTVESTINGDURATION is a free data retrieval call binding the contract method 0x2714c51c.
+//
+// Solidity: function DEFAULT_VESTING_DURATION() view returns(uint256)
+func (_RewardsDistributor *RewardsDistributorCallerSession) DEFAULTVESTINGDURATION() (*big.Int, error) {
+	return _RewardsDistributor.Contract.DEFAULTVESTINGDURATION(&_RewardsDistributor.CallOpts)
+}
+
+// MINSCALEBPS is a free data retrieval call binding the contract method 0x18fafd65.
+//
+// Solidity: function MIN_SCALE_BPS() view returns(uint256)
+func (_RewardsDistributor *RewardsDistributorCaller) MINSCALEBPS(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _RewardsDistributor.contract.Call(opts, &out, "MIN_SCALE_BPS")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MINSCALEBPS is a free data retrieval call binding the contract method 0x18fafd65.
+//
+// Solidity: function MIN_SCALE_BPS() view returns(uint256)
+func (_RewardsDistributor *RewardsDistributorSession) MINSCALEBPS() (*big.Int, error) {
+	return _RewardsDistributor.Contract.MINSCALEBPS(&_RewardsDistributor.CallOpts)
+}
+
+// MINSCALEBPS is a free data retrieval call binding the contract method 0x18fafd65.
+//
+// Solidity: function MIN_SCALE_BPS() view returns(uint256)
+func (_RewardsDistributor *RewardsDistributorCallerSession) MINSCALEBPS() (*big.Int, error) {
+	return _RewardsDistributor.Contract.MINSCALEBPS(&_RewardsDistributor.CallOpts)
+}
+
+// Claimed is a free data retrieval call binding the contract method 0x4dd6c8de.
+//
+// Solidity: function claimed(address , uint256 ) view returns(uint256)
+func (_RewardsDistributor *RewardsDistributorCaller) Claimed(opts *bind.CallOpts, arg0 common.Address, arg1 *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _RewardsDistributor.contract.Call(opts, &out, "claimed", arg0, arg1)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// Claimed is a free data retrieval call binding the contract method 0x4dd6c8de.
+//
+// Solidity: function claimed(address , uint256 ) view returns(uint256)
+func (_RewardsDistributor *RewardsDistributorSession) Claimed(arg0 common.Address, arg1 *big.Int) (*big.Int, error) {
+	return _RewardsDistributor.Contract.Claimed(&_RewardsDistributor.CallOpts, arg0, arg1)
+}
+
+// Claimed is a free data retrieval call binding the contract method 0x4dd6c8de.
+//
+// Solidity: function claimed(address , uint256 ) view returns(uint256)
+func (_RewardsDistributor *RewardsDistributorCallerSession) Claimed(arg0 common.Address, arg1 *big.Int) (*big.Int, error) {
+	return _RewardsDistributor.Contract.Claimed(&_RewardsDistributor.CallOpts, arg0, arg1)
+}
+
+// CurrentWeek is a free data retrieval call binding the contract method 0x06575c89.
+//
+// Solidity: function currentWeek() view returns(uint256)
+func (_RewardsDistributor *RewardsDistributorCaller) CurrentWeek(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _RewardsDistributor.contract.Call(opts, &out, "currentWeek")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// CurrentWeek is a free data retrieval call binding the contract method 0x06575c89.
+//
+// Solidity: function currentWeek() view returns(uint256)
+func (_RewardsDistributor *RewardsDistributorSession) CurrentWeek() (*big.Int, error) {
+	return _RewardsDistributor.Contract.CurrentWeek(&_RewardsDistributor.CallOpts)
+}
+
+// CurrentWeek is a free data retrieval call binding the contract method 0x06575c89.
+//
+// Solidity: function currentWeek() view returns(uint256)
+func (_RewardsDistributor *RewardsDistributorCallerSession) CurrentWeek() (*big.Int, error) {
+	return _RewardsDistributor.Contract.CurrentWeek(&_RewardsDistributor.CallOpts)
+}
+
+// EmergencyWithdrawAddress is a free data retrieval call binding the contract method 0x2ee72e18.
+//
+// Solidity: function emergencyWithdrawAddress() view returns(address)
+func (_RewardsDistributor *RewardsDistributorCaller) EmergencyWithdrawAddress(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _RewardsDistributor.contract.Call(opts, &out, "emergencyWithdrawAddress")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// EmergencyWithdrawAddress is a free data retrieval call binding the contract method 0x2ee72e18.
+//
+// Solidity: function emergencyWithdrawAddress() view returns(address)
+func (_RewardsDistributor *RewardsDistributorSession) EmergencyWithdrawAddress() (common.Address, error) {
+	return _RewardsDistributor.Contract.EmergencyWithdrawAddress(&_RewardsDistributor.CallOpts)
+}
+
+// EmergencyWithdrawAddress is a free data retrieval call binding the contract method 0x2ee72e18.
+//
+// Solidity: function emergencyWithdrawAddress() view returns(address)
+func (_RewardsDistributor *RewardsDistributorCallerSession) EmergencyWithdrawAddress() (common.Address, error) {
+	return _RewardsDistributor.Contract.EmergencyWithdrawAddress(&_RewardsDistributor.CallOpts)
+}
+
+// GetBatchClaimed is a free data retrieval call binding the contract method 0xc80b19a8.
+//
+// Solidity: function getBatchClaimed(address user, uint256[] weekNumbers) view returns(uint256[] amounts)
+func (_RewardsDistributor *RewardsDistributorCaller) GetBatchClaimed(opts *bind.CallOpts, user common.Address, weekNumbers []*big.Int) ([]*big.Int, error) {
+	var out []interface{}
+	err := _RewardsDistributor.contract.Call(opts, &out, "getBatchClaimed", user, weekNumbers)
+
+	if err != nil {
+		return *new([]*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]*big.Int)).(*[]*big.Int)
+
+	return out0, err
+
+}
+
+// GetBatchClaimed is a free data retrieval call binding the contract method 0xc80b19a8.
+//
+// Solidity: function getBatchClaimed(address user, uint256[] weekNumbers) view returns(uint256[] amounts)
+func (_RewardsDistributor *RewardsDistributorSession) GetBatchClaimed(user common.Address, weekNumbers []*big.Int) ([]*big.Int, error) {
+	return _RewardsDistributor.Contract.GetBatchClaimed(&_RewardsDistributor.CallOpts, user, weekNumbers)
+}
+
+// GetBatchClaimed is a free data retrieval call binding the contract method 0xc80b19a8.
+//
+// Solidity: function getBatchClaimed(address user, uint256[] weekNumbers) view returns(uint256[] amounts)
+func (_RewardsDistributor *RewardsDistributorCallerSession) GetBatchClaimed(user common.Address, weekNumbers []*big.Int) ([]*big.Int, error) {
+	return _RewardsDistributor.Contract.GetBatchClaimed(&_RewardsDistributor.CallOpts, user, weekNumbers)
+}
+
+// GetClaimable is a free data retrieval call binding the contract method 0xbc98b002.
+//
+// Solidity: function getClaimable(address user, uint256 week, uint256 amount, bytes32[] proof) view returns(uint256 claimable, bool alreadyClaimed)
+func (_RewardsDistributor *RewardsDistributorCaller) GetClaimable(opts *bind.CallOpts, user common.Address, week *big.Int, amount *big.Int, proof [][32]byte) (struct {
+	Claimable      *big.Int
+	AlreadyClaimed bool
+}, error) {
+	var out []interface{}
+	err := _RewardsDistributor.contract.Call(opts, &out, "getClaimable", user, week, amount, proof)
+
+	outstruct := new(struct {
+		Claimable      *big.Int
+		AlreadyClaimed bool
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Claimable = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.AlreadyClaimed = *abi.ConvertType(out[1], new(bool)).(*bool)
+
+	return *outstruct, err
+
+}
+
+// GetClaimable is a free data retrieval call binding the contract method 0xbc98b002.
+//
+// Solidity: function getClaimable(address user, uint256 week, uint256 amount, bytes32[] proof) view returns(uint256 claimable, bool alreadyClaimed)
+func (_RewardsDistributor *RewardsDistributorSession) GetClaimable(user common.Address, week *big.Int, amount *big.Int, proof [][32]byte) (struct {
+	Claimable      *big.Int
+	AlreadyClaimed bool
+}, error) {
+	return _RewardsDistributor.Contract.GetClaimable(&_RewardsDistributor.CallOpts, user, week, amount, proof)
+}
+
+// GetClaimable is a free data retrieval call binding the contract method 0xbc98b002.
+//
+// Solidity: function getClaimable(address user, uint256 week, uint256 amount, bytes32[] proof) view returns(uint256 claimable, bool alreadyClaimed)
+func (_RewardsDistributor *RewardsDistributorCallerSession) GetClaimable(user common.Address, week *big.Int, amount *big.Int, proof [][32]byte) (struct {
+	Claimable      *big.Int
+	AlreadyClaimed bool
+}, error) {
+	return _RewardsDistributor.Contract.GetClaimable(&_RewardsDistributor.CallOpts, user, week, amount, proof)
+}
+
+// GetWeekStats is a free data retrieval call binding the contract method 0x7f11f33e.
+//
+// Solidity: function getWeekStats(uint256 week) view returns(bytes32 merkleRoot, uint256 totalAmount, uint256 scaleBps, uint256 claimedAmount, uint256 claimRate)
+func (_RewardsDistributor *RewardsDistributorCaller) GetWeekStats(opts *bind.CallOpts, week *big.Int) (struct {
+	MerkleRoot    [32]byte
+	TotalAmount   *big.Int
+	ScaleBps      *big.Int
+	ClaimedAmount *big.Int
+	ClaimRate     *big.Int
+}, error) {
+	var out []interface{}
+	err := _RewardsDistributor.contract.Call(opts, &out, "getWeekStats", week)
+
+	outstruct := new(struct {
+		MerkleRoot    [32]byte
+		TotalAmount   *big.Int
+		ScaleBps      *big.Int
+		ClaimedAmount *big.Int
+		ClaimRate     *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.MerkleRoot = *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+	outstruct.TotalAmount = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	outstruct.ScaleBps = *abi.ConvertType(out[2], new(*big.Int)).(**big.Int)
+	outstruct.ClaimedAmount = *abi.ConvertType(out[3], new(*big.Int)).(**big.Int)
+	outstruct.ClaimRate = *abi.ConvertType(out[4], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// GetWeekStats is a free data retrieval call binding the contract method 0x7f11f33e.
+//
+// Solidity: function getWeekStats(uint256 week) view returns(bytes32 merkleRoot, uint256 totalAmount, uint256 scaleBps, uint256 claimedAmount, uint256 claimRate)
+func (_RewardsDistributor *RewardsDistributorSession) GetWeekStats(week *big.Int) (struct {
+	MerkleRoot    [32]byte
+	TotalAmount   *big.Int
+	ScaleBps      *big.Int
+	ClaimedAmount *big.Int
+	ClaimRate     *big.Int
+}, error) {
+	return _RewardsDistributor.Contract.GetWeekStats(&_RewardsDistributor.CallOpts, week)
+}
+
+// GetWeekStats is a free data retrieval call binding the contract method 0x7f11f33e.
+//
+// Solidity: function getWeekStats(uint256 week) view returns(bytes32 merkleRoot, uint256 totalAmount, uint256 scaleBps, uint256 claimedAmount, uint256 claimRate)
+func (_RewardsDistributor *RewardsDistributorCallerSession) GetWeekStats(week *big.Int) (struct {
+	MerkleRoot    [32]byte
+	TotalAmount   *big.Int
+	ScaleBps      *big.Int
+	ClaimedAmount *big.Int
+	ClaimRate     *big.Int
+}, error) {
+	return _RewardsDistributor.Contract.GetWeekStats(&_RewardsDistributor.CallOpts, week)
+}
+
+// IsPublisher is a free data retrieval call binding the contract method 0x41859ac8.
+//
+// Solidity: function isPublisher(address ) view returns(bool)
+func (_RewardsDistributor *RewardsDistributorCaller) IsPublisher(opts *bind.CallOpts, arg0 common.Address) (bool, error) {
+	var out []interface{}
+	err := _RewardsDistributor.contract.Call(opts, &out, "isPublisher", arg0)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsPublisher is a free data retrieval call binding the contract method 0x41859ac8.
+//
+// Solidity: function isPublisher(address ) view returns(bool)
+func (_RewardsDistributor *RewardsDistributorSession) IsPublisher(arg0 common.Address) (bool, error) {
+	return _RewardsDistributor.Contract.IsPublisher(&_RewardsDistributor.CallOpts, arg0)
+}
+
+// IsPublisher is a free data retrieval call binding the contract method 0x41859ac8.
+//
+// Solidity: function isPublisher(address ) view returns(bool)
+func (_RewardsDistributor *RewardsDistributorCallerSession) IsPublisher(arg0 common.Address) (bool, error) {
+	return _RewardsDistributor.Contract.IsPublisher(&_RewardsDistributor.CallOpts, arg0)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_RewardsDistributor *RewardsDistributorCaller) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _RewardsDistributor.contract.Call(opts, &out, "owner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_RewardsDistributor *RewardsDistributorSession) Owner() (common.Address, error) {
+	return _RewardsDistributor.Contract.Owner(&_RewardsDistributor.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_RewardsDistributor *RewardsDistributorCallerSession) Owner() (common.Address, error) {
+	return _RewardsDistributor.Contract.Owner(&_RewardsDistributor.CallOpts)
+}
+
+// Paused is a free data retrieval call binding the contract method 0x5c975abb.
+//
+// Solidity: function paused() view returns(bool)
+func (_RewardsDistributor *RewardsDistributorCaller) Paused(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _RewardsDistributor.contract.Call(opts, &out, "paused")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// Paused is a free data retrieval call binding the contract method 0x5c975abb.
+//
+// Solidity: function paused() view returns(bool)
+func (_RewardsDistributor *RewardsDistributorSession) Paused() (bool, error) {
+	return _RewardsDistributor.Contract.Paused(&_RewardsDistributor.CallOpts)
+}
+
+// Paused is a free data retrieval call binding the contract method 0x5c975abb.
+//
+// Solidity: function paused() view returns(bool)
+func (_RewardsDistributor *RewardsDistributorCallerSession) Paused() (bool, error) {
+	return _RewardsDistributor.Contract.Paused(&_RewardsDistributor.CallOpts)
+}
+
+// RewardToken is a free data retrieval call binding the contract method 0xf7c618c1.
+//
+// Solidity: function rewardToken() view returns(address)
+func (_RewardsDistributor *RewardsDistributorCaller) RewardToken(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _RewardsDistributor.contract.Call(opts, &out, "rewardToken")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// RewardToken is a free data retrieval call binding the contract method 0xf7c618c1.
+//
+// Solidity: function rewardToken() view returns(address)
+func (_RewardsDistributor *RewardsDistributorSession) RewardToken() (common.Address, error) {
+	return _RewardsDistributor.Contract.RewardToken(&_RewardsDistributor.CallOpts)
+}
+
+// RewardToken is a free data retrieval call binding the contract method 0xf7c618c1.
+//
+// Solidity: function rewardToken() view returns(address)
+func (_RewardsDistributor *RewardsDistributorCallerSession) RewardToken() (common.Address, error) {
+	return _RewardsDistributor.Contract.RewardToken(&_RewardsDistributor.CallOpts)
+}
+
+// VestingConfig is a free data retrieval call binding the contract method 0xfbf3aa21.
+//
+// Solidity: function vestingConfig() view returns(bool enabled, uint256 vestingDuration)
+func (_RewardsDistributor *RewardsDistributorCaller) VestingConfig(opts *bind.CallOpts) (struct {
+	Enabled         bool
+	VestingDuration *big.Int
+}, error) {
+	var out []interface{}
+	err := _RewardsDistributor.contract.Call(opts, &out, "vestingConfig")
+
+	outstruct := new(struct {
+		Enabled         bool
+		VestingDuration *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Enabled = *abi.ConvertType(out[0], new(bool)).(*bool)
+	outstruct.VestingDuration = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// VestingConfig is a free data retrieval call binding the contract method 0xfbf3aa21.
+//
+// Solidity: function vestingConfig() view returns(bool enabled, uint256 vestingDuration)
+func (_RewardsDistributor *RewardsDistributorSession) VestingConfig() (struct {
+	Enabled         bool
+	VestingDuration *big.Int
+}, error) {
+	return _RewardsDistributor.Contract.VestingConfig(&_RewardsDistributor.CallOpts)
+}
+
+// VestingConfig is a free data retrieval call binding the contract method 0xfbf3aa21.
+//
+// Solidity: function vestingConfig() view returns(bool enabled, uint256 vestingDuration)
+func (_RewardsDistributor *RewardsDistributorCallerSession) VestingConfig() (struct {
+	Enabled         bool
+	VestingDuration *big.Int
+}, error) {
+	return _RewardsDistributor.Contract.VestingConfig(&_RewardsDistributor.CallOpts)
+}
+
+// WeeklyRewards is a free data retrieval call binding the contract method 0xaf364c05.
+//
+// Solidity: function weeklyRewards(uint256 ) view returns(bytes32 merkleRoot, uint256 totalAmount, uint256 scaleBps, uint256 publishedAt, uint256 claimedAmount)
+func (_RewardsDistributor *RewardsDistributorCaller) WeeklyRewards(opts *bind.CallOpts, arg0 *big.Int) (struct {
+	MerkleRoot    [32]byte
+	TotalAmount   *big.Int
+	ScaleBps      *big.Int
+	PublishedAt   *big.Int
+	ClaimedAmount *big.Int
+}, error) {
+	var out []interface{}
+	err := _RewardsDistributor.contract.Call(opts, &out, "weeklyRewards", arg0)
+
+	outstruct := new(struct {
+		MerkleRoot    [32]byte
+		TotalAmount   *big.Int
+		ScaleBps      *big.Int
+		PublishedAt   *big.Int
+		ClaimedAmount *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.MerkleRoot = *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+	outstruct.TotalAmount = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	outstruct.ScaleBps = *abi.ConvertType(out[2], new(*big.Int)).(**big.Int)
+	outstruct.PublishedAt = *abi.ConvertType(out[3], new(*big.Int)).(**big.Int)
+	outstruct.ClaimedAmount = *abi.ConvertType(out[4], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// WeeklyRewards is a free data retrieval call binding the contract method 0xaf364c05.
+//
+// Solidity: function weeklyRewards(uint256 ) view returns(bytes32 merkleRoot, uint256 totalAmount, uint256 scaleBps, uint256 publishedAt, uint256 claimedAmount)
+func (_RewardsDistributor *RewardsDistributorSession) WeeklyRewards(arg0 *big.Int) (struct {
+	MerkleRoot    [32]byte
+	TotalAmount   *big.Int
+	ScaleBps      *big.Int
+	PublishedAt   *big.Int
+	ClaimedAmount *big.Int
+}, error) {
+	return _RewardsDistributor.Contract.WeeklyRewards(&_RewardsDistributor.CallOpts, arg0)
+}
+
+// WeeklyRewards is a free data retrieval call binding the contract method 0xaf364c05.
+//
+// Solidity: function weeklyRewards(uint256 ) view returns(bytes32 merkleRoot, uint256 totalAmount, uint256 scaleBps, uint256 publishedAt, uint256 claimedAmount)
+func (_RewardsDistributor *RewardsDistributorCallerSession) WeeklyRewards(arg0 *big.Int) (struct {
+	MerkleRoot    [32]byte
+	TotalAmount   *big.Int
+	ScaleBps      *big.Int
+	PublishedAt   *big.Int
+	ClaimedAmount *big.Int
+}, error) {
+	return _RewardsDistributor.Contract.WeeklyRewards(&_RewardsDistributor.CallOpts, arg0)
+}
+
+// BatchClaim is a paid mutator transaction binding the contract method 0x4be7adee.
+//
+// Solidity: function batchClaim(uint256[] weekNumbers, uint256[] amounts, bytes32[][] proofs) returns()
+func (_RewardsDistributor *RewardsDistributorTransactor) BatchClaim(opts *bind.TransactOpts, weekNumbers []*big.Int, amounts []*big.Int, proofs [][][32]byte) (*types.Transaction, error) {
+	return _RewardsDistributor.contract.Transact(opts, "batchClaim", weekNumbers, amounts, proofs)
+}
+
+// BatchClaim is a paid mutator transaction binding the contract method 0x4be7adee.
+//
+// Solidity: function batchClaim(uint256[] weekNumbers, uint256[] amounts, bytes32[][] proofs) returns()
+func (_RewardsDistributor *RewardsDistributorSession) BatchClaim(weekNumbers []*big.Int, amounts []*big.Int, proofs [][][32]byte) (*types.Transaction, error) {
+	return _RewardsDistributor.Contract.BatchClaim(&_RewardsDistributor.TransactOpts, weekNumbers, amounts, proofs)
+}
+
+// BatchClaim is a paid mutator transaction binding the contract method 0x4be7adee.
+//
+// Solidity: function batchClaim(uint256[] weekNumbers, uint256[] amounts, bytes32[][] proofs) returns()
+func (_RewardsDistributor *RewardsDistributorTransactorSession) BatchClaim(weekNumbers []*big.Int, amounts []*big.Int, proofs [][][32]byte) (*types.Transaction, error) {
+	return _RewardsDistributor.Contract.BatchClaim(&_RewardsDistributor.TransactOpts, weekNumbers, amounts, proofs)
+}
+
+// Claim is a paid mutator transaction binding the contract method 0xae0b51df.
+//
+// Solidity: function claim(uint256 week, uint256 amount, bytes32[] proof) returns()
+func (_RewardsDistributor *RewardsDistributorTransactor) Claim(opts *bind.TransactOpts, week *big.Int, amount *big.Int, proof [][32]byte) (*types.Transaction, error) {
+	return _RewardsDistributor.contract.Transact(opts, "claim", week, amount, proof)
+}
+
+// Claim is a paid mutator transaction binding the contract method 0xae0b51df.
+//
+// Solidity: function claim(uint256 week, uint256 amount, bytes32[] proof) returns()
+func (_RewardsDistributor *RewardsDistributorSession) Claim(week *big.Int, amount *big.Int, proof [][32]byte) (*types.Transaction, error) {
+	return _RewardsDistributor.Contract.Claim(&_RewardsDistributor.TransactOpts, week, amount, proof)
+}
+
+// Claim is a paid mutator transaction binding the contract method 0xae0b51df.
+//
+// Solidity: function claim(uint256 week, uint256 amount, bytes32[] proof) returns()
+func (_RewardsDistributor *RewardsDistributorTransactorSession) Claim(week *big.Int, amount *big.Int, proof [][32]byte) (*types.Transaction, error) {
+	return _RewardsDistributor.Contract.Claim(&_RewardsDistributor.TransactOpts, week, amount, proof)
+}
+
+// EmergencyWithdraw is a paid mutator transaction binding the contract method 0x5312ea8e.
+//
+// Solidity: function emergencyWithdraw(uint256 amount) returns()
+func (_RewardsDistributor *RewardsDistributorTransactor) EmergencyWithdraw(opts *bind.TransactOpts, amount *big.Int) (*types.Transaction, error) {
+	return _RewardsDistributor.contract.Transact(opts, "emergencyWithdraw", amount)
+}
+
+// EmergencyWithdraw is a paid mutator transaction binding the contract method 0x5312ea8e.
+//
+// Solidity: function emergencyWithdraw(uint256 amount) returns()
+func (_RewardsDistributor *RewardsDistributorSession) EmergencyWithdraw(amount *big.Int) (*types.Transaction, error) {
+	return _RewardsDistributor.Contract.EmergencyWithdraw(&_RewardsDistributor.TransactOpts, amount)
+}
+
+// EmergencyWithdraw is a paid mutator transaction binding the contract method 0x5312ea8e.
+//
+// Solidity: function emergencyWithdraw(uint256 amount) returns()
+func (_RewardsDistributor *RewardsDistributorTransactorSession) EmergencyWithdraw(amount *big.Int) (*types.Transaction, error) {
+	return _RewardsDistributor.Contract.EmergencyWithdraw(&_RewardsDistributor.TransactOpts, amount)
+}
+
+// Pause is a paid mutator transaction binding the contract method 0x8456cb59.
+//
+// Solidity: function pause() returns()
+func (_RewardsDistributor *RewardsDistributorTransactor) Pause(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _RewardsDistributor.contract.Transact(opts, "pause")
+}
+
+// Pause is a paid mutator transaction binding the contract method 0x8456cb59.
+//
+// Solidity: function pause() returns()
+func (_RewardsDistributor *RewardsDistributorSession) Pause() (*types.Transaction, error) {
+	return _RewardsDistributor.Contract.Pause(&_RewardsDistributor.TransactOpts)
+}
+
+// Pause is a paid mutator transaction binding the contract method 0x8456cb59.
+//
+// Solidity: function pause() returns()
+func (_RewardsDistributor *RewardsDistributorTransactorSession) Pause() (*types.Transaction, error) {
+	return _RewardsDistributor.Contract.Pause(&_RewardsDistributor.TransactOpts)
+}
+
+// PublishRoot is a paid mutator transaction binding the contract method 0xffa1c412.
+//
+// Solidity: function publishRoot(uint256 week, bytes32 merkleRoot, uint256 totalAmount, uint256 scaleBps) returns()
+func (_RewardsDistributor *RewardsDistributorTransactor) PublishRoot(opts *bind.TransactOpts, week *big.Int, merkleRoot [32]byte, totalAmount *big.Int, scaleBps *big.Int) (*types.Transaction, error) {
+	return _RewardsDistributor.contract.Transact(opts, "publishRoot", week, merkleRoot, totalAmount, scaleBps)
+}
+
+// PublishRoot is a paid mutator transaction binding the contract method 0xffa1c412.
+//
+// Solidity: function publishRoot(uint256 week, bytes32 merkleRoot, uint256 totalAmount, uint256 scaleBps) returns()
+func (_RewardsDistributor *RewardsDistributorSession) PublishRoot(week *big.Int, merkleRoot [32]byte, totalAmount *big.Int, scaleBps *big.Int) (*types.Transaction, error) {
+	return _RewardsDistributor.Contract.PublishRoot(&_RewardsDistributor.TransactOpts, week, merkleRoot, totalAmount, scaleBps)
+}
+
+// PublishRoot is a paid mutator transaction binding the contract method 0xffa1c412.
+//
+// Solidity: function publishRoot(uint256 week, bytes32 merkleRoot, uint256 totalAmount, uint256 scaleBps) returns()
+func (_RewardsDistributor *RewardsDistributorTransactorSession) PublishRoot(week *big.Int, merkleRoot [32]byte, totalAmount *big.Int, scaleBps *big.Int) (*types.Transaction, error) {
+	return _RewardsDistributor.Contract.PublishRoot(&_RewardsDistributor.TransactOpts, week, merkleRoot, totalAmount, scaleBps)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_RewardsDistributor *RewardsDistributorTransactor) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _RewardsDistributor.contract.Transact(opts, "renounceOwnership")
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_RewardsDistributor *RewardsDistributorSession) RenounceOwnership() (*types.Transaction, error) {
+	return _RewardsDistributor.Contract.RenounceOwnership(&_RewardsDistributor.TransactOpts)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_RewardsDistributor *RewardsDistributorTransactorSession) RenounceOwnership() (*types.Transaction, error) {
+	return _RewardsDistributor.Contract.RenounceOwnership(&_RewardsDistributor.TransactOpts)
+}
+
+// SetEmergencyWithdrawAddress is a paid mutator transaction binding the contract method 0x47c85634.
+//
+// Solidity: function setEmergencyWithdrawAddress(address _address) returns()
+func (_RewardsDistributor *RewardsDistributorTransactor) SetEmergencyWithdrawAddress(opts *bind.TransactOpts, _address common.Address) (*types.Transaction, error) {
+	return _RewardsDistributor.contract.Transact(opts, "setEmergencyWithdrawAddress", _address)
+}
+
+// SetEmergencyWithdrawAddress is a paid mutator transaction binding the contract method 0x47c85634.
+//
+// Solidity: function setEmergencyWithdrawAddress(address _address) returns()
+func (_RewardsDistributor *RewardsDistributorSession) SetEmergencyWithdrawAddress(_address common.Address) (*types.Transaction, error) {
+	return _RewardsDistributor.Contract.SetEmergencyWithdrawAddress(&_RewardsDistributor.TransactOpts, _address)
+}
+
+// SetEmergencyWithdrawAddress is a paid mutator transaction binding the contract method 0x47c85634.
+//
+// Solidity: function setEmergencyWithdrawAddress(address _address) returns()
+func (_RewardsDistributor *RewardsDistributorTransactorSession) SetEmergencyWithdrawAddress(_address common.Address) (*types.Transaction, error) {
+	return _RewardsDistributor.Contract.SetEmergencyWithdrawAddress(&_RewardsDistributor.TransactOpts, _address)
+}
+
+// SetPublisher is a paid mutator transaction binding the contract method 0x618bb079.
+//
+// Solidity: function setPublisher(address publisher, bool enabled) returns()
+func (_RewardsDistributor *RewardsDistributorTransactor) SetPublisher(opts *bind.TransactOpts, publisher common.Address, enabled bool) (*types.Transaction, error) {
+	return _RewardsDistributor.contract.Transact(opts, "setPublisher", publisher, enabled)
+}
+
+// SetPublisher is a paid mutator transaction binding the contract method 0x618bb079.
+//
+// Solidity: function setPublisher(address publisher, bool enabled) returns()
+func (_RewardsDistributor *RewardsDistributorSession) SetPublisher(publisher common.Address, enabled bool) (*types.Transaction, error) {
+	return _RewardsDistributor.Contract.SetPublisher(&_RewardsDistributor.TransactOpts, publisher, enabled)
+}
+
+// SetPublisher is a paid mutator transaction binding the contract method 0x618bb079.
+//
+// Solidity: function setPublisher(address publisher, bool enabled) returns()
+func (_RewardsDistributor *RewardsDistributorTransactorSession) SetPublisher(publisher common.Address, enabled bool) (*types.Transaction, error) {
+	return _RewardsDistributor.Contract.SetPublisher(&_RewardsDistributor.TransactOpts, publisher, enabled)
+}
+
+// SetVestingConfig is a paid mutator transaction binding the contract method 0x8a4ae6c0.
+//
+// Solidity: function setVestingConfig(bool enabled, uint256 duration) returns()
+func (_RewardsDistributor *RewardsDistributorTransactor) SetVestingConfig(opts *bind.TransactOpts, enabled bool, duration *big.Int) (*types.Transaction, error) {
+	return _RewardsDistributor.contract.Transact(opts, "setVestingConfig", enabled, duration)
+}
+
+// SetVestingConfig is a paid mutator transaction binding the contract method 0x8a4ae6c0.
+//
+// Solidity: function setVestingConfig(bool enabled, uint256 duration) returns()
+func (_RewardsDistributor *RewardsDistributorSession) SetVestingConfig(enabled bool, duration *big.Int) (*types.Transaction, error) {
+	return _RewardsDistributor.Contract.SetVestingConfig(&_RewardsDistributor.TransactOpts, enabled, duration)
+}
+
+// SetVestingConfig is a paid mutator transaction binding the contract method 0x8a4ae6c0.
+//
+// Solidity: function setVestingConfig(bool enabled, uint256 duration) returns()
+func (_RewardsDistributor *RewardsDistributorTransactorSession) SetVestingConfig(enabled bool, duration *big.Int) (*types.Transaction, error) {
+	return _RewardsDistributor.Contract.SetVestingConfig(&_RewardsDistributor.TransactOpts, enabled, duration)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_RewardsDistributor *RewardsDistributorTransactor) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	return _RewardsDistributor.contract.Transact(opts, "transferOwnership", newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_RewardsDistributor *RewardsDistributorSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _RewardsDistributor.Contract.TransferOwnership(&_RewardsDistributor.TransactOpts, newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_RewardsDistributor *RewardsDistributorTransactorSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _RewardsDistributor.Contract.TransferOwnership(&_RewardsDistributor.TransactOpts, newOwner)
+}
+
+// Unpause is a paid mutator transaction binding the contract method 0x3f4ba83a.
+//
+// Solidity: function unpause() returns()
+func (_RewardsDistributor *RewardsDistributorTransactor) Unpause(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _RewardsDistributor.contract.Transact(opts, "unpause")
+}
+
+// Unpause is a paid mutator transaction binding the contract method 0x3f4ba83a.
+//
+// Solidity: function unpause() returns()
+func (_RewardsDistributor *RewardsDistributorSession) Unpause() (*types.Transaction, error) {
+	return _RewardsDistributor.Contract.Unpause(&_RewardsDistributor.TransactOpts)
+}
+
+// Unpause is a paid mutator transaction binding the contract method 0x3f4ba83a.
+//
+// Solidity: function unpause() returns()
+func (_RewardsDistributor *RewardsDistributorTransactorSession) Unpause() (*types.Transaction, error) {
+	return _RewardsDistributor.Contract.Unpause(&_RewardsDistributor.TransactOpts)
+}
+
+// RewardsDistributorBatchClaimedIterator is returned from FilterBatchClaimed and is used to iterate over the raw logs and unpacked data for BatchClaimed events raised by the RewardsDistributor contract.
+type RewardsDistributorBatchClaimedIterator struct {
+	Event *RewardsDistributorBatchClaimed // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *RewardsDistributorBatchClaimedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(RewardsDistributorBatchClaimed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(RewardsDistributorBatchClaimed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *RewardsDistributorBatchClaimedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *RewardsDistributorBatchClaimedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// RewardsDistributorBatchClaimed represents a BatchClaimed event raised by the RewardsDistributor contract.
+type RewardsDistributorBatchClaimed struct {
+	User        common.Address
+	WeekCount   *big.Int
+	TotalAmount *big.Int
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterBatchClaimed is a free log retrieval operation binding the contract event 0xf3f5e449de3de5506a6c5e1390e685f684feae755d32dc163dd4761b2ce5e86f.
+//
+// Solidity: event BatchClaimed(address indexed user, uint256 weekCount, uint256 totalAmount)
+func (_RewardsDistributor *RewardsDistributorFilterer) FilterBatchClaimed(opts *bind.FilterOpts, user []common.Address) (*RewardsDistributorBatchClaimedIterator, error) {
+
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+
+	logs, sub, err := _RewardsDistributor.contract.FilterLogs(opts, "BatchClaimed", userRule)
+	if err != nil {
+		return nil, err
+	}
+	return &RewardsDistributorBatchClaimedIterator{contract: _RewardsDistributor.contract, event: "BatchClaimed", logs: logs, sub: sub}, nil
+}
+
+// WatchBatchClaimed is a free log subscription operation binding the contract event 0xf3f5e449de3de5506a6c5e1390e685f684feae755d32dc163dd4761b2ce5e86f.
+//
+// Solidity: event BatchClaimed(address indexed user, uint256 weekCount, uint256 totalAmount)
+func (_RewardsDistributor *RewardsDistributorFilterer) WatchBatchClaimed(opts *bind.WatchOpts, sink chan<- *RewardsDistributorBatchClaimed, user []common.Address) (event.Subscription, error) {
+
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+
+	logs, sub, err := _RewardsDistributor.contract.WatchLogs(opts, "BatchClaimed", userRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(RewardsDistributorBatchClaimed)
+				if err := _RewardsDistributor.contract.UnpackLog(event, "BatchClaimed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseBatchClaimed is a log parse operation binding the contract event 0xf3f5e449de3de5506a6c5e1390e685f684feae755d32dc163dd4761b2ce5e86f.
+//
+// Solidity: event BatchClaimed(address indexed user, uint256 weekCount, uint256 totalAmount)
+func (_RewardsDistributor *RewardsDistributorFilterer) ParseBatchClaimed(log types.Log) (*RewardsDistributorBatchClaimed, error) {
+	event := new(RewardsDistributorBatchClaimed)
+	if err := _RewardsDistributor.contract.UnpackLog(event, "BatchClaimed", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// RewardsDistributorEmergencyWithdrawIterator is returned from FilterEmergencyWithdraw and is used to iterate over the raw logs and unpacked data for EmergencyWithdraw events raised by the RewardsDistributor contract.
+type RewardsDistributorEmergencyWithdrawIterator struct {
+	Event *RewardsDistributorEmergencyWithdraw // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *RewardsDistributorEmergencyWithdrawIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(RewardsDistributorEmergencyWithdraw)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(RewardsDistributorEmergencyWithdraw)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *RewardsDistributorEmergencyWithdrawIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *RewardsDistributorEmergencyWithdrawIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// RewardsDistributorEmergencyWithdraw represents a EmergencyWithdraw event raised by the RewardsDistributor contract.
+type RewardsDistributorEmergencyWithdraw struct {
+	To     common.Address
+	Amount *big.Int
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterEmergencyWithdraw is a free log retrieval operation binding the contract event 0x5fafa99d0643513820be26656b45130b01e1c03062e1266bf36f88cbd3bd9695.
+//
+// Solidity: event EmergencyWithdraw(address indexed to, uint256 amount)
+func (_RewardsDistributor *RewardsDistributorFilterer) FilterEmergencyWithdraw(opts *bind.FilterOpts, to []common.Address) (*RewardsDistributorEmergencyWithdrawIterator, error) {
+
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _RewardsDistributor.contract.FilterLogs(opts, "EmergencyWithdraw", toRule)
+	if err != nil {
+		return nil, err
+	}
+	return &RewardsDistributorEmergencyWithdrawIterator{contract: _RewardsDistributor.contract, event: "EmergencyWithdraw", logs: logs, sub: sub}, nil
+}
+
+// WatchEmergencyWithdraw is a free log subscription operation binding the contract event 0x5fafa99d0643513820be26656b45130b01e1c03062e1266bf36f88cbd3bd9695.
+//
+// Solidity: event EmergencyWithdraw(address indexed to, uint256 amount)
+func (_RewardsDistributor *RewardsDistributorFilterer) WatchEmergencyWithdraw(opts *bind.WatchOpts, sink chan<- *RewardsDistributorEmergencyWithdraw, to []common.Address) (event.Subscription, error) {
+
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _RewardsDistributor.contract.WatchLogs(opts, "EmergencyWithdraw", toRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(RewardsDistributorEmergencyWithdraw)
+				if err := _RewardsDistributor.contract.UnpackLog(event, "EmergencyWithdraw", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseEmergencyWithdraw is a log parse operation binding the contract event 0x5fafa99d0643513820be26656b45130b01e1c03062e1266bf36f88cbd3bd9695.
+//
+// Solidity: event EmergencyWithdraw(address indexed to, uint256 amount)
+func (_RewardsDistributor *RewardsDistributorFilterer) ParseEmergencyWithdraw(log types.Log) (*RewardsDistributorEmergencyWithdraw, error) {
+	event := new(RewardsDistributorEmergencyWithdraw)
+	if err := _RewardsDistributor.contract.UnpackLog(event, "EmergencyWithdraw", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// RewardsDistributorOwnershipTransferredIterator is returned from FilterOwnershipTransferred and is used to iterate over the raw logs and unpacked data for OwnershipTransferred events raised by the RewardsDistributor contract.
+type RewardsDistributorOwnershipTransferredIterator struct {
+	Event *RewardsDistributorOwnershipTransferred // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *RewardsDistributorOwnershipTransferredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(RewardsDistributorOwnershipTransferred)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(RewardsDistributorOwnershipTransferred)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *RewardsDistributorOwnershipTransferredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *RewardsDistributorOwnershipTransferredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// RewardsDistributorOwnershipTransferred represents a OwnershipTransferred event raised by the RewardsDistributor contract.
+type RewardsDistributorOwnershipTransferred struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterOwnershipTransferred is a free log retrieval operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_RewardsDistributor *RewardsDistributorFilterer) FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*RewardsDistributorOwnershipTransferredIterator, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _RewardsDistributor.contract.FilterLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &RewardsDistributorOwnershipTransferredIterator{contract: _RewardsDistributor.contract, event: "OwnershipTransferred", logs: logs, sub: sub}, nil
+}
+
+// WatchOwnershipTransferred is a free log subscription operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_RewardsDistributor *RewardsDistributorFilterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *RewardsDistributorOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _RewardsDistributor.contract.WatchLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(RewardsDistributorOwnershipTransferred)
+				if err := _RewardsDistributor.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOwnershipTransferred is a log parse operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_RewardsDistributor *RewardsDistributorFilterer) ParseOwnershipTransferred(log types.Log) (*RewardsDistributorOwnershipTransferred, error) {
+	event := new(RewardsDistributorOwnershipTransferred)
+	if err := _RewardsDistributor.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// RewardsDistributorPausedIterator is returned from FilterPaused and is used to iterate over the raw logs and unpacked data for Paused events raised by the RewardsDistributor contract.
+type RewardsDistributorPausedIterator struct {
+	Event *RewardsDistributorPaused // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *RewardsDistributorPausedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(RewardsDistributorPaused)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(RewardsDistributorPaused)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *RewardsDistributorPausedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *RewardsDistributorPausedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// RewardsDistributorPaused represents a Paused event raised by the RewardsDistributor contract.
+type RewardsDistributorPaused struct {
+	Account common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterPaused is a free log retrieval operation binding the contract event 0x62e78cea01bee320cd4e420270b5ea74000d11b0c9f74754ebdbfc544b05a258.
+//
+// Solidity: event Paused(address account)
+func (_RewardsDistributor *RewardsDistributorFilterer) FilterPaused(opts *bind.FilterOpts) (*RewardsDistributorPausedIterator, error) {
+
+	logs, sub, err := _RewardsDistributor.contract.FilterLogs(opts, "Paused")
+	if err != nil {
+		return nil, err
+	}
+	return &RewardsDistributorPausedIterator{contract: _RewardsDistributor.contract, event: "Paused", logs: logs, sub: sub}, nil
+}
+
+// WatchPaused is a free log subscription operation binding the contract event 0x62e78cea01bee320cd4e420270b5ea74000d11b0c9f74754ebdbfc544b05a258.
+//
+// Solidity: event Paused(address account)
+func (_RewardsDistributor *RewardsDistributorFilterer) WatchPaused(opts *bind.WatchOpts, sink chan<- *RewardsDistributorPaused) (event.Subscription, error) {
+
+	logs, sub, err := _RewardsDistributor.contract.WatchLogs(opts, "Paused")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(RewardsDistributorPaused)
+				if err := _RewardsDistributor.contract.UnpackLog(event, "Paused", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParsePaused is a log parse operation binding the contract event 0x62e78cea01bee320cd4e420270b5ea74000d11b0c9f74754ebdbfc544b05a258.
+//
+// Solidity: event Paused(address account)
+func (_RewardsDistributor *RewardsDistributorFilterer) ParsePaused(log types.Log) (*RewardsDistributorPaused, error) {
+	event := new(RewardsDistributorPaused)
+	if err := _RewardsDistributor.contract.UnpackLog(event, "Paused", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// RewardsDistributorPublisherUpdatedIterator is returned from FilterPublisherUpdated and is used to iterate over the raw logs and unpacked data for PublisherUpdated events raised by the RewardsDistributor contract.
+type RewardsDistributorPublisherUpdatedIterator struct {
+	Event *RewardsDistributorPublisherUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *RewardsDistributorPublisherUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(RewardsDistributorPublisherUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(RewardsDistributorPublisherUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *RewardsDistributorPublisherUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *RewardsDistributorPublisherUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// RewardsDistributorPublisherUpdated represents a PublisherUpdated event raised by the RewardsDistributor contract.
+type RewardsDistributorPublisherUpdated struct {
+	Publisher common.Address
+	Enabled   bool
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterPublisherUpdated is a free log retrieval operation binding the contract event 0xdfd87d205b27ab761c75f0d3d9fcc6f0a6c8aefb93971bf081775600091fc998.
+//
+// Solidity: event PublisherUpdated(address indexed publisher, bool enabled)
+func (_RewardsDistributor *RewardsDistributorFilterer) FilterPublisherUpdated(opts *bind.FilterOpts, publisher []common.Address) (*RewardsDistributorPublisherUpdatedIterator, error) {
+
+	var publisherRule []interface{}
+	for _, publisherItem := range publisher {
+		publisherRule = append(publisherRule, publisherItem)
+	}
+
+	logs, sub, err := _RewardsDistributor.contract.FilterLogs(opts, "PublisherUpdated", publisherRule)
+	if err != nil {
+		return nil, err
+	}
+	return &RewardsDistributorPublisherUpdatedIterator{contract: _RewardsDistributor.contract, event: "PublisherUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchPublisherUpdated is a free log subscription operation binding the contract event 0xdfd87d205b27ab761c75f0d3d9fcc6f0a6c8aefb93971bf081775600091fc998.
+//
+// Solidity: event PublisherUpdated(address indexed publisher, bool enabled)
+func (_RewardsDistributor *RewardsDistributorFilterer) WatchPublisherUpdated(opts *bind.WatchOpts, sink chan<- *RewardsDistributorPublisherUpdated, publisher []common.Address) (event.Subscription, error) {
+
+	var publisherRule []interface{}
+	for _, publisherItem := range publisher {
+		publisherRule = append(publisherRule, publisherItem)
+	}
+
+	logs, sub, err := _RewardsDistributor.contract.WatchLogs(opts, "PublisherUpdated", publisherRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(RewardsDistributorPublisherUpdated)
+				if err := _RewardsDistributor.contract.UnpackLog(event, "PublisherUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParsePublisherUpdated is a log parse operation binding the contract event 0xdfd87d205b27ab761c75f0d3d9fcc6f0a6c8aefb93971bf081775600091fc998.
+//
+// Solidity: event PublisherUpdated(address indexed publisher, bool enabled)
+func (_RewardsDistributor *RewardsDistributorFilterer) ParsePublisherUpdated(log types.Log) (*RewardsDistributorPublisherUpdated, error) {
+	event := new(RewardsDistributorPublisherUpdated)
+	if err := _RewardsDistributor.contract.UnpackLog(event, "PublisherUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// RewardsDistributorRewardClaimedIterator is returned from FilterRewardClaimed and is used to iterate over the raw logs and unpacked data for RewardClaimed events raised by the RewardsDistributor contract.
+type RewardsDistributorRewardClaimedIterator struct {
+	Event *RewardsDistributorRewardClaimed // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *RewardsDistributorRewardClaimedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(RewardsDistributorRewardClaimed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(RewardsDistributorRewardClaimed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *RewardsDistributorRewardClaimedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *RewardsDistributorRewardClaimedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// RewardsDistributorRewardClaimed represents a RewardClaimed event raised by the RewardsDistributor contract.
+type RewardsDistributorRewardClaimed struct {
+	User      common.Address
+	Week      *big.Int
+	Amount    *big.Int
+	ClaimedAt *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterRewardClaimed is a free log retrieval operation binding the contract event 0x812be816db82c66cd18ca8457005cd84689642d8ac4d38599cc6af444a2dc72a.
+//
+// Solidity: event RewardClaimed(address indexed user, uint256 indexed week, uint256 amount, uint256 claimedAt)
+func (_RewardsDistributor *RewardsDistributorFilterer) FilterRewardClaimed(opts *bind.FilterOpts, user []common.Address, week []*big.Int) (*RewardsDistributorRewardClaimedIterator, error) {
+
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+	var weekRule []interface{}
+	for _, weekItem := range week {
+		weekRule = append(weekRule, weekItem)
+	}
+
+	logs, sub, err := _RewardsDistributor.contract.FilterLogs(opts, "RewardClaimed", userRule, weekRule)
+	if err != nil {
+		return nil, err
+	}
+	return &RewardsDistributorRewardClaimedIterator{contract: _RewardsDistributor.contract, event: "RewardClaimed", logs: logs, sub: sub}, nil
+}
+
+// WatchRewardClaimed is a free log subscription operation binding the contract event 0x812be816db82c66cd18ca8457005cd84689642d8ac4d38599cc6af444a2dc72a.
+//
+// Solidity: event RewardClaimed(address indexed user, uint256 indexed week, uint256 amount, uint256 claimedAt)
+func (_RewardsDistributor *RewardsDistributorFilterer) WatchRewardClaimed(opts *bind.WatchOpts, sink chan<- *RewardsDistributorRewardClaimed, user []common.Address, week []*big.Int) (event.Subscription, error) {
+
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+	var weekRule []interface{}
+	for _, weekItem := range week {
+		weekRule = append(weekRule, weekItem)
+	}
+
+	logs, sub, err := _RewardsDistributor.contract.WatchLogs(opts, "RewardClaimed", userRule, weekRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(RewardsDistributorRewardClaimed)
+				if err := _RewardsDistributor.contract.UnpackLog(event, "RewardClaimed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRewardClaimed is a log parse operation binding the contract event 0x812be816db82c66cd18ca8457005cd84689642d8ac4d38599cc6af444a2dc72a.
+//
+// Solidity: event RewardClaimed(address indexed user, uint256 indexed week, uint256 amount, uint256 claimedAt)
+func (_RewardsDistributor *RewardsDistributorFilterer) ParseRewardClaimed(log types.Log) (*RewardsDistributorRewardClaimed, error) {
+	event := new(RewardsDistributorRewardClaimed)
+	if err := _RewardsDistributor.contract.UnpackLog(event, "RewardClaimed", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// RewardsDistributorRewardsRootPublishedIterator is returned from FilterRewardsRootPublished and is used to iterate over the raw logs and unpacked data for RewardsRootPublished events raised by the RewardsDistributor contract.
+type RewardsDistributorRewardsRootPublishedIterator struct {
+	Event *RewardsDistributorRewardsRootPublished // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *RewardsDistributorRewardsRootPublishedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(RewardsDistributorRewardsRootPublished)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(RewardsDistributorRewardsRootPublished)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *RewardsDistributorRewardsRootPublishedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *RewardsDistributorRewardsRootPublishedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// RewardsDistributorRewardsRootPublished represents a RewardsRootPublished event raised by the RewardsDistributor contract.
+type RewardsDistributorRewardsRootPublished struct {
+	Week        *big.Int
+	MerkleRoot  [32]byte
+	TotalAmount *big.Int
+	ScaleBps    *big.Int
+	PublishedAt *big.Int
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterRewardsRootPublished is a free log retrieval operation binding the contract event 0x35330647a204e4e7e5233e78539d4d4373d27f4948ce89f3ef39561bc897c131.
+//
+// Solidity: event RewardsRootPublished(uint256 indexed week, bytes32 merkleRoot, uint256 totalAmount, uint256 scaleBps, uint256 publishedAt)
+func (_RewardsDistributor *RewardsDistributorFilterer) FilterRewardsRootPublished(opts *bind.FilterOpts, week []*big.Int) (*RewardsDistributorRewardsRootPublishedIterator, error) {
+
+	var weekRule []interface{}
+	for _, weekItem := range week {
+		weekRule = append(weekRule, weekItem)
+	}
+
+	logs, sub, err := _RewardsDistributor.contract.FilterLogs(opts, "RewardsRootPublished", weekRule)
+	if err != nil {
+		return nil, err
+	}
+	return &RewardsDistributorRewardsRootPublishedIterator{contract: _RewardsDistributor.contract, event: "RewardsRootPublished", logs: logs, sub: sub}, nil
+}
+
+// WatchRewardsRootPublished is a free log subscription operation binding the contract event 0x35330647a204e4e7e5233e78539d4d4373d27f4948ce89f3ef39561bc897c131.
+//
+// Solidity: event RewardsRootPublished(uint256 indexed week, bytes32 merkleRoot, uint256 totalAmount, uint256 scaleBps, uint256 publishedAt)
+func (_RewardsDistributor *RewardsDistributorFilterer) WatchRewardsRootPublished(opts *bind.WatchOpts, sink chan<- *RewardsDistributorRewardsRootPublished, week []*big.Int) (event.Subscription, error) {
+
+	var weekRule []interface{}
+	for _, weekItem := range week {
+		weekRule = append(weekRule, weekItem)
+	}
+
+	logs, sub, err := _RewardsDistributor.contract.WatchLogs(opts, "RewardsRootPublished", weekRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(RewardsDistributorRewardsRootPublished)
+				if err := _RewardsDistributor.contract.UnpackLog(event, "RewardsRootPublished", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRewardsRootPublished is a log parse operation binding the contract event 0x35330647a204e4e7e5233e78539d4d4373d27f4948ce89f3ef39561bc897c131.
+//
+// Solidity: event RewardsRootPublished(uint256 indexed week, bytes32 merkleRoot, uint256 totalAmount, uint256 scaleBps, uint256 publishedAt)
+func (_RewardsDistributor *RewardsDistributorFilterer) ParseRewardsRootPublished(log types.Log) (*RewardsDistributorRewardsRootPublished, error) {
+	event := new(RewardsDistributorRewardsRootPublished)
+	if err := _RewardsDistributor.contract.UnpackLog(event, "RewardsRootPublished", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// RewardsDistributorUnpausedIterator is returned from FilterUnpaused and is used to iterate over the raw logs and unpacked data for Unpaused events raised by the RewardsDistributor contract.
+type RewardsDistributorUnpausedIterator struct {
+	Event *RewardsDistributorUnpaused // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *RewardsDistributorUnpausedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(RewardsDistributorUnpaused)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(RewardsDistributorUnpaused)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *RewardsDistributorUnpausedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *RewardsDistributorUnpausedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// RewardsDistributorUnpaused represents a Unpaused event raised by the RewardsDistributor contract.
+type RewardsDistributorUnpaused struct {
+	Account common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterUnpaused is a free log retrieval operation binding the contract event 0x5db9ee0a495bf2e6ff9c91a7834c1ba4fdd244a5e8aa4e537bd38aeae4b073aa.
+//
+// Solidity: event Unpaused(address account)
+func (_RewardsDistributor *RewardsDistributorFilterer) FilterUnpaused(opts *bind.FilterOpts) (*RewardsDistributorUnpausedIterator, error) {
+
+	logs, sub, err := _RewardsDistributor.contract.FilterLogs(opts, "Unpaused")
+	if err != nil {
+		return nil, err
+	}
+	return &RewardsDistributorUnpausedIterator{contract: _RewardsDistributor.contract, event: "Unpaused", logs: logs, sub: sub}, nil
+}
+
+// WatchUnpaused is a free log subscription operation binding the contract event 0x5db9ee0a495bf2e6ff9c91a7834c1ba4fdd244a5e8aa4e537bd38aeae4b073aa.
+//
+// Solidity: event Unpaused(address account)
+func (_RewardsDistributor *RewardsDistributorFilterer) WatchUnpaused(opts *bind.WatchOpts, sink chan<- *RewardsDistributorUnpaused) (event.Subscription, error) {
+
+	logs, sub, err := _RewardsDistributor.contract.WatchLogs(opts, "Unpaused")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(RewardsDistributorUnpaused)
+				if err := _RewardsDistributor.contract.UnpackLog(event, "Unpaused", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUnpaused is a log parse operation binding the contract event 0x5db9ee0a495bf2e6ff9c91a7834c1ba4fdd244a5e8aa4e537bd38aeae4b073aa.
+//
+// Solidity: event Unpaused(address account)
+func (_RewardsDistributor *RewardsDistributorFilterer) ParseUnpaused(log types.Log) (*RewardsDistributorUnpaused, error) {
+	event := new(RewardsDistributorUnpaused)
+	if err := _RewardsDistributor.contract.UnpackLog(event, "Unpaused", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// RewardsDistributorVestingConfigUpdatedIterator is returned from FilterVestingConfigUpdated and is used to iterate over the raw logs and unpacked data for VestingConfigUpdated events raised by the RewardsDistributor contract.
+type RewardsDistributorVestingConfigUpdatedIterator struct {
+	Event *RewardsDistributorVestingConfigUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *RewardsDistributorVestingConfigUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(RewardsDistributorVestingConfigUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(RewardsDistributorVestingConfigUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *RewardsDistributorVestingConfigUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *RewardsDistributorVestingConfigUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// RewardsDistributorVestingConfigUpdated represents a VestingConfigUpdated event raised by the RewardsDistributor contract.
+type RewardsDistributorVestingConfigUpdated struct {
+	Enabled  bool
+	Duration *big.Int
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterVestingConfigUpdated is a free log retrieval operation binding the contract event 0x0168d6ee739e76db920bffe938fc56088ff6cfc22d03200459c366f3f234d927.
+//
+// Solidity: event VestingConfigUpdated(bool enabled, uint256 duration)
+func (_RewardsDistributor *RewardsDistributorFilterer) FilterVestingConfigUpdated(opts *bind.FilterOpts) (*RewardsDistributorVestingConfigUpdatedIterator, error) {
+
+	logs, sub, err := _RewardsDistributor.contract.FilterLogs(opts, "VestingConfigUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return &RewardsDistributorVestingConfigUpdatedIterator{contract: _RewardsDistributor.contract, event: "VestingConfigUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchVestingConfigUpdated is a free log subscription operation binding the contract event 0x0168d6ee739e76db920bffe938fc56088ff6cfc22d03200459c366f3f234d927.
+//
+// Solidity: event VestingConfigUpdated(bool enabled, uint256 duration)
+func (_RewardsDistributor *RewardsDistributorFilterer) WatchVestingConfigUpdated(opts *bind.WatchOpts, sink chan<- *RewardsDistributorVestingConfigUpdated) (event.Subscription, error) {
+
+	logs, sub, err := _RewardsDistributor.contract.WatchLogs(opts, "VestingConfigUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(RewardsDistributorVestingConfigUpdated)
+				if err := _RewardsDistributor.contract.UnpackLog(event, "VestingConfigUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseVestingConfigUpdated is a log parse operation binding the contract event 0x0168d6ee739e76db920bffe938fc56088ff6cfc22d03200459c366f3f234d927.
+//
+// Solidity: event VestingConfigUpdated(bool enabled, uint256 duration)
+func (_RewardsDistributor *RewardsDistributorFilterer) ParseVestingConfigUpdated(log types.Log) (*RewardsDistributorVestingConfigUpdated, error) {
+	event := new(RewardsDistributorVestingConfigUpdated)
+	if err := _RewardsDistributor.contract.UnpackLog(event, "VestingConfigUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
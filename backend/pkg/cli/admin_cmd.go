@@ -0,0 +1,310 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"os"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/spf13/cobra"
+
+	"github.com/pitchone/sportsbook/internal/admin"
+	"github.com/pitchone/sportsbook/internal/safetx"
+	"github.com/pitchone/sportsbook/pkg/output"
+)
+
+var (
+	adminDryRun   bool
+	adminExportTx string
+	adminSafe     string
+	adminFrom     string
+	adminHome     int
+	adminAway     int
+	adminScaleBps uint64
+	adminReason   string
+)
+
+// adminCmd 运维命令
+var adminCmd = &cobra.Command{
+	Use:   "admin",
+	Short: "运维操作（发送交易）",
+}
+
+// adminMarketCmd 市场生命周期运维
+var adminMarketCmd = &cobra.Command{
+	Use:   "market",
+	Short: "Market_V3 锁盘、结算、终结、取消与暂停",
+	Long: `对 Market_V3 执行生命周期运维操作，替代 ResolveAndFinalize.s.sol 脚本。
+
+发送前检查市场状态并以签名账户身份 eth_call 模拟，确认后才发送交易。
+  --dry-run     只模拟不发送（可配合 --from 以指定地址模拟，无需 keystore）
+  --export-tx   导出 Safe Transaction Builder 批量交易文件，由多签执行（可配合 --safe 以 Safe 地址模拟）
+
+示例:
+  p1cli admin market lock 0x1234... --keystore ~/.foundry/keystores/keeper
+  p1cli admin market resolve 0x1234... --home 2 --away 1 --dry-run --from 0xKeeper...
+  p1cli admin market finalize 0x1234... --scale-bps 10000 --yes
+  p1cli admin market cancel 0x1234... --reason "比赛延期" --export-tx cancel.json --safe 0xSafe...`,
+}
+
+// adminLockCmd 锁盘
+var adminLockCmd = &cobra.Command{
+	Use:   "lock <market>",
+	Short: "锁盘（Open → Locked）",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runAdminMarket(cmd.Context(), args[0], func(market common.Address, _ *admin.MarketState) (*admin.Call, error) {
+			return admin.NewLockCall(market)
+		})
+	},
+}
+
+// adminResolveCmd 以比分结算
+var adminResolveCmd = &cobra.Command{
+	Use:   "resolve <market>",
+	Short: "以指定比分结算（Locked → Resolved）",
+	Long: `以指定比分调用 resolve，赛果编码与 Keeper 一致（abi.encode(uint256 home, uint256 away)）。
+
+发送前通过市场的 ResultMapper 预览比分映射到的获胜结果与权重。`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if adminHome < 0 || adminHome > 255 || adminAway < 0 || adminAway > 255 {
+			return fmt.Errorf("比分无效: %d-%d", adminHome, adminAway)
+		}
+		return runAdminMarket(cmd.Context(), args[0], func(market common.Address, _ *admin.MarketState) (*admin.Call, error) {
+			return admin.NewResolveCall(market, uint8(adminHome), uint8(adminAway))
+		})
+	},
+}
+
+// adminFinalizeCmd 终结市场
+var adminFinalizeCmd = &cobra.Command{
+	Use:   "finalize <market>",
+	Short: "终结市场，开放赎回（Resolved → Finalized）",
+	Long: `调用 finalize(scaleBps)。
+
+--scale-bps 为 0 时正常结算，Vault 亏损超限会 revert；
+1-10000 时按比例缩减赔付并由储备金兜底（10000 = 全额赔付）。`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runAdminMarket(cmd.Context(), args[0], func(market common.Address, _ *admin.MarketState) (*admin.Call, error) {
+			return admin.NewFinalizeCall(market, adminScaleBps)
+		})
+	},
+}
+
+// adminCancelCmd 取消市场
+var adminCancelCmd = &cobra.Command{
+	Use:   "cancel <market>",
+	Short: "取消市场（Open/Locked 使用 cancel，Resolved 使用 cancelResolved）",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runAdminMarket(cmd.Context(), args[0], func(market common.Address, state *admin.MarketState) (*admin.Call, error) {
+			return admin.NewCancelCall(market, adminReason, state.Status == admin.StatusResolved)
+		})
+	},
+}
+
+// adminPauseCmd 暂停市场
+var adminPauseCmd = &cobra.Command{
+	Use:   "pause <market>",
+	Short: "暂停市场（停止下注）",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runAdminMarket(cmd.Context(), args[0], func(market common.Address, _ *admin.MarketState) (*admin.Call, error) {
+			return admin.NewPauseCall(market)
+		})
+	},
+}
+
+// adminUnpauseCmd 恢复市场
+var adminUnpauseCmd = &cobra.Command{
+	Use:   "unpause <market>",
+	Short: "恢复已暂停的市场",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runAdminMarket(cmd.Context(), args[0], func(market common.Address, _ *admin.MarketState) (*admin.Call, error) {
+			return admin.NewUnpauseCall(market)
+		})
+	},
+}
+
+// runAdminMarket 读取市场状态、构造调用并预览，随后按 --export-tx / --dry-run / 确认发送 三种方式执行
+func runAdminMarket(parent context.Context, arg string, build func(common.Address, *admin.MarketState) (*admin.Call, error)) error {
+	market, err := ParseAddress(arg)
+	if err != nil {
+		return err
+	}
+
+	if parent == nil {
+		parent = context.Background()
+	}
+	ctx, cancel := context.WithTimeout(parent, 5*time.Minute)
+	defer cancel()
+
+	client, chainID, err := dialChain(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	state, err := admin.ReadMarket(ctx, client, market)
+	if err != nil {
+		return err
+	}
+
+	call, err := build(market, state)
+	if err != nil {
+		return err
+	}
+	if err := call.Check(state); err != nil {
+		return fmt.Errorf("无法执行 %s: %w", call.Method.Name, err)
+	}
+
+	if err := printAdminPreview(ctx, client, state, call); err != nil {
+		return err
+	}
+
+	if adminExportTx != "" {
+		return exportAdminCall(ctx, client, chainID, call)
+	}
+
+	if adminDryRun && adminFrom != "" {
+		from, err := ParseAddress(adminFrom)
+		if err != nil {
+			return err
+		}
+		return simulateAdminCall(ctx, client, call, from)
+	}
+
+	privateKey, from, err := loadSigner()
+	if err != nil {
+		return err
+	}
+	if err := simulateAdminCall(ctx, client, call, from); err != nil {
+		return err
+	}
+	if adminDryRun {
+		return nil
+	}
+
+	ok, err := confirm(fmt.Sprintf("确认以 %s 发送 %s 交易?", from.Hex(), call.Method.Name))
+	if err != nil {
+		return err
+	}
+	if !ok {
+		fmt.Println("已取消")
+		return nil
+	}
+
+	receipt, err := call.Send(ctx, client, privateKey, chainID)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("✓ %s 已确认: tx %s, block %d\n", call.Method.Name, receipt.TxHash.Hex(), receipt.BlockNumber.Uint64())
+	return nil
+}
+
+// printAdminPreview 显示市场状态、调用参数；resolve 额外显示 ResultMapper 映射结果
+func printAdminPreview(ctx context.Context, client *ethclient.Client, state *admin.MarketState, call *admin.Call) error {
+	paused := "no"
+	if state.Paused {
+		paused = "yes"
+	}
+
+	fmt.Printf("\nMarket:  %s\n", state.Market.Hex())
+	fmt.Printf("Status:  %s (paused: %s)\n", state.StatusName(), paused)
+	fmt.Printf("Call:    %s\n", call.Method.Sig)
+	for _, input := range call.Method.Inputs {
+		fmt.Printf("  %s = %s\n", input.Name, call.Inputs[input.Name])
+	}
+	fmt.Printf("Data:    %#x\n", call.Data)
+
+	if call.Action == admin.ActionResolve {
+		outcomes, err := admin.PreviewResolve(ctx, client, state, uint8(adminHome), uint8(adminAway))
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("\nScore %d-%d maps to:\n\n", adminHome, adminAway)
+		rows := make([][]string, 0, len(outcomes))
+		for _, o := range outcomes {
+			rows = append(rows, []string{o.OutcomeID.String(), o.Name, FormatBps(o.Weight)})
+		}
+		formatter := output.NewFromString(GetOutput())
+		formatter.SetHeader([]string{"Outcome", "Name", "Weight"})
+		formatter.AddRows(rows)
+		if err := formatter.Render(); err != nil {
+			return err
+		}
+	}
+
+	fmt.Println()
+	return nil
+}
+
+// simulateAdminCall 以 from 身份模拟调用
+func simulateAdminCall(ctx context.Context, client *ethclient.Client, call *admin.Call, from common.Address) error {
+	if err := call.Simulate(ctx, client, from); err != nil {
+		return err
+	}
+	fmt.Printf("✓ 以 %s 模拟 %s 成功\n", from.Hex(), call.Method.Name)
+	return nil
+}
+
+// exportAdminCall 导出 Safe 批量交易；指定 --safe 时先以 Safe 地址模拟
+func exportAdminCall(ctx context.Context, client *ethclient.Client, chainID *big.Int, call *admin.Call) error {
+	var safe common.Address
+	if adminSafe != "" {
+		addr, err := ParseAddress(adminSafe)
+		if err != nil {
+			return err
+		}
+		safe = addr
+		if err := call.Simulate(ctx, client, safe); err != nil {
+			fmt.Printf("⚠ 以 Safe %s 模拟失败（请确认 Safe 拥有所需角色）: %v\n", safe.Hex(), err)
+		} else {
+			fmt.Printf("✓ 以 Safe %s 模拟 %s 成功\n", safe.Hex(), call.Method.Name)
+		}
+	}
+
+	file, err := os.Create(adminExportTx)
+	if err != nil {
+		return fmt.Errorf("创建导出文件失败: %w", err)
+	}
+	defer file.Close()
+
+	if err := safetx.Write(file, call.SafeBatch(chainID, safe)); err != nil {
+		return err
+	}
+	fmt.Printf("已导出 Safe 交易: %s\n", adminExportTx)
+	return nil
+}
+
+func init() {
+	rootCmd.AddCommand(adminCmd)
+	adminCmd.AddCommand(adminMarketCmd)
+
+	adminMarketCmd.PersistentFlags().BoolVar(&adminDryRun, "dry-run", false, "只模拟（eth_call），不发送交易")
+	adminMarketCmd.PersistentFlags().StringVar(&adminFrom, "from", "", "--dry-run 时模拟的调用地址（默认 keystore 地址）")
+	adminMarketCmd.PersistentFlags().StringVar(&adminExportTx, "export-tx", "", "导出 Safe Transaction Builder 文件而不发送交易")
+	adminMarketCmd.PersistentFlags().StringVar(&adminSafe, "safe", "", "--export-tx 的 Safe 地址（用于模拟与文件元数据）")
+
+	adminResolveCmd.Flags().IntVar(&adminHome, "home", -1, "主队进球数")
+	adminResolveCmd.Flags().IntVar(&adminAway, "away", -1, "客队进球数")
+	adminResolveCmd.MarkFlagRequired("home")
+	adminResolveCmd.MarkFlagRequired("away")
+
+	adminFinalizeCmd.Flags().Uint64Var(&adminScaleBps, "scale-bps", 0, "赔付缩放比例（基点，0 = 正常结算，10000 = 全额赔付 + 储备金兜底）")
+
+	adminCancelCmd.Flags().StringVar(&adminReason, "reason", "", "取消原因（必填）")
+	adminCancelCmd.MarkFlagRequired("reason")
+
+	for _, cmd := range []*cobra.Command{adminLockCmd, adminResolveCmd, adminFinalizeCmd, adminCancelCmd, adminPauseCmd, adminUnpauseCmd} {
+		addSignerFlags(cmd)
+		adminMarketCmd.AddCommand(cmd)
+	}
+}
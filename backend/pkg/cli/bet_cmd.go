@@ -0,0 +1,230 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+
+	"github.com/pitchone/sportsbook/internal/betting"
+	"github.com/pitchone/sportsbook/pkg/output"
+)
+
+// usdcDecimals 下注金额按 USDC 精度解析
+const usdcDecimals = 6
+
+var betSlippageBps uint64
+
+// betCmd 下注命令
+var betCmd = &cobra.Command{
+	Use:   "bet",
+	Short: "下注（发送交易）",
+	Long: `通过 BettingRouter_V3 下注。
+
+交易使用 keystore 签名，发送前显示 previewBet 预估份额与 calculateFeeForMarket 手续费明细并要求确认。
+USDC 授权不足时会先发送 approve 交易（授权本次所需金额）。`,
+}
+
+// betPlaceCmd 单笔下注
+var betPlaceCmd = &cobra.Command{
+	Use:   "place <market> <outcome> <amount>",
+	Short: "单笔下注",
+	Long: `通过 BettingRouter_V3.placeBet 下注，amount 为 USDC 金额（含手续费）。
+
+示例:
+  p1cli bet place 0x1234... 0 10 --keystore ~/.foundry/keystores/qa
+  p1cli bet place 0x1234... 2 2.5 --slippage 50 --yes`,
+	Args: cobra.ExactArgs(3),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		market, err := ParseAddress(args[0])
+		if err != nil {
+			return err
+		}
+		outcome, err := strconv.ParseUint(args[1], 10, 64)
+		if err != nil {
+			return fmt.Errorf("无效的结果 ID: %s", args[1])
+		}
+		amount, err := betting.ParseAmount(args[2], usdcDecimals)
+		if err != nil {
+			return err
+		}
+
+		return runBets(cmd.Context(), []betting.BetRequest{{Market: market, OutcomeID: outcome, Amount: amount}})
+	},
+}
+
+// betBatchCmd 批量下注
+var betBatchCmd = &cobra.Command{
+	Use:   "batch <file>",
+	Short: "批量下注",
+	Long: `通过 BettingRouter_V3.placeBetBatch 在一笔交易中下注。
+
+文件每行一笔下注（USDC 金额含手续费），空行与 # 注释会被忽略:
+  market,outcome,amount
+  0x1234...,0,10
+  0x5678...,2,2.5`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		bets, err := betting.LoadBatchFile(args[0], usdcDecimals)
+		if err != nil {
+			return err
+		}
+		return runBets(cmd.Context(), bets)
+	},
+}
+
+// runBets 预览、确认并发送下注；单笔使用 placeBet，多笔使用 placeBetBatch
+func runBets(parent context.Context, bets []betting.BetRequest) error {
+	if parent == nil {
+		parent = context.Background()
+	}
+	ctx, cancel := context.WithTimeout(parent, 5*time.Minute)
+	defer cancel()
+
+	svc, client, err := newBettingService(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	quotes := make([]*betting.BetQuote, 0, len(bets))
+	for _, bet := range bets {
+		quote, err := svc.QuoteBet(ctx, bet, betSlippageBps)
+		if err != nil {
+			return err
+		}
+		quotes = append(quotes, quote)
+	}
+
+	approvals, err := svc.CheckApprovals(ctx, quotes)
+	if err != nil {
+		return err
+	}
+
+	if err := printBetPreview(svc, quotes, approvals); err != nil {
+		return err
+	}
+
+	ok, err := confirm("确认发送交易?")
+	if err != nil {
+		return err
+	}
+	if !ok {
+		fmt.Println("已取消")
+		return nil
+	}
+
+	for _, a := range approvals {
+		if a.Sufficient() {
+			continue
+		}
+		fmt.Printf("授权 %s 给 BettingRouter: %s\n", FormatAddress(a.Token, false), FormatUSDC(a.Required))
+		receipt, err := svc.Approve(ctx, a.Token, a.Required)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("  ✓ approve 已确认 (tx %s)\n", receipt.TxHash.Hex())
+	}
+
+	var results []*betting.BetResult
+	if len(quotes) == 1 {
+		receipt, r, err := svc.PlaceBet(ctx, quotes[0])
+		if err != nil {
+			return err
+		}
+		fmt.Printf("✓ placeBet 已确认: tx %s, block %d\n", receipt.TxHash.Hex(), receipt.BlockNumber.Uint64())
+		results = r
+	} else {
+		receipt, r, err := svc.PlaceBetBatch(ctx, quotes)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("✓ placeBetBatch 已确认: tx %s, block %d\n", receipt.TxHash.Hex(), receipt.BlockNumber.Uint64())
+		results = r
+	}
+
+	if len(results) < len(quotes) {
+		fmt.Printf("注意: %d 笔下注中只有 %d 笔成交（其余未通过 Router 校验）\n", len(quotes), len(results))
+	}
+
+	rows := make([][]string, 0, len(results))
+	for _, r := range results {
+		rows = append(rows, []string{
+			FormatAddress(r.Market, false),
+			r.OutcomeID.String(),
+			FormatUSDC(r.Amount),
+			FormatUSDC(r.Fee),
+			FormatShares(r.Shares),
+		})
+	}
+
+	formatter := output.NewFromString(GetOutput())
+	formatter.SetHeader([]string{"Market", "Outcome", "Amount", "Fee", "Shares"})
+	formatter.AddRows(rows)
+	return formatter.Render()
+}
+
+// printBetPreview 显示下注预览、手续费明细与授权情况
+func printBetPreview(svc *betting.Service, quotes []*betting.BetQuote, approvals []*betting.Approval) error {
+	fmt.Printf("\nAccount: %s\n", svc.From().Hex())
+	fmt.Printf("Router:  %s\n", svc.Router().Hex())
+	fmt.Printf("Slippage: %s\n\n", FormatBps(new(big.Int).SetUint64(betSlippageBps)))
+
+	totalAmount := big.NewInt(0)
+	totalFee := big.NewInt(0)
+
+	rows := make([][]string, 0, len(quotes))
+	for _, q := range quotes {
+		referrer := "-"
+		if q.Fee.Referrer != (common.Address{}) {
+			referrer = FormatAddress(q.Fee.Referrer, false)
+		}
+		rows = append(rows, []string{
+			FormatAddress(q.Market, false),
+			fmt.Sprintf("%d", q.OutcomeID),
+			FormatUSDC(q.Fee.GrossAmount),
+			FormatUSDC(q.Fee.FeeAmount),
+			FormatBps(q.Fee.DiscountBps),
+			referrer,
+			FormatUSDC(q.Fee.NetAmount),
+			FormatShares(q.Shares),
+			FormatShares(q.MinShares),
+		})
+		totalAmount.Add(totalAmount, q.Amount)
+		totalFee.Add(totalFee, q.Fee.FeeAmount)
+	}
+
+	formatter := output.NewFromString(GetOutput())
+	formatter.SetHeader([]string{"Market", "Outcome", "Amount", "Fee", "Discount", "Referrer", "Net", "Est. Shares", "Min Shares"})
+	formatter.AddRows(rows)
+	if err := formatter.Render(); err != nil {
+		return err
+	}
+
+	fmt.Printf("\nTotal: %s USDC (fee %s USDC)\n", FormatUSDC(totalAmount), FormatUSDC(totalFee))
+	for _, a := range approvals {
+		if a.Sufficient() {
+			continue
+		}
+		fmt.Printf("需要先授权 %s: 当前 %s, 需要 %s\n",
+			FormatAddress(a.Token, false), FormatUSDC(a.Allowance), FormatUSDC(a.Required))
+	}
+	fmt.Println()
+	return nil
+}
+
+func init() {
+	rootCmd.AddCommand(betCmd)
+
+	betCmd.AddCommand(betPlaceCmd)
+	betCmd.AddCommand(betBatchCmd)
+
+	for _, cmd := range []*cobra.Command{betPlaceCmd, betBatchCmd} {
+		addSignerFlags(cmd)
+		cmd.Flags().Uint64Var(&betSlippageBps, "slippage", 100, "滑点容忍度（基点，100 = 1%）")
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
+	"github.com/pitchone/sportsbook/internal/query"
 	"github.com/pitchone/sportsbook/pkg/output"
 )
 
@@ -31,6 +32,8 @@ var configShowCmd = &cobra.Command{
 			"Chain ID":    fmt.Sprintf("%d", viper.GetInt64("chain_id")),
 			"RPC URL":     viper.GetString("rpc_url"),
 			"Database":    maskDatabaseURL(viper.GetString("database.url")),
+			"Subgraph":    viper.GetString("subgraph.url"),
+			"History":     historySourceName(),
 			"Config File": viper.ConfigFileUsed(),
 		}
 
@@ -128,6 +131,7 @@ var configContractsCmd = &cobra.Command{
 			{"ReferralRegistry", viper.GetString("contracts.referral_registry")},
 			{"FeeRouter", viper.GetString("contracts.fee_router")},
 			{"Factory", viper.GetString("contracts.factory")},
+			{"Multicall3", viper.GetString("contracts.multicall3")},
 		}
 
 		formatter := output.NewFromString(format)
@@ -170,6 +174,14 @@ func init() {
 	configCmd.AddCommand(configTemplatesCmd)
 }
 
+// historySourceName 返回历史数据来源（默认 subgraph）
+func historySourceName() string {
+	if source := viper.GetString("history.source"); source != "" {
+		return source
+	}
+	return query.HistorySourceSubgraph
+}
+
 // maskDatabaseURL 隐藏数据库密码
 func maskDatabaseURL(url string) string {
 	if url == "" {
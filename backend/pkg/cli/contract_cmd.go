@@ -10,7 +10,6 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
-	"github.com/pitchone/sportsbook/internal/query"
 	"github.com/pitchone/sportsbook/pkg/output"
 )
 
@@ -72,6 +71,7 @@ var contractInfoCmd = &cobra.Command{
 			{"ReferralRegistry", viper.GetString("contracts.referral_registry")},
 			{"FeeRouter", viper.GetString("contracts.fee_router")},
 			{"Factory", viper.GetString("contracts.factory")},
+			{"BettingRouter", viper.GetString("contracts.betting_router")},
 		}
 
 		formatter := output.NewFromString(format)
@@ -97,9 +97,9 @@ var usdcSupplyCmd = &cobra.Command{
 		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 		defer cancel()
 
-		svc, err := query.NewService(ctx)
+		svc, err := newQueryService(ctx)
 		if err != nil {
-			return fmt.Errorf("初始化查询服务失败: %w", err)
+			return err
 		}
 		defer svc.Close()
 
@@ -135,9 +135,9 @@ var usdcBalanceCmd = &cobra.Command{
 		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 		defer cancel()
 
-		svc, err := query.NewService(ctx)
+		svc, err := newQueryService(ctx)
 		if err != nil {
-			return fmt.Errorf("初始化查询服务失败: %w", err)
+			return err
 		}
 		defer svc.Close()
 
@@ -172,9 +172,9 @@ var vaultInfoCmd = &cobra.Command{
 		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 		defer cancel()
 
-		svc, err := query.NewService(ctx)
+		svc, err := newQueryService(ctx)
 		if err != nil {
-			return fmt.Errorf("初始化查询服务失败: %w", err)
+			return err
 		}
 		defer svc.Close()
 
@@ -211,9 +211,9 @@ var feeRouterConfigCmd = &cobra.Command{
 		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 		defer cancel()
 
-		svc, err := query.NewService(ctx)
+		svc, err := newQueryService(ctx)
 		if err != nil {
-			return fmt.Errorf("初始化查询服务失败: %w", err)
+			return err
 		}
 		defer svc.Close()
 
@@ -264,9 +264,9 @@ var referralInfoCmd = &cobra.Command{
 		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 		defer cancel()
 
-		svc, err := query.NewService(ctx)
+		svc, err := newQueryService(ctx)
 		if err != nil {
-			return fmt.Errorf("初始化查询服务失败: %w", err)
+			return err
 		}
 		defer svc.Close()
 
@@ -0,0 +1,195 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+
+	"github.com/charmbracelet/x/term"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+
+	"github.com/pitchone/sportsbook/internal/events"
+)
+
+var (
+	eventsContract   string
+	eventsAddresses  []string
+	eventsNames      []string
+	eventsFrom       uint64
+	eventsTo         uint64
+	eventsFormat     string
+	eventsOut        string
+	eventsCheckpoint string
+	eventsChunkSize  uint64
+)
+
+// eventsContractKeys 合约类型对应的配置项（contracts.<key>），market 需要 --address
+var eventsContractKeys = map[string]string{
+	events.ContractFactory:   "factory",
+	events.ContractRouter:    "betting_router",
+	events.ContractVault:     "vault",
+	events.ContractFeeRouter: "fee_router",
+}
+
+// eventsCmd 事件命令
+var eventsCmd = &cobra.Command{
+	Use:   "events",
+	Short: "合约事件导出",
+}
+
+// eventsExportCmd 导出事件
+var eventsExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "按区块区间导出合约事件（NDJSON / CSV / Parquet）",
+	Long: `扫描 --from 到 --to 区块的合约日志，按绑定 ABI 解码后逐行导出。
+
+  --contract   market | factory | router | vault | fee-router
+  --address    合约地址，可重复；market 必填，其余默认使用配置中的地址
+  --event      只导出指定事件，可重复（默认全部）
+  --format     ndjson | csv | parquet（默认按 --out 扩展名推断，否则 ndjson）
+
+factory 按 MarketFactory_V3 的事件解码，vault 按 LiquidityVault_V3 的事件解码（默认地址为 contracts.vault）。
+事件参数统一写入 args 列（JSON 对象，键为参数名），不按事件拆分为类型化列：
+地址为小写十六进制，uint256 / int256 为十进制字符串以免丢失精度。
+
+eth_getLogs 按自适应区间请求：RPC 报错（区间过大、结果过多、超时）时区间减半重试，连续成功后逐步放大。
+指定 --out 时每个区间写出后保存检查点（默认 <out>.checkpoint），中断后以相同参数重新运行即可续传。
+Parquet 导出过程中暂存为 <out>.ndjson.part，扫描完成后转换。
+
+示例:
+  p1cli events export --contract market --address 0x1234... --from 5000000 --out bets.ndjson
+  p1cli events export --contract fee-router --from 5000000 --to 5100000 --format csv > fees.csv
+  p1cli events export --contract router --event BetPlaced --from 5000000 --out bets.parquet`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		addresses, err := eventsExportAddresses()
+		if err != nil {
+			return err
+		}
+		format, err := eventsExportFormat()
+		if err != nil {
+			return err
+		}
+
+		parent := cmd.Context()
+		if parent == nil {
+			parent = context.Background()
+		}
+		ctx, stop := signal.NotifyContext(parent, os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		client, err := NewEthClient(ctx)
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+
+		opts := events.ExportOptions{
+			Contract:   eventsContract,
+			Addresses:  addresses,
+			Events:     eventsNames,
+			From:       eventsFrom,
+			To:         eventsTo,
+			Format:     format,
+			Output:     eventsOut,
+			Checkpoint: eventsCheckpoint,
+			ChunkSize:  eventsChunkSize,
+		}
+		if term.IsTerminal(os.Stderr.Fd()) {
+			opts.Progress = printExportProgress
+		}
+
+		progress, err := events.Export(ctx, client, opts)
+		if progress != nil && opts.Progress != nil {
+			fmt.Fprintln(os.Stderr)
+		}
+		if err != nil {
+			if eventsOut != "" && progress != nil && progress.Next > progress.From {
+				fmt.Fprintf(os.Stderr, "进度已保存到 %s（下一个区块 %d），以相同参数重新运行即可续传\n", opts.CheckpointPath(), progress.Next)
+			}
+			return err
+		}
+
+		target := eventsOut
+		if target == "" {
+			target = "stdout"
+		}
+		fmt.Fprintf(os.Stderr, "已导出 %d 条事件（区块 %d - %d）→ %s\n", progress.Rows, progress.From, progress.To, target)
+		return nil
+	},
+}
+
+// eventsExportAddresses 解析 --address，未指定时使用配置中的合约地址
+func eventsExportAddresses() ([]common.Address, error) {
+	if len(eventsAddresses) > 0 {
+		addresses := make([]common.Address, 0, len(eventsAddresses))
+		for _, s := range eventsAddresses {
+			addr, err := ParseAddress(s)
+			if err != nil {
+				return nil, err
+			}
+			addresses = append(addresses, addr)
+		}
+		return addresses, nil
+	}
+
+	if eventsContract == events.ContractMarket {
+		return nil, fmt.Errorf("--contract market 需要指定 --address")
+	}
+	key, ok := eventsContractKeys[eventsContract]
+	if !ok {
+		return nil, fmt.Errorf("不支持的合约类型: %s (支持: %s)", eventsContract, strings.Join(events.Contracts(), ", "))
+	}
+	addr := common.HexToAddress(GetContractAddress(key))
+	if addr == (common.Address{}) {
+		return nil, fmt.Errorf("配置中未设置 contracts.%s，请使用 --address 指定", key)
+	}
+	return []common.Address{addr}, nil
+}
+
+// eventsExportFormat 返回输出格式：--format 优先，其次按 --out 扩展名推断
+func eventsExportFormat() (string, error) {
+	format := strings.ToLower(eventsFormat)
+	if format == "" {
+		switch strings.ToLower(filepath.Ext(eventsOut)) {
+		case ".csv":
+			format = events.FormatCSV
+		case ".parquet":
+			format = events.FormatParquet
+		default:
+			format = events.FormatNDJSON
+		}
+	}
+	if format == events.FormatParquet && eventsOut == "" {
+		return "", fmt.Errorf("Parquet 格式需要指定 --out")
+	}
+	return format, nil
+}
+
+// printExportProgress 在 stderr 显示扫描进度
+func printExportProgress(p *events.Checkpoint) {
+	total := p.To - p.From + 1
+	done := p.Next - p.From
+	fmt.Fprintf(os.Stderr, "\r区块 %d / %d (%.1f%%)，已导出 %d 条事件", p.Next-1, p.To, float64(done)*100/float64(total), p.Rows)
+}
+
+func init() {
+	rootCmd.AddCommand(eventsCmd)
+	eventsCmd.AddCommand(eventsExportCmd)
+
+	eventsExportCmd.Flags().StringVar(&eventsContract, "contract", "", "合约类型 (market|factory|router|vault|fee-router)")
+	eventsExportCmd.Flags().StringSliceVar(&eventsAddresses, "address", nil, "合约地址（可重复，market 必填）")
+	eventsExportCmd.Flags().StringSliceVar(&eventsNames, "event", nil, "只导出指定事件（可重复）")
+	eventsExportCmd.Flags().Uint64Var(&eventsFrom, "from", 0, "起始区块")
+	eventsExportCmd.Flags().Uint64Var(&eventsTo, "to", 0, "结束区块（默认最新区块）")
+	eventsExportCmd.Flags().StringVar(&eventsFormat, "format", "", "输出格式 (ndjson|csv|parquet)")
+	eventsExportCmd.Flags().StringVar(&eventsOut, "out", "", "输出文件（默认 stdout，不记录检查点）")
+	eventsExportCmd.Flags().StringVar(&eventsCheckpoint, "checkpoint", "", "检查点文件（默认 <out>.checkpoint）")
+	eventsExportCmd.Flags().Uint64Var(&eventsChunkSize, "chunk-size", events.DefaultChunkSize, "初始每次 eth_getLogs 的区块数")
+	eventsExportCmd.MarkFlagRequired("contract")
+	eventsExportCmd.MarkFlagRequired("from")
+}
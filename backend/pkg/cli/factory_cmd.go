@@ -3,6 +3,7 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -27,9 +28,9 @@ var factoryInfoCmd = &cobra.Command{
 		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 		defer cancel()
 
-		svc, err := query.NewService(ctx)
+		svc, err := newQueryService(ctx)
 		if err != nil {
-			return fmt.Errorf("初始化查询服务失败: %w", err)
+			return err
 		}
 		defer svc.Close()
 
@@ -57,9 +58,9 @@ var factoryTemplatesCmd = &cobra.Command{
 		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 		defer cancel()
 
-		svc, err := query.NewService(ctx)
+		svc, err := newQueryService(ctx)
 		if err != nil {
-			return fmt.Errorf("初始化查询服务失败: %w", err)
+			return err
 		}
 		defer svc.Close()
 
@@ -125,9 +126,9 @@ var marketsListCmd = &cobra.Command{
 		ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
 		defer cancel()
 
-		svc, err := query.NewService(ctx)
+		svc, err := newQueryService(ctx)
 		if err != nil {
-			return fmt.Errorf("初始化查询服务失败: %w", err)
+			return err
 		}
 		defer svc.Close()
 
@@ -139,9 +140,10 @@ var marketsListCmd = &cobra.Command{
 		// 解析状态筛选
 		if marketListStatus != "" {
 			status := parseStatus(marketListStatus)
-			if status != 255 {
-				opts.Status = &status
+			if status == "" {
+				return fmt.Errorf("无效的状态: %s", marketListStatus)
 			}
+			opts.Status = status
 		}
 
 		// 解析模板筛选
@@ -191,9 +193,9 @@ var marketsCountCmd = &cobra.Command{
 		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 		defer cancel()
 
-		svc, err := query.NewService(ctx)
+		svc, err := newQueryService(ctx)
 		if err != nil {
-			return fmt.Errorf("初始化查询服务失败: %w", err)
+			return err
 		}
 		defer svc.Close()
 
@@ -216,9 +218,9 @@ var marketsStatsCmd = &cobra.Command{
 		ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
 		defer cancel()
 
-		svc, err := query.NewService(ctx)
+		svc, err := newQueryService(ctx)
 		if err != nil {
-			return fmt.Errorf("初始化查询服务失败: %w", err)
+			return err
 		}
 		defer svc.Close()
 
@@ -230,6 +232,7 @@ var marketsStatsCmd = &cobra.Command{
 		format := GetOutput()
 
 		rows := [][]string{
+			{"Created", fmt.Sprintf("%d", stats["Created"])},
 			{"Open", fmt.Sprintf("%d", stats["Open"])},
 			{"Locked", fmt.Sprintf("%d", stats["Locked"])},
 			{"Resolved", fmt.Sprintf("%d", stats["Resolved"])},
@@ -267,26 +270,28 @@ func init() {
 	marketsCmd.AddCommand(marketsStatsCmd)
 
 	// markets list flags
-	marketsListCmd.Flags().StringVar(&marketListStatus, "status", "", "按状态筛选 (open|locked|resolved|finalized|cancelled)")
+	marketsListCmd.Flags().StringVar(&marketListStatus, "status", "", "按状态筛选 (created|open|locked|resolved|finalized|cancelled)")
 	marketsListCmd.Flags().StringVar(&marketListTemplate, "template", "", "按模板 ID 筛选")
 	marketsListCmd.Flags().IntVar(&marketListLimit, "limit", 20, "返回数量限制")
 	marketsListCmd.Flags().IntVar(&marketListOffset, "offset", 0, "分页偏移")
 }
 
-// parseStatus 解析状态字符串
-func parseStatus(s string) uint8 {
-	switch s {
-	case "open", "Open":
-		return 0
-	case "locked", "Locked":
-		return 1
-	case "resolved", "Resolved":
-		return 2
-	case "finalized", "Finalized":
-		return 3
-	case "cancelled", "Cancelled":
-		return 4
+// parseStatus 解析状态字符串为状态名（V2 与 V3 市场的状态编号不同，按名称筛选）
+func parseStatus(s string) string {
+	switch strings.ToLower(s) {
+	case "created":
+		return "Created"
+	case "open":
+		return "Open"
+	case "locked":
+		return "Locked"
+	case "resolved":
+		return "Resolved"
+	case "finalized":
+		return "Finalized"
+	case "cancelled":
+		return "Cancelled"
 	default:
-		return 255 // 无效
+		return "" // 无效
 	}
 }
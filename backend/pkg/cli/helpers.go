@@ -4,10 +4,13 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"os"
 	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethclient"
+
+	"github.com/pitchone/sportsbook/internal/query"
 )
 
 // 状态名称映射
@@ -204,6 +207,18 @@ func NewEthClient(ctx context.Context) (*ethclient.Client, error) {
 	return client, nil
 }
 
+// newQueryService 创建查询服务；指定 --block / --at 时在 stderr 提示查询的区块
+func newQueryService(ctx context.Context) (*query.Service, error) {
+	svc, err := query.NewService(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("初始化查询服务失败: %w", err)
+	}
+	if block := svc.Block(); block != nil {
+		fmt.Fprintf(os.Stderr, "历史查询: 区块 %d (%s)\n", block.Number, block.Timestamp.Format("2006-01-02 15:04:05 MST"))
+	}
+	return svc, nil
+}
+
 // CheckNetwork 检查网络连接
 func CheckNetwork(ctx context.Context, client *ethclient.Client) error {
 	chainID, err := client.ChainID(ctx)
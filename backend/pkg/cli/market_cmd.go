@@ -3,6 +3,8 @@ package cli
 import (
 	"context"
 	"fmt"
+	"os"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -33,9 +35,9 @@ var marketInfoCmd = &cobra.Command{
 		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 		defer cancel()
 
-		svc, err := query.NewService(ctx)
+		svc, err := newQueryService(ctx)
 		if err != nil {
-			return fmt.Errorf("初始化查询服务失败: %w", err)
+			return err
 		}
 		defer svc.Close()
 
@@ -46,6 +48,11 @@ var marketInfoCmd = &cobra.Command{
 
 		format := GetOutput()
 
+		// JSON 输出完整结构（含 V3 结果规则与结算结果）
+		if format == string(output.FormatJSON) {
+			return output.NewJSONFormatter(os.Stdout).RenderRaw(info)
+		}
+
 		kickoff := "-"
 		if !info.KickoffTime.IsZero() {
 			kickoff = info.KickoffTime.Format("2006-01-02 15:04:05")
@@ -64,13 +71,83 @@ var marketInfoCmd = &cobra.Command{
 			"Outcome Count":   fmt.Sprintf("%d", info.OutcomeCount),
 			"Winning Outcome": winningOutcome,
 			"Total Liquidity": FormatUSDC(info.TotalLiquidity) + " USDC",
-			"Fee Rate":        fmt.Sprintf("%d bps (%.2f%%)", info.FeeRate, float64(info.FeeRate)/100),
+			"Version":         fmt.Sprintf("V%d", info.Version),
+		}
+
+		if info.V3 == nil {
+			data["Fee Rate"] = fmt.Sprintf("%d bps (%.2f%%)", info.FeeRate, float64(info.FeeRate)/100)
+			return output.PrintMap(format, data, "Market Information")
+		}
+
+		addMarketV3Info(data, info)
+		if err := output.PrintMap(format, data, "Market Information"); err != nil {
+			return err
+		}
+
+		fmt.Println()
+		rows := make([][]string, 0, len(info.V3.Outcomes))
+		for _, o := range info.V3.Outcomes {
+			rows = append(rows, []string{
+				fmt.Sprintf("%d", o.OutcomeID),
+				o.Name,
+				o.PayoutType,
+				FormatUSDC(o.TotalBet),
+				FormatShares(o.TotalShares),
+			})
 		}
 
-		return output.PrintMap(format, data, "Market Information")
+		formatter := output.NewFromString(format)
+		formatter.SetHeader([]string{"ID", "Outcome", "Payout", "Total Bet", "Total Shares"})
+		formatter.AddRows(rows)
+		return formatter.Render()
 	},
 }
 
+// addMarketV3Info 添加 Market_V3 特有字段（定价策略、Mapper、结算结果）
+func addMarketV3Info(data map[string]string, info *query.MarketInfo) {
+	v3 := info.V3
+
+	if info.MatchID != "" {
+		data["Match ID"] = info.MatchID
+	}
+
+	data["Pricing Strategy"] = fmt.Sprintf("%s (%s)", valueOrDash(v3.PricingStrategyType), v3.PricingStrategy.Hex())
+	data["Result Mapper"] = fmt.Sprintf("%s v%s (%s)", valueOrDash(v3.MapperType), valueOrDash(v3.MapperVersion), v3.ResultMapper.Hex())
+	data["Mapper Params"] = valueOrDash(v3.MapperParams)
+	data["Total Bet"] = FormatUSDC(v3.TotalBetAmount) + " USDC"
+	data["Borrowed"] = FormatUSDC(v3.BorrowedAmount) + " USDC"
+	data["Paused"] = fmt.Sprintf("%t", v3.Paused)
+
+	settlement := "-"
+	if s := v3.Settlement; s != nil {
+		parts := make([]string, 0, len(s.OutcomeIDs))
+		for i, id := range s.OutcomeIDs {
+			name := fmt.Sprintf("%d", id)
+			if id < uint64(len(v3.Outcomes)) {
+				name = fmt.Sprintf("%d %s", id, v3.Outcomes[id].Name)
+			}
+			if i < len(s.Weights) {
+				name = fmt.Sprintf("%s (%.2f%%)", name, float64(s.Weights[i])/100)
+			}
+			parts = append(parts, name)
+		}
+		settlement = strings.Join(parts, ", ")
+		if s.Score != "" {
+			settlement = fmt.Sprintf("%s | Score %s", settlement, s.Score)
+		}
+		data["Settled At"] = s.SettledAt.Format("2006-01-02 15:04:05")
+	}
+	data["Settlement"] = settlement
+}
+
+// valueOrDash 空字符串显示为 -
+func valueOrDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
 // marketPricesCmd 市场赔率
 var marketPricesCmd = &cobra.Command{
 	Use:   "prices <address>",
@@ -86,9 +163,9 @@ var marketPricesCmd = &cobra.Command{
 		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 		defer cancel()
 
-		svc, err := query.NewService(ctx)
+		svc, err := newQueryService(ctx)
 		if err != nil {
-			return fmt.Errorf("初始化查询服务失败: %w", err)
+			return err
 		}
 		defer svc.Close()
 
@@ -142,7 +219,7 @@ var marketPricesCmd = &cobra.Command{
 var marketPositionsCmd = &cobra.Command{
 	Use:   "positions <address>",
 	Short: "查询市场头寸分布",
-	Long:  `查询指定市场的头寸分布情况。`,
+	Long:  `查询指定市场的头寸分布情况（数据来自 Subgraph）。`,
 	Args:  cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		addr, err := ParseAddress(args[0])
@@ -153,9 +230,9 @@ var marketPositionsCmd = &cobra.Command{
 		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 		defer cancel()
 
-		svc, err := query.NewService(ctx)
+		svc, err := newQueryService(ctx)
 		if err != nil {
-			return fmt.Errorf("初始化查询服务失败: %w", err)
+			return err
 		}
 		defer svc.Close()
 
@@ -165,7 +242,7 @@ var marketPositionsCmd = &cobra.Command{
 		}
 
 		if len(positions) == 0 {
-			fmt.Println("没有头寸数据（需要通过事件日志或数据库获取）")
+			fmt.Println("没有头寸数据")
 			return nil
 		}
 
@@ -177,11 +254,12 @@ var marketPositionsCmd = &cobra.Command{
 				FormatAddress(p.Owner, false),
 				fmt.Sprintf("%d", p.OutcomeID),
 				FormatShares(p.Balance),
+				FormatUSDC(p.CostBasis),
 			})
 		}
 
 		formatter := output.NewFromString(format)
-		formatter.SetHeader([]string{"Owner", "Outcome", "Balance"})
+		formatter.SetHeader([]string{"Owner", "Outcome", "Balance", "Invested"})
 		formatter.AddRows(rows)
 		return formatter.Render()
 	},
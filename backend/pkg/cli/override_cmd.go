@@ -0,0 +1,295 @@
+package cli
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	_ "github.com/lib/pq"
+	"github.com/spf13/cobra"
+
+	"github.com/pitchone/sportsbook/internal/repository"
+	"github.com/pitchone/sportsbook/pkg/output"
+)
+
+var (
+	overrideHome      int
+	overrideAway      int
+	overrideExtraTime bool
+	overrideReason    string
+	overrideEvidence  string
+	overrideNote      string
+	overrideStatus    string
+	overrideLimit     int
+)
+
+// overrideCmd 人工赛果覆盖命令
+var overrideCmd = &cobra.Command{
+	Use:   "override",
+	Short: "人工赛果覆盖（双人审批）",
+	Long: `管理 Keeper 结算使用的人工赛果覆盖（需要数据库支持）。
+
+覆盖记录创建后处于 pending 状态，必须由另一名运营人员批准后才会被 Keeper 使用。
+操作人身份取数据库会话用户（连接串中的用户名），由数据库记录，命令行无法指定；
+每名运营人员须使用自己的数据库账号连接。
+
+示例:
+  p1cli override create 0x1234... --home 2 --away 1 --reason "数据源缺失" --evidence https://... --db postgres://alice@db/p1
+  p1cli override approve 12 --db postgres://bob@db/p1
+  p1cli override list --status pending`,
+}
+
+// overrideCreateCmd 创建覆盖
+var overrideCreateCmd = &cobra.Command{
+	Use:   "create <market>",
+	Short: "创建赛果覆盖（待审批）",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		market, err := ParseAddress(args[0])
+		if err != nil {
+			return err
+		}
+		if overrideHome < 0 || overrideHome > 255 || overrideAway < 0 || overrideAway > 255 {
+			return fmt.Errorf("比分无效: %d-%d", overrideHome, overrideAway)
+		}
+		if overrideReason == "" {
+			return fmt.Errorf("必须提供 --reason")
+		}
+		if u, err := url.ParseRequestURI(overrideEvidence); err != nil || u.Host == "" {
+			return fmt.Errorf("必须提供有效的 --evidence URL")
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		repo, closeDB, err := openOverridesRepository(ctx)
+		if err != nil {
+			return err
+		}
+		defer closeDB()
+
+		override := &repository.ResultOverride{
+			MarketAddress: market.Hex(),
+			HomeGoals:     uint8(overrideHome),
+			AwayGoals:     uint8(overrideAway),
+			ExtraTime:     overrideExtraTime,
+			Reason:        overrideReason,
+			EvidenceURL:   overrideEvidence,
+		}
+		id, err := repo.CreateOverride(ctx, override)
+		if err != nil {
+			return fmt.Errorf("创建覆盖失败: %w", err)
+		}
+
+		fmt.Printf("%s 已创建覆盖 #%d（待另一名运营人员批准）\n", override.CreatedBy, id)
+		return nil
+	},
+}
+
+// overrideApproveCmd 批准覆盖
+var overrideApproveCmd = &cobra.Command{
+	Use:   "approve <id>",
+	Short: "批准赛果覆盖（须与创建人不同）",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return reviewOverride(args[0], true)
+	},
+}
+
+// overrideRejectCmd 拒绝覆盖
+var overrideRejectCmd = &cobra.Command{
+	Use:   "reject <id>",
+	Short: "拒绝赛果覆盖",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return reviewOverride(args[0], false)
+	},
+}
+
+// overrideListCmd 列出覆盖
+var overrideListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "列出赛果覆盖",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		repo, closeDB, err := openOverridesRepository(ctx)
+		if err != nil {
+			return err
+		}
+		defer closeDB()
+
+		overrides, err := repo.ListOverrides(ctx, overrideStatus, overrideLimit)
+		if err != nil {
+			return fmt.Errorf("查询覆盖失败: %w", err)
+		}
+
+		if len(overrides) == 0 {
+			fmt.Println("没有覆盖记录")
+			return nil
+		}
+
+		rows := make([][]string, 0, len(overrides))
+		for _, o := range overrides {
+			rows = append(rows, []string{
+				strconv.FormatInt(o.ID, 10),
+				o.MarketAddress,
+				fmt.Sprintf("%d-%d", o.HomeGoals, o.AwayGoals),
+				o.Status,
+				o.CreatedBy,
+				o.ApprovedBy,
+				o.Reason,
+				time.Unix(o.CreatedAt, 0).Format("2006-01-02 15:04:05"),
+			})
+		}
+
+		formatter := output.NewFromString(GetOutput())
+		formatter.SetHeader([]string{"ID", "Market", "Score", "Status", "Created By", "Approved By", "Reason", "Created"})
+		formatter.AddRows(rows)
+		return formatter.Render()
+	},
+}
+
+// overrideShowCmd 查看覆盖及审批轨迹
+var overrideShowCmd = &cobra.Command{
+	Use:   "show <id>",
+	Short: "查看赛果覆盖及审批轨迹",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("无效的覆盖 ID: %s", args[0])
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		repo, closeDB, err := openOverridesRepository(ctx)
+		if err != nil {
+			return err
+		}
+		defer closeDB()
+
+		o, err := repo.GetOverride(ctx, id)
+		if err != nil {
+			return fmt.Errorf("查询覆盖失败: %w", err)
+		}
+
+		format := GetOutput()
+		data := map[string]string{
+			"ID":          strconv.FormatInt(o.ID, 10),
+			"Market":      o.MarketAddress,
+			"Score":       fmt.Sprintf("%d-%d", o.HomeGoals, o.AwayGoals),
+			"Extra Time":  strconv.FormatBool(o.ExtraTime),
+			"Status":      o.Status,
+			"Reason":      o.Reason,
+			"Evidence":    o.EvidenceURL,
+			"Created By":  o.CreatedBy,
+			"Approved By": o.ApprovedBy,
+			"Settle Tx":   o.SettleTxHash,
+		}
+		if err := output.PrintMap(format, data, "Result Override"); err != nil {
+			return err
+		}
+
+		rows := make([][]string, 0, len(o.Trail))
+		for _, e := range o.Trail {
+			rows = append(rows, []string{
+				time.Unix(e.CreatedAt, 0).Format("2006-01-02 15:04:05"),
+				e.Action,
+				e.Actor,
+				e.Note,
+			})
+		}
+
+		fmt.Printf("\nApproval Trail\n\n")
+		formatter := output.NewFromString(format)
+		formatter.SetHeader([]string{"Time", "Action", "Actor", "Note"})
+		formatter.AddRows(rows)
+		return formatter.Render()
+	},
+}
+
+// reviewOverride 批准或拒绝覆盖
+func reviewOverride(rawID string, approve bool) error {
+	id, err := strconv.ParseInt(rawID, 10, 64)
+	if err != nil {
+		return fmt.Errorf("无效的覆盖 ID: %s", rawID)
+	}
+
+	if !approve && overrideNote == "" {
+		return fmt.Errorf("拒绝时必须提供 --note")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	repo, closeDB, err := openOverridesRepository(ctx)
+	if err != nil {
+		return err
+	}
+	defer closeDB()
+
+	if approve {
+		operator, err := repo.ApproveOverride(ctx, id, overrideNote)
+		if err != nil {
+			return fmt.Errorf("批准覆盖失败: %w", err)
+		}
+		fmt.Printf("覆盖 #%d 已由 %s 批准，Keeper 下次结算时生效\n", id, operator)
+		return nil
+	}
+
+	operator, err := repo.RejectOverride(ctx, id, overrideNote)
+	if err != nil {
+		return fmt.Errorf("拒绝覆盖失败: %w", err)
+	}
+	fmt.Printf("覆盖 #%d 已由 %s 拒绝\n", id, operator)
+	return nil
+}
+
+// openOverridesRepository 连接数据库并创建覆盖仓库
+func openOverridesRepository(ctx context.Context) (*repository.OverridesRepository, func(), error) {
+	dbURL := GetDatabaseURL()
+	if dbURL == "" {
+		return nil, nil, fmt.Errorf("未配置数据库连接（使用 --db 或 database.url）")
+	}
+
+	db, err := sql.Open("postgres", dbURL)
+	if err != nil {
+		return nil, nil, fmt.Errorf("连接数据库失败: %w", err)
+	}
+	if err := db.PingContext(ctx); err != nil {
+		db.Close()
+		return nil, nil, fmt.Errorf("连接数据库失败: %w", err)
+	}
+
+	return repository.NewOverridesRepository(db), func() { db.Close() }, nil
+}
+
+func init() {
+	rootCmd.AddCommand(overrideCmd)
+
+	overrideCreateCmd.Flags().IntVar(&overrideHome, "home", -1, "主队进球数")
+	overrideCreateCmd.Flags().IntVar(&overrideAway, "away", -1, "客队进球数")
+	overrideCreateCmd.Flags().BoolVar(&overrideExtraTime, "extra-time", false, "是否包含加时")
+	overrideCreateCmd.Flags().StringVar(&overrideReason, "reason", "", "覆盖原因（必填）")
+	overrideCreateCmd.Flags().StringVar(&overrideEvidence, "evidence", "", "证据链接（必填）")
+	overrideCreateCmd.MarkFlagRequired("home")
+	overrideCreateCmd.MarkFlagRequired("away")
+
+	overrideApproveCmd.Flags().StringVar(&overrideNote, "note", "", "审批备注")
+	overrideRejectCmd.Flags().StringVar(&overrideNote, "note", "", "拒绝原因（必填）")
+
+	overrideListCmd.Flags().StringVar(&overrideStatus, "status", "", "按状态过滤 (pending|approved|rejected|applied)")
+	overrideListCmd.Flags().IntVar(&overrideLimit, "limit", 50, "最多显示条数")
+
+	overrideCmd.AddCommand(overrideCreateCmd)
+	overrideCmd.AddCommand(overrideApproveCmd)
+	overrideCmd.AddCommand(overrideRejectCmd)
+	overrideCmd.AddCommand(overrideListCmd)
+	overrideCmd.AddCommand(overrideShowCmd)
+}
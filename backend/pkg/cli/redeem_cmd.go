@@ -0,0 +1,158 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+
+	"github.com/pitchone/sportsbook/internal/betting"
+	"github.com/pitchone/sportsbook/pkg/output"
+)
+
+// redeemCmd 赎回获胜头寸
+var redeemCmd = &cobra.Command{
+	Use:   "redeem <market>...",
+	Short: "赎回已终结市场的获胜头寸（发送交易）",
+	Long: `赎回 Finalized 市场中签名账户持有的全部获胜头寸，每个市场一笔 redeemBatchFor 交易。
+
+发送前模拟每个头寸的赎回金额，未获胜的头寸会被跳过。
+
+示例:
+  p1cli redeem 0x1234... --keystore ~/.foundry/keystores/qa
+  p1cli redeem 0x1234... 0x5678... --yes`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runClaims(cmd.Context(), args, false)
+	},
+}
+
+// refundCmd 取消市场退款
+var refundCmd = &cobra.Command{
+	Use:   "refund <market>...",
+	Short: "取消市场退款（发送交易）",
+	Long: `对 Cancelled 市场中签名账户持有的头寸退款，每个有余额的结果一笔 refundFor 交易。
+
+示例:
+  p1cli refund 0x1234... --keystore ~/.foundry/keystores/qa`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runClaims(cmd.Context(), args, true)
+	},
+}
+
+// runClaims 预览、确认并发送赎回或退款交易
+func runClaims(parent context.Context, args []string, refund bool) error {
+	markets := make([]common.Address, 0, len(args))
+	for _, arg := range args {
+		addr, err := ParseAddress(arg)
+		if err != nil {
+			return err
+		}
+		markets = append(markets, addr)
+	}
+
+	if parent == nil {
+		parent = context.Background()
+	}
+	ctx, cancel := context.WithTimeout(parent, 5*time.Minute)
+	defer cancel()
+
+	svc, client, err := newBettingService(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	action, amountHeader := "赎回", "Payout"
+	if refund {
+		action, amountHeader = "退款", "Refund"
+	}
+
+	claimsByMarket := make(map[common.Address][]*betting.Claim)
+	total := big.NewInt(0)
+	rows := make([][]string, 0)
+
+	for _, market := range markets {
+		var claims []*betting.Claim
+		if refund {
+			claims, err = svc.QuoteRefund(ctx, market)
+		} else {
+			claims, err = svc.QuoteRedeem(ctx, market)
+		}
+		if err != nil {
+			return fmt.Errorf("市场 %s: %w", market.Hex(), err)
+		}
+
+		claimsByMarket[market] = claims
+		for _, c := range claims {
+			rows = append(rows, []string{
+				FormatAddress(c.Market, false),
+				c.OutcomeID.String(),
+				FormatShares(c.Shares),
+				FormatUSDC(c.Amount),
+			})
+			total.Add(total, c.Amount)
+		}
+	}
+
+	if len(rows) == 0 {
+		fmt.Printf("账户 %s 没有可%s的头寸\n", svc.From().Hex(), action)
+		return nil
+	}
+
+	fmt.Printf("\nAccount: %s\n\n", svc.From().Hex())
+	formatter := output.NewFromString(GetOutput())
+	formatter.SetHeader([]string{"Market", "Outcome", "Shares", amountHeader})
+	formatter.AddRows(rows)
+	if err := formatter.Render(); err != nil {
+		return err
+	}
+	fmt.Printf("\nTotal %s: %s USDC\n\n", amountHeader, FormatUSDC(total))
+
+	ok, err := confirm(fmt.Sprintf("确认发送%s交易?", action))
+	if err != nil {
+		return err
+	}
+	if !ok {
+		fmt.Println("已取消")
+		return nil
+	}
+
+	for _, market := range markets {
+		claims := claimsByMarket[market]
+		if len(claims) == 0 {
+			continue
+		}
+
+		if refund {
+			receipts, err := svc.Refund(ctx, market, claims)
+			for _, receipt := range receipts {
+				fmt.Printf("✓ refundFor 已确认: %s tx %s\n", FormatAddress(market, false), receipt.TxHash.Hex())
+			}
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		receipt, err := svc.Redeem(ctx, market, claims)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("✓ redeemBatchFor 已确认: %s tx %s\n", FormatAddress(market, false), receipt.TxHash.Hex())
+	}
+
+	return nil
+}
+
+func init() {
+	rootCmd.AddCommand(redeemCmd)
+	rootCmd.AddCommand(refundCmd)
+
+	addSignerFlags(redeemCmd)
+	addSignerFlags(refundCmd)
+}
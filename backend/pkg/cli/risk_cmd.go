@@ -0,0 +1,386 @@
+package cli
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+
+	"github.com/pitchone/sportsbook/internal/query"
+	"github.com/pitchone/sportsbook/pkg/cli/styles"
+	"github.com/pitchone/sportsbook/pkg/output"
+)
+
+var (
+	riskMaxGoals int
+	riskMatrix   string
+	riskScore    string
+	riskOut      string
+)
+
+// riskCmd 风险分析命令
+var riskCmd = &cobra.Command{
+	Use:   "risk",
+	Short: "风险敞口分析",
+	Long:  `按赛果分析平台的赔付与亏损敞口。`,
+}
+
+// riskMatchCmd 比赛比分矩阵
+var riskMatchCmd = &cobra.Command{
+	Use:   "match <matchId>",
+	Short: "比赛比分矩阵风险报告",
+	Long: `汇总一场比赛的所有 Market_V3 市场（WDL、OU、AH、Score、OddEven 等），
+对 0..max-goals 的每个比分，用各市场 ResultMapper.previewResult 映射赛果，按当前份额计算赔付与庄家净盈亏，
+并与 ILiquidityVault_V3.availableLiquidity 对比。
+
+matchId 匹配完全相同或以 "<matchId>_" 开头的市场，如 EPL_2425_R20_MUN_vs_MCI 包含 ..._WDL、..._OU_2.5。
+已结算、已终结或已取消的市场不参与计算。
+
+输出:
+  -o table  热力图（--matrix 选择 pnl | payout | liability）
+  -o json   完整报告
+  -o csv    比分矩阵（每行一个比分，含各市场赔付）
+  --out     同时导出矩阵文件（按扩展名 .json / .csv 选择格式）
+
+示例:
+  p1cli risk match EPL_2425_R20_MUN_vs_MCI
+  p1cli risk match EPL_2425_R20_MUN_vs_MCI --score 2-1
+  p1cli risk match EPL_2425_R20_MUN_vs_MCI --max-goals 6 --out mun-mci.csv`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		switch riskMatrix {
+		case "pnl", "payout", "liability":
+		default:
+			return fmt.Errorf("不支持的矩阵类型: %s (支持: pnl, payout, liability)", riskMatrix)
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 120*time.Second)
+		defer cancel()
+
+		svc, err := newQueryService(ctx)
+		if err != nil {
+			return err
+		}
+		defer svc.Close()
+
+		risk, err := svc.GetMatchRisk(ctx, args[0], riskMaxGoals)
+		if err != nil {
+			return fmt.Errorf("计算风险矩阵失败: %w", err)
+		}
+
+		if riskOut != "" {
+			if err := exportRiskMatrix(riskOut, risk); err != nil {
+				return err
+			}
+			fmt.Fprintf(os.Stderr, "已导出比分矩阵: %s\n", riskOut)
+		}
+
+		switch GetOutput() {
+		case "json":
+			return output.NewJSONFormatter(os.Stdout).RenderRaw(risk)
+		case "csv":
+			return writeRiskCSV(os.Stdout, risk)
+		}
+
+		if riskScore != "" {
+			return printScoreBreakdown(risk, riskScore)
+		}
+		printRiskReport(risk)
+		return nil
+	},
+}
+
+// printRiskReport 打印市场列表、比分热力图与汇总
+func printRiskReport(risk *query.MatchRisk) {
+	fmt.Printf("\nMatch Risk: %s\n\n", risk.MatchID)
+
+	if len(risk.Markets) > 0 {
+		table := styles.NewTable()
+		table.SetHeaders([]string{"Market", "Type", "Status", "Bets (USDC)", "Max Payout (USDC)"})
+		for _, m := range risk.Markets {
+			maxPayout := big.NewInt(0)
+			for _, payout := range m.OutcomePayouts {
+				if payout.Cmp(maxPayout) > 0 {
+					maxPayout = payout
+				}
+			}
+			table.AddRow([]string{
+				FormatAddress(m.Address, false),
+				m.Label(),
+				m.StatusName,
+				FormatUSDC(m.TotalBetAmount),
+				FormatUSDC(maxPayout),
+			})
+		}
+		fmt.Println(table.Render())
+		fmt.Println()
+
+		fmt.Println(styles.SubtitleStyle.Render(fmt.Sprintf("%s (USDC)，行为主队进球，列为客队进球", riskMatrixTitle())))
+		fmt.Println(renderRiskHeatmap(risk))
+		fmt.Println()
+	}
+
+	fmt.Println(styles.RenderKeyValue("Total Bets", FormatUSDC(risk.TotalBetAmount)+" USDC"))
+	if risk.Worst != nil {
+		fmt.Println(styles.RenderKeyValue("Worst Score", fmt.Sprintf("%s  PnL %s USDC", risk.Worst.Score(), formatSignedUSDC(risk.Worst.PnL))))
+		fmt.Println(styles.RenderKeyValue("Best Score", fmt.Sprintf("%s  PnL %s USDC", risk.Best.Score(), formatSignedUSDC(risk.Best.PnL))))
+	}
+	fmt.Println(styles.RenderKeyValue("Available Liquidity", FormatUSDC(risk.AvailableLiquidity)+" USDC"))
+	if risk.Worst != nil {
+		fmt.Println(styles.KeyStyle.Render("Worst-case Coverage") + renderCoverage(risk.Worst.Liability, risk.AvailableLiquidity))
+	}
+
+	for _, m := range risk.Skipped {
+		fmt.Println(styles.RenderInfo(fmt.Sprintf("跳过 %s (%s): %s", m.Address.Hex(), m.StatusName, m.Note)))
+	}
+	for _, w := range risk.Warnings {
+		fmt.Println(styles.RenderWarning(w))
+	}
+}
+
+// printScoreBreakdown 打印指定比分下各市场的赔付与盈亏
+func printScoreBreakdown(risk *query.MatchRisk, score string) error {
+	home, away, err := parseScore(score)
+	if err != nil {
+		return err
+	}
+
+	var cell *query.ScoreRisk
+	for _, r := range risk.Scores {
+		if r.Home == home && r.Away == away {
+			cell = r
+			break
+		}
+	}
+	if cell == nil {
+		return fmt.Errorf("比分 %s 超出矩阵范围（--max-goals %d）", score, risk.MaxGoals)
+	}
+
+	fmt.Printf("\nMatch: %s  Score: %s\n\n", risk.MatchID, cell.Score())
+
+	rows := make([][]string, 0, len(risk.Markets))
+	for i, m := range risk.Markets {
+		payout := cell.MarketPayouts[i]
+		rows = append(rows, []string{
+			m.Label(),
+			FormatUSDC(m.TotalBetAmount),
+			FormatUSDC(payout),
+			formatSignedUSDC(new(big.Int).Sub(m.TotalBetAmount, payout)),
+		})
+	}
+	rows = append(rows, []string{
+		"Total",
+		FormatUSDC(risk.TotalBetAmount),
+		FormatUSDC(cell.Payout),
+		formatSignedUSDC(cell.PnL),
+	})
+
+	formatter := output.NewFromString(GetOutput())
+	formatter.SetHeader([]string{"Market", "Bets", "Payout", "PnL"})
+	formatter.AddRows(rows)
+	if err := formatter.Render(); err != nil {
+		return err
+	}
+
+	fmt.Printf("\nAvailable Liquidity: %s USDC  Coverage: %s\n", FormatUSDC(risk.AvailableLiquidity), renderCoverage(cell.Liability, risk.AvailableLiquidity))
+	return nil
+}
+
+// renderRiskHeatmap 渲染比分热力图：盈利为绿色，亏损按占最大亏损的比例由黄到红，超过可用流动性反色显示
+func renderRiskHeatmap(risk *query.MatchRisk) string {
+	n := risk.MaxGoals + 1
+	texts := make([]string, len(risk.Scores))
+	width := lipgloss.Width("Home\\Away")
+	for i, r := range risk.Scores {
+		texts[i] = riskCellText(r)
+		if w := lipgloss.Width(texts[i]); w > width {
+			width = w
+		}
+	}
+
+	cell := lipgloss.NewStyle().Width(width).Align(lipgloss.Right)
+	border := styles.DividerStyle.Render("│")
+	var sb strings.Builder
+
+	header := []string{styles.TableHeaderStyle.UnsetPadding().Width(width).Render("Home\\Away")}
+	for away := 0; away < n; away++ {
+		header = append(header, styles.TableHeaderStyle.UnsetPadding().Width(width).Align(lipgloss.Right).Render(strconv.Itoa(away)))
+	}
+	sb.WriteString(strings.Join(header, " "+border+" "))
+	sb.WriteString("\n")
+	sb.WriteString(styles.DividerStyle.Render(strings.Repeat("─", (width+3)*(n+1)-3)))
+
+	maxLiability := big.NewInt(0)
+	if risk.Worst != nil {
+		maxLiability = risk.Worst.Liability
+	}
+
+	for home := 0; home < n; home++ {
+		row := []string{styles.TableHeaderStyle.UnsetPadding().Width(width).Render(strconv.Itoa(home))}
+		for away := 0; away < n; away++ {
+			i := home*n + away
+			row = append(row, heatStyle(cell, risk.Scores[i], maxLiability, risk.AvailableLiquidity).Render(texts[i]))
+		}
+		sb.WriteString("\n")
+		sb.WriteString(strings.Join(row, " "+border+" "))
+	}
+	return sb.String()
+}
+
+// riskCellText 按 --matrix 取热力图单元格的值
+func riskCellText(r *query.ScoreRisk) string {
+	switch riskMatrix {
+	case "payout":
+		return FormatUSDC(r.Payout)
+	case "liability":
+		return FormatUSDC(r.Liability)
+	default:
+		return formatSignedUSDC(r.PnL)
+	}
+}
+
+// riskMatrixTitle 热力图标题
+func riskMatrixTitle() string {
+	switch riskMatrix {
+	case "payout":
+		return "赔付"
+	case "liability":
+		return "亏损"
+	default:
+		return "庄家净盈亏"
+	}
+}
+
+// heatStyle 单元格颜色
+func heatStyle(base lipgloss.Style, r *query.ScoreRisk, maxLiability, available *big.Int) lipgloss.Style {
+	if r.Liability.Sign() == 0 {
+		return base.Foreground(styles.Success)
+	}
+	if available.Sign() > 0 && r.Liability.Cmp(available) > 0 {
+		return base.Foreground(styles.Error).Bold(true).Reverse(true)
+	}
+	// 亏损超过最大亏损的一半显示为红色
+	if new(big.Int).Mul(r.Liability, big.NewInt(2)).Cmp(maxLiability) > 0 {
+		return base.Foreground(styles.Error)
+	}
+	return base.Foreground(styles.Warning)
+}
+
+// renderCoverage 亏损占可用流动性的比例
+func renderCoverage(liability, available *big.Int) string {
+	if liability.Sign() == 0 {
+		return styles.SuccessStyle.Render("无亏损")
+	}
+	if available.Sign() == 0 {
+		return styles.ErrorStyle.Render("无可用流动性")
+	}
+	ratio, _ := new(big.Rat).SetFrac(liability, available).Float64()
+	text := fmt.Sprintf("亏损占可用流动性 %.2f%%", ratio*100)
+	if ratio > 1 {
+		return styles.ErrorStyle.Render(text + "（不足以覆盖）")
+	}
+	return styles.ValueStyle.Render(text)
+}
+
+// exportRiskMatrix 导出比分矩阵，.json 为完整报告，其余为 CSV
+func exportRiskMatrix(path string, risk *query.MatchRisk) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("创建导出文件失败: %w", err)
+	}
+	defer file.Close()
+
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		return output.NewJSONFormatter(file).RenderRaw(risk)
+	}
+	return writeRiskCSV(file, risk)
+}
+
+// writeRiskCSV 写出比分矩阵：每行一个比分，金额为 USDC（6 位小数），末尾为各市场赔付
+func writeRiskCSV(w io.Writer, risk *query.MatchRisk) error {
+	writer := csv.NewWriter(w)
+
+	header := []string{"home", "away", "payout", "pnl", "liability", "available_liquidity"}
+	for _, m := range risk.Markets {
+		header = append(header, "payout:"+m.Label())
+	}
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+
+	available := plainUSDC(risk.AvailableLiquidity)
+	for _, r := range risk.Scores {
+		row := []string{
+			strconv.Itoa(r.Home),
+			strconv.Itoa(r.Away),
+			plainUSDC(r.Payout),
+			plainUSDC(r.PnL),
+			plainUSDC(r.Liability),
+			available,
+		}
+		for _, payout := range r.MarketPayouts {
+			row = append(row, plainUSDC(payout))
+		}
+		if err := writer.Write(row); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// parseScore 解析 "2-1" 形式的比分
+func parseScore(s string) (int, int, error) {
+	parts := strings.Split(strings.TrimSpace(s), "-")
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("无效的比分: %s（格式如 2-1）", s)
+	}
+	home, err := strconv.Atoi(parts[0])
+	if err != nil || home < 0 {
+		return 0, 0, fmt.Errorf("无效的主队进球数: %s", parts[0])
+	}
+	away, err := strconv.Atoi(parts[1])
+	if err != nil || away < 0 {
+		return 0, 0, fmt.Errorf("无效的客队进球数: %s", parts[1])
+	}
+	return home, away, nil
+}
+
+// formatSignedUSDC 带符号的 USDC 金额，如 +1,200.00 / -350.50
+func formatSignedUSDC(amount *big.Int) string {
+	if amount == nil || amount.Sign() == 0 {
+		return "0.00"
+	}
+	if amount.Sign() < 0 {
+		return "-" + FormatUSDC(new(big.Int).Neg(amount))
+	}
+	return "+" + FormatUSDC(amount)
+}
+
+// plainUSDC 不带千分位的 USDC 金额，用于导出
+func plainUSDC(amount *big.Int) string {
+	if amount == nil {
+		return "0"
+	}
+	return new(big.Rat).SetFrac(amount, big.NewInt(1_000_000)).FloatString(6)
+}
+
+func init() {
+	rootCmd.AddCommand(riskCmd)
+	riskCmd.AddCommand(riskMatchCmd)
+
+	riskMatchCmd.Flags().IntVar(&riskMaxGoals, "max-goals", query.DefaultRiskMaxGoals, "每方最大进球数（矩阵为 0..max-goals）")
+	riskMatchCmd.Flags().StringVar(&riskMatrix, "matrix", "pnl", "热力图数值 (pnl|payout|liability)")
+	riskMatchCmd.Flags().StringVar(&riskScore, "score", "", "只显示该比分下各市场的赔付，如 2-1")
+	riskMatchCmd.Flags().StringVar(&riskOut, "out", "", "导出比分矩阵文件（.csv 或 .json）")
+}
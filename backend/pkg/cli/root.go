@@ -7,6 +7,8 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+
+	"github.com/pitchone/sportsbook/internal/query"
 )
 
 var (
@@ -16,6 +18,9 @@ var (
 	outputFormat string
 	rpcURL       string
 	dbURL        string
+	subgraphURL  string
+	atBlock      uint64
+	atTime       string
 
 	// Version 信息
 	Version   = "dev"
@@ -34,19 +39,34 @@ var rootCmd = &cobra.Command{
   - 单个市场详情、赔率、头寸
   - 用户余额、头寸、订单
   - 平台统计数据
+  - 比赛风险矩阵：各比分下所有市场的赔付、盈亏与 Vault 可用流动性对比
+  - 合约事件导出（NDJSON / CSV / Parquet，支持断点续传）
+  - 历史状态：--block 或 --at 固定查询的区块（链上读取需要归档节点）
+
+交易（需要 keystore 签名）:
+  - 下注、批量下注
+  - 赎回获胜头寸、取消市场退款
+  - 市场运维：锁盘、结算、终结、取消、暂停（支持模拟与 Safe 导出）
 
 示例:
   p1cli contract info                     # 显示所有合约地址
   p1cli contract vault info               # 查询 Vault 状态
   p1cli factory markets list --status open # 列出开放的市场
   p1cli market prices 0x1234...           # 查询市场赔率
-  p1cli user positions 0x5678...          # 查询用户头寸`,
+  p1cli user positions 0x5678...          # 查询用户头寸
+  p1cli risk match EPL_2425_R20_MUN_vs_MCI  # 比分风险矩阵
+  p1cli bet place 0x1234... 0 10          # 下注 10 USDC
+  p1cli market prices 0x1234... --at "2024-06-14 21:00"  # 开赛时的赔率`,
 	Version: Version,
 }
 
 // Execute 执行根命令
 func Execute() error {
-	return rootCmd.Execute()
+	err := rootCmd.Execute()
+	if err != nil && historicalQuery() && query.IsMissingStateError(err) {
+		fmt.Fprintln(os.Stderr, "提示: RPC 节点已裁剪该区块的历史状态，--block/--at 需要连接归档节点（archive node），可通过 --rpc 指定")
+	}
+	return err
 }
 
 func init() {
@@ -58,12 +78,18 @@ func init() {
 	rootCmd.PersistentFlags().StringVarP(&outputFormat, "output", "o", "table", "输出格式 (table|json|csv)")
 	rootCmd.PersistentFlags().StringVar(&rpcURL, "rpc", "", "覆盖 RPC URL")
 	rootCmd.PersistentFlags().StringVar(&dbURL, "db", "", "覆盖数据库连接串")
+	rootCmd.PersistentFlags().StringVar(&subgraphURL, "subgraph", "", "覆盖 Subgraph GraphQL 端点")
+	rootCmd.PersistentFlags().Uint64Var(&atBlock, "block", 0, "查询指定区块的历史状态")
+	rootCmd.PersistentFlags().StringVar(&atTime, "at", "", "查询指定时间的历史状态（Unix 秒、RFC3339 或 \"2006-01-02 15:04:05\"）")
 
 	// 绑定到 viper
 	viper.BindPFlag("network", rootCmd.PersistentFlags().Lookup("network"))
 	viper.BindPFlag("output", rootCmd.PersistentFlags().Lookup("output"))
 	viper.BindPFlag("rpc_url", rootCmd.PersistentFlags().Lookup("rpc"))
 	viper.BindPFlag("database.url", rootCmd.PersistentFlags().Lookup("db"))
+	viper.BindPFlag("subgraph.url", rootCmd.PersistentFlags().Lookup("subgraph"))
+	viper.BindPFlag("block", rootCmd.PersistentFlags().Lookup("block"))
+	viper.BindPFlag("at", rootCmd.PersistentFlags().Lookup("at"))
 }
 
 // initConfig 初始化配置
@@ -113,6 +139,9 @@ func initConfig() {
 	if dbURL != "" {
 		viper.Set("database.url", dbURL)
 	}
+	if subgraphURL != "" {
+		viper.Set("subgraph.url", subgraphURL)
+	}
 }
 
 // GetNetwork 获取当前网络
@@ -135,6 +164,16 @@ func GetDatabaseURL() string {
 	return viper.GetString("database.url")
 }
 
+// GetSubgraphURL 获取 Subgraph GraphQL 端点
+func GetSubgraphURL() string {
+	return viper.GetString("subgraph.url")
+}
+
+// historicalQuery 是否指定了 --block 或 --at
+func historicalQuery() bool {
+	return viper.GetUint64("block") > 0 || viper.GetString("at") != ""
+}
+
 // GetChainID 获取链 ID
 func GetChainID() int64 {
 	return viper.GetInt64("chain_id")
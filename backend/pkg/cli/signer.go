@@ -0,0 +1,139 @@
+package cli
+
+import (
+	"bufio"
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/x/term"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/pitchone/sportsbook/internal/betting"
+)
+
+// 交易命令的签名 flags
+var (
+	keystorePath string
+	passwordFile string
+	assumeYes    bool
+)
+
+// addSignerFlags 为交易命令添加签名相关 flags
+func addSignerFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&keystorePath, "keystore", "", "keystore 文件路径（默认 signer.keystore 或 P1CLI_KEYSTORE）")
+	cmd.Flags().StringVar(&passwordFile, "password-file", "", "keystore 密码文件（默认读取 P1CLI_KEYSTORE_PASSWORD，否则交互输入）")
+	cmd.Flags().BoolVarP(&assumeYes, "yes", "y", false, "跳过确认提示")
+}
+
+// newBettingService 连接 RPC、解锁 keystore 并创建交易服务
+func newBettingService(ctx context.Context) (*betting.Service, *ethclient.Client, error) {
+	privateKey, from, err := loadSigner()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	client, chainID, err := dialChain(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	router := common.HexToAddress(GetContractAddress("betting_router"))
+	svc, err := betting.NewService(client, router, privateKey, from, chainID)
+	if err != nil {
+		client.Close()
+		return nil, nil, err
+	}
+
+	return svc, client, nil
+}
+
+// loadSigner 按 --keystore、signer.keystore、P1CLI_KEYSTORE 的顺序定位并解锁 keystore
+func loadSigner() (*ecdsa.PrivateKey, common.Address, error) {
+	path := keystorePath
+	if path == "" {
+		path = viper.GetString("signer.keystore")
+	}
+	if path == "" {
+		path = os.Getenv("P1CLI_KEYSTORE")
+	}
+	if path == "" {
+		return nil, common.Address{}, fmt.Errorf("未指定 keystore，请设置 --keystore 参数或配置 signer.keystore")
+	}
+
+	password, err := keystorePassword(path)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return betting.LoadKeystore(path, password)
+}
+
+// dialChain 连接 RPC、校验网络并返回链 ID
+func dialChain(ctx context.Context) (*ethclient.Client, *big.Int, error) {
+	if historicalQuery() {
+		return nil, nil, fmt.Errorf("--block/--at 只用于查询，发送交易时不能使用")
+	}
+
+	client, err := NewEthClient(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := CheckNetwork(ctx, client); err != nil {
+		client.Close()
+		return nil, nil, err
+	}
+
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		client.Close()
+		return nil, nil, fmt.Errorf("获取链 ID 失败: %w", err)
+	}
+
+	return client, chainID, nil
+}
+
+// keystorePassword 按 --password-file、P1CLI_KEYSTORE_PASSWORD、终端输入的顺序获取密码
+func keystorePassword(path string) (string, error) {
+	if passwordFile != "" {
+		return betting.ReadPasswordFile(passwordFile)
+	}
+	if password, ok := os.LookupEnv("P1CLI_KEYSTORE_PASSWORD"); ok {
+		return password, nil
+	}
+	if !term.IsTerminal(os.Stdin.Fd()) {
+		return "", fmt.Errorf("非交互环境请通过 --password-file 或 P1CLI_KEYSTORE_PASSWORD 提供 keystore 密码")
+	}
+
+	fmt.Fprintf(os.Stderr, "输入 %s 的密码: ", path)
+	password, err := term.ReadPassword(os.Stdin.Fd())
+	fmt.Fprintln(os.Stderr)
+	if err != nil {
+		return "", fmt.Errorf("读取密码失败: %w", err)
+	}
+	return string(password), nil
+}
+
+// confirm 显示确认提示，--yes 时直接通过
+func confirm(prompt string) (bool, error) {
+	if assumeYes {
+		return true, nil
+	}
+	if !term.IsTerminal(os.Stdin.Fd()) {
+		return false, fmt.Errorf("非交互环境请使用 --yes 确认发送交易")
+	}
+
+	fmt.Printf("%s [y/N]: ", prompt)
+	answer, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil {
+		return false, nil
+	}
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	return answer == "y" || answer == "yes", nil
+}
@@ -7,7 +7,6 @@ import (
 
 	"github.com/spf13/cobra"
 
-	"github.com/pitchone/sportsbook/internal/query"
 	"github.com/pitchone/sportsbook/pkg/output"
 )
 
@@ -27,9 +26,9 @@ var statsOverviewCmd = &cobra.Command{
 		ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
 		defer cancel()
 
-		svc, err := query.NewService(ctx)
+		svc, err := newQueryService(ctx)
 		if err != nil {
-			return fmt.Errorf("初始化查询服务失败: %w", err)
+			return err
 		}
 		defer svc.Close()
 
@@ -58,14 +57,14 @@ var volumePeriod string
 var statsVolumeCmd = &cobra.Command{
 	Use:   "volume",
 	Short: "交易量统计",
-	Long:  `按时间周期统计交易量（需要数据库支持）。`,
+	Long:  `按时间周期统计交易量（数据来自 Subgraph，或 history.source=sql 时的数据库）。`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 		defer cancel()
 
-		svc, err := query.NewService(ctx)
+		svc, err := newQueryService(ctx)
 		if err != nil {
-			return fmt.Errorf("初始化查询服务失败: %w", err)
+			return err
 		}
 		defer svc.Close()
 
@@ -106,48 +105,53 @@ var topMarketsLimit int
 var statsTopMarketsCmd = &cobra.Command{
 	Use:   "top-markets",
 	Short: "热门市场排名",
-	Long:  `查询交易量最高的市场（需要数据库支持）。`,
+	Long:  `查询最近 7 天有成交的市场中交易量最高的市场（数据来自 Subgraph，或 history.source=sql 时的数据库）。`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
-		defer cancel()
-
-		svc, err := query.NewService(ctx)
-		if err != nil {
-			return fmt.Errorf("初始化查询服务失败: %w", err)
-		}
-		defer svc.Close()
-
-		markets, err := svc.GetTopMarkets(ctx, topMarketsLimit)
-		if err != nil {
-			return fmt.Errorf("查询热门市场失败: %w", err)
-		}
-
-		if len(markets) == 0 {
-			fmt.Println("没有市场数据")
-			return nil
-		}
-
-		format := GetOutput()
-
-		fmt.Printf("\nTop Markets (Top %d)\n\n", topMarketsLimit)
-
-		rows := make([][]string, 0, len(markets))
-		for i, m := range markets {
-			rows = append(rows, []string{
-				fmt.Sprintf("%d", i+1),
-				FormatAddress(m.Address, false),
-				m.TemplateName,
-				m.StatusName,
-			})
-		}
-
-		formatter := output.NewFromString(format)
-		formatter.SetHeader([]string{"Rank", "Address", "Template", "Status"})
-		formatter.AddRows(rows)
-		return formatter.Render()
+		return runTopMarkets(topMarketsLimit)
 	},
 }
 
+// runTopMarkets 打印热门市场排名
+func runTopMarkets(limit int) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	svc, err := newQueryService(ctx)
+	if err != nil {
+		return err
+	}
+	defer svc.Close()
+
+	markets, err := svc.GetTopMarkets(ctx, limit)
+	if err != nil {
+		return fmt.Errorf("查询热门市场失败: %w", err)
+	}
+
+	if len(markets) == 0 {
+		fmt.Println("没有市场数据")
+		return nil
+	}
+
+	format := GetOutput()
+
+	fmt.Printf("\nTop Markets (Top %d)\n\n", limit)
+
+	rows := make([][]string, 0, len(markets))
+	for i, m := range markets {
+		rows = append(rows, []string{
+			fmt.Sprintf("%d", i+1),
+			FormatAddress(m.Address, false),
+			m.TemplateName,
+			m.StatusName,
+		})
+	}
+
+	formatter := output.NewFromString(format)
+	formatter.SetHeader([]string{"Rank", "Address", "Template", "Status"})
+	formatter.AddRows(rows)
+	return formatter.Render()
+}
+
 func init() {
 	rootCmd.AddCommand(statsCmd)
 
@@ -1,6 +1,7 @@
 package styles
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/charmbracelet/lipgloss"
@@ -27,7 +28,7 @@ func (t *Table) SetHeaders(headers []string) {
 	t.headers = headers
 	t.widths = make([]int, len(headers))
 	for i, h := range headers {
-		t.widths[i] = len(h)
+		t.widths[i] = lipgloss.Width(h)
 	}
 }
 
@@ -35,8 +36,8 @@ func (t *Table) SetHeaders(headers []string) {
 func (t *Table) AddRow(row []string) {
 	t.rows = append(t.rows, row)
 	for i, cell := range row {
-		if i < len(t.widths) && len(cell) > t.widths[i] {
-			t.widths[i] = len(cell)
+		if i < len(t.widths) && lipgloss.Width(cell) > t.widths[i] {
+			t.widths[i] = lipgloss.Width(cell)
 		}
 	}
 }
@@ -126,7 +127,7 @@ func (t *Table) renderHeaderSeparator() string {
 func (t *Table) renderHeaderRow() string {
 	var cells []string
 	for i, h := range t.headers {
-		cell := TableHeaderStyle.Width(t.widths[i]).Render(h)
+		cell := TableHeaderStyle.UnsetPadding().Width(t.widths[i]).Render(h)
 		cells = append(cells, cell)
 	}
 	border := DividerStyle.Render("│")
@@ -135,10 +136,6 @@ func (t *Table) renderHeaderRow() string {
 
 func (t *Table) renderDataRow(row []string, even bool) string {
 	var cells []string
-	style := TableCellStyle
-	if even {
-		style = style.Inherit(TableRowEvenStyle)
-	}
 
 	for i, cell := range row {
 		width := 10
@@ -156,8 +153,8 @@ func (t *Table) renderDataRow(row []string, even bool) string {
 }
 
 func (t *Table) styleCell(cell string, width int) string {
-	// 检测特殊内容并应用样式
-	cellStyle := TableCellStyle.Width(width)
+	// 检测特殊内容并应用样式（内边距由行渲染统一添加）
+	cellStyle := TableCellStyle.UnsetPadding().Width(width)
 
 	// 检测状态
 	switch cell {
@@ -316,8 +313,8 @@ func RenderNetworkBadge(network string) string {
 	return style.Render(network)
 }
 
-// RenderHeader 渲染页面标题（带 logo）
-func RenderHeader(title, network string, chainID int64) string {
+// RenderNetworkHeader 渲染带网络标签与链 ID 的页面标题
+func RenderNetworkHeader(title, network string, chainID int64) string {
 	logo := LogoStyle.Render("⚽ PitchOne")
 	badge := RenderNetworkBadge(network)
 
@@ -326,10 +323,10 @@ func RenderHeader(title, network string, chainID int64) string {
 		logo,
 		"  ",
 		badge,
+		"  ",
+		SubtitleStyle.Render(fmt.Sprintf("Chain ID: %d", chainID)),
 	)
 
-	subtitle := SubtitleStyle.Render("Chain ID: " + string(rune(chainID)))
-
 	return lipgloss.JoinVertical(
 		lipgloss.Left,
 		header,
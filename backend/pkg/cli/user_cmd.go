@@ -3,11 +3,11 @@ package cli
 import (
 	"context"
 	"fmt"
+	"math/big"
 	"time"
 
 	"github.com/spf13/cobra"
 
-	"github.com/pitchone/sportsbook/internal/query"
 	"github.com/pitchone/sportsbook/pkg/output"
 )
 
@@ -33,9 +33,9 @@ var userBalanceCmd = &cobra.Command{
 		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 		defer cancel()
 
-		svc, err := query.NewService(ctx)
+		svc, err := newQueryService(ctx)
 		if err != nil {
-			return fmt.Errorf("初始化查询服务失败: %w", err)
+			return err
 		}
 		defer svc.Close()
 
@@ -69,9 +69,9 @@ var userPositionsCmd = &cobra.Command{
 		ctx, cancel := context.WithTimeout(context.Background(), 120*time.Second)
 		defer cancel()
 
-		svc, err := query.NewService(ctx)
+		svc, err := newQueryService(ctx)
 		if err != nil {
-			return fmt.Errorf("初始化查询服务失败: %w", err)
+			return err
 		}
 		defer svc.Close()
 
@@ -120,7 +120,7 @@ var userPositionsCmd = &cobra.Command{
 var userOrdersCmd = &cobra.Command{
 	Use:   "orders <address>",
 	Short: "查询用户订单历史",
-	Long:  `查询指定用户的历史订单（需要数据库支持）。`,
+	Long:  `查询指定用户的历史订单（数据来自 Subgraph，或 history.source=sql 时的数据库）。`,
 	Args:  cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		addr, err := ParseAddress(args[0])
@@ -131,9 +131,9 @@ var userOrdersCmd = &cobra.Command{
 		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 		defer cancel()
 
-		svc, err := query.NewService(ctx)
+		svc, err := newQueryService(ctx)
 		if err != nil {
-			return fmt.Errorf("初始化查询服务失败: %w", err)
+			return err
 		}
 		defer svc.Close()
 
@@ -182,9 +182,9 @@ var userReferralCmd = &cobra.Command{
 		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 		defer cancel()
 
-		svc, err := query.NewService(ctx)
+		svc, err := newQueryService(ctx)
 		if err != nil {
-			return fmt.Errorf("初始化查询服务失败: %w", err)
+			return err
 		}
 		defer svc.Close()
 
@@ -211,11 +211,14 @@ var userReferralCmd = &cobra.Command{
 	},
 }
 
+// rewardCategories 奖励类别（与 rewards.RewardTypes 顺序一致）
+var rewardCategories = []string{"referral", "trading", "campaign"}
+
 // userRewardsCmd 用户奖励
 var userRewardsCmd = &cobra.Command{
 	Use:   "rewards <address>",
-	Short: "查询用户奖励状态",
-	Long:  `查询指定用户的未领取奖励（需要数据库支持）。`,
+	Short: "查询用户奖励历史",
+	Long:  `按周查询指定用户的奖励分配，拆分为推荐返佣、交易奖励和活动奖励（需要数据库支持）。`,
 	Args:  cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		addr, err := ParseAddress(args[0])
@@ -226,24 +229,51 @@ var userRewardsCmd = &cobra.Command{
 		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 		defer cancel()
 
-		svc, err := query.NewService(ctx)
+		svc, err := newQueryService(ctx)
 		if err != nil {
-			return fmt.Errorf("初始化查询服务失败: %w", err)
+			return err
 		}
 		defer svc.Close()
 
-		rewards, err := svc.GetUserRewards(ctx, addr)
+		history, err := svc.GetUserRewardHistory(ctx, addr)
 		if err != nil {
 			return fmt.Errorf("查询奖励失败: %w", err)
 		}
 
+		if len(history) == 0 {
+			fmt.Println("没有奖励记录")
+			return nil
+		}
+
 		format := GetOutput()
-		data := map[string]string{
-			"Address":           addr.Hex(),
-			"Unclaimed Rewards": FormatUSDC(rewards) + " USDC",
+
+		rows := make([][]string, 0, len(history))
+		for _, w := range history {
+			row := []string{fmt.Sprintf("%d", w.Week)}
+			for _, category := range rewardCategories {
+				amount, ok := w.Breakdown[category]
+				if !ok {
+					if len(w.Breakdown) == 0 {
+						row = append(row, "-") // 该周未记录类别明细
+						continue
+					}
+					amount = big.NewInt(0)
+				}
+				row = append(row, FormatUSDC(amount))
+			}
+			row = append(row,
+				FormatUSDC(w.Amount),
+				fmt.Sprintf("%.2f%%", float64(w.ScaleBps)/100),
+			)
+			rows = append(rows, row)
 		}
 
-		return output.PrintMap(format, data, "User Rewards")
+		fmt.Printf("\nUser Rewards %s (%d weeks)\n\n", addr.Hex(), len(history))
+
+		formatter := output.NewFromString(format)
+		formatter.SetHeader([]string{"Week", "Referral", "Trading", "Campaign", "Total", "Scale"})
+		formatter.AddRows(rows)
+		return formatter.Render()
 	},
 }
 
@@ -0,0 +1,111 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/term"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+)
+
+var (
+	watchInterval   time.Duration
+	marketsTopLimit int
+	marketsTopWatch bool
+)
+
+// marketWatchCmd 实时看板
+var marketWatchCmd = &cobra.Command{
+	Use:   "watch <address>...",
+	Short: "全屏实时看板",
+	Long: `全屏显示市场赔率、隐含概率、下注额与赔付敞口，按 --interval 轮询链上数据刷新。
+
+赔率变化以 ▲/▼ 标记，状态流转（Open → Locked → Resolved）会高亮显示。
+指定多个市场时可用 ←/→ 或 tab 切换，enter / esc 在详情与列表之间切换，r 立即刷新，q 退出。
+
+示例:
+  p1cli market watch 0x1234...
+  p1cli market watch 0x1234... 0x5678... --interval 2s`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		markets := make([]common.Address, 0, len(args))
+		for _, arg := range args {
+			addr, err := ParseAddress(arg)
+			if err != nil {
+				return err
+			}
+			markets = append(markets, addr)
+		}
+		return runWatch(markets, 0)
+	},
+}
+
+// marketsGroupCmd 多市场视图
+var marketsGroupCmd = &cobra.Command{
+	Use:   "markets",
+	Short: "多市场视图",
+}
+
+// marketsTopCmd 热门市场
+var marketsTopCmd = &cobra.Command{
+	Use:   "top",
+	Short: "热门市场排名（--watch 全屏实时刷新）",
+	Long: `查询最近 7 天交易量最高的市场，与 stats top-markets 相同。
+
+--watch 进入全屏看板，每次刷新重新获取排名；↑/↓ 选择市场，enter 查看详情，esc 返回列表。
+
+示例:
+  p1cli markets top --limit 20
+  p1cli markets top --watch --interval 10s`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if !marketsTopWatch {
+			return runTopMarkets(marketsTopLimit)
+		}
+		if marketsTopLimit <= 0 {
+			return fmt.Errorf("--limit 必须大于 0")
+		}
+		return runWatch(nil, marketsTopLimit)
+	},
+}
+
+// runWatch 启动全屏看板，直到用户退出
+func runWatch(markets []common.Address, topLimit int) error {
+	if historicalQuery() {
+		return fmt.Errorf("实时看板不支持 --block/--at")
+	}
+	if watchInterval < time.Second {
+		return fmt.Errorf("--interval 不能小于 1s")
+	}
+	if !term.IsTerminal(os.Stdout.Fd()) {
+		return fmt.Errorf("看板需要交互式终端")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	svc, err := newQueryService(ctx)
+	cancel()
+	if err != nil {
+		return err
+	}
+	defer svc.Close()
+
+	program := tea.NewProgram(newWatchModel(svc, markets, topLimit, watchInterval), tea.WithAltScreen())
+	_, err = program.Run()
+	return err
+}
+
+func init() {
+	marketCmd.AddCommand(marketWatchCmd)
+
+	rootCmd.AddCommand(marketsGroupCmd)
+	marketsGroupCmd.AddCommand(marketsTopCmd)
+
+	for _, cmd := range []*cobra.Command{marketWatchCmd, marketsTopCmd} {
+		cmd.Flags().DurationVar(&watchInterval, "interval", 5*time.Second, "刷新间隔")
+	}
+	marketsTopCmd.Flags().IntVar(&marketsTopLimit, "limit", 10, "返回数量")
+	marketsTopCmd.Flags().BoolVar(&marketsTopWatch, "watch", false, "全屏实时看板")
+}
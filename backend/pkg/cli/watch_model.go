@@ -0,0 +1,467 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/pitchone/sportsbook/internal/query"
+	"github.com/pitchone/sportsbook/pkg/cli/styles"
+)
+
+// transitionHighlight 状态变化高亮保留时长
+const transitionHighlight = 30 * time.Second
+
+// watchModel 全屏市场看板：定时刷新快照，标记赔率变化与状态流转
+type watchModel struct {
+	svc      *query.Service
+	interval time.Duration
+	topLimit int // > 0 时为热门市场模式，每次刷新重新获取排名
+
+	markets []common.Address
+	views   map[common.Address]*marketView
+	cursor  int
+	detail  bool // true 显示单个市场详情，false 显示市场列表
+
+	refreshing  bool
+	lastRefresh time.Time
+	err         error
+}
+
+// marketView 单个市场的最新快照与相对上次刷新的变化
+type marketView struct {
+	snap         *query.MarketSnapshot
+	moves        []int  // 各结果价格方向：1 上涨，-1 下跌，0 不变
+	transition   string // 最近一次状态变化，如 "Open → Locked"
+	transitionAt time.Time
+	err          error
+}
+
+type watchTickMsg time.Time
+
+type watchRefreshMsg struct {
+	markets []common.Address
+	snaps   map[common.Address]*query.MarketSnapshot
+	errs    map[common.Address]error
+	err     error
+}
+
+// newWatchModel 创建看板；markets 为固定市场列表，topLimit > 0 时改为跟踪热门市场
+func newWatchModel(svc *query.Service, markets []common.Address, topLimit int, interval time.Duration) *watchModel {
+	return &watchModel{
+		svc:      svc,
+		interval: interval,
+		topLimit: topLimit,
+		markets:  markets,
+		views:    make(map[common.Address]*marketView),
+		detail:   topLimit == 0,
+	}
+}
+
+func (m *watchModel) Init() tea.Cmd {
+	m.refreshing = true
+	return m.refresh()
+}
+
+// refresh 拉取所有市场快照
+func (m *watchModel) refresh() tea.Cmd {
+	markets := append([]common.Address(nil), m.markets...)
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		msg := watchRefreshMsg{
+			markets: markets,
+			snaps:   make(map[common.Address]*query.MarketSnapshot),
+			errs:    make(map[common.Address]error),
+		}
+
+		if m.topLimit > 0 {
+			top, err := m.svc.GetTopMarkets(ctx, m.topLimit)
+			if err != nil {
+				msg.err = fmt.Errorf("查询热门市场失败: %w", err)
+				return msg
+			}
+			msg.markets = make([]common.Address, 0, len(top))
+			for _, t := range top {
+				msg.markets = append(msg.markets, t.Address)
+			}
+		}
+
+		for _, addr := range msg.markets {
+			snap, err := m.svc.GetMarketSnapshot(ctx, addr)
+			if err != nil {
+				msg.errs[addr] = err
+				continue
+			}
+			msg.snaps[addr] = snap
+		}
+		return msg
+	}
+}
+
+func (m *watchModel) tick() tea.Cmd {
+	return tea.Tick(m.interval, func(t time.Time) tea.Msg {
+		return watchTickMsg(t)
+	})
+}
+
+func (m *watchModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		return m.handleKey(msg)
+
+	case watchTickMsg:
+		if m.refreshing {
+			return m, nil
+		}
+		m.refreshing = true
+		return m, m.refresh()
+
+	case watchRefreshMsg:
+		m.refreshing = false
+		m.err = msg.err
+		if msg.err == nil {
+			m.applyRefresh(msg)
+		}
+		return m, m.tick()
+	}
+
+	return m, nil
+}
+
+func (m *watchModel) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "q", "ctrl+c":
+		return m, tea.Quit
+	case "r":
+		if m.refreshing {
+			return m, nil
+		}
+		m.refreshing = true
+		return m, m.refresh()
+	case "up", "k", "shift+tab", "left", "h":
+		m.move(-1)
+	case "down", "j", "tab", "right", "l":
+		m.move(1)
+	case "enter":
+		if len(m.markets) > 0 {
+			m.detail = true
+		}
+	case "esc", "backspace":
+		if m.topLimit > 0 || len(m.markets) > 1 {
+			m.detail = false
+		}
+	}
+	return m, nil
+}
+
+// move 在市场之间移动光标（循环）
+func (m *watchModel) move(delta int) {
+	if len(m.markets) == 0 {
+		return
+	}
+	m.cursor = (m.cursor + delta + len(m.markets)) % len(m.markets)
+}
+
+// applyRefresh 合并新快照，计算赔率方向与状态变化
+func (m *watchModel) applyRefresh(msg watchRefreshMsg) {
+	var selected common.Address
+	if m.cursor < len(m.markets) {
+		selected = m.markets[m.cursor]
+	}
+
+	m.markets = msg.markets
+	m.cursor = 0
+	for i, addr := range m.markets {
+		if addr == selected {
+			m.cursor = i
+		}
+	}
+
+	now := time.Now()
+	for _, addr := range m.markets {
+		view, ok := m.views[addr]
+		if !ok {
+			view = &marketView{}
+			m.views[addr] = view
+		}
+
+		view.err = msg.errs[addr]
+		snap := msg.snaps[addr]
+		if snap == nil {
+			continue
+		}
+
+		if view.snap != nil {
+			view.moves = priceMoves(view.snap.Prices, snap.Prices)
+			if prev, cur := view.snap.Info.StatusName, snap.Info.StatusName; prev != cur {
+				view.transition = prev + " → " + cur
+				view.transitionAt = now
+			}
+		}
+		view.snap = snap
+	}
+	m.lastRefresh = now
+}
+
+// priceMoves 比较两次刷新的结果价格
+func priceMoves(prev, cur []*query.OutcomePrice) []int {
+	moves := make([]int, len(cur))
+	for i, p := range cur {
+		if i >= len(prev) || prev[i].Price == nil || p.Price == nil {
+			continue
+		}
+		moves[i] = p.Price.Cmp(prev[i].Price)
+	}
+	return moves
+}
+
+func (m *watchModel) View() string {
+	var sb strings.Builder
+
+	title := "Market Watch"
+	if m.topLimit > 0 {
+		title = fmt.Sprintf("Top %d Markets", m.topLimit)
+	}
+	sb.WriteString(styles.RenderNetworkHeader(title, GetNetwork(), GetChainID()))
+	sb.WriteString("\n")
+	sb.WriteString(m.renderStatusLine())
+	sb.WriteString("\n\n")
+
+	switch {
+	case m.err != nil:
+		sb.WriteString(styles.RenderError(m.err.Error()))
+		sb.WriteString("\n")
+	case len(m.markets) == 0 && !m.lastRefresh.IsZero():
+		sb.WriteString(styles.RenderInfo("没有市场数据"))
+		sb.WriteString("\n")
+	case len(m.markets) == 0:
+		sb.WriteString(styles.RenderInfo("加载中..."))
+		sb.WriteString("\n")
+	case m.detail:
+		sb.WriteString(m.renderDetail(m.markets[m.cursor]))
+	default:
+		sb.WriteString(m.renderList())
+	}
+
+	sb.WriteString("\n")
+	sb.WriteString(m.renderHelp())
+	return sb.String()
+}
+
+func (m *watchModel) renderStatusLine() string {
+	parts := []string{fmt.Sprintf("刷新间隔 %s", m.interval)}
+	if !m.lastRefresh.IsZero() {
+		parts = append(parts, "更新于 "+m.lastRefresh.Format("15:04:05"))
+	}
+	if view := m.currentView(); view != nil && view.snap != nil && view.snap.BlockNumber > 0 {
+		parts = append(parts, fmt.Sprintf("区块 %d", view.snap.BlockNumber))
+	}
+	if m.refreshing {
+		parts = append(parts, "刷新中…")
+	}
+	return styles.SubtitleStyle.Render(strings.Join(parts, "  │  "))
+}
+
+func (m *watchModel) currentView() *marketView {
+	if m.cursor >= len(m.markets) {
+		return nil
+	}
+	return m.views[m.markets[m.cursor]]
+}
+
+// renderList 市场列表：状态、下注额、热门结果与最大敞口
+func (m *watchModel) renderList() string {
+	table := styles.NewTable()
+	table.SetHeaders([]string{" ", "#", "Market", "Template", "Status", "Volume", "Favourite", "Max Liability"})
+
+	for i, addr := range m.markets {
+		marker := " "
+		if i == m.cursor {
+			marker = styles.HighlightStyle.Render("›")
+		}
+
+		view := m.views[addr]
+		if view == nil || view.snap == nil {
+			errText := "-"
+			if view != nil && view.err != nil {
+				errText = styles.ErrorStyle.Render("读取失败")
+			}
+			table.AddRow([]string{marker, fmt.Sprintf("%d", i+1), FormatAddress(addr, false), "-", errText, "-", "-", "-"})
+			continue
+		}
+
+		snap := view.snap
+		table.AddRow([]string{
+			marker,
+			fmt.Sprintf("%d", i+1),
+			marketLabel(snap.Info),
+			snap.Info.TemplateName,
+			m.renderStatus(view),
+			formatOptionalUSDC(snap.Volume),
+			favourite(snap.Prices, view.moves),
+			formatOptionalUSDC(snap.MaxLiability),
+		})
+	}
+
+	return table.Render() + "\n"
+}
+
+// renderDetail 单个市场：结果赔率、隐含概率、下注额与敞口
+func (m *watchModel) renderDetail(addr common.Address) string {
+	view := m.views[addr]
+	if view == nil || view.snap == nil {
+		if view != nil && view.err != nil {
+			return styles.RenderError(fmt.Sprintf("%s: %v", addr.Hex(), view.err)) + "\n"
+		}
+		return styles.RenderInfo("加载中...") + "\n"
+	}
+
+	snap := view.snap
+	info := snap.Info
+
+	var sb strings.Builder
+	if len(m.markets) > 1 {
+		sb.WriteString(styles.SubtitleStyle.Render(fmt.Sprintf("市场 %d / %d", m.cursor+1, len(m.markets))))
+		sb.WriteString("\n")
+	}
+	sb.WriteString(styles.RenderKeyValueHighlight("Market", marketLabel(info)))
+	sb.WriteString("\n")
+	sb.WriteString(styles.RenderKeyValue("Address", addr.Hex()))
+	sb.WriteString("\n")
+	sb.WriteString(styles.RenderKeyValue("Template", info.TemplateName))
+	sb.WriteString("\n")
+	if !info.KickoffTime.IsZero() {
+		sb.WriteString(styles.RenderKeyValue("Kickoff", info.KickoffTime.Local().Format("2006-01-02 15:04")))
+		sb.WriteString("\n")
+	}
+	sb.WriteString(styles.KeyStyle.Render("Status") + m.renderStatus(view))
+	sb.WriteString("\n")
+	sb.WriteString(styles.RenderKeyValue("Liquidity", FormatUSDC(info.TotalLiquidity)+" USDC"))
+	sb.WriteString("\n")
+	sb.WriteString(styles.RenderKeyValue("Volume", formatOptionalUSDC(snap.Volume)))
+	sb.WriteString("\n\n")
+
+	var outcomes []*query.OutcomeRuleInfo
+	if info.V3 != nil {
+		outcomes = info.V3.Outcomes
+	}
+
+	table := styles.NewTable()
+	table.SetHeaders([]string{"ID", "Outcome", "Implied", "Odds", "Bet (USDC)", "Liability (USDC)"})
+	for i, p := range snap.Prices {
+		move := 0
+		if i < len(view.moves) {
+			move = view.moves[i]
+		}
+
+		bet := "-"
+		if i < len(outcomes) {
+			bet = FormatUSDC(outcomes[i].TotalBet)
+		}
+		liability := "-"
+		if i < len(snap.Liability) {
+			liability = FormatUSDC(snap.Liability[i])
+		}
+
+		table.AddRow([]string{
+			fmt.Sprintf("%d", p.OutcomeID),
+			p.OutcomeName,
+			renderMove(fmt.Sprintf("%.1f%%", p.ImpliedProb), move),
+			renderMove(fmt.Sprintf("%.2f", p.Odds), -move), // 价格上涨即赔率缩水
+			bet,
+			liability,
+		})
+	}
+	sb.WriteString(table.Render())
+	sb.WriteString("\n")
+
+	if view.err != nil {
+		sb.WriteString(styles.RenderWarning(fmt.Sprintf("最近一次刷新失败，显示上次数据: %v", view.err)))
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
+
+// renderStatus 状态名；最近发生状态变化时高亮显示流转
+func (m *watchModel) renderStatus(view *marketView) string {
+	status := view.snap.Info.StatusName
+	if info := view.snap.Info; info.V3 != nil && info.V3.Paused {
+		status += " (paused)"
+	}
+	rendered := styles.GetStatusStyle(view.snap.Info.StatusName).Render(status)
+
+	if view.transition != "" && time.Since(view.transitionAt) < transitionHighlight {
+		rendered += " " + styles.WarningStyle.Render("⚑ "+view.transition)
+	}
+	return rendered
+}
+
+func (m *watchModel) renderHelp() string {
+	keys := [][2]string{{"←/→ ↑/↓", "切换市场"}, {"r", "立即刷新"}, {"q", "退出"}}
+	if m.topLimit > 0 || len(m.markets) > 1 {
+		keys = append(keys[:1], append([][2]string{{"enter", "详情"}, {"esc", "列表"}}, keys[1:]...)...)
+	}
+
+	parts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		parts = append(parts, styles.HelpKeyStyle.Render(k[0])+" "+styles.HelpDescStyle.Render(k[1]))
+	}
+	return lipgloss.NewStyle().MarginTop(1).Render(strings.Join(parts, "   "))
+}
+
+// renderMove move > 0 显示绿色 ▲，move < 0 显示红色 ▼
+func renderMove(text string, move int) string {
+	switch {
+	case move > 0:
+		return styles.SuccessStyle.Render(text + " ▲")
+	case move < 0:
+		return styles.ErrorStyle.Render(text + " ▼")
+	}
+	return text
+}
+
+// favourite 隐含概率最高的结果
+func favourite(prices []*query.OutcomePrice, moves []int) string {
+	best := -1
+	for i, p := range prices {
+		if best < 0 || p.ImpliedProb > prices[best].ImpliedProb {
+			best = i
+		}
+	}
+	if best < 0 {
+		return "-"
+	}
+
+	move := 0
+	if best < len(moves) {
+		move = moves[best]
+	}
+	p := prices[best]
+	return renderMove(fmt.Sprintf("%s @ %.2f", p.OutcomeName, p.Odds), -move)
+}
+
+// marketLabel 市场展示名：优先队名，其次比赛 ID，最后地址
+func marketLabel(info *query.MarketInfo) string {
+	if info.HomeTeam != "" && info.AwayTeam != "" {
+		return info.HomeTeam + " vs " + info.AwayTeam
+	}
+	if info.MatchID != "" {
+		return info.MatchID
+	}
+	return FormatAddress(info.Address, false)
+}
+
+func formatOptionalUSDC(amount *big.Int) string {
+	if amount == nil {
+		return "-"
+	}
+	return FormatUSDC(amount) + " USDC"
+}
@@ -0,0 +1,53 @@
+-- PitchOne Database Schema (V2): cmd/indexer
+-- 在 init.sql 之后执行，可重复执行
+-- Date: 2026-10-19
+
+-- ============================================
+-- Market lifecycle events
+-- ============================================
+
+-- 锁盘 / 结算 / 最终确认 / 取消事件，链重组回滚后据此重建 markets 的状态字段
+CREATE TABLE IF NOT EXISTS market_events (
+    id SERIAL PRIMARY KEY,
+    market_id VARCHAR(66) NOT NULL REFERENCES markets(id) ON DELETE CASCADE,
+    event_type VARCHAR(30) NOT NULL,
+    event_time BIGINT NOT NULL,
+    winner_outcome INT,
+    result_hash BYTEA,
+    reason TEXT,
+    block_number BIGINT NOT NULL,
+    tx_hash VARCHAR(66) NOT NULL,
+    log_index INT NOT NULL,
+    UNIQUE(tx_hash, log_index)
+);
+
+CREATE INDEX IF NOT EXISTS idx_market_events_market ON market_events(market_id, block_number, log_index);
+CREATE INDEX IF NOT EXISTS idx_market_events_block ON market_events(block_number);
+
+-- ============================================
+-- Payout details
+-- ============================================
+
+ALTER TABLE payouts ADD COLUMN IF NOT EXISTS outcome INT;
+ALTER TABLE payouts ADD COLUMN IF NOT EXISTS shares NUMERIC(78, 0);
+ALTER TABLE payouts ADD COLUMN IF NOT EXISTS event_type VARCHAR(30) NOT NULL DEFAULT 'PayoutClaimed';
+
+CREATE INDEX IF NOT EXISTS idx_payouts_block ON payouts(block_number);
+
+-- ============================================
+-- Reorg tracking
+-- ============================================
+
+-- 最近已处理区块的哈希（含日志的区块与每个扫描区间的最后一个区块），用于检测链重组
+CREATE TABLE IF NOT EXISTS indexer_blocks (
+    block_number BIGINT PRIMARY KEY,
+    block_hash VARCHAR(66) NOT NULL,
+    parent_hash VARCHAR(66) NOT NULL,
+    block_time BIGINT NOT NULL
+);
+
+-- ============================================
+-- Schema Version
+-- ============================================
+
+INSERT INTO schema_version (version, description) VALUES (2, 'Indexer lifecycle events and reorg tracking') ON CONFLICT DO NOTHING;
@@ -155,6 +155,42 @@ CREATE TABLE IF NOT EXISTS alert_logs (
 CREATE INDEX idx_alert_logs_level ON alert_logs(level);
 CREATE INDEX idx_alert_logs_created ON alert_logs(created_at DESC);
 
+-- Manual result overrides (two-person approval)
+CREATE TABLE IF NOT EXISTS result_overrides (
+    id SERIAL PRIMARY KEY,
+    market_address VARCHAR(42) NOT NULL,
+    home_goals SMALLINT NOT NULL,
+    away_goals SMALLINT NOT NULL,
+    extra_time BOOLEAN NOT NULL DEFAULT FALSE,
+    reason TEXT NOT NULL,
+    evidence_url TEXT NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    created_by VARCHAR(100) NOT NULL,
+    created_at BIGINT NOT NULL,
+    approved_by VARCHAR(100),
+    approved_at BIGINT,
+    applied_at BIGINT,
+    settle_tx_hash VARCHAR(66),
+    CONSTRAINT valid_override_status CHECK (status IN ('pending', 'approved', 'rejected', 'applied')),
+    CONSTRAINT valid_override_goals CHECK (home_goals >= 0 AND away_goals >= 0),
+    CONSTRAINT override_second_approver CHECK (approved_by IS NULL OR approved_by <> created_by)
+);
+
+CREATE INDEX idx_result_overrides_market ON result_overrides(market_address);
+CREATE INDEX idx_result_overrides_status ON result_overrides(status);
+CREATE UNIQUE INDEX idx_result_overrides_active ON result_overrides(market_address) WHERE status IN ('pending', 'approved');
+
+CREATE TABLE IF NOT EXISTS result_override_events (
+    id SERIAL PRIMARY KEY,
+    override_id INT NOT NULL REFERENCES result_overrides(id) ON DELETE CASCADE,
+    action VARCHAR(20) NOT NULL,
+    actor VARCHAR(100) NOT NULL,
+    note TEXT,
+    created_at BIGINT NOT NULL
+);
+
+CREATE INDEX idx_result_override_events_override ON result_override_events(override_id);
+
 -- ============================================
 -- Rewards Tables
 -- ============================================
@@ -165,14 +201,18 @@ CREATE TABLE IF NOT EXISTS reward_distributions (
     total_amount NUMERIC(78, 0) NOT NULL,
     recipients INT NOT NULL,
     scale_bps INT NOT NULL,
+    category_scale_bps JSONB,
     created_at BIGINT NOT NULL,
     updated_at BIGINT,
     tx_hash VARCHAR(66),
     block_number BIGINT,
     published_at BIGINT,
     status VARCHAR(20) DEFAULT 'pending',
+    publish_error TEXT,
+    budget_status VARCHAR(20),
     CONSTRAINT valid_scale CHECK (scale_bps >= 1000 AND scale_bps <= 10000),
-    CONSTRAINT valid_recipients CHECK (recipients > 0)
+    CONSTRAINT valid_recipients CHECK (recipients > 0),
+    CONSTRAINT valid_budget_status CHECK (budget_status IN ('reserved', 'used'))
 );
 
 CREATE INDEX idx_reward_distributions_status ON reward_distributions(status);
@@ -195,6 +235,8 @@ CREATE INDEX idx_reward_entries_type ON reward_entries(reward_type);
 CREATE TABLE IF NOT EXISTS merkle_proofs (
     week BIGINT NOT NULL REFERENCES reward_distributions(week),
     user_address VARCHAR(42) NOT NULL,
+    amount NUMERIC(78, 0) NOT NULL,
+    leaf_index INT NOT NULL,
     proof JSONB NOT NULL,
     created_at BIGINT NOT NULL,
     PRIMARY KEY (week, user_address)
@@ -202,6 +244,50 @@ CREATE TABLE IF NOT EXISTS merkle_proofs (
 
 CREATE INDEX idx_merkle_proofs_user ON merkle_proofs(user_address);
 
+-- Campaign spending: one row per campaign and week, recorded on-chain after the week's root is published
+CREATE TABLE IF NOT EXISTS campaign_spending (
+    campaign_id VARCHAR(66) NOT NULL,
+    week BIGINT NOT NULL REFERENCES reward_distributions(week),
+    amount NUMERIC(78, 0) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    tx_hash VARCHAR(66),
+    attempts INT NOT NULL DEFAULT 0,
+    last_error TEXT,
+    updated_at BIGINT NOT NULL,
+    PRIMARY KEY (campaign_id, week),
+    CONSTRAINT valid_campaign_spending_status CHECK (status IN ('pending', 'recorded'))
+);
+
+CREATE INDEX idx_campaign_spending_pending ON campaign_spending(week) WHERE status = 'pending';
+
+-- Cumulative distributions: each epoch root commits to every user's lifetime total
+CREATE TABLE IF NOT EXISTS cumulative_distributions (
+    epoch BIGINT PRIMARY KEY,
+    merkle_root VARCHAR(66) NOT NULL,
+    total_amount NUMERIC(78, 0) NOT NULL,
+    epoch_amount NUMERIC(78, 0) NOT NULL,
+    recipients INT NOT NULL,
+    created_at BIGINT NOT NULL,
+    updated_at BIGINT,
+    tx_hash VARCHAR(66),
+    published_at BIGINT,
+    status VARCHAR(20) DEFAULT 'pending',
+    CONSTRAINT valid_cumulative_recipients CHECK (recipients > 0)
+);
+
+CREATE TABLE IF NOT EXISTS cumulative_proofs (
+    epoch BIGINT NOT NULL REFERENCES cumulative_distributions(epoch),
+    user_address VARCHAR(42) NOT NULL,
+    cumulative_amount NUMERIC(78, 0) NOT NULL,
+    epoch_amount NUMERIC(78, 0) NOT NULL,
+    leaf_index INT NOT NULL,
+    proof JSONB NOT NULL,
+    created_at BIGINT NOT NULL,
+    PRIMARY KEY (epoch, user_address)
+);
+
+CREATE INDEX idx_cumulative_proofs_user ON cumulative_proofs(user_address);
+
 -- ============================================
 -- Campaign & Quest Tables
 -- ============================================
@@ -509,6 +595,24 @@ BEGIN
 END;
 $$ LANGUAGE plpgsql;
 
+-- 覆盖记录的创建人与审批人取数据库会话用户，客户端无法指定
+CREATE OR REPLACE FUNCTION set_result_override_actors()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        NEW.created_by = session_user;
+    ELSE
+        NEW.created_by = OLD.created_by;
+        IF NEW.status = 'approved' AND OLD.status <> 'approved' THEN
+            NEW.approved_by = session_user;
+        ELSIF OLD.approved_by IS DISTINCT FROM NEW.approved_by THEN
+            NEW.approved_by = OLD.approved_by;
+        END IF;
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
 -- Triggers
 CREATE TRIGGER update_keeper_tasks_updated_at
     BEFORE UPDATE ON keeper_tasks
@@ -525,6 +629,11 @@ CREATE TRIGGER trigger_update_fixtures_updated_at
     FOR EACH ROW
     EXECUTE FUNCTION update_updated_at_column();
 
+CREATE TRIGGER trigger_result_override_actors
+    BEFORE INSERT OR UPDATE ON result_overrides
+    FOR EACH ROW
+    EXECUTE FUNCTION set_result_override_actors();
+
 -- ============================================
 -- Views
 -- ============================================
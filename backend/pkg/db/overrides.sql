@@ -0,0 +1,73 @@
+-- PitchOne Database Schema (V8): 人工赛果覆盖（双人审批）
+-- 为按旧版 init.sql 创建的数据库补齐 result_overrides 相关表与触发器，在 init.sql 之后执行，可重复执行
+-- Date: 2026-10-19
+
+-- ============================================
+-- Result overrides
+-- ============================================
+
+CREATE TABLE IF NOT EXISTS result_overrides (
+    id SERIAL PRIMARY KEY,
+    market_address VARCHAR(42) NOT NULL,
+    home_goals SMALLINT NOT NULL,
+    away_goals SMALLINT NOT NULL,
+    extra_time BOOLEAN NOT NULL DEFAULT FALSE,
+    reason TEXT NOT NULL,
+    evidence_url TEXT NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    created_by VARCHAR(100) NOT NULL,
+    created_at BIGINT NOT NULL,
+    approved_by VARCHAR(100),
+    approved_at BIGINT,
+    applied_at BIGINT,
+    settle_tx_hash VARCHAR(66),
+    CONSTRAINT valid_override_status CHECK (status IN ('pending', 'approved', 'rejected', 'applied')),
+    CONSTRAINT valid_override_goals CHECK (home_goals >= 0 AND away_goals >= 0),
+    CONSTRAINT override_second_approver CHECK (approved_by IS NULL OR approved_by <> created_by)
+);
+
+CREATE INDEX IF NOT EXISTS idx_result_overrides_market ON result_overrides(market_address);
+CREATE INDEX IF NOT EXISTS idx_result_overrides_status ON result_overrides(status);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_result_overrides_active ON result_overrides(market_address) WHERE status IN ('pending', 'approved');
+
+-- 审批轨迹
+CREATE TABLE IF NOT EXISTS result_override_events (
+    id SERIAL PRIMARY KEY,
+    override_id INT NOT NULL REFERENCES result_overrides(id) ON DELETE CASCADE,
+    action VARCHAR(20) NOT NULL,
+    actor VARCHAR(100) NOT NULL,
+    note TEXT,
+    created_at BIGINT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_result_override_events_override ON result_override_events(override_id);
+
+-- ============================================
+-- Override actors
+-- ============================================
+
+-- 覆盖记录的创建人与审批人取数据库会话用户，客户端无法指定
+CREATE OR REPLACE FUNCTION set_result_override_actors()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        NEW.created_by = session_user;
+    ELSE
+        NEW.created_by = OLD.created_by;
+        IF NEW.status = 'approved' AND OLD.status <> 'approved' THEN
+            NEW.approved_by = session_user;
+        ELSIF OLD.approved_by IS DISTINCT FROM NEW.approved_by THEN
+            NEW.approved_by = OLD.approved_by;
+        END IF;
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trigger_result_override_actors ON result_overrides;
+CREATE TRIGGER trigger_result_override_actors
+    BEFORE INSERT OR UPDATE ON result_overrides
+    FOR EACH ROW
+    EXECUTE FUNCTION set_result_override_actors();
+
+INSERT INTO schema_version (version, description) VALUES (8, 'Result overrides with two-person approval') ON CONFLICT DO NOTHING;
@@ -0,0 +1,170 @@
+-- PitchOne Database Schema (V3+): 奖励相关表的升级
+-- 为按旧版 init.sql 创建的数据库补齐列，在 init.sql 与 indexer.sql 之后执行，可重复执行
+-- Date: 2026-10-19
+
+-- ============================================
+-- V3: merkle_proofs.amount
+-- ============================================
+
+-- 证明对应的叶子金额，旧数据按 reward_entries 汇总回填
+ALTER TABLE merkle_proofs ADD COLUMN IF NOT EXISTS amount NUMERIC(78, 0);
+
+UPDATE merkle_proofs p
+SET amount = e.amount
+FROM (
+    SELECT week, user_address, SUM(amount) AS amount
+    FROM reward_entries
+    GROUP BY week, user_address
+) e
+WHERE p.amount IS NULL
+  AND e.week = p.week
+  AND e.user_address = p.user_address;
+
+-- 无法回填金额的证明（reward_entries 中没有对应条目）无法用于领取，
+-- 移入 merkle_proofs_unmatched 后再加 NOT NULL 约束，重新生成对应周的分配即可恢复
+CREATE TABLE IF NOT EXISTS merkle_proofs_unmatched (
+    week BIGINT NOT NULL,
+    user_address VARCHAR(42) NOT NULL,
+    proof JSONB NOT NULL,
+    created_at BIGINT NOT NULL,
+    moved_at BIGINT NOT NULL,
+    PRIMARY KEY (week, user_address)
+);
+
+DO $$
+DECLARE
+    moved_count INT;
+    moved_weeks TEXT;
+BEGIN
+    WITH moved AS (
+        DELETE FROM merkle_proofs
+        WHERE amount IS NULL
+        RETURNING week, user_address, proof, created_at
+    ), saved AS (
+        INSERT INTO merkle_proofs_unmatched (week, user_address, proof, created_at, moved_at)
+        SELECT week, user_address, proof, created_at, EXTRACT(EPOCH FROM NOW())::BIGINT FROM moved
+        ON CONFLICT (week, user_address) DO UPDATE SET
+            proof = EXCLUDED.proof,
+            created_at = EXCLUDED.created_at,
+            moved_at = EXCLUDED.moved_at
+        RETURNING week
+    )
+    SELECT COUNT(*), string_agg(DISTINCT week::TEXT, ', ') INTO moved_count, moved_weeks FROM saved;
+
+    IF moved_count > 0 THEN
+        RAISE WARNING '% merkle proofs without a matching reward_entries amount were moved to merkle_proofs_unmatched (weeks: %); regenerate these weeks',
+            moved_count, moved_weeks;
+    END IF;
+END $$;
+
+ALTER TABLE merkle_proofs ALTER COLUMN amount SET NOT NULL;
+
+INSERT INTO schema_version (version, description) VALUES (3, 'Merkle proof leaf amounts') ON CONFLICT DO NOTHING;
+
+-- ============================================
+-- V4: merkle_proofs.leaf_index
+-- ============================================
+
+-- 叶子在按哈希排序后的下标。数据库无法计算 keccak256，旧数据保持 NULL，
+-- 读取时视为未知（-1），重新生成该周分配后写入
+ALTER TABLE merkle_proofs ADD COLUMN IF NOT EXISTS leaf_index INT;
+
+INSERT INTO schema_version (version, description) VALUES (4, 'Merkle proof leaf indexes') ON CONFLICT DO NOTHING;
+
+-- ============================================
+-- V5: reward_distributions.category_scale_bps
+-- ============================================
+
+-- 各奖励类别的预算缩放比例（JSON: 类别 -> bps），旧数据为 NULL 表示未按类别缩放
+ALTER TABLE reward_distributions ADD COLUMN IF NOT EXISTS category_scale_bps JSONB;
+
+INSERT INTO schema_version (version, description) VALUES (5, 'Per-category reward scale') ON CONFLICT DO NOTHING;
+
+-- ============================================
+-- V6: campaign_spending
+-- ============================================
+
+-- 每个活动每周的预算扣减，Root 发布后调用 Campaign.recordSpending，记录成功前一直重试
+CREATE TABLE IF NOT EXISTS campaign_spending (
+    campaign_id VARCHAR(66) NOT NULL,
+    week BIGINT NOT NULL REFERENCES reward_distributions(week),
+    amount NUMERIC(78, 0) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    tx_hash VARCHAR(66),
+    attempts INT NOT NULL DEFAULT 0,
+    last_error TEXT,
+    updated_at BIGINT NOT NULL,
+    PRIMARY KEY (campaign_id, week),
+    CONSTRAINT valid_campaign_spending_status CHECK (status IN ('pending', 'recorded'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_campaign_spending_pending ON campaign_spending(week) WHERE status = 'pending';
+
+INSERT INTO schema_version (version, description) VALUES (6, 'Campaign spending records') ON CONFLICT DO NOTHING;
+
+-- ============================================
+-- V7: reward_distributions.publish_error
+-- ============================================
+
+-- 发布状态：pending（已保存未发布）、published（Root 已上链确认）、failed（发布失败，下次运行重试）
+-- publish_error 保存最近一次发布失败的原因
+ALTER TABLE reward_distributions ADD COLUMN IF NOT EXISTS publish_error TEXT;
+
+INSERT INTO schema_version (version, description) VALUES (7, 'Reward distribution publish errors') ON CONFLICT DO NOTHING;
+
+-- ============================================
+-- V9: reward_distributions.budget_status
+-- ============================================
+
+-- PayoutScaler 预算状态：reserved（发布或导出 Safe 交易前已 calculateScale）、
+-- used（Root 上链后已 markBudgetUsed）。markBudgetUsed 不幂等，只对 reserved 的已发布周调用一次；
+-- 旧数据为 NULL，不会被补扣
+ALTER TABLE reward_distributions ADD COLUMN IF NOT EXISTS budget_status VARCHAR(20);
+
+DO $$
+BEGIN
+    IF NOT EXISTS (
+        SELECT 1 FROM pg_constraint
+        WHERE conname = 'valid_budget_status' AND conrelid = 'reward_distributions'::regclass
+    ) THEN
+        ALTER TABLE reward_distributions
+            ADD CONSTRAINT valid_budget_status CHECK (budget_status IN ('reserved', 'used'));
+    END IF;
+END $$;
+
+INSERT INTO schema_version (version, description) VALUES (9, 'Reward distribution budget status') ON CONFLICT DO NOTHING;
+
+-- ============================================
+-- V10: cumulative_distributions / cumulative_proofs
+-- ============================================
+
+-- 累计快照：每个 epoch 的 Root 承诺每个用户截至该 epoch 的终身累计奖励总额。
+-- 只保存快照，没有累计分发合约，累计 Root 不会上链
+CREATE TABLE IF NOT EXISTS cumulative_distributions (
+    epoch BIGINT PRIMARY KEY,
+    merkle_root VARCHAR(66) NOT NULL,
+    total_amount NUMERIC(78, 0) NOT NULL,
+    epoch_amount NUMERIC(78, 0) NOT NULL,
+    recipients INT NOT NULL,
+    created_at BIGINT NOT NULL,
+    updated_at BIGINT,
+    tx_hash VARCHAR(66),
+    published_at BIGINT,
+    status VARCHAR(20) DEFAULT 'pending',
+    CONSTRAINT valid_cumulative_recipients CHECK (recipients > 0)
+);
+
+CREATE TABLE IF NOT EXISTS cumulative_proofs (
+    epoch BIGINT NOT NULL REFERENCES cumulative_distributions(epoch),
+    user_address VARCHAR(42) NOT NULL,
+    cumulative_amount NUMERIC(78, 0) NOT NULL,
+    epoch_amount NUMERIC(78, 0) NOT NULL,
+    leaf_index INT NOT NULL,
+    proof JSONB NOT NULL,
+    created_at BIGINT NOT NULL,
+    PRIMARY KEY (epoch, user_address)
+);
+
+CREATE INDEX IF NOT EXISTS idx_cumulative_proofs_user ON cumulative_proofs(user_address);
+
+INSERT INTO schema_version (version, description) VALUES (10, 'Cumulative reward snapshots') ON CONFLICT DO NOTHING;
@@ -0,0 +1,101 @@
+-- PitchOne Database Schema (V2, optional): TimescaleDB
+-- 在 init.sql 与 indexer.sql 之后执行，需要 TimescaleDB 2.x；可重复执行
+-- Date: 2026-10-19
+--
+-- orders 的主键与唯一约束不含时间列，无法直接转换为 hypertable，
+-- 因此由触发器同步到 order_ticks（hypertable），并在其上建立连续聚合。
+-- query 的 SQL 数据源检测到 order_volume_daily / market_volume_daily 时改为读取聚合视图。
+
+CREATE EXTENSION IF NOT EXISTS timescaledb;
+
+-- ============================================
+-- Order ticks (hypertable)
+-- ============================================
+
+CREATE TABLE IF NOT EXISTS order_ticks (
+    time TIMESTAMPTZ NOT NULL,
+    market_id VARCHAR(66) NOT NULL,
+    user_address VARCHAR(42) NOT NULL,
+    outcome INT NOT NULL,
+    stake NUMERIC(78, 0) NOT NULL,
+    fee NUMERIC(78, 0) NOT NULL,
+    block_number BIGINT NOT NULL,
+    tx_hash VARCHAR(66) NOT NULL,
+    log_index INT NOT NULL,
+    PRIMARY KEY (tx_hash, log_index, time)
+);
+
+SELECT create_hypertable('order_ticks', 'time', chunk_time_interval => INTERVAL '7 days', if_not_exists => TRUE);
+
+CREATE INDEX IF NOT EXISTS idx_order_ticks_market ON order_ticks(market_id, time DESC);
+
+-- 同步 orders 的插入与删除（链重组回滚时 indexer 删除 orders 行）
+CREATE OR REPLACE FUNCTION sync_order_ticks() RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        INSERT INTO order_ticks (time, market_id, user_address, outcome, stake, fee, block_number, tx_hash, log_index)
+        VALUES (to_timestamp(NEW.timestamp), NEW.market_id, NEW.user_address, NEW.outcome, NEW.stake, NEW.fee,
+                NEW.block_number, NEW.tx_hash, NEW.log_index)
+        ON CONFLICT DO NOTHING;
+        RETURN NEW;
+    END IF;
+
+    DELETE FROM order_ticks
+    WHERE tx_hash = OLD.tx_hash AND log_index = OLD.log_index AND time = to_timestamp(OLD.timestamp);
+    RETURN OLD;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_sync_order_ticks ON orders;
+CREATE TRIGGER trg_sync_order_ticks
+    AFTER INSERT OR DELETE ON orders
+    FOR EACH ROW EXECUTE FUNCTION sync_order_ticks();
+
+-- 回填已有订单
+INSERT INTO order_ticks (time, market_id, user_address, outcome, stake, fee, block_number, tx_hash, log_index)
+SELECT to_timestamp(timestamp), market_id, user_address, outcome, stake, fee, block_number, tx_hash, log_index
+FROM orders
+ON CONFLICT DO NOTHING;
+
+-- ============================================
+-- Continuous aggregates
+-- ============================================
+
+-- 每日每用户交易量：按日 / 周 / 月汇总交易量、订单数与去重用户数
+CREATE MATERIALIZED VIEW IF NOT EXISTS order_volume_daily
+WITH (timescaledb.continuous) AS
+SELECT
+    time_bucket(INTERVAL '1 day', time) AS bucket,
+    user_address,
+    SUM(stake) AS volume,
+    SUM(fee) AS fees,
+    COUNT(*) AS order_count
+FROM order_ticks
+GROUP BY bucket, user_address
+WITH NO DATA;
+
+-- 每日每市场交易量：热门市场排名
+CREATE MATERIALIZED VIEW IF NOT EXISTS market_volume_daily
+WITH (timescaledb.continuous) AS
+SELECT
+    time_bucket(INTERVAL '1 day', time) AS bucket,
+    market_id,
+    SUM(stake) AS volume,
+    COUNT(*) AS order_count
+FROM order_ticks
+GROUP BY bucket, market_id
+WITH NO DATA;
+
+-- 实时聚合：尚未物化的区间直接读取 order_ticks
+ALTER MATERIALIZED VIEW order_volume_daily SET (timescaledb.materialized_only = false);
+ALTER MATERIALIZED VIEW market_volume_daily SET (timescaledb.materialized_only = false);
+
+SELECT add_continuous_aggregate_policy('order_volume_daily',
+    start_offset => INTERVAL '3 days', end_offset => INTERVAL '1 hour',
+    schedule_interval => INTERVAL '30 minutes', if_not_exists => TRUE);
+SELECT add_continuous_aggregate_policy('market_volume_daily',
+    start_offset => INTERVAL '3 days', end_offset => INTERVAL '1 hour',
+    schedule_interval => INTERVAL '30 minutes', if_not_exists => TRUE);
+
+CALL refresh_continuous_aggregate('order_volume_daily', NULL, NULL);
+CALL refresh_continuous_aggregate('market_volume_daily', NULL, NULL);
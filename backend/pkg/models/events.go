@@ -20,7 +20,7 @@ type Event struct {
 type MarketCreatedEvent struct {
 	Event
 	MarketAddress common.Address
-	TemplateID    common.Address
+	TemplateID    common.Hash // MarketFactory_V3 的 bytes32 模板 ID
 	MatchID       string
 	HomeTeam      string
 	AwayTeam      string
@@ -59,7 +59,7 @@ type ResolvedEvent struct {
 	ResultHash     common.Hash
 }
 
-// RedeemedEvent 兑付事件
+// RedeemedEvent 兑付事件（PayoutClaimed；取消市场的 RefundClaimed 以 EventType 区分）
 type RedeemedEvent struct {
 	Event
 	MarketAddress common.Address
@@ -75,3 +75,10 @@ type FinalizedEvent struct {
 	MarketAddress common.Address
 	FinalizeTime  uint64
 }
+
+// CancelledEvent 市场取消事件
+type CancelledEvent struct {
+	Event
+	MarketAddress common.Address
+	Reason        string
+}
@@ -0,0 +1,19 @@
+// Package results 比赛结果的链上编码，供 Keeper 结算与 admin 运维交易共用
+package results
+
+import "math/big"
+
+// EncodeMatchResult 将比分编码为 abi.encode(uint256 homeScore, uint256 awayScore)，
+// 即 IResultMapper 接受的 rawResult
+func EncodeMatchResult(homeGoals, awayGoals uint8) ([]byte, error) {
+	// 两个 uint256 各占 32 字节，大端左侧补零
+	result := make([]byte, 64)
+
+	homeBytes := big.NewInt(int64(homeGoals)).Bytes()
+	copy(result[32-len(homeBytes):32], homeBytes)
+
+	awayBytes := big.NewInt(int64(awayGoals)).Bytes()
+	copy(result[64-len(awayBytes):64], awayBytes)
+
+	return result, nil
+}